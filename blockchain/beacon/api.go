@@ -20,7 +20,6 @@ import (
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	ssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
-	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	prysmTypes "github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
@@ -130,7 +129,7 @@ func (c *APIClient) requestClientVersion() (string, error) {
 	return strings.ToLower(nodeVersionBody.Data.Version), nil
 }
 
-func (c *APIClient) requestBlock(hash string) (interfaces.ReadOnlySignedBeaconBlock, error) {
+func (c *APIClient) requestBlock(hash string) (signedBeaconBlock, error) {
 	uri := fmt.Sprintf(requestBlockRoute, c.URL, hash)
 	req, err := c.newRequest(uri)
 	if err != nil {
@@ -174,11 +173,15 @@ func (c *APIClient) doRequest(req *http.Request) ([]byte, string, error) {
 	return respBodyRaw, resp.Header.Get("Eth-Consensus-Version"), nil
 }
 
-func (c *APIClient) processResponse(respBodyRaw []byte, v, hash string) (interfaces.ReadOnlySignedBeaconBlock, error) {
+func (c *APIClient) processResponse(respBodyRaw []byte, v, hash string) (signedBeaconBlock, error) {
 	var rawBlock ssz.Unmarshaler
 	switch v {
+	case types.BeaconVersionString(types.VersionElectra):
+		rawBlock = &types.SignedBeaconBlockElectra{}
+	case types.BeaconVersionString(types.VersionDeneb):
+		rawBlock = &types.SignedBeaconBlockDeneb{}
 	case version.String(version.Bellatrix):
-		rawBlock = &ethpb.SignedBlindedBeaconBlockBellatrix{}
+		rawBlock = &ethpb.SignedBeaconBlockBellatrix{}
 	case version.String(version.Altair):
 		rawBlock = &ethpb.SignedBeaconBlockAltair{}
 	case version.String(version.Phase0):
@@ -192,6 +195,11 @@ func (c *APIClient) processResponse(respBodyRaw []byte, v, hash string) (interfa
 		return nil, fmt.Errorf("[hash=%s,version=%s], failed to unmarshal response body: %s, err: %v", hash, v, string(respBodyRaw), err)
 	}
 
+	// The blocks of the forks after Capella are not supported by prysm and are used as is
+	if nativeBlock, ok := rawBlock.(types.BeaconBlock); ok {
+		return nativeBlock, nil
+	}
+
 	return blocks.NewSignedBeaconBlock(rawBlock)
 }
 
@@ -271,23 +279,24 @@ func (c *APIClient) eventHandler() func(msg *sse.Event) {
 			return
 		}
 
+		slot := blockSlot(block)
 		blockHash, err := c.hashOfBlock(block)
 		if (err != nil) || (blockHash != data.Block) {
-			c.log.Errorf("could not approve beacon block[slot=%d,hash=%s]: %v", block.Block().Slot(), data.Block, err)
+			c.log.Errorf("could not approve beacon block[slot=%d,hash=%s]: %v", slot, data.Block, err)
 			return
 		}
 
 		if c.isOldBlock(block) {
-			c.log.Errorf("block[slot=%d,hash=%s] is too old to process", block.Block().Slot(), blockHash)
+			c.log.Errorf("block[slot=%d,hash=%s] is too old to process", slot, blockHash)
 			return
 		}
 
-		if err := sendBlockToBDN(c.clock, c.log, block, c.bridge, *c.nodeEndpoint); err != nil {
-			c.log.Errorf("could not proccess beacon block[slot=%d,hash=%s] to eth: %v", block.Block().Slot(), blockHash, err)
+		if err := sendBlockToBDN(c.clock, c.log, block, nil, c.bridge, *c.nodeEndpoint); err != nil {
+			c.log.Errorf("could not proccess beacon block[slot=%d,hash=%s] to eth: %v", slot, blockHash, err)
 			return
 		}
 
-		c.log.Tracef("received beacon block[slot=%d,hash=%s]", block.Block().Slot(), blockHash)
+		c.log.Tracef("received beacon block[slot=%d,hash=%s]", slot, blockHash)
 	}
 }
 
@@ -299,8 +308,8 @@ func (c *APIClient) unmarshalEvent(eventData []byte) (headEventData, error) {
 }
 
 // hashOfBlock returns the hash of a beacon block.
-func (c *APIClient) hashOfBlock(block interfaces.ReadOnlySignedBeaconBlock) (string, error) {
-	blockHash, err := block.Block().HashTreeRoot()
+func (c *APIClient) hashOfBlock(block signedBeaconBlock) (string, error) {
+	blockHash, err := blockRoot(block)
 	if err != nil {
 		return "", err
 	}
@@ -308,19 +317,20 @@ func (c *APIClient) hashOfBlock(block interfaces.ReadOnlySignedBeaconBlock) (str
 }
 
 // isOldBlock checks whether a beacon block is too old to be processed.
-func (c *APIClient) isOldBlock(block interfaces.ReadOnlySignedBeaconBlock) bool {
-	return block.Block().Slot() <= currentSlot(c.config.GenesisTime)-prysmTypes.Slot(c.config.IgnoreSlotCount)
+func (c *APIClient) isOldBlock(block signedBeaconBlock) bool {
+	return blockSlot(block) <= currentSlot(c.config.GenesisTime)-prysmTypes.Slot(c.config.IgnoreSlotCount)
 }
 
-// BroadcastBlock sends the block in octet-stream format to the beacon API endpoint
-func (c *APIClient) BroadcastBlock(block interfaces.ReadOnlySignedBeaconBlock) error {
+// BroadcastBlock sends the block in octet-stream format to the beacon API endpoint, starting from Deneb
+// the block is sent with the blobs of the sidecars
+func (c *APIClient) BroadcastBlock(block signedBeaconBlock, blobSidecars []*types.BlobSidecar) error {
 	if !c.initilized.Load() {
 		return fmt.Errorf("unknown client version")
 	}
 
 	uri := fmt.Sprintf(broadcastBlockRoute, c.URL)
 
-	rawBlock, err := c.blockEncoder.encodeBlock(block)
+	rawBlock, err := c.blockEncoder.encodeBlock(block, blobSidecars)
 	if err != nil {
 		return fmt.Errorf("failed to prepare block: %v", err)
 	}
@@ -330,7 +340,7 @@ func (c *APIClient) BroadcastBlock(block interfaces.ReadOnlySignedBeaconBlock) e
 		return fmt.Errorf("failed to create new request: %v", err)
 	}
 
-	req.Header.Set("Eth-Consensus-Version", version.String(block.Version()))
+	req.Header.Set("Eth-Consensus-Version", types.BeaconVersionString(block.Version()))
 	req.Header.Set("Content-Type", c.blockEncoder.contentType())
 
 	resp, err := c.httpClient.Do(req)
@@ -12,7 +12,10 @@ import (
 
 	"github.com/bloXroute-Labs/gateway/v2/blockchain"
 	"github.com/bloXroute-Labs/gateway/v2/blockchain/network"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
 	httpclient "github.com/bloXroute-Labs/gateway/v2/utils/httpclient"
+	"github.com/ethereum/go-ethereum/common"
 	httpmock "github.com/jarcoal/httpmock"
 	fieldparams "github.com/prysmaticlabs/prysm/v4/config/fieldparams"
 	blocks "github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
@@ -170,7 +173,7 @@ func TestAPIClient_hashOfBlock(t *testing.T) {
 
 	tests := []struct {
 		name         string
-		block        interfaces.ReadOnlySignedBeaconBlock
+		block        signedBeaconBlock
 		wantFuncErr  bool
 		expectedHash string
 		wantHashErr  bool
@@ -257,6 +260,41 @@ func TestAPIClient_processResponse(t *testing.T) {
 	}
 }
 
+func TestAPIClient_processResponseDeneb(t *testing.T) {
+	// Initialize httpmock
+	httpClient := httpclient.Client(nil)
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	// Mock the getBeaconNodeClientVersion function
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("http://%s/eth/v1/node/version", url),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{"data":{"version":"mocked-version"}}`), nil
+		},
+	)
+
+	// Initialize Beacon API client
+	client, err := NewAPIClient(ctx, httpClient, config, bridge, url, blockchainNetwork)
+	assert.Nil(t, err)
+
+	denebBlock := bxmock.NewDenebBeaconBlock(t, 11, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil))
+	denebBlockData, err := denebBlock.MarshalSSZ()
+	assert.Nil(t, err)
+
+	block, err := client.processResponse(denebBlockData, "deneb", "")
+	assert.Nil(t, err)
+	assert.IsType(t, &types.SignedBeaconBlockDeneb{}, block)
+
+	expectedHash, err := denebBlock.BlockRoot()
+	assert.Nil(t, err)
+	hash, err := client.hashOfBlock(block)
+	assert.Nil(t, err)
+	assert.Equal(t, common.BytesToHash(expectedHash[:]).String(), hash)
+
+	_, err = client.processResponse(denebBlockData, "electra", "")
+	assert.NotNil(t, err)
+}
+
 func TestAPIClient_broadcastBlock(t *testing.T) {
 	// Initialize httpmock
 	httpClient := httpclient.Client(nil)
@@ -294,7 +332,7 @@ func TestAPIClient_broadcastBlock(t *testing.T) {
 		},
 	)
 
-	err = client.BroadcastBlock(block)
+	err = client.BroadcastBlock(block, nil)
 	if err != nil {
 		t.Errorf("Expected no error, but got: %v", err)
 	}
@@ -307,8 +345,52 @@ func TestAPIClient_broadcastBlock(t *testing.T) {
 		},
 	)
 
-	err = client.BroadcastBlock(block)
+	err = client.BroadcastBlock(block, nil)
 	if err == nil {
 		t.Error("Expected an error, but got none")
 	}
 }
+
+func TestAPIClient_broadcastDenebBlock(t *testing.T) {
+	// Initialize httpmock
+	httpClient := httpclient.Client(nil)
+	httpmock.ActivateNonDefault(httpClient)
+	defer httpmock.DeactivateAndReset()
+
+	// Mock the getBeaconNodeClientVersion function
+	httpmock.RegisterResponder(http.MethodGet, fmt.Sprintf("http://%s/eth/v1/node/version", url),
+		func(req *http.Request) (*http.Response, error) {
+			return httpmock.NewStringResponse(http.StatusOK, `{"data":{"version":"mocked-version"}}`), nil
+		},
+	)
+
+	// Initialize Beacon API client
+	client, err := NewAPIClient(ctx, httpClient, config, bridge, url, blockchainNetwork)
+	assert.Nil(t, err)
+	client.Start()
+	time.Sleep(time.Second)
+
+	block := bxmock.NewDenebBeaconBlock(t, 11, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil))
+	mockRawBlock, err := (&types.SignedBlockContentsDeneb{SignedBlock: block}).MarshalSSZ()
+	assert.Nil(t, err)
+
+	httpmock.RegisterResponder(http.MethodPost, fmt.Sprintf("http://%s/eth/v1/beacon/blocks", url),
+		func(req *http.Request) (*http.Response, error) {
+			if req.Header.Get("Eth-Consensus-Version") != "deneb" {
+				t.Errorf("Expected consensus version to be 'deneb', but got '%s'", req.Header.Get("Eth-Consensus-Version"))
+			}
+
+			reqBody, _ := io.ReadAll(req.Body)
+			if !bytes.Equal(reqBody, mockRawBlock) {
+				t.Errorf("Expected request body of %d bytes, but got %d bytes", len(mockRawBlock), len(reqBody))
+			}
+
+			return httpmock.NewStringResponse(http.StatusOK, ""), nil
+		},
+	)
+
+	err = client.BroadcastBlock(block, nil)
+	if err != nil {
+		t.Errorf("Expected no error, but got: %v", err)
+	}
+}
@@ -0,0 +1,89 @@
+package beacon
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	prysmTypes "github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+)
+
+// blobSidecarSubnetTopicFormat is the topic of a blob sidecar subnet, the digest is formatted when subscribing
+const blobSidecarSubnetTopicFormat = "/eth2/%%x/blob_sidecar_%d"
+
+// validateBlobSidecar validates the blob sidecar received over the subnet, the KZG proof of the blob is not verified
+func validateBlobSidecar(sidecar *types.BlobSidecar, subnet uint64, fork beaconFork) error {
+	if sidecar.Index >= fork.maxBlobsPerBlock {
+		return fmt.Errorf("index %v exceeds the max blobs per block %v", sidecar.Index, fork.maxBlobsPerBlock)
+	}
+
+	if sidecar.Index%fork.blobSidecarSubnets != subnet {
+		return fmt.Errorf("index %v does not belong to subnet %v", sidecar.Index, subnet)
+	}
+
+	return sidecar.VerifyInclusionProof()
+}
+
+type blockBlobSidecars struct {
+	slot     prysmTypes.Slot
+	sidecars map[uint64]*types.BlobSidecar
+}
+
+// blobSidecarCache keeps the blob sidecars of the recent blocks by the block root,
+// so the sidecars received before the block are sent to the BDN with the block
+type blobSidecarCache struct {
+	blocks map[[32]byte]*blockBlobSidecars
+	mu     sync.Mutex
+}
+
+func newBlobSidecarCache() *blobSidecarCache {
+	return &blobSidecarCache{
+		blocks: make(map[[32]byte]*blockBlobSidecars),
+	}
+}
+
+// add stores the sidecar and returns false if the sidecar of the index is already stored.
+// The blocks before minSlot are removed
+func (c *blobSidecarCache) add(blockRoot [32]byte, sidecar *types.BlobSidecar, minSlot prysmTypes.Slot) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for root, block := range c.blocks {
+		if block.slot < minSlot {
+			delete(c.blocks, root)
+		}
+	}
+
+	block, ok := c.blocks[blockRoot]
+	if !ok {
+		block = &blockBlobSidecars{slot: sidecar.Slot(), sidecars: make(map[uint64]*types.BlobSidecar)}
+		c.blocks[blockRoot] = block
+	}
+
+	if _, ok := block.sidecars[sidecar.Index]; ok {
+		return false
+	}
+
+	block.sidecars[sidecar.Index] = sidecar
+	return true
+}
+
+// get returns the sidecars of the block sorted by the index
+func (c *blobSidecarCache) get(blockRoot [32]byte) []*types.BlobSidecar {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	block, ok := c.blocks[blockRoot]
+	if !ok {
+		return nil
+	}
+
+	sidecars := make([]*types.BlobSidecar, 0, len(block.sidecars))
+	for _, sidecar := range block.sidecars {
+		sidecars = append(sidecars, sidecar)
+	}
+	sort.Slice(sidecars, func(i, j int) bool { return sidecars[i].Index < sidecars[j].Index })
+
+	return sidecars
+}
@@ -0,0 +1,56 @@
+package beacon
+
+import (
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/test"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBlobSidecar(t *testing.T) {
+	block := bxmock.NewDenebBeaconBlock(t, 10, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil), bxmock.NewSignedBlobTx(2, nil))
+	sidecars := bxmock.NewBlobSidecars(t, block)
+	require.Len(t, sidecars, 2)
+
+	fork := postCapellaForks["Mainnet"][0]
+
+	for _, sidecar := range sidecars {
+		assert.NoError(t, validateBlobSidecar(sidecar, sidecar.Index, fork))
+	}
+
+	// the sidecar is gossiped over the subnet of another index
+	assert.Error(t, validateBlobSidecar(sidecars[0], 1, fork))
+
+	// the commitment is not included in the block
+	sidecar := *sidecars[0]
+	sidecar.KzgCommitment = test.GenerateBytes(48)
+	assert.Error(t, validateBlobSidecar(&sidecar, 0, fork))
+
+	// the index exceeds the blobs of the fork
+	sidecar = *sidecars[0]
+	sidecar.Index = fork.maxBlobsPerBlock
+	assert.Error(t, validateBlobSidecar(&sidecar, 0, fork))
+}
+
+func TestBlobSidecarCache(t *testing.T) {
+	block := bxmock.NewDenebBeaconBlock(t, 10, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil), bxmock.NewSignedBlobTx(2, nil))
+	sidecars := bxmock.NewBlobSidecars(t, block)
+	blockRoot, err := block.BlockRoot()
+	require.NoError(t, err)
+
+	cache := newBlobSidecarCache()
+	assert.True(t, cache.add(blockRoot, sidecars[1], 0))
+	assert.True(t, cache.add(blockRoot, sidecars[0], 0))
+	assert.False(t, cache.add(blockRoot, sidecars[0], 0))
+
+	// the sidecars are sorted by the index
+	assert.Equal(t, sidecars, cache.get(blockRoot))
+	assert.Nil(t, cache.get([32]byte{}))
+
+	// the blocks before the min slot are removed
+	assert.True(t, cache.add([32]byte{1}, sidecars[0], block.Slot()+1))
+	assert.Nil(t, cache.get(blockRoot))
+}
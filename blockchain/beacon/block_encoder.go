@@ -3,20 +3,22 @@ package beacon
 import (
 	"encoding/json"
 	"fmt"
+	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 
+	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/ethereum/go-ethereum/common/hexutil"
-	"github.com/prysmaticlabs/prysm/v4/beacon-chain/rpc/apimiddleware"
+	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
-	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
 	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
-	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
-	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 )
 
 type consensusBlockEncoder interface {
 	contentType() string
-	encodeBlock(block interfaces.ReadOnlySignedBeaconBlock) ([]byte, error)
+	encodeBlock(block signedBeaconBlock, blobSidecars []*types.BlobSidecar) ([]byte, error)
 }
 
 func newSSZConsensusBlockEncoder() consensusBlockEncoder {
@@ -33,8 +35,20 @@ func (c *sszConsensusBlockEncoder) contentType() string {
 	return "application/octet-stream"
 }
 
-func (c *sszConsensusBlockEncoder) encodeBlock(block interfaces.ReadOnlySignedBeaconBlock) ([]byte, error) {
-	rawBlock, err := block.MarshalSSZ()
+func (c *sszConsensusBlockEncoder) encodeBlock(block signedBeaconBlock, blobSidecars []*types.BlobSidecar) ([]byte, error) {
+	var msg fastssz.Marshaler = block
+
+	// Starting from Deneb the block is published with its blobs
+	switch b := block.(type) {
+	case *types.SignedBeaconBlockDeneb:
+		kzgProofs, blobs := blobsOfSidecars(blobSidecars)
+		msg = &types.SignedBlockContentsDeneb{SignedBlock: b, KzgProofs: kzgProofs, Blobs: blobs}
+	case *types.SignedBeaconBlockElectra:
+		kzgProofs, blobs := blobsOfSidecars(blobSidecars)
+		msg = &types.SignedBlockContentsElectra{SignedBlock: b, KzgProofs: kzgProofs, Blobs: blobs}
+	}
+
+	rawBlock, err := msg.MarshalSSZ()
 	if err != nil {
 		return nil, fmt.Errorf("failed to marshal block %v", err)
 	}
@@ -47,233 +61,150 @@ func (c *jsonConsensusBlockEncoder) contentType() string {
 	return "application/json"
 }
 
-func (c *jsonConsensusBlockEncoder) encodeBlock(block interfaces.ReadOnlySignedBeaconBlock) ([]byte, error) {
-	var signedCapella *ethpb.SignedBeaconBlockCapella
-	signedCapella, err := block.PbCapellaBlock()
-	if err != nil {
-		return nil, fmt.Errorf("failed to get generic capella: %v", err)
-	}
-	rawBlock, err := marshallBeaconBlockCapella(signedCapella)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshall blinded capella beacon block: %v", err)
+func (c *jsonConsensusBlockEncoder) encodeBlock(block signedBeaconBlock, blobSidecars []*types.BlobSidecar) ([]byte, error) {
+	v := block.Version()
+
+	var msg interface{}
+	switch v {
+	case version.Phase0, version.Altair, version.Bellatrix, version.Capella:
+		prysmBlock, ok := block.(interfaces.ReadOnlySignedBeaconBlock)
+		if !ok {
+			return nil, fmt.Errorf("unsupported %v beacon block %T", types.BeaconVersionString(v), block)
+		}
+		pbBlock, err := prysmBlock.Proto()
+		if err != nil {
+			return nil, fmt.Errorf("failed to get %v beacon block proto: %v", types.BeaconVersionString(v), err)
+		}
+		msg, err = jsonifyContainer(reflect.ValueOf(pbBlock))
+		if err != nil {
+			return nil, fmt.Errorf("failed to jsonify %v beacon block: %v", types.BeaconVersionString(v), err)
+		}
+	case types.VersionDeneb, types.VersionElectra:
+		// Starting from Deneb the block is published with its blobs
+		signedBlock, err := jsonifyContainer(reflect.ValueOf(block))
+		if err != nil {
+			return nil, fmt.Errorf("failed to jsonify %v beacon block: %v", types.BeaconVersionString(v), err)
+		}
+		kzgProofs, blobs := blobsOfSidecars(blobSidecars)
+		msg = &signedBlockContentsJSON{SignedBlock: signedBlock, KzgProofs: jsonifyBytesList(kzgProofs), Blobs: jsonifyBytesList(blobs)}
+	default:
+		return nil, fmt.Errorf("unsupported beacon block version %v", types.BeaconVersionString(v))
 	}
-	return rawBlock, nil
-}
 
-func uint64ToString[T uint64 | primitives.Slot | primitives.ValidatorIndex | primitives.CommitteeIndex | primitives.Epoch](val T) string {
-	return strconv.FormatUint(uint64(val), 10)
+	return json.Marshal(msg)
 }
 
-func marshallBeaconBlockCapella(block *ethpb.SignedBeaconBlockCapella) ([]byte, error) {
-	signedBeaconBlockCapellaJSON := &apimiddleware.SignedBeaconBlockCapellaContainerJson{
-		Signature: hexutil.Encode(block.Signature),
-		Message: &apimiddleware.BeaconBlockCapellaJson{
-			ParentRoot:    hexutil.Encode(block.Block.ParentRoot),
-			ProposerIndex: uint64ToString(block.Block.ProposerIndex),
-			Slot:          uint64ToString(block.Block.Slot),
-			StateRoot:     hexutil.Encode(block.Block.StateRoot),
-			Body: &apimiddleware.BeaconBlockBodyCapellaJson{
-				Attestations:      jsonifyAttestations(block.Block.Body.Attestations),
-				AttesterSlashings: jsonifyAttesterSlashings(block.Block.Body.AttesterSlashings),
-				Deposits:          jsonifyDeposits(block.Block.Body.Deposits),
-				Eth1Data:          jsonifyEth1Data(block.Block.Body.Eth1Data),
-				Graffiti:          hexutil.Encode(block.Block.Body.Graffiti),
-				ProposerSlashings: jsonifyProposerSlashings(block.Block.Body.ProposerSlashings),
-				RandaoReveal:      hexutil.Encode(block.Block.Body.RandaoReveal),
-				VoluntaryExits:    JsonifySignedVoluntaryExits(block.Block.Body.VoluntaryExits),
-				SyncAggregate: &apimiddleware.SyncAggregateJson{
-					SyncCommitteeBits:      hexutil.Encode(block.Block.Body.SyncAggregate.SyncCommitteeBits),
-					SyncCommitteeSignature: hexutil.Encode(block.Block.Body.SyncAggregate.SyncCommitteeSignature),
-				},
-				ExecutionPayload: &apimiddleware.ExecutionPayloadCapellaJson{
-					BaseFeePerGas: bytesutil.LittleEndianBytesToBigInt(block.Block.Body.ExecutionPayload.BaseFeePerGas).String(),
-					BlockHash:     hexutil.Encode(block.Block.Body.ExecutionPayload.BlockHash),
-					BlockNumber:   uint64ToString(block.Block.Body.ExecutionPayload.BlockNumber),
-					ExtraData:     hexutil.Encode(block.Block.Body.ExecutionPayload.ExtraData),
-					FeeRecipient:  hexutil.Encode(block.Block.Body.ExecutionPayload.FeeRecipient),
-					GasLimit:      uint64ToString(block.Block.Body.ExecutionPayload.GasLimit),
-					GasUsed:       uint64ToString(block.Block.Body.ExecutionPayload.GasUsed),
-					LogsBloom:     hexutil.Encode(block.Block.Body.ExecutionPayload.LogsBloom),
-					ParentHash:    hexutil.Encode(block.Block.Body.ExecutionPayload.ParentHash),
-					PrevRandao:    hexutil.Encode(block.Block.Body.ExecutionPayload.PrevRandao),
-					ReceiptsRoot:  hexutil.Encode(block.Block.Body.ExecutionPayload.ReceiptsRoot),
-					StateRoot:     hexutil.Encode(block.Block.Body.ExecutionPayload.StateRoot),
-					TimeStamp:     uint64ToString(block.Block.Body.ExecutionPayload.Timestamp),
-					Transactions:  jsonifyTransactions(block.Block.Body.ExecutionPayload.Transactions),
-					Withdrawals:   jsonifyWithdrawals(block.Block.Body.ExecutionPayload.Withdrawals),
-				},
-				BLSToExecutionChanges: jsonifyBlsToExecutionChanges(block.Block.Body.BlsToExecutionChanges),
-			},
-		},
+// blobsOfSidecars returns the KZG proofs and the blobs of the sidecars in the order of the indexes
+func blobsOfSidecars(blobSidecars []*types.BlobSidecar) ([][]byte, [][]byte) {
+	sorted := make([]*types.BlobSidecar, len(blobSidecars))
+	copy(sorted, blobSidecars)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Index < sorted[j].Index })
+
+	kzgProofs := make([][]byte, 0, len(sorted))
+	blobs := make([][]byte, 0, len(sorted))
+	for _, sidecar := range sorted {
+		kzgProofs = append(kzgProofs, sidecar.KzgProof)
+		blobs = append(blobs, sidecar.Blob)
 	}
 
-	return json.Marshal(signedBeaconBlockCapellaJSON)
+	return kzgProofs, blobs
 }
 
-func jsonifyTransactions(transactions [][]byte) []string {
-	jsonTransactions := make([]string, len(transactions))
-	for index, transaction := range transactions {
-		jsonTransaction := hexutil.Encode(transaction)
-		jsonTransactions[index] = jsonTransaction
-	}
-	return jsonTransactions
+// signedBlockContentsJSON is the signed block with its blobs, as published to the beacon API starting from Deneb
+type signedBlockContentsJSON struct {
+	SignedBlock interface{} `json:"signed_block"`
+	KzgProofs   []string    `json:"kzg_proofs"`
+	Blobs       []string    `json:"blobs"`
 }
 
-func jsonifyBlsToExecutionChanges(blsToExecutionChanges []*ethpb.SignedBLSToExecutionChange) []*apimiddleware.SignedBLSToExecutionChangeJson {
-	jsonBlsToExecutionChanges := make([]*apimiddleware.SignedBLSToExecutionChangeJson, len(blsToExecutionChanges))
-	for index, signedBlsToExecutionChange := range blsToExecutionChanges {
-		blsToExecutionChangeJSON := &apimiddleware.BLSToExecutionChangeJson{
-			ValidatorIndex:     uint64ToString(signedBlsToExecutionChange.Message.ValidatorIndex),
-			FromBLSPubkey:      hexutil.Encode(signedBlsToExecutionChange.Message.FromBlsPubkey),
-			ToExecutionAddress: hexutil.Encode(signedBlsToExecutionChange.Message.ToExecutionAddress),
-		}
-		signedJSON := &apimiddleware.SignedBLSToExecutionChangeJson{
-			Message:   blsToExecutionChangeJSON,
-			Signature: hexutil.Encode(signedBlsToExecutionChange.Signature),
-		}
-		jsonBlsToExecutionChanges[index] = signedJSON
-	}
-	return jsonBlsToExecutionChanges
+// beaconAPIFieldNames are the names of the beacon API fields which differ from the JSON names of the SSZ containers
+var beaconAPIFieldNames = map[string]string{
+	"block":           "message",
+	"exit":            "message",
+	"header":          "message",
+	"header_1":        "signed_header_1",
+	"header_2":        "signed_header_2",
+	"public_key":      "pubkey",
+	"committee_index": "index",
 }
 
-func jsonifyEth1Data(eth1Data *ethpb.Eth1Data) *apimiddleware.Eth1DataJson {
-	return &apimiddleware.Eth1DataJson{
-		BlockHash:    hexutil.Encode(eth1Data.BlockHash),
-		DepositCount: uint64ToString(eth1Data.DepositCount),
-		DepositRoot:  hexutil.Encode(eth1Data.DepositRoot),
+// beaconAPIFieldName returns the beacon API name of the field of the SSZ container, or an empty string if the field
+// is not a part of the container
+func beaconAPIFieldName(field reflect.StructField) string {
+	if !field.IsExported() {
+		return ""
 	}
-}
 
-func jsonifyAttestations(attestations []*ethpb.Attestation) []*apimiddleware.AttestationJson {
-	jsonAttestations := make([]*apimiddleware.AttestationJson, len(attestations))
-	for index, attestation := range attestations {
-		jsonAttestations[index] = jsonifyAttestation(attestation)
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if apiName, ok := beaconAPIFieldNames[name]; ok {
+		return apiName
 	}
-	return jsonAttestations
+	return name
 }
 
-func jsonifyAttesterSlashings(attesterSlashings []*ethpb.AttesterSlashing) []*apimiddleware.AttesterSlashingJson {
-	jsonAttesterSlashings := make([]*apimiddleware.AttesterSlashingJson, len(attesterSlashings))
-	for index, attesterSlashing := range attesterSlashings {
-		jsonAttesterSlashing := &apimiddleware.AttesterSlashingJson{
-			Attestation_1: jsonifyIndexedAttestation(attesterSlashing.Attestation_1),
-			Attestation_2: jsonifyIndexedAttestation(attesterSlashing.Attestation_2),
-		}
-		jsonAttesterSlashings[index] = jsonAttesterSlashing
+// jsonifyContainer converts the SSZ container to the beacon API JSON format. The containers of all the forks share
+// the encoding rules, so the conversion is driven by the fields of the container: the integers are decimal strings,
+// the byte lists and the bitfields are hex strings, and the base fee of the execution payload is a decimal string
+// of its little endian bytes
+func jsonifyContainer(container reflect.Value) (map[string]interface{}, error) {
+	container = reflect.Indirect(container)
+	if container.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("unsupported container %v", container.Type())
 	}
-	return jsonAttesterSlashings
-}
 
-func jsonifyDeposits(deposits []*ethpb.Deposit) []*apimiddleware.DepositJson {
-	jsonDeposits := make([]*apimiddleware.DepositJson, len(deposits))
-	for depositIndex, deposit := range deposits {
-		proofs := make([]string, len(deposit.Proof))
-		for proofIndex, proof := range deposit.Proof {
-			proofs[proofIndex] = hexutil.Encode(proof)
+	jsonContainer := make(map[string]interface{}, container.NumField())
+	for i := 0; i < container.NumField(); i++ {
+		field := container.Type().Field(i)
+		name := beaconAPIFieldName(field)
+		if name == "" {
+			continue
 		}
 
-		jsonDeposit := &apimiddleware.DepositJson{
-			Data: &apimiddleware.Deposit_DataJson{
-				Amount:                uint64ToString(deposit.Data.Amount),
-				PublicKey:             hexutil.Encode(deposit.Data.PublicKey),
-				Signature:             hexutil.Encode(deposit.Data.Signature),
-				WithdrawalCredentials: hexutil.Encode(deposit.Data.WithdrawalCredentials),
-			},
-			Proof: proofs,
+		value, err := jsonifyValue(name, container.Field(i))
+		if err != nil {
+			return nil, fmt.Errorf("failed to jsonify %v: %v", name, err)
 		}
-		jsonDeposits[depositIndex] = jsonDeposit
+		jsonContainer[name] = value
 	}
-	return jsonDeposits
-}
 
-func jsonifyProposerSlashings(proposerSlashings []*ethpb.ProposerSlashing) []*apimiddleware.ProposerSlashingJson {
-	jsonProposerSlashings := make([]*apimiddleware.ProposerSlashingJson, len(proposerSlashings))
-	for index, proposerSlashing := range proposerSlashings {
-		jsonProposerSlashing := &apimiddleware.ProposerSlashingJson{
-			Header_1: jsonifySignedBeaconBlockHeader(proposerSlashing.Header_1),
-			Header_2: jsonifySignedBeaconBlockHeader(proposerSlashing.Header_2),
-		}
-		jsonProposerSlashings[index] = jsonProposerSlashing
-	}
-	return jsonProposerSlashings
+	return jsonContainer, nil
 }
 
-// JsonifySignedVoluntaryExits converts an array of voluntary exit structs to a JSON hex string compatible format.
-func JsonifySignedVoluntaryExits(voluntaryExits []*ethpb.SignedVoluntaryExit) []*apimiddleware.SignedVoluntaryExitJson {
-	jsonSignedVoluntaryExits := make([]*apimiddleware.SignedVoluntaryExitJson, len(voluntaryExits))
-	for index, signedVoluntaryExit := range voluntaryExits {
-		jsonSignedVoluntaryExit := &apimiddleware.SignedVoluntaryExitJson{
-			Exit: &apimiddleware.VoluntaryExitJson{
-				Epoch:          uint64ToString(signedVoluntaryExit.Exit.Epoch),
-				ValidatorIndex: uint64ToString(signedVoluntaryExit.Exit.ValidatorIndex),
-			},
-			Signature: hexutil.Encode(signedVoluntaryExit.Signature),
+func jsonifyValue(name string, value reflect.Value) (interface{}, error) {
+	switch value.Kind() {
+	case reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10), nil
+	case reflect.Ptr:
+		if value.IsNil() {
+			return nil, nil
+		}
+		return jsonifyContainer(value)
+	case reflect.Slice:
+		if value.Type().Elem().Kind() == reflect.Uint8 {
+			if name == "base_fee_per_gas" {
+				return bytesutil.LittleEndianBytesToBigInt(value.Bytes()).String(), nil
+			}
+			return hexutil.Encode(value.Bytes()), nil
 		}
-		jsonSignedVoluntaryExits[index] = jsonSignedVoluntaryExit
-	}
-	return jsonSignedVoluntaryExits
-}
-
-func jsonifySignedBeaconBlockHeader(signedBeaconBlockHeader *ethpb.SignedBeaconBlockHeader) *apimiddleware.SignedBeaconBlockHeaderJson {
-	return &apimiddleware.SignedBeaconBlockHeaderJson{
-		Header: &apimiddleware.BeaconBlockHeaderJson{
-			BodyRoot:      hexutil.Encode(signedBeaconBlockHeader.Header.BodyRoot),
-			ParentRoot:    hexutil.Encode(signedBeaconBlockHeader.Header.ParentRoot),
-			ProposerIndex: uint64ToString(signedBeaconBlockHeader.Header.ProposerIndex),
-			Slot:          uint64ToString(signedBeaconBlockHeader.Header.Slot),
-			StateRoot:     hexutil.Encode(signedBeaconBlockHeader.Header.StateRoot),
-		},
-		Signature: hexutil.Encode(signedBeaconBlockHeader.Signature),
-	}
-}
-
-func jsonifyIndexedAttestation(indexedAttestation *ethpb.IndexedAttestation) *apimiddleware.IndexedAttestationJson {
-	attestingIndices := make([]string, len(indexedAttestation.AttestingIndices))
-	for index, attestingIndex := range indexedAttestation.AttestingIndices {
-		attestingIndex := uint64ToString(attestingIndex)
-		attestingIndices[index] = attestingIndex
-	}
-
-	return &apimiddleware.IndexedAttestationJson{
-		AttestingIndices: attestingIndices,
-		Data:             jsonifyAttestationData(indexedAttestation.Data),
-		Signature:        hexutil.Encode(indexedAttestation.Signature),
-	}
-}
-
-func jsonifyAttestationData(attestationData *ethpb.AttestationData) *apimiddleware.AttestationDataJson {
-	return &apimiddleware.AttestationDataJson{
-		BeaconBlockRoot: hexutil.Encode(attestationData.BeaconBlockRoot),
-		CommitteeIndex:  uint64ToString(attestationData.CommitteeIndex),
-		Slot:            uint64ToString(attestationData.Slot),
-		Source: &apimiddleware.CheckpointJson{
-			Epoch: uint64ToString(attestationData.Source.Epoch),
-			Root:  hexutil.Encode(attestationData.Source.Root),
-		},
-		Target: &apimiddleware.CheckpointJson{
-			Epoch: uint64ToString(attestationData.Target.Epoch),
-			Root:  hexutil.Encode(attestationData.Target.Root),
-		},
-	}
-}
 
-func jsonifyAttestation(attestation *ethpb.Attestation) *apimiddleware.AttestationJson {
-	return &apimiddleware.AttestationJson{
-		AggregationBits: hexutil.Encode(attestation.AggregationBits),
-		Data:            jsonifyAttestationData(attestation.Data),
-		Signature:       hexutil.Encode(attestation.Signature),
+		jsonList := make([]interface{}, value.Len())
+		for i := range jsonList {
+			item, err := jsonifyValue(name, value.Index(i))
+			if err != nil {
+				return nil, err
+			}
+			jsonList[i] = item
+		}
+		return jsonList, nil
+	default:
+		return nil, fmt.Errorf("unsupported type %v", value.Type())
 	}
 }
 
-func jsonifyWithdrawals(withdrawals []*enginev1.Withdrawal) []*apimiddleware.WithdrawalJson {
-	jsonWithdrawals := make([]*apimiddleware.WithdrawalJson, len(withdrawals))
-	for index, withdrawal := range withdrawals {
-		jsonWithdrawals[index] = &apimiddleware.WithdrawalJson{
-			WithdrawalIndex:  strconv.FormatUint(withdrawal.Index, 10),
-			ValidatorIndex:   strconv.FormatUint(uint64(withdrawal.ValidatorIndex), 10),
-			ExecutionAddress: hexutil.Encode(withdrawal.Address),
-			Amount:           strconv.FormatUint(withdrawal.Amount, 10),
-		}
+func jsonifyBytesList(list [][]byte) []string {
+	jsonList := make([]string, len(list))
+	for index, item := range list {
+		jsonList[index] = hexutil.Encode(item)
 	}
-	return jsonWithdrawals
+	return jsonList
 }
@@ -0,0 +1,78 @@
+//go:build integration
+// +build integration
+
+package beacon
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+//Requires setting these environment variables:
+//BEACON_API_URL - the beacon node API, e.g. http://localhost:3500
+//BEACON_BLOCK_<FORK> - the block id of each fork to check, e.g. BEACON_BLOCK_CAPELLA=head
+//RECORD_FIXTURES - if set, the responses of the node are written as the fixtures of the unit tests
+
+// TestJSONConsensusBlockEncoder_BeaconNode compares the JSON blocks with the /eth/v2/beacon/blocks responses of a
+// beacon node. The Electra block should have execution requests, to check the encoding of all its containers
+func TestJSONConsensusBlockEncoder_BeaconNode(t *testing.T) {
+	apiURL := os.Getenv("BEACON_API_URL")
+	require.NotEmpty(t, apiURL, "BEACON_API_URL is required")
+
+	for _, v := range []int{version.Phase0, version.Altair, version.Bellatrix, version.Capella, types.VersionDeneb, types.VersionElectra} {
+		name := types.BeaconVersionString(v)
+		t.Run(name, func(t *testing.T) {
+			blockID := os.Getenv("BEACON_BLOCK_" + strings.ToUpper(name))
+			if blockID == "" {
+				t.Skipf("no %v block id", name)
+			}
+			url := fmt.Sprintf("%v/eth/v2/beacon/blocks/%v", apiURL, blockID)
+
+			rawBlock, header := fetchBeaconBlock(t, url, "application/octet-stream")
+			require.Equal(t, name, header.Get("Eth-Consensus-Version"))
+			rawResponse, _ := fetchBeaconBlock(t, url, "application/json")
+			var response struct {
+				Version string          `json:"version"`
+				Data    json.RawMessage `json:"data"`
+			}
+			require.NoError(t, json.Unmarshal(rawResponse, &response))
+			require.Equal(t, name, response.Version)
+
+			block, err := (&APIClient{}).processResponse(rawBlock, name, "")
+			require.NoError(t, err)
+			jsonBlock, err := encodedSignedBlock(block)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(response.Data), string(jsonBlock))
+
+			if os.Getenv("RECORD_FIXTURES") != "" {
+				require.NoError(t, os.WriteFile(fmt.Sprintf("test_data/%v_block.ssz", name), rawBlock, 0644))
+				require.NoError(t, os.WriteFile(fmt.Sprintf("test_data/%v_block.json", name), response.Data, 0644))
+			}
+		})
+	}
+}
+
+func fetchBeaconBlock(t *testing.T, url, accept string) ([]byte, http.Header) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	req.Header.Set("Accept", accept)
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	body, err := io.ReadAll(resp.Body)
+	require.NoError(t, err)
+	return body, resp.Header
+}
@@ -0,0 +1,139 @@
+package beacon
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlockContentsJSON struct {
+	SignedBlock struct {
+		Message struct {
+			Slot string `json:"slot"`
+			Body struct {
+				ExecutionPayload struct {
+					BlockHash     string   `json:"block_hash"`
+					Transactions  []string `json:"transactions"`
+					BlobGasUsed   string   `json:"blob_gas_used"`
+					ExcessBlobGas string   `json:"excess_blob_gas"`
+				} `json:"execution_payload"`
+				Attestations []struct {
+					CommitteeBits string `json:"committee_bits"`
+				} `json:"attestations"`
+				BlobKzgCommitments []string `json:"blob_kzg_commitments"`
+				ExecutionRequests  *struct {
+					Withdrawals []struct {
+						Amount string `json:"amount"`
+					} `json:"withdrawals"`
+				} `json:"execution_requests"`
+			} `json:"body"`
+		} `json:"message"`
+	} `json:"signed_block"`
+	KzgProofs []string `json:"kzg_proofs"`
+	Blobs     []string `json:"blobs"`
+}
+
+func TestJSONConsensusBlockEncoder_Deneb(t *testing.T) {
+	block := bxmock.NewDenebBeaconBlock(t, 11, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil))
+
+	rawBlock, err := newJSONConsensusBlockEncoder().encodeBlock(block, nil)
+	require.NoError(t, err)
+
+	var contents testBlockContentsJSON
+	require.NoError(t, json.Unmarshal(rawBlock, &contents))
+
+	message := contents.SignedBlock.Message
+	payload := block.ExecutionPayload()
+	assert.Equal(t, "1", message.Slot)
+	assert.Equal(t, hexutil.Encode(payload.BlockHash), message.Body.ExecutionPayload.BlockHash)
+	assert.Len(t, message.Body.ExecutionPayload.Transactions, len(payload.Transactions))
+	assert.Equal(t, "131072", message.Body.ExecutionPayload.BlobGasUsed)
+	assert.Equal(t, "0", message.Body.ExecutionPayload.ExcessBlobGas)
+	assert.Equal(t, []string{hexutil.Encode(block.BlobKzgCommitments()[0])}, message.Body.BlobKzgCommitments)
+	assert.Nil(t, message.Body.ExecutionRequests)
+	assert.NotNil(t, contents.KzgProofs)
+	assert.NotNil(t, contents.Blobs)
+}
+
+func TestJSONConsensusBlockEncoder_Electra(t *testing.T) {
+	block := bxmock.NewElectraBeaconBlock(t, 11, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil))
+
+	rawBlock, err := newJSONConsensusBlockEncoder().encodeBlock(block, nil)
+	require.NoError(t, err)
+
+	var contents testBlockContentsJSON
+	require.NoError(t, json.Unmarshal(rawBlock, &contents))
+
+	body := contents.SignedBlock.Message.Body
+	require.Len(t, body.Attestations, len(block.Block.Body.Attestations))
+	assert.Equal(t, hexutil.Encode(block.Block.Body.Attestations[0].CommitteeBits), body.Attestations[0].CommitteeBits)
+	require.NotNil(t, body.ExecutionRequests)
+	require.Len(t, body.ExecutionRequests.Withdrawals, 1)
+	assert.Equal(t, "1", body.ExecutionRequests.Withdrawals[0].Amount)
+}
+
+// TestJSONConsensusBlockEncoder_BeaconAPI compares the JSON blocks with the data of the /eth/v2/beacon/blocks responses
+// of the fixtures. The Capella SSZ fixture is a mainnet block, the forks without fixtures are skipped until they are
+// recorded from a beacon node with the integration test
+func TestJSONConsensusBlockEncoder_BeaconAPI(t *testing.T) {
+	for _, v := range []int{version.Phase0, version.Altair, version.Bellatrix, version.Capella, types.VersionDeneb, types.VersionElectra} {
+		v := v
+		name := types.BeaconVersionString(v)
+		t.Run(name, func(t *testing.T) {
+			rawBlock, err := os.ReadFile(fmt.Sprintf("test_data/%v_block.ssz", name))
+			if errors.Is(err, os.ErrNotExist) {
+				t.Skipf("no %v fixture", name)
+			}
+			require.NoError(t, err)
+			expectedJSON, err := os.ReadFile(fmt.Sprintf("test_data/%v_block.json", name))
+			require.NoError(t, err)
+
+			block, err := (&APIClient{}).processResponse(rawBlock, name, "")
+			require.NoError(t, err)
+			require.Equal(t, v, block.Version())
+
+			jsonBlock, err := encodedSignedBlock(block)
+			require.NoError(t, err)
+			assert.JSONEq(t, string(expectedJSON), string(jsonBlock))
+		})
+	}
+}
+
+// encodedSignedBlock returns the JSON signed block, without the blobs published with it starting from Deneb
+func encodedSignedBlock(block signedBeaconBlock) ([]byte, error) {
+	jsonBlock, err := newJSONConsensusBlockEncoder().encodeBlock(block, nil)
+	if err != nil || block.Version() < types.VersionDeneb {
+		return jsonBlock, err
+	}
+
+	var contents struct {
+		SignedBlock json.RawMessage `json:"signed_block"`
+	}
+	if err = json.Unmarshal(jsonBlock, &contents); err != nil {
+		return nil, err
+	}
+	return contents.SignedBlock, nil
+}
+
+func TestJSONConsensusBlockEncoder_UnsupportedVersion(t *testing.T) {
+	block := bxmock.NewDenebBeaconBlock(t, 11, bxmock.NewEthBlock(10, common.Hash{}))
+
+	_, err := newJSONConsensusBlockEncoder().encodeBlock(&unsupportedBeaconBlock{block}, nil)
+	assert.EqualError(t, err, "unsupported beacon block version unknown version")
+}
+
+type unsupportedBeaconBlock struct {
+	*types.SignedBeaconBlockDeneb
+}
+
+func (b *unsupportedBeaconBlock) Version() int { return types.VersionElectra + 1 }
@@ -6,7 +6,6 @@ import (
 
 	"github.com/bloXroute-Labs/gateway/v2/blockchain"
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
-	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 )
 
 // HandleBDNBlocksBridge waits for block from BDN and broadcast it to the connected nodes using P2P and Beacon API
@@ -22,7 +21,11 @@ func HandleBDNBlocksBridge(ctx context.Context, b blockchain.Bridge, n *Node, be
 				log.Errorf("could not convert BDN block to beacon block: %v", err)
 				continue
 			}
-			castedBlock := beaconBlock.(interfaces.ReadOnlySignedBeaconBlock)
+			castedBlock, ok := beaconBlock.(signedBeaconBlock)
+			if !ok {
+				log.Errorf("could not broadcast block of unsupported type %T, block_hash: %v", beaconBlock, bdnBlock.Hash())
+				continue
+			}
 
 			var wg sync.WaitGroup
 
@@ -36,6 +39,16 @@ func HandleBDNBlocksBridge(ctx context.Context, b blockchain.Bridge, n *Node, be
 					} else {
 						log.Tracef("broadcasted block to blockchain: p2p, block_hash: %v", bdnBlock.Hash())
 					}
+
+					if len(bdnBlock.BlobSidecars) == 0 {
+						return
+					}
+
+					if err := n.BroadcastBlobSidecars(bdnBlock.BlobSidecars); err != nil {
+						log.Errorf("could not broadcast blob sidecars to p2p connection, block_hash: %v, err: %v", bdnBlock.Hash(), err)
+					} else {
+						log.Tracef("broadcasted %v blob sidecars to blockchain: p2p, block_hash: %v", len(bdnBlock.BlobSidecars), bdnBlock.Hash())
+					}
 				}()
 
 			}
@@ -46,7 +59,7 @@ func HandleBDNBlocksBridge(ctx context.Context, b blockchain.Bridge, n *Node, be
 
 					go func(client *APIClient) {
 						defer wg.Done()
-						if err := client.BroadcastBlock(castedBlock); err != nil {
+						if err := client.BroadcastBlock(castedBlock, bdnBlock.BlobSidecars); err != nil {
 							log.Errorf("could not broadcast block to beacon API endpoint %s, block hash: %v, err %v", client.URL, bdnBlock.Hash(), err)
 						} else {
 							log.Tracef("broadcasted block to blockchain: beacon API :%v, block_hash: %v", client.URL, bdnBlock.Hash())
@@ -29,6 +29,7 @@ import (
 	"github.com/pkg/errors"
 	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/blocks"
+	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/encoder"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
@@ -41,7 +42,6 @@ import (
 	"github.com/prysmaticlabs/prysm/v4/network/forks"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/time/slots"
-	"google.golang.org/protobuf/proto"
 )
 
 var errPeerUnknown = errors.New("peer is unknown")
@@ -114,6 +114,29 @@ var networkInitMapping = map[string]func(){
 	},
 }
 
+// beaconFork is a fork which is not part of the prysm config, the blocks of these forks are encoded natively
+type beaconFork struct {
+	version     int
+	forkVersion [4]byte
+	epoch       prysmTypes.Epoch
+
+	// maxBlobsPerBlock is the limit of the blobs of a block and blobSidecarSubnets is the number of
+	// the blob_sidecar_{subnet} topics the sidecars are gossiped over
+	maxBlobsPerBlock   uint64
+	blobSidecarSubnets uint64
+}
+
+// postCapellaForks are the forks after Capella of the networks
+var postCapellaForks = map[string][]beaconFork{
+	"Mainnet": {
+		{version: bxTypes.VersionDeneb, forkVersion: [4]byte{0x04, 0x00, 0x00, 0x00}, epoch: 269568, maxBlobsPerBlock: 6, blobSidecarSubnets: 6},
+		{version: bxTypes.VersionElectra, forkVersion: [4]byte{0x05, 0x00, 0x00, 0x00}, epoch: 364032, maxBlobsPerBlock: 9, blobSidecarSubnets: 9},
+	},
+	"Goerli": {
+		{version: bxTypes.VersionDeneb, forkVersion: [4]byte{0x04, 0x00, 0x10, 0x20}, epoch: 231680, maxBlobsPerBlock: 6, blobSidecarSubnets: 6},
+	},
+}
+
 func initNetwork(networkName string) error {
 	init, ok := networkInitMapping[networkName]
 	if !ok {
@@ -123,6 +146,13 @@ func initNetwork(networkName string) error {
 	// Influences on fork version and therefore on digest
 	init()
 
+	// The fork schedule drives the fork digest, so the later forks are added to it
+	cfg := params.BeaconConfig()
+	for _, fork := range postCapellaForks[networkName] {
+		cfg.ForkVersionSchedule[fork.forkVersion] = fork.epoch
+		cfg.ForkVersionNames[fork.forkVersion] = bxTypes.BeaconVersionString(fork.version)
+	}
+
 	return nil
 }
 
@@ -157,6 +187,8 @@ type Node struct {
 	peers    peers
 	topicMap *syncmap.SyncMap[string, *topicSubscription]
 
+	blobSidecars *blobSidecarCache
+
 	encoding encoder.NetworkEncoding
 
 	status status
@@ -225,6 +257,7 @@ func newNode(parent context.Context, networkName string, config *network.EthConf
 		bridge:       bridge,
 		peers:        newPeers(),
 		topicMap:     syncmap.NewStringMapOf[*topicSubscription](),
+		blobSidecars: newBlobSidecarCache(),
 		encoding:     encoder.SszNetworkEncoder{},
 		cancel:       cancel,
 		log:          logCtx,
@@ -297,57 +330,71 @@ func newNode(parent context.Context, networkName string, config *network.EthConf
 	return n, nil
 }
 
-func (n *Node) scheduleCapellaForkUpdate() error {
-	// TODO: do for all forks
-
+// scheduleForkUpdates switches the subscriptions to the digests of the upcoming forks
+func (n *Node) scheduleForkUpdates() error {
 	currentSlot := slots.CurrentSlot(n.genesisState.GenesisTime())
 	currentEpoch := slots.ToEpoch(currentSlot)
 
-	// Check if we haven't passed capella udpate yet
-	if currentEpoch >= params.BeaconConfig().CapellaForkEpoch {
-		return nil
+	fSchedule := params.BeaconConfig().ForkVersionSchedule
+	for _, forkVersion := range forks.SortedForkVersions(fSchedule) {
+		forkEpoch := fSchedule[forkVersion]
+
+		// Skip the forks we have already passed and the forks which are not scheduled yet
+		if forkEpoch <= currentEpoch || forkEpoch == params.BeaconConfig().FarFutureEpoch {
+			continue
+		}
+
+		if err := n.scheduleForkUpdate(params.BeaconConfig().ForkVersionNames[forkVersion], forkEpoch); err != nil {
+			return err
+		}
 	}
 
-	capellaTime, err := epochStartTime(n.genesisState.GenesisTime(), params.BeaconConfig().CapellaForkEpoch)
+	return nil
+}
+
+func (n *Node) scheduleForkUpdate(forkName string, forkEpoch prysmTypes.Epoch) error {
+	forkTime, err := epochStartTime(n.genesisState.GenesisTime(), forkEpoch)
 	if err != nil {
-		return fmt.Errorf("could not get capella time: %v", err)
+		return fmt.Errorf("could not get %v time: %v", forkName, err)
 	}
 
 	timeInEpoch := time.Second * time.Duration(params.BeaconConfig().SecondsPerSlot*uint64(params.BeaconConfig().SlotsPerEpoch))
 
-	// Subscribe to Capella topics before update and unsubscribe Bellatrix topics after.
-	// So we maintain two sets of subscriptions during the merge.
-	epochBeforeCapellaTime := capellaTime.Add(-timeInEpoch) // 1 full epoch before Capella merge
-	epochAfterCapellaTime := capellaTime.Add(timeInEpoch)   // 1 full epoch after Capella merge
+	// Subscribe to the fork topics before the update and unsubscribe the previous fork topics after.
+	// So we maintain two sets of subscriptions during the update.
+	epochBeforeForkTime := forkTime.Add(-timeInEpoch) // 1 full epoch before the fork
+	epochAfterForkTime := forkTime.Add(timeInEpoch)   // 1 full epoch after the fork
 
-	currentForkDigest, err := n.currentForkDigest()
+	previousForkDigest, err := forks.ForkDigestFromEpoch(forkEpoch-1, n.genesisState.GenesisValidatorsRoot())
 	if err != nil {
-		return fmt.Errorf("could not get current fork digest: %v", err)
+		return fmt.Errorf("could not get fork digest before %v: %v", forkName, err)
 	}
 
-	capellaForkDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().CapellaForkEpoch, n.genesisState.GenesisValidatorsRoot())
+	forkDigest, err := forks.ForkDigestFromEpoch(forkEpoch, n.genesisState.GenesisValidatorsRoot())
 	if err != nil {
-		return fmt.Errorf("could not get capella fork digest: %v", err)
+		return fmt.Errorf("could not get %v fork digest: %v", forkName, err)
 	}
 
-	if n.clock.Now().After(epochBeforeCapellaTime) {
+	if n.clock.Now().After(epochBeforeForkTime) {
 		// Gateway started in the middle between epochs during the update
-		if err := n.subscribeAll(capellaForkDigest); err != nil {
-			n.log.Errorf("could not subscribe after shanghai update: %v", err)
+		if err := n.subscribeAll(forkDigest); err != nil {
+			n.log.Errorf("could not subscribe after %v update: %v", forkName, err)
 		}
 	} else {
 		// Gateway started before the update
-		n.clock.AfterFunc(n.clock.Now().Sub(epochBeforeCapellaTime), func() {
-			if err := n.subscribeAll(capellaForkDigest); err != nil {
-				n.log.Errorf("could not subscribe after shanghai update: %v", err)
+		n.clock.AfterFunc(epochBeforeForkTime.Sub(n.clock.Now()), func() {
+			if err := n.subscribeAll(forkDigest); err != nil {
+				n.log.Errorf("could not subscribe after %v update: %v", forkName, err)
 			}
 		})
 	}
 
-	n.clock.AfterFunc(epochAfterCapellaTime.Sub(n.clock.Now()), func() {
-		n.unsubscribeAll(currentForkDigest)
+	n.clock.AfterFunc(epochAfterForkTime.Sub(n.clock.Now()), func() {
+		n.unsubscribeAll(previousForkDigest)
 	})
 
+	n.log.Infof("scheduled %v fork update at epoch %v", forkName, forkEpoch)
+
 	return nil
 }
 
@@ -358,8 +405,8 @@ func (n *Node) Start() error {
 	go n.ensurePeerConnections()
 	go n.sendStatusRequests()
 
-	if err := n.scheduleCapellaForkUpdate(); err != nil {
-		return fmt.Errorf("could not schedule capella fork update: %v", err)
+	if err := n.scheduleForkUpdates(); err != nil {
+		return fmt.Errorf("could not schedule fork updates: %v", err)
 	}
 
 	currentForkDigest, err := n.currentForkDigest()
@@ -438,13 +485,47 @@ func (n *Node) Stop() {
 }
 
 // BroadcastBlock broadcasts block to peers
-func (n *Node) BroadcastBlock(block interfaces.ReadOnlySignedBeaconBlock) error {
-	msg, err := block.Proto()
+func (n *Node) BroadcastBlock(block signedBeaconBlock) error {
+	switch b := block.(type) {
+	case bxTypes.BeaconBlock:
+		// The natively encoded blocks have no proto representation and are encoded as is
+		return n.broadcast(p2p.BlockSubnetTopicFormat, b)
+	case interfaces.ReadOnlySignedBeaconBlock:
+		msg, err := b.Proto()
+		if err != nil {
+			return err
+		}
+
+		castMsg, ok := msg.(fastssz.Marshaler)
+		if !ok {
+			return errors.Errorf("message of %T does not support marshaller interface", msg)
+		}
+
+		return n.broadcast(p2p.BlockSubnetTopicFormat, castMsg)
+	default:
+		return errors.Errorf("unsupported beacon block %T", block)
+	}
+}
+
+// BroadcastBlobSidecars broadcasts the blob sidecars of a block to peers over their subnets
+func (n *Node) BroadcastBlobSidecars(sidecars []*bxTypes.BlobSidecar) error {
+	digest, err := n.currentForkDigest()
 	if err != nil {
-		return err
+		return fmt.Errorf("could not get current fork digest: %v", err)
+	}
+
+	fork, ok := n.forkOfDigest(digest)
+	if !ok || fork.blobSidecarSubnets == 0 {
+		return fmt.Errorf("blob sidecars are not supported by the fork of digest %x", digest)
+	}
+
+	for _, sidecar := range sidecars {
+		if err := n.broadcast(fmt.Sprintf(blobSidecarSubnetTopicFormat, sidecar.Index%fork.blobSidecarSubnets), sidecar); err != nil {
+			return fmt.Errorf("could not broadcast blob sidecar %v: %v", sidecar.Index, err)
+		}
 	}
 
-	return n.broadcast(p2p.BlockSubnetTopicFormat, msg)
+	return nil
 }
 
 // FilterIncomingSubscriptions is invoked for all RPCs containing subscription notifications.
@@ -471,37 +552,19 @@ func (n *Node) CanSubscribe(topic string) bool {
 	if parts[1] != "eth2" {
 		return false
 	}
-	phase0ForkDigest, err := n.currentForkDigest()
-	if err != nil {
-		n.log.Errorf("Could not determine fork digest: %v", err)
-		return false
-	}
-	altairForkDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().AltairForkEpoch, n.genesisState.GenesisValidatorsRoot())
-	if err != nil {
-		n.log.Errorf("Could not determine altair fork digest: %v", err)
-		return false
-	}
-	bellatrixForkDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().BellatrixForkEpoch, n.genesisState.GenesisValidatorsRoot())
-	if err != nil {
-		n.log.Errorf("Could not determine Bellatrix fork digest: %v", err)
-		return false
-	}
-	capellaForkDigest, err := forks.ForkDigestFromEpoch(params.BeaconConfig().CapellaForkEpoch, n.genesisState.GenesisValidatorsRoot())
-	if err != nil {
-		n.log.Errorf("Could not determine Capella fork digest: %v", err)
-		return false
-	}
+	for forkVersion := range params.BeaconConfig().ForkVersionSchedule {
+		digest, err := signing.ComputeForkDigest(forkVersion[:], n.genesisState.GenesisValidatorsRoot())
+		if err != nil {
+			n.log.Errorf("Could not determine %v fork digest: %v", params.BeaconConfig().ForkVersionNames[forkVersion], err)
+			return false
+		}
 
-	switch parts[2] {
-	case fmt.Sprintf("%x", phase0ForkDigest):
-	case fmt.Sprintf("%x", altairForkDigest):
-	case fmt.Sprintf("%x", bellatrixForkDigest):
-	case fmt.Sprintf("%x", capellaForkDigest):
-	default:
-		return false
+		if parts[2] == fmt.Sprintf("%x", digest) {
+			return parts[4] == encoder.ProtocolSuffixSSZSnappy
+		}
 	}
 
-	return parts[4] == encoder.ProtocolSuffixSSZSnappy
+	return false
 }
 
 func (n *Node) unsubscribeAll(digest [4]byte) {
@@ -516,11 +579,10 @@ func (n *Node) unsubscribeAll(digest [4]byte) {
 		} else {
 			n.log.Infof("closed subscription, topic: %v", k)
 		}
+		n.topicMap.Delete(k)
 
 		return true
 	})
-
-	n.topicMap.Clear()
 }
 
 func (n *Node) subscribeAll(digest [4]byte) error {
@@ -548,9 +610,35 @@ func (n *Node) subscribeAll(digest [4]byte) error {
 		return err
 	}
 
+	// Starting from Deneb the blobs of the blocks are gossiped over the blob sidecar subnets
+	if fork, ok := n.forkOfDigest(digest); ok {
+		for subnet := uint64(0); subnet < fork.blobSidecarSubnets; subnet++ {
+			if err := n.subscribe(digest, fmt.Sprintf(blobSidecarSubnetTopicFormat, subnet), n.blobSidecarSubscriber(subnet, fork)); err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// forkOfDigest returns the fork after Capella of the digest
+func (n *Node) forkOfDigest(digest [4]byte) (beaconFork, bool) {
+	for _, fork := range postCapellaForks[n.networkName] {
+		forkDigest, err := signing.ComputeForkDigest(fork.forkVersion[:], n.genesisState.GenesisValidatorsRoot())
+		if err != nil {
+			n.log.Errorf("could not determine %v fork digest: %v", bxTypes.BeaconVersionString(fork.version), err)
+			continue
+		}
+
+		if forkDigest == digest {
+			return fork, true
+		}
+	}
+
+	return beaconFork{}, false
+}
+
 func (n *Node) subscribe(digest [4]byte, topic string, handler func(msg *pubsub.Message)) error {
 	topicWithDigest := fmt.Sprintf(topic+n.encoding.ProtocolSuffix(), digest)
 	pbTopic, err := n.pubSub.Join(topicWithDigest)
@@ -623,7 +711,7 @@ func (n *Node) blockSubscriber(msg *pubsub.Message) {
 		return
 	}
 
-	blk, err := extractBlockDataType(fDigest[:], n.genesisState.GenesisValidatorsRoot())
+	blk, err := extractBlockDataType(fDigest[:], n.genesisState.GenesisValidatorsRoot(), postCapellaForks[n.networkName])
 	if err != nil {
 		logCtx.Errorf("could not extract block data type: %v", err)
 		return
@@ -634,24 +722,84 @@ func (n *Node) blockSubscriber(msg *pubsub.Message) {
 		return
 	}
 
-	blockHash, err := blk.Block().HashTreeRoot()
+	slot := blockSlot(blk)
+	blockHash, err := blockRoot(blk)
 	if err != nil {
-		logCtx.Errorf("could not get block[slot=%d] hash: %v", blk.Block().Slot(), err)
+		logCtx.Errorf("could not get block[slot=%d] hash: %v", slot, err)
 		return
 	}
 	blockHashHex := ethcommon.BytesToHash(blockHash[:]).String()
 
-	if blk.Block().Slot() <= currentSlot(n.genesisState.GenesisTime())-prysmTypes.Slot(n.config.IgnoreSlotCount) {
-		logCtx.Errorf("block[slot=%d,hash=%s] is too old to process", blk.Block().Slot(), blockHashHex)
+	if slot <= currentSlot(n.genesisState.GenesisTime())-prysmTypes.Slot(n.config.IgnoreSlotCount) {
+		logCtx.Errorf("block[slot=%d,hash=%s] is too old to process", slot, blockHashHex)
 		return
 	}
 
-	if err := sendBlockToBDN(n.clock, n.log, blk, n.bridge, *endpoint); err != nil {
-		logCtx.Errorf("could not process block[slot=%d,hash=%s]: %v", blk.Block().Slot(), blockHashHex, err)
+	// The blob sidecars which arrived before the block are carried over the BDN with the block
+	if err := sendBlockToBDN(n.clock, n.log, blk, n.blobSidecars.get(blockHash), n.bridge, *endpoint); err != nil {
+		logCtx.Errorf("could not process block[slot=%d,hash=%s]: %v", slot, blockHashHex, err)
 		return
 	}
 
-	logCtx.Tracef("received beacon block[slot=%d,hash=%s]", blk.Block().Slot(), blockHashHex)
+	logCtx.Tracef("received beacon block[slot=%d,hash=%s]", slot, blockHashHex)
+}
+
+func (n *Node) blobSidecarSubscriber(subnet uint64, fork beaconFork) func(msg *pubsub.Message) {
+	return func(msg *pubsub.Message) {
+		endpoint, err := n.loadNodeEndpointFromPeerID(msg.ReceivedFrom)
+		if err != nil {
+			if err == errPeerUnknown {
+				n.log.Debugf("skipping blob sidecar, the peer ID %v that broadcasted the blob sidecar is not trusted", msg.ReceivedFrom)
+			} else {
+				n.log.Errorf("could not load peer endpoint: %v", err)
+			}
+			return
+		}
+
+		logCtx := n.log.WithField("remoteAddr", fmt.Sprintf("%v:%v", endpoint.IP, endpoint.Port))
+
+		if msg.Data == nil {
+			logCtx.Errorf("msg is nil from peer: %v", msg.ReceivedFrom)
+			return
+		}
+
+		sidecar := new(bxTypes.BlobSidecar)
+		if err := n.encoding.DecodeGossip(msg.Data, sidecar); err != nil {
+			logCtx.Errorf("could not decode blob sidecar: %v", err)
+			return
+		}
+
+		slot := sidecar.Slot()
+		blockHash, err := sidecar.BlockRoot()
+		if err != nil {
+			logCtx.Errorf("could not get blob sidecar[slot=%d,index=%d] block hash: %v", slot, sidecar.Index, err)
+			return
+		}
+		blockHashHex := ethcommon.BytesToHash(blockHash[:]).String()
+
+		minSlot := currentSlot(n.genesisState.GenesisTime()) - prysmTypes.Slot(n.config.IgnoreSlotCount)
+		if slot <= minSlot {
+			logCtx.Errorf("blob sidecar[slot=%d,hash=%s,index=%d] is too old to process", slot, blockHashHex, sidecar.Index)
+			return
+		}
+
+		if err := validateBlobSidecar(sidecar, subnet, fork); err != nil {
+			logCtx.Errorf("invalid blob sidecar[slot=%d,hash=%s,index=%d]: %v", slot, blockHashHex, sidecar.Index, err)
+			return
+		}
+
+		if !n.blobSidecars.add(blockHash, sidecar, minSlot) {
+			logCtx.Tracef("duplicate blob sidecar[slot=%d,hash=%s,index=%d]", slot, blockHashHex, sidecar.Index)
+			return
+		}
+
+		if err := n.bridge.SendBlobSidecarToGateway(sidecar, *endpoint); err != nil {
+			logCtx.Errorf("could not send blob sidecar[slot=%d,hash=%s,index=%d] to gateway: %v", slot, blockHashHex, sidecar.Index, err)
+			return
+		}
+
+		logCtx.Tracef("received blob sidecar[slot=%d,hash=%s,index=%d]", slot, blockHashHex, sidecar.Index)
+	}
 }
 
 func (n *Node) loadNodeEndpointFromPeerID(peerID libp2pPeer.ID) (*bxTypes.NodeEndpoint, error) {
@@ -664,7 +812,7 @@ func (n *Node) loadNodeEndpointFromPeerID(peerID libp2pPeer.ID) (*bxTypes.NodeEn
 	return &multiaddr, nil
 }
 
-func (n *Node) broadcast(topic string, msg proto.Message) error {
+func (n *Node) broadcast(topic string, msg fastssz.Marshaler) error {
 	digest, err := n.currentForkDigest()
 	if err != nil {
 		return fmt.Errorf("could not get current fork digest: %v", err)
@@ -681,13 +829,8 @@ func (n *Node) broadcast(topic string, msg proto.Message) error {
 		return nil
 	}
 
-	castMsg, ok := msg.(fastssz.Marshaler)
-	if !ok {
-		return errors.Errorf("message of %T does not support marshaller interface", msg)
-	}
-
 	buf := new(bytes.Buffer)
-	if _, err := n.encoding.EncodeGossip(buf, castMsg); err != nil {
+	if _, err := n.encoding.EncodeGossip(buf, msg); err != nil {
 		return fmt.Errorf("could not encode gossip: %v", err)
 	}
 
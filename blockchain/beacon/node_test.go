@@ -0,0 +1,51 @@
+package beacon
+
+import (
+	"testing"
+
+	bxTypes "github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/prysmaticlabs/prysm/v4/config/params"
+	prysmTypes "github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/network/forks"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitNetwork_PostCapellaForks(t *testing.T) {
+	require.NoError(t, initNetwork("Mainnet"))
+
+	vRoot := params.BeaconConfig().ZeroHash[:]
+
+	tests := []struct {
+		name            string
+		epoch           prysmTypes.Epoch
+		expectedVersion int
+	}{
+		{name: "capella", epoch: params.BeaconConfig().CapellaForkEpoch, expectedVersion: version.Capella},
+		{name: "deneb", epoch: 269568, expectedVersion: bxTypes.VersionDeneb},
+		{name: "electra", epoch: 364032, expectedVersion: bxTypes.VersionElectra},
+	}
+
+	digests := make(map[[4]byte]struct{})
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			digest, err := forks.ForkDigestFromEpoch(tt.epoch, vRoot)
+			require.NoError(t, err)
+			digests[digest] = struct{}{}
+
+			// the digest of the epoch before the fork is the digest of the previous fork
+			previousDigest, err := forks.ForkDigestFromEpoch(tt.epoch-1, vRoot)
+			require.NoError(t, err)
+			assert.NotEqual(t, previousDigest, digest)
+
+			blk, err := extractBlockDataType(digest[:], vRoot, postCapellaForks["Mainnet"])
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedVersion, blk.Version())
+		})
+	}
+
+	// every fork has its own digest
+	assert.Len(t, digests, len(tests))
+	assert.Equal(t, "electra", params.BeaconConfig().ForkVersionNames[[4]byte{0x05, 0x00, 0x00, 0x00}])
+}
@@ -115,7 +115,7 @@ func (c *PrysmClient) run() {
 					continue
 				}
 
-				if err := sendBlockToBDN(c.clock, c.log, blk, c.bridge, c.endpoint); err != nil {
+				if err := sendBlockToBDN(c.clock, c.log, blk, nil, c.bridge, c.endpoint); err != nil {
 					c.log.Errorf("could not proccess beacon block[slot=%d,hash=%s] to eth: %v", blk.Block().Slot(), blockHashHex, err)
 					continue
 				}
@@ -9,6 +9,7 @@ import (
 	"github.com/bloXroute-Labs/gateway/v2/logger"
 	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils"
+	fastssz "github.com/prysmaticlabs/fastssz"
 	"github.com/prysmaticlabs/prysm/v4/beacon-chain/core/signing"
 	p2ptypes "github.com/prysmaticlabs/prysm/v4/beacon-chain/p2p/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
@@ -20,11 +21,43 @@ import (
 
 const confirmationDelay = 4 * time.Second
 
-func sendBlockToBDN(clock utils.Clock, log *logger.Entry, block interfaces.ReadOnlySignedBeaconBlock, bridge blockchain.Bridge, endpoint types.NodeEndpoint) error {
+// signedBeaconBlock is a prysm signed beacon block or a natively encoded beacon block of the forks after Capella
+type signedBeaconBlock interface {
+	fastssz.Marshaler
+	fastssz.Unmarshaler
+	Version() int
+}
+
+// blockSlot returns the slot of the block
+func blockSlot(block signedBeaconBlock) prysmTypes.Slot {
+	switch b := block.(type) {
+	case interfaces.ReadOnlySignedBeaconBlock:
+		return b.Block().Slot()
+	case types.BeaconBlock:
+		return b.Slot()
+	default:
+		return 0
+	}
+}
+
+// blockRoot returns the hash tree root of the block message, which is the block hash
+func blockRoot(block signedBeaconBlock) ([32]byte, error) {
+	switch b := block.(type) {
+	case interfaces.ReadOnlySignedBeaconBlock:
+		return b.Block().HashTreeRoot()
+	case types.BeaconBlock:
+		return b.BlockRoot()
+	default:
+		return [32]byte{}, fmt.Errorf("unsupported beacon block %T", block)
+	}
+}
+
+func sendBlockToBDN(clock utils.Clock, log *logger.Entry, block signedBeaconBlock, blobSidecars []*types.BlobSidecar, bridge blockchain.Bridge, endpoint types.NodeEndpoint) error {
 	bdnBeaconBlock, err := bridge.BlockBlockchainToBDN(block)
 	if err != nil {
 		return fmt.Errorf("could not convert beacon block: %v", err)
 	}
+	bdnBeaconBlock.BlobSidecars = blobSidecars
 
 	if err := bridge.SendBlockToBDN(bdnBeaconBlock, endpoint); err != nil {
 		return fmt.Errorf("could not send block to gateway: %v", err)
@@ -52,7 +85,7 @@ func epochStartTime(genesisTime uint64, epoch prysmTypes.Epoch) (time.Time, erro
 	return slots.ToTime(genesisTime, slot)
 }
 
-func extractBlockDataType(digest []byte, vRoot []byte) (interfaces.ReadOnlySignedBeaconBlock, error) {
+func extractBlockDataType(digest []byte, vRoot []byte, forks []beaconFork) (signedBeaconBlock, error) {
 	if len(digest) == 0 {
 		bFunc, ok := p2ptypes.BlockMap[bytesutil.ToBytes4(params.BeaconConfig().GenesisForkVersion)]
 		if !ok {
@@ -63,6 +96,15 @@ func extractBlockDataType(digest []byte, vRoot []byte) (interfaces.ReadOnlySigne
 	if len(digest) != forkDigestLength {
 		return nil, fmt.Errorf("invalid digest returned, wanted a length of %d but received %d", forkDigestLength, len(digest))
 	}
+	for _, fork := range forks {
+		rDigest, err := signing.ComputeForkDigest(fork.forkVersion[:], vRoot)
+		if err != nil {
+			return nil, err
+		}
+		if rDigest == bytesutil.ToBytes4(digest) {
+			return types.NewBeaconBlock(fork.version)
+		}
+	}
 	for k, blkFunc := range p2ptypes.BlockMap {
 		rDigest, err := signing.ComputeForkDigest(k[:], vRoot[:])
 		if err != nil {
@@ -33,6 +33,12 @@ type BlockFromNode struct {
 	PeerEndpoint types.NodeEndpoint
 }
 
+// BlobSidecarFromNode is used to pass the blob sidecars gossiped by a node to the gateway
+type BlobSidecarFromNode struct {
+	Sidecar      *types.BlobSidecar
+	PeerEndpoint types.NodeEndpoint
+}
+
 // BlockAnnouncement represents an available block from a given peer that can be requested
 type BlockAnnouncement struct {
 	Hash         types.SHA256Hash
@@ -60,6 +66,7 @@ const (
 	transactionBacklog       = 2000
 	transactionHashesBacklog = 1000
 	blockBacklog             = 100
+	blobSidecarBacklog       = 100
 	statusBacklog            = 10
 )
 
@@ -89,6 +96,9 @@ type Bridge interface {
 	ReceiveBlockFromNode() <-chan BlockFromNode
 	ReceiveConfirmedBlockFromNode() <-chan BlockFromNode
 
+	SendBlobSidecarToGateway(sidecar *types.BlobSidecar, peerEndpoint types.NodeEndpoint) error
+	ReceiveBlobSidecarFromNode() <-chan BlobSidecarFromNode
+
 	ReceiveNoActiveBlockchainPeersAlert() <-chan NoActiveBlockchainPeersAlert
 	SendNoActiveBlockchainPeersAlert() error
 
@@ -138,6 +148,8 @@ type BxBridge struct {
 
 	confirmedBlockFromNode chan BlockFromNode
 
+	blobSidecarsFromNode chan BlobSidecarFromNode
+
 	noActiveBlockchainPeers chan NoActiveBlockchainPeersAlert
 
 	blockchainStatusRequest     chan struct{}
@@ -162,6 +174,7 @@ func NewBxBridge(converter Converter, beaconBlock bool) Bridge {
 		ethBlocksFromBDN:            make(chan *types.BxBlock, blockBacklog),
 		beaconBlocksFromBDN:         make(chan *types.BxBlock, blockBacklog),
 		confirmedBlockFromNode:      make(chan BlockFromNode, blockBacklog),
+		blobSidecarsFromNode:        make(chan BlobSidecarFromNode, blobSidecarBacklog),
 		noActiveBlockchainPeers:     make(chan NoActiveBlockchainPeersAlert),
 		blockchainStatusRequest:     make(chan struct{}, statusBacklog),
 		blockchainStatusResponse:    make(chan []*types.NodeEndpoint, statusBacklog),
@@ -274,7 +287,7 @@ func (b BxBridge) SendBlockToNode(block *types.BxBlock) error {
 		default:
 			return ErrChannelFull
 		}
-	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
+	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella, types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
 		// No listener, `b.beaconBlock` is true if the gateway started with a beacon P2P node or Beacon API
 		if !b.beaconBlock {
 			return nil
@@ -312,6 +325,21 @@ func (b BxBridge) ReceiveConfirmedBlockFromNode() <-chan BlockFromNode {
 	return b.confirmedBlockFromNode
 }
 
+// SendBlobSidecarToGateway sends a blob sidecar gossiped by a node to the gateway feeds
+func (b BxBridge) SendBlobSidecarToGateway(sidecar *types.BlobSidecar, peerEndpoint types.NodeEndpoint) error {
+	select {
+	case b.blobSidecarsFromNode <- BlobSidecarFromNode{Sidecar: sidecar, PeerEndpoint: peerEndpoint}:
+		return nil
+	default:
+		return ErrChannelFull
+	}
+}
+
+// ReceiveBlobSidecarFromNode provides a channel that pushes blob sidecars from nodes
+func (b BxBridge) ReceiveBlobSidecarFromNode() <-chan BlobSidecarFromNode {
+	return b.blobSidecarsFromNode
+}
+
 // SendNoActiveBlockchainPeersAlert sends alerts to the BDN when there is no active blockchain peer
 func (b BxBridge) SendNoActiveBlockchainPeersAlert() error {
 	select {
@@ -1,6 +1,7 @@
 package eth
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
@@ -35,6 +36,7 @@ type Backend interface {
 	Handle(peer *Peer, packet eth.Packet) error
 	GetHeaders(start eth.HashOrNumber, count int, skip int, reverse bool) ([]*ethtypes.Header, error)
 	GetBodies(hashes []ethcommon.Hash) ([]*ethtypes.Body, error)
+	GetPooledTransactions(hashes []ethcommon.Hash) []rlp.RawValue
 	GetBridge() blockchain.Bridge
 }
 
@@ -47,6 +49,7 @@ type Handler struct {
 	config           *network.EthConfig
 	wsManager        blockchain.WSManager
 	recommendedPeers map[string]struct{}
+	blobTxs          *blobTxPool
 }
 
 // NewHandler returns a new Handler and starts its processing go routines
@@ -60,6 +63,7 @@ func NewHandler(parent context.Context, config *network.EthConfig, chain *Chain,
 		cancel:           cancel,
 		wsManager:        wsManager,
 		recommendedPeers: recommendedPeers,
+		blobTxs:          newBlobTxPool(),
 	}
 	go h.checkInitialBlockchainLiveliness(100 * time.Second)
 	go h.handleBDNBridge(ctx)
@@ -267,14 +271,22 @@ func (h *Handler) processBDNTransactions(bdnTxs blockchain.Transactions) {
 			continue
 		}
 
-		ethTx, ok := blockchainTx.(*ethtypes.Transaction)
-		if !ok {
+		// allow sending tx to inbound node only if it's paid tx or marked as deliver to node, but it cannot be next_validator tx or validators only
+		isAllowedForInbound := (bdnTx.Flags().IsPaidTx() || bdnTx.Flags().IsDeliverToNode()) && !bdnTx.Flags().IsNextValidator() && !bdnTx.Flags().IsValidatorsOnly()
+
+		switch tx := blockchainTx.(type) {
+		case *ethtypes.Transaction:
+			p.Add(tx, isAllowedForInbound)
+		case *types.BlobTx:
+			// the nodes request the announced blob transactions from the pool, their content is their pooled form
+			h.blobTxs.add(tx.Hash(), rlp.RawValue(bdnTx.Content()))
+			p.AddBlobTx(tx.Hash(), uint32(tx.Size()), isAllowedForInbound)
+		case *types.SetCodeTx:
+			// the set-code transactions are sent in their encoded form, which is their BDN content
+			p.AddSetCodeTx(rlp.RawValue(bdnTx.Content()), isAllowedForInbound)
+		default:
 			logTransactionConverterFailure(err, bdnTx)
-			continue
 		}
-
-		// allow sending tx to inbound node only if it's paid tx or marked as deliver to node, but it cannot be next_validator tx or validators only
-		p.Add(ethTx, (bdnTx.Flags().IsPaidTx() || bdnTx.Flags().IsDeliverToNode()) && !bdnTx.Flags().IsNextValidator() && !bdnTx.Flags().IsValidatorsOnly())
 	}
 
 	h.broadcastTransactions(p, bdnTxs.PeerEndpoint, bdnTxs.ConnectionType)
@@ -535,6 +547,10 @@ func (h *Handler) Handle(peer *Peer, packet eth.Packet) error {
 		return h.processTransactions(peer, *p)
 	case *eth.PooledTransactionsPacket:
 		return h.processTransactions(peer, *p)
+	case *blobTransactionsPacket:
+		return h.processBlobTransactions(peer, *p)
+	case *setCodeTransactionsPacket:
+		return h.processSetCodeTransactions(peer, *p)
 	case *eth.NewPooledTransactionHashesPacket66:
 		return h.processTransactionHashes(peer, *p)
 	case *eth.NewPooledTransactionHashesPacket68:
@@ -570,8 +586,27 @@ func (h *Handler) broadcastTransactions(p *datatype.ProcessingETHTransaction, so
 			continue
 		}
 		txs := p.Transactions(connectionType, peer.Dynamic())
-		if err := peer.SendTransactions(txs); err != nil {
-			peer.Log().Errorf("could not send %v transactions: %v", len(txs), err)
+		if len(txs) > 0 {
+			if err := peer.SendTransactions(txs); err != nil {
+				peer.Log().Errorf("could not send %v transactions: %v", len(txs), err)
+			}
+		}
+
+		setCodeTxs := p.SetCodeTransactions(connectionType, peer.Dynamic())
+		if len(setCodeTxs) > 0 {
+			if err := peer.SendRawTransactions(setCodeTxs); err != nil {
+				peer.Log().Errorf("could not send %v set-code transactions: %v", len(setCodeTxs), err)
+			}
+		}
+
+		// the blob transactions are only announced, and only eth/68 announcements have the transaction types
+		blobTxHashes, blobTxSizes := p.BlobTransactions(connectionType, peer.Dynamic())
+		if len(blobTxHashes) == 0 || peer.version < eth.ETH68 {
+			continue
+		}
+		blobTxTypes := bytes.Repeat([]byte{types.BlobTxType}, len(blobTxHashes))
+		if err := peer.AnnounceTransactions(blobTxTypes, blobTxSizes, blobTxHashes); err != nil {
+			peer.Log().Errorf("could not announce %v blob transactions: %v", len(blobTxHashes), err)
 		}
 	}
 }
@@ -614,10 +649,38 @@ func (h *Handler) processTransactions(peer *Peer, txs []*ethtypes.Transaction) e
 		}
 		bdnTxs = append(bdnTxs, bdnTx)
 	}
+	return h.sendTransactionsToBDN(peer, bdnTxs)
+}
+
+func (h *Handler) processBlobTransactions(peer *Peer, txs []*types.BlobTx) error {
+	bdnTxs := make([]*types.BxTransaction, 0, len(txs))
+	for _, tx := range txs {
+		bdnTx, err := h.bridge.TransactionBlockchainToBDN(tx)
+		if err != nil {
+			return err
+		}
+		bdnTxs = append(bdnTxs, bdnTx)
+	}
+	return h.sendTransactionsToBDN(peer, bdnTxs)
+}
+
+func (h *Handler) processSetCodeTransactions(peer *Peer, txs []*types.SetCodeTx) error {
+	bdnTxs := make([]*types.BxTransaction, 0, len(txs))
+	for _, tx := range txs {
+		bdnTx, err := h.bridge.TransactionBlockchainToBDN(tx)
+		if err != nil {
+			return err
+		}
+		bdnTxs = append(bdnTxs, bdnTx)
+	}
+	return h.sendTransactionsToBDN(peer, bdnTxs)
+}
+
+func (h *Handler) sendTransactionsToBDN(peer *Peer, bdnTxs []*types.BxTransaction) error {
 	err := h.bridge.SendTransactionsToBDN(bdnTxs, peer.IPEndpoint())
 
 	if err == blockchain.ErrChannelFull {
-		log.Warnf("transaction channel for sending to the BDN is full; dropping %v transactions...", len(txs))
+		log.Warnf("transaction channel for sending to the BDN is full; dropping %v transactions...", len(bdnTxs))
 		return nil
 	}
 
@@ -849,6 +912,11 @@ func (h *Handler) GetBodies(hashes []ethcommon.Hash) ([]*ethtypes.Body, error) {
 	return h.chain.GetBodies(hashes)
 }
 
+// GetPooledTransactions returns the blob transactions received from the BDN and announced to the nodes
+func (h *Handler) GetPooledTransactions(hashes []ethcommon.Hash) []rlp.RawValue {
+	return h.blobTxs.get(hashes)
+}
+
 // GetHeaders assembles and returns a set of headers
 func (h *Handler) GetHeaders(start eth.HashOrNumber, count int, skip int, reverse bool) ([]*ethtypes.Header, error) {
 	return h.chain.GetHeaders(start, count, skip, reverse)
@@ -188,6 +188,161 @@ func TestHandler_HandleTransactionHashes(t *testing.T) {
 	}
 }
 
+func TestHandler_HandleTransactionHashes68(t *testing.T) {
+	bridge, handler, _ := setup()
+	peer, _, _ := testPeer(-1, 1)
+	peer.version = eth.ETH68
+	_ = handler.peers.register(peer)
+
+	hashes := []common.Hash{{1}, {2}, {3}, {4}}
+	err := handleNewPooledTransactionHashes68(handler, encodeRLP(eth.NewPooledTransactionHashesMsg, eth.NewPooledTransactionHashesPacket68{
+		Types:  []byte{ethtypes.LegacyTxType, types.BlobTxType, types.BlobTxType, 0x05},
+		Sizes:  []uint32{100, 200000, maxBlobTxSize + 1, 100},
+		Hashes: hashes,
+	}), peer)
+	assert.Nil(t, err)
+
+	// the oversized blob transaction and the unknown type are not announced to the BDN
+	txAnnouncements := <-bridge.ReceiveTransactionHashesAnnouncement()
+	assert.Equal(t, types.SHA256HashList{NewSHA256Hash(hashes[0]), NewSHA256Hash(hashes[1])}, txAnnouncements.Hashes)
+}
+
+func TestHandler_HandleBlobTransactionsFromNode(t *testing.T) {
+	bridge, handler, _ := setup()
+	peer, _, _ := testPeer(-1, 1)
+	peer.version = eth.ETH68
+	_ = handler.peers.register(peer)
+
+	tx, txContent := bxmock.NewSignedEthTxBytes(ethtypes.DynamicFeeTxType, 1, nil)
+	blobTx := bxmock.NewSignedBlobTx(2, nil)
+	blobTxContent, err := blobTx.Content()
+	assert.Nil(t, err)
+
+	err = handlePooledTransactions66(handler, encodeRLP(eth.PooledTransactionsMsg, eth.PooledTransactionsRLPPacket66{
+		RequestId:                   1,
+		PooledTransactionsRLPPacket: []rlp.RawValue{txContent, rlp.RawValue(blobTxContent)},
+	}), peer)
+	assert.Nil(t, err)
+
+	// the blob transactions are sent to the BDN with their sidecar
+	bxTxs := <-bridge.ReceiveNodeTransactions()
+	assert.Equal(t, 1, len(bxTxs.Transactions))
+	assert.Equal(t, NewSHA256Hash(blobTx.Hash()), bxTxs.Transactions[0].Hash())
+	assert.Equal(t, blobTxContent, bxTxs.Transactions[0].Content())
+
+	bxTxs = <-bridge.ReceiveNodeTransactions()
+	assert.Equal(t, 1, len(bxTxs.Transactions))
+	assert.Equal(t, NewSHA256Hash(tx.Hash()), bxTxs.Transactions[0].Hash())
+
+	canonical, err := blobTx.MarshalBinary()
+	assert.Nil(t, err)
+	canonicalContent, err := rlp.EncodeToBytes(canonical)
+	assert.Nil(t, err)
+	err = handlePooledTransactions66(handler, encodeRLP(eth.PooledTransactionsMsg, eth.PooledTransactionsRLPPacket66{
+		RequestId:                   2,
+		PooledTransactionsRLPPacket: []rlp.RawValue{canonicalContent},
+	}), peer)
+	assert.NotNil(t, err)
+}
+
+func TestHandler_HandleBlobTransactionsFromBDN(t *testing.T) {
+	_, handler, _ := setup()
+	peer68, rw68, _ := testPeer(-1, 1)
+	peer68.version = eth.ETH68
+	_ = handler.peers.register(peer68)
+	peer66, rw66, _ := testPeer(-1, 2)
+	peer66.version = eth.ETH66
+	_ = handler.peers.register(peer66)
+
+	blobTx := bxmock.NewSignedBlobTx(1, nil)
+	blobTxContent, err := blobTx.Content()
+	assert.Nil(t, err)
+	bxTx := types.NewRawBxTransaction(NewSHA256Hash(blobTx.Hash()), blobTxContent)
+	bxTx.AddFlags(types.TFPaidTx)
+
+	handler.processBDNTransactions(blockchain.Transactions{Transactions: []*types.BxTransaction{bxTx}})
+
+	// the blob transactions are only announced to the eth/68 peers
+	assert.Equal(t, 0, len(rw66.WriteMessages))
+	assert.Equal(t, 1, len(rw68.WriteMessages))
+	msg := rw68.PopWrittenMessage()
+	assert.Equal(t, uint64(eth.NewPooledTransactionHashesMsg), msg.Code)
+	var announcement eth.NewPooledTransactionHashesPacket68
+	assert.Nil(t, msg.Decode(&announcement))
+	assert.Equal(t, []byte{types.BlobTxType}, announcement.Types)
+	assert.Equal(t, []uint32{uint32(blobTx.Size())}, announcement.Sizes)
+	assert.Equal(t, []common.Hash{blobTx.Hash()}, announcement.Hashes)
+
+	// the node requests the announced transaction
+	err = handleGetPooledTransactions66(handler, encodeRLP(eth.GetPooledTransactionsMsg, eth.GetPooledTransactionsPacket66{
+		RequestId:                   5,
+		GetPooledTransactionsPacket: []common.Hash{blobTx.Hash(), {1}},
+	}), peer68)
+	assert.Nil(t, err)
+	msg = rw68.PopWrittenMessage()
+	assert.Equal(t, uint64(eth.PooledTransactionsMsg), msg.Code)
+	var response eth.PooledTransactionsRLPPacket66
+	assert.Nil(t, msg.Decode(&response))
+	assert.Equal(t, uint64(5), response.RequestId)
+	assert.Equal(t, eth.PooledTransactionsRLPPacket{rlp.RawValue(blobTxContent)}, response.PooledTransactionsRLPPacket)
+}
+
+func TestHandler_HandleSetCodeTransactionsFromNode(t *testing.T) {
+	bridge, handler, _ := setup()
+	peer, _, _ := testPeer(-1, 1)
+	_ = handler.peers.register(peer)
+
+	tx, txContent := bxmock.NewSignedEthTxBytes(ethtypes.DynamicFeeTxType, 1, nil)
+	setCodeTx := bxmock.NewSignedSetCodeTx(2, nil, nil, common.Address{1})
+	setCodeTxContent, err := setCodeTx.Content()
+	assert.Nil(t, err)
+
+	err = handleTransactions(handler, encodeRLP(eth.TransactionsMsg, []rlp.RawValue{txContent, rlp.RawValue(setCodeTxContent)}), peer)
+	assert.Nil(t, err)
+
+	// the set-code transactions are decoded separately from the go-ethereum transactions
+	bxTxs := <-bridge.ReceiveNodeTransactions()
+	assert.Equal(t, 1, len(bxTxs.Transactions))
+	assert.Equal(t, NewSHA256Hash(setCodeTx.Hash()), bxTxs.Transactions[0].Hash())
+	assert.Equal(t, setCodeTxContent, bxTxs.Transactions[0].Content())
+
+	bxTxs = <-bridge.ReceiveNodeTransactions()
+	assert.Equal(t, 1, len(bxTxs.Transactions))
+	assert.Equal(t, NewSHA256Hash(tx.Hash()), bxTxs.Transactions[0].Hash())
+
+	err = handlePooledTransactions66(handler, encodeRLP(eth.PooledTransactionsMsg, eth.PooledTransactionsRLPPacket66{
+		RequestId:                   1,
+		PooledTransactionsRLPPacket: []rlp.RawValue{rlp.RawValue(setCodeTxContent)},
+	}), peer)
+	assert.Nil(t, err)
+
+	bxTxs = <-bridge.ReceiveNodeTransactions()
+	assert.Equal(t, 1, len(bxTxs.Transactions))
+	assert.Equal(t, NewSHA256Hash(setCodeTx.Hash()), bxTxs.Transactions[0].Hash())
+}
+
+func TestHandler_HandleSetCodeTransactionsFromBDN(t *testing.T) {
+	_, handler, _ := setup()
+	peer, rw, _ := testPeer(-1, 1)
+	_ = handler.peers.register(peer)
+
+	setCodeTx := bxmock.NewSignedSetCodeTx(1, nil, nil, common.Address{1})
+	setCodeTxContent, err := setCodeTx.Content()
+	assert.Nil(t, err)
+	bxTx := types.NewRawBxTransaction(NewSHA256Hash(setCodeTx.Hash()), setCodeTxContent)
+	bxTx.AddFlags(types.TFPaidTx)
+
+	handler.processBDNTransactions(blockchain.Transactions{Transactions: []*types.BxTransaction{bxTx}})
+
+	// the set-code transactions are sent in their encoded form
+	assert.Equal(t, 1, len(rw.WriteMessages))
+	msg := rw.PopWrittenMessage()
+	assert.Equal(t, uint64(eth.TransactionsMsg), msg.Code)
+	var txs []rlp.RawValue
+	assert.Nil(t, msg.Decode(&txs))
+	assert.Equal(t, []rlp.RawValue{rlp.RawValue(setCodeTxContent)}, txs)
+}
+
 func TestHandler_HandleNewBlock_MultiNode_SlowNode(t *testing.T) {
 	bridge, handler, _ := setup()
 	peer, _, _ := testPeer(-1, 1)
@@ -0,0 +1,53 @@
+package eth
+
+import (
+	"sync"
+
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// blobTxPoolSize is the max number of blob transactions kept for the nodes, about 200MB with the max number of blobs
+const blobTxPoolSize = 256
+
+// blobTxPool keeps the blob transactions received from the BDN until the nodes request them, since the blob
+// transactions are announced to the nodes instead of being broadcast. The oldest transactions are evicted first
+type blobTxPool struct {
+	lock  sync.Mutex
+	txs   map[ethcommon.Hash]rlp.RawValue
+	order []ethcommon.Hash
+}
+
+func newBlobTxPool() *blobTxPool {
+	return &blobTxPool{txs: make(map[ethcommon.Hash]rlp.RawValue)}
+}
+
+// add stores the encoded network form of the blob transaction
+func (p *blobTxPool) add(hash ethcommon.Hash, encodedTx rlp.RawValue) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, ok := p.txs[hash]; ok {
+		return
+	}
+	if len(p.order) == blobTxPoolSize {
+		delete(p.txs, p.order[0])
+		p.order = p.order[1:]
+	}
+	p.txs[hash] = encodedTx
+	p.order = append(p.order, hash)
+}
+
+// get returns the encoded transactions of the hashes which are in the pool
+func (p *blobTxPool) get(hashes []ethcommon.Hash) []rlp.RawValue {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	txs := make([]rlp.RawValue, 0, len(hashes))
+	for _, hash := range hashes {
+		if tx, ok := p.txs[hash]; ok {
+			txs = append(txs, tx)
+		}
+	}
+	return txs
+}
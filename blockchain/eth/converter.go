@@ -29,6 +29,13 @@ type Converter struct{}
 
 // TransactionBDNToBlockchain convert a BDN transaction to an Ethereum one
 func (c Converter) TransactionBDNToBlockchain(transaction *types.BxTransaction) (interface{}, error) {
+	if types.IsBlobTxContent(transaction.Content()) {
+		return types.BlobTxFromContent(transaction.Content())
+	}
+	if types.IsSetCodeTxContent(transaction.Content()) {
+		return types.SetCodeTxFromContent(transaction.Content())
+	}
+
 	var ethTransaction ethtypes.Transaction
 	err := rlp.DecodeBytes(transaction.Content(), &ethTransaction)
 	return &ethTransaction, err
@@ -36,6 +43,22 @@ func (c Converter) TransactionBDNToBlockchain(transaction *types.BxTransaction)
 
 // TransactionBlockchainToBDN converts an Ethereum transaction to a BDN transaction
 func (c Converter) TransactionBlockchainToBDN(i interface{}) (*types.BxTransaction, error) {
+	if blobTx, ok := i.(*types.BlobTx); ok {
+		// the blob transactions are propagated in their network form, with their sidecar
+		content, err := blobTx.Content()
+		if err != nil {
+			return nil, err
+		}
+		return types.NewRawBxTransaction(NewSHA256Hash(blobTx.Hash()), content), nil
+	}
+	if setCodeTx, ok := i.(*types.SetCodeTx); ok {
+		content, err := setCodeTx.Content()
+		if err != nil {
+			return nil, err
+		}
+		return types.NewRawBxTransaction(NewSHA256Hash(setCodeTx.Hash()), content), nil
+	}
+
 	transaction := i.(*ethtypes.Transaction)
 	hash := NewSHA256Hash(transaction.Hash())
 
@@ -54,6 +77,8 @@ func (c Converter) BlockBlockchainToBDN(i interface{}) (*types.BxBlock, error) {
 		return c.ethBlockBlockchainToBDN(b)
 	case interfaces.ReadOnlySignedBeaconBlock:
 		return c.beaconBlockBlockchainToBDN(b)
+	case types.BeaconBlock:
+		return c.nativeBeaconBlockBlockchainToBDN(b)
 	case *ethtypes.Block:
 		return c.ethBlockBlockchainToBDN(NewBlockInfo(b, b.Difficulty()))
 	default:
@@ -217,6 +242,111 @@ func (c Converter) beaconBlockBlockchainToBDN(block interfaces.ReadOnlySignedBea
 	return types.NewBxBlock(hash, beaconHash, bxBlockType, nil, txs, encodedBlock, nil, new(big.Int).SetUint64(number), blockSize)
 }
 
+// nativeBeaconBlockBlockchainToBDN converts a beacon block of the Deneb fork or later
+func (c Converter) nativeBeaconBlockBlockchainToBDN(block types.BeaconBlock) (*types.BxBlock, error) {
+	var bxBlockType types.BxBlockType
+	switch block.Version() {
+	case types.VersionDeneb:
+		bxBlockType = types.BxBlockTypeBeaconDeneb
+	case types.VersionElectra:
+		bxBlockType = types.BxBlockTypeBeaconElectra
+	default:
+		return nil, fmt.Errorf("unrecognized beacon block version %v", types.BeaconVersionString(block.Version()))
+	}
+
+	rawHash, err := block.BlockRoot()
+	if err != nil {
+		return nil, fmt.Errorf("could not get hash: %v", err)
+	}
+	beaconHash := NewSHA256Hash(rawHash)
+
+	blockSize := block.SizeSSZ()
+
+	// Safe modification
+	encodedBlock, err := block.MarshalSSZ()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode block %v: %v", beaconHash, err)
+	}
+	block, err = types.NewBeaconBlock(block.Version())
+	if err != nil {
+		return nil, err
+	}
+	if err := block.UnmarshalSSZ(encodedBlock); err != nil {
+		return nil, fmt.Errorf("could not copy block %v: %v", beaconHash, err)
+	}
+
+	payload := block.ExecutionPayload()
+	if payload == nil {
+		return nil, fmt.Errorf("block %v has no execution payload", beaconHash)
+	}
+
+	var hash types.SHA256Hash
+	copy(hash[:], payload.BlockHash)
+
+	txs := make([]*types.BxBlockTransaction, 0, len(payload.Transactions))
+	for i, tx := range payload.Transactions {
+		compressedTx, err := beaconTransactionBlockchainToBDN(tx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %v", i, err)
+		}
+		txs = append(txs, compressedTx)
+	}
+	payload.Transactions = nil
+
+	encodedBlock, err = block.MarshalSSZ()
+	if err != nil {
+		return nil, fmt.Errorf("could not encode block %v body: %v", beaconHash, err)
+	}
+
+	return types.NewBxBlock(hash, beaconHash, bxBlockType, nil, txs, encodedBlock, nil, new(big.Int).SetUint64(payload.BlockNumber), blockSize)
+}
+
+// beaconTransactionBlockchainToBDN converts a transaction of an execution payload to a BDN block transaction.
+// The blob transactions of the payload have no sidecar, their content is the RLP string of the canonical form
+func beaconTransactionBlockchainToBDN(tx []byte) (*types.BxBlockTransaction, error) {
+	if types.IsBlobTx(tx) {
+		blobTx, err := types.DecodeBlobTx(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txBytes, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewBxBlockTransaction(NewSHA256Hash(blobTx.Hash()), txBytes), nil
+	}
+	if types.IsSetCodeTx(tx) {
+		setCodeTx, err := types.DecodeSetCodeTx(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		txBytes, err := rlp.EncodeToBytes(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		return types.NewBxBlockTransaction(NewSHA256Hash(setCodeTx.Hash()), txBytes), nil
+	}
+
+	t := new(ethtypes.Transaction)
+	if err := t.UnmarshalBinary(tx); err != nil {
+		return nil, err
+	}
+
+	// This is for back compatibility
+	// Beacon block encodes transaction using MarshalBinary instead of rlp.EncodeBytes
+	// For more info look at the comment of calcBeaconTransactionLength func
+	txBytes, err := rlp.EncodeToBytes(t)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewBxBlockTransaction(NewSHA256Hash(t.Hash()), txBytes), nil
+}
+
 // BlockBDNtoBlockchain converts a BDN block to an Ethereum block
 func (c Converter) BlockBDNtoBlockchain(block *types.BxBlock) (interface{}, error) {
 	switch block.Type {
@@ -224,6 +354,8 @@ func (c Converter) BlockBDNtoBlockchain(block *types.BxBlock) (interface{}, erro
 		return c.ethBlockBDNtoBlockchain(block)
 	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
 		return c.beaconBlockBDNtoBlockchain(block)
+	case types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
+		return c.nativeBeaconBlockBDNtoBlockchain(block)
 	default:
 		return nil, fmt.Errorf("could not convert block %v block type %v", block.Hash(), block.Type)
 	}
@@ -327,6 +459,75 @@ func (c Converter) beaconBlockBDNtoBlockchain(block *types.BxBlock) (interfaces.
 	return blocks.NewSignedBeaconBlock(blk)
 }
 
+// nativeBeaconBlockBDNtoBlockchain converts a BDN block to a beacon block of the Deneb fork or later
+func (c Converter) nativeBeaconBlockBDNtoBlockchain(block *types.BxBlock) (types.BeaconBlock, error) {
+	var v int
+	switch block.Type {
+	case types.BxBlockTypeBeaconDeneb:
+		v = types.VersionDeneb
+	case types.BxBlockTypeBeaconElectra:
+		v = types.VersionElectra
+	default:
+		return nil, fmt.Errorf("could not convert block %v to beacon block %v", block.Hash(), block.Type)
+	}
+
+	b, err := types.NewBeaconBlock(v)
+	if err != nil {
+		return nil, err
+	}
+	if err := b.UnmarshalSSZ(block.Trailer); err != nil {
+		return nil, fmt.Errorf("could not convert block %v body to blockchain format:%v", block.Hash(), err)
+	}
+
+	payload := b.ExecutionPayload()
+	if payload == nil {
+		return nil, fmt.Errorf("block %v has no execution payload", block.Hash())
+	}
+
+	txs := make([][]byte, 0, len(block.Txs))
+	for i, tx := range block.Txs {
+		txBytes, err := beaconTransactionBDNtoBlockchain(tx.Content())
+		if err != nil {
+			return nil, fmt.Errorf("could not decode transaction %d: %v", i, err)
+		}
+		txs = append(txs, txBytes)
+	}
+	payload.Transactions = txs
+
+	return b, nil
+}
+
+// beaconTransactionBDNtoBlockchain converts the content of a BDN block transaction to the binary encoding of the
+// execution payload. The blob transactions of the tx store are in their network form, the sidecar is dropped
+func beaconTransactionBDNtoBlockchain(content types.TxContent) ([]byte, error) {
+	if types.IsBlobTxContent(content) {
+		blobTx, err := types.BlobTxFromContent(content)
+		if err != nil {
+			return nil, err
+		}
+
+		return blobTx.MarshalBinary()
+	}
+	if types.IsSetCodeTxContent(content) {
+		setCodeTx, err := types.SetCodeTxFromContent(content)
+		if err != nil {
+			return nil, err
+		}
+
+		return setCodeTx.MarshalBinary()
+	}
+
+	t := new(ethtypes.Transaction)
+	if err := rlp.DecodeBytes(content, t); err != nil {
+		return nil, err
+	}
+
+	// This is for back compatibility
+	// Beacon block encodes transaction using MarshalBinary instead of rlp.EncodeBytes
+	// For more info look at the comment of calcBeaconTransactionLength func
+	return t.MarshalBinary()
+}
+
 // BeaconBlockToEthBlock converts beacon block to ETH block
 func BeaconBlockToEthBlock(block interfaces.ReadOnlySignedBeaconBlock) (*ethtypes.Block, error) {
 	execution, err := block.Block().Body().Execution()
@@ -1,6 +1,7 @@
 package eth
 
 import (
+	"encoding/hex"
 	"math/big"
 	"testing"
 
@@ -9,8 +10,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestConverter_Transactions(t *testing.T) {
@@ -152,3 +155,70 @@ func TestConverter_CapellaBeaconBlock(t *testing.T) {
 		assert.Equal(t, notificationTx.Hash(), capellaTx.Hash())
 	}
 }
+
+func TestConverter_DenebBeaconBlock(t *testing.T) {
+	block := bxmock.NewEthBlock(10, common.Hash{})
+	blobTx := bxmock.NewSignedBlobTx(1, nil)
+
+	beaconBlock := bxmock.NewDenebBeaconBlock(t, 11, block, blobTx)
+	testNativeBeaconBlock(t, beaconBlock, types.BxBlockTypeBeaconDeneb, blobTx)
+
+	// Without the blob transaction the payload has the transactions of the eth block
+	ethNotification, err := types.NewEthBlockNotificationFromPayload(block.Hash(), bxmock.NewDenebBeaconBlock(t, 11, block).ExecutionPayload(), nil)
+	require.NoError(t, err)
+	assert.Equal(t, ethtypes.DeriveSha(block.Transactions(), trie.NewStackTrie(nil)), ethNotification.Header.TransactionsRoot)
+	assert.Equal(t, block.NumberU64(), ethNotification.Header.GetNumber())
+}
+
+func TestConverter_ElectraBeaconBlock(t *testing.T) {
+	block := bxmock.NewEthBlock(10, common.Hash{})
+	blobTx := bxmock.NewSignedBlobTx(1, nil)
+
+	beaconBlock := bxmock.NewElectraBeaconBlock(t, 11, block, blobTx)
+	testNativeBeaconBlock(t, beaconBlock, types.BxBlockTypeBeaconElectra, blobTx)
+}
+
+func testNativeBeaconBlock(t *testing.T, beaconBlock types.BeaconBlock, bxBlockType types.BxBlockType, blobTx *types.BlobTx) {
+	c := Converter{}
+
+	encodedBlock, err := beaconBlock.MarshalSSZ()
+	require.NoError(t, err)
+	beaconHash, err := beaconBlock.BlockRoot()
+	require.NoError(t, err)
+	payload := beaconBlock.ExecutionPayload()
+
+	bxBlock, err := c.BlockBlockchainToBDN(beaconBlock)
+	require.NoError(t, err)
+	assert.Equal(t, bxBlockType, bxBlock.Type)
+	assert.Equal(t, payload.BlockHash, bxBlock.Hash().Bytes())
+	assert.Equal(t, beaconHash[:], bxBlock.BeaconHash().Bytes())
+	assert.Equal(t, len(payload.Transactions), len(bxBlock.Txs))
+
+	// The original block is not modified
+	reencodedBlock, err := beaconBlock.MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, encodedBlock, reencodedBlock)
+
+	// The blob transaction is known by its hash and is taken from the tx store in its network form
+	blobTxIndex := len(bxBlock.Txs) - 1
+	assert.Equal(t, blobTx.Hash().Bytes(), bxBlock.Txs[blobTxIndex].Hash().Bytes())
+	networkContent, err := blobTx.Content()
+	require.NoError(t, err)
+	bxBlock.Txs[blobTxIndex] = types.NewBxBlockTransaction(bxBlock.Txs[blobTxIndex].Hash(), networkContent)
+
+	blockchainBlock, err := c.BlockBDNtoBlockchain(bxBlock)
+	require.NoError(t, err)
+
+	convertedBlock, err := blockchainBlock.(types.BeaconBlock).MarshalSSZ()
+	require.NoError(t, err)
+	assert.Equal(t, encodedBlock, convertedBlock)
+
+	beaconNotification, err := types.NewNativeBeaconBlockNotification(blockchainBlock.(types.BeaconBlock))
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(beaconHash[:]), beaconNotification.GetHash())
+
+	ethNotification, err := types.NewEthBlockNotificationFromPayload(common.BytesToHash(payload.BlockHash), blockchainBlock.(types.BeaconBlock).ExecutionPayload(), nil)
+	require.NoError(t, err)
+	require.Len(t, ethNotification.Transactions, len(payload.Transactions))
+	assert.Equal(t, blobTx.Hash().String(), ethNotification.Transactions[blobTxIndex]["hash"])
+}
@@ -2,13 +2,24 @@ package datatype
 
 import (
 	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 // ProcessingETHTransaction structure of list for processing eth transactions
 type ProcessingETHTransaction struct {
 	txs                 ethtypes.Transactions
 	isAllowedForInbound []bool
+
+	// blob transactions are announced instead of being sent
+	blobTxHashes            []common.Hash
+	blobTxSizes             []uint32
+	isBlobAllowedForInbound []bool
+
+	// set-code transactions are sent in their encoded form
+	setCodeTxs                 []rlp.RawValue
+	isSetCodeAllowedForInbound []bool
 }
 
 // NewProcessingETHTransaction return new list with given size
@@ -38,3 +49,46 @@ func (p *ProcessingETHTransaction) Transactions(connectionType utils.NodeType, i
 	}
 	return p.txs
 }
+
+// AddBlobTx adds a blob transaction with the size of its network form to the list
+func (p *ProcessingETHTransaction) AddBlobTx(hash common.Hash, size uint32, isAllowedForInbound bool) {
+	p.blobTxHashes = append(p.blobTxHashes, hash)
+	p.blobTxSizes = append(p.blobTxSizes, size)
+	p.isBlobAllowedForInbound = append(p.isBlobAllowedForInbound, isAllowedForInbound)
+}
+
+// BlobTransactions return the hashes and sizes of the blob transactions based on input parameters
+func (p *ProcessingETHTransaction) BlobTransactions(connectionType utils.NodeType, inbound bool) ([]common.Hash, []uint32) {
+	if connectionType == utils.Blockchain && inbound {
+		var hashes []common.Hash
+		var sizes []uint32
+		for i, hash := range p.blobTxHashes {
+			if p.isBlobAllowedForInbound[i] {
+				hashes = append(hashes, hash)
+				sizes = append(sizes, p.blobTxSizes[i])
+			}
+		}
+		return hashes, sizes
+	}
+	return p.blobTxHashes, p.blobTxSizes
+}
+
+// AddSetCodeTx adds an encoded set-code transaction to the list
+func (p *ProcessingETHTransaction) AddSetCodeTx(encodedTx rlp.RawValue, isAllowedForInbound bool) {
+	p.setCodeTxs = append(p.setCodeTxs, encodedTx)
+	p.isSetCodeAllowedForInbound = append(p.isSetCodeAllowedForInbound, isAllowedForInbound)
+}
+
+// SetCodeTransactions return the encoded set-code transactions based on input parameters
+func (p *ProcessingETHTransaction) SetCodeTransactions(connectionType utils.NodeType, inbound bool) []rlp.RawValue {
+	if connectionType == utils.Blockchain && inbound {
+		var result []rlp.RawValue
+		for i, tx := range p.setCodeTxs {
+			if p.isSetCodeAllowedForInbound[i] {
+				result = append(result, tx)
+			}
+		}
+		return result
+	}
+	return p.setCodeTxs
+}
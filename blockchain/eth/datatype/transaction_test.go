@@ -5,6 +5,7 @@ import (
 
 	"github.com/bloXroute-Labs/gateway/v2/utils"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 
 	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -23,4 +24,9 @@ func TestProcessingETHTransaction(t *testing.T) {
 	assert.Equal(t, len(txs), 1)
 	txs = p.Transactions(utils.Gateway, true)
 	assert.Equal(t, len(txs), 2)
+
+	p.AddSetCodeTx(rlp.RawValue{0x01}, true)
+	p.AddSetCodeTx(rlp.RawValue{0x02}, false)
+	assert.Equal(t, len(p.SetCodeTransactions(utils.Blockchain, false)), 2)
+	assert.Equal(t, len(p.SetCodeTransactions(utils.Blockchain, true)), 1)
 }
@@ -5,11 +5,29 @@ import (
 	"math"
 
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
+// maxBlobTxSize is the max size of the network form of a blob transaction, with its max number of blobs
+const maxBlobTxSize = types.MaxBlobsPerTx*(types.BlobSize+2*types.KZGCommitmentSize) + 128*1024
+
+// blobTransactionsPacket is the blob transactions of a pooled transactions response, which go-ethereum cannot decode
+type blobTransactionsPacket []*types.BlobTx
+
+func (*blobTransactionsPacket) Name() string { return "BlobTransactions" }
+func (*blobTransactionsPacket) Kind() byte   { return eth.PooledTransactionsMsg }
+
+// setCodeTransactionsPacket is the set-code transactions of a transactions message or of a pooled transactions
+// response, which go-ethereum cannot decode
+type setCodeTransactionsPacket []*types.SetCodeTx
+
+func (*setCodeTransactionsPacket) Name() string { return "SetCodeTransactions" }
+func (*setCodeTransactionsPacket) Kind() byte   { return eth.TransactionsMsg }
+
 func handleGetBlockHeaders(backend Backend, msg Decoder, peer *Peer) error {
 	var query eth.GetBlockHeadersPacket
 	if err := msg.Decode(&query); err != nil {
@@ -160,19 +178,61 @@ func handleNewBlockMsg(backend Backend, msg Decoder, peer *Peer) error {
 }
 
 func handleTransactions(backend Backend, msg Decoder, peer *Peer) error {
-	var txs eth.TransactionsPacket
-	if err := msg.Decode(&txs); err != nil {
+	var rawTxs []rlp.RawValue
+	if err := msg.Decode(&rawTxs); err != nil {
 		return fmt.Errorf("could not decode message: %v: %v", msg, err)
 	}
-	hashes := make([]common.Hash, len(txs))
-	for idx, tx := range txs {
-		hashes[idx] = tx.Hash()
+	decodedTxs, setCodeTxs, err := decodeTransactions(rawTxs)
+	if err != nil {
+		return fmt.Errorf("could not decode message: %v: %v", msg, err)
+	}
+	txs := eth.TransactionsPacket(decodedTxs)
+
+	hashes := make([]common.Hash, 0, len(txs)+len(setCodeTxs))
+	for _, tx := range txs {
+		hashes = append(hashes, tx.Hash())
+	}
+	for _, tx := range setCodeTxs {
+		hashes = append(hashes, tx.Hash())
 	}
 	log.Tracef("%v: receive tx %v", peer, hashes)
 
+	if len(setCodeTxs) > 0 {
+		setCodeTxsPacket := setCodeTransactionsPacket(setCodeTxs)
+		if err = backend.Handle(peer, &setCodeTxsPacket); err != nil {
+			return err
+		}
+	}
+	if len(txs) == 0 {
+		return nil
+	}
 	return backend.Handle(peer, &txs)
 }
 
+// decodeTransactions decodes the transactions of a transactions message. The set-code transactions, which
+// go-ethereum does not support, are decoded separately
+func decodeTransactions(rawTxs []rlp.RawValue) ([]*ethtypes.Transaction, []*types.SetCodeTx, error) {
+	txs := make([]*ethtypes.Transaction, 0, len(rawTxs))
+	var setCodeTxs []*types.SetCodeTx
+	for _, rawTx := range rawTxs {
+		if types.IsSetCodeTxContent(types.TxContent(rawTx)) {
+			setCodeTx, err := types.SetCodeTxFromContent(types.TxContent(rawTx))
+			if err != nil {
+				return nil, nil, err
+			}
+			setCodeTxs = append(setCodeTxs, setCodeTx)
+			continue
+		}
+
+		var tx ethtypes.Transaction
+		if err := rlp.DecodeBytes(rawTx, &tx); err != nil {
+			return nil, nil, err
+		}
+		txs = append(txs, &tx)
+	}
+	return txs, setCodeTxs, nil
+}
+
 func handlePooledTransactions(backend Backend, msg Decoder, peer *Peer) error {
 	var txs eth.PooledTransactionsPacket
 	if err := msg.Decode(&txs); err != nil {
@@ -187,21 +247,77 @@ func handlePooledTransactions(backend Backend, msg Decoder, peer *Peer) error {
 }
 
 func handlePooledTransactions66(backend Backend, msg Decoder, peer *Peer) error {
-	var pooledTxsResponse eth.PooledTransactionsPacket66
+	var pooledTxsResponse eth.PooledTransactionsRLPPacket66
 	if err := msg.Decode(&pooledTxsResponse); err != nil {
 		return fmt.Errorf("could not decode message: %v: %v", msg, err)
 	}
 	// TODO: check why we get empty
-	if len(pooledTxsResponse.PooledTransactionsPacket) == 0 {
+	if len(pooledTxsResponse.PooledTransactionsRLPPacket) == 0 {
 		return nil
 	}
-	hashes := make([]common.Hash, len(pooledTxsResponse.PooledTransactionsPacket))
-	for idx, tx := range pooledTxsResponse.PooledTransactionsPacket {
-		hashes[idx] = tx.Hash()
+	txs, blobTxs, setCodeTxs, err := decodePooledTransactions(pooledTxsResponse.PooledTransactionsRLPPacket)
+	if err != nil {
+		return fmt.Errorf("could not decode message: %v: %v", msg, err)
 	}
 
-	log.Tracef("%v: received pooled txs %v", peer, len(hashes))
-	return backend.Handle(peer, &pooledTxsResponse.PooledTransactionsPacket)
+	log.Tracef("%v: received pooled txs %v", peer, len(txs)+len(blobTxs)+len(setCodeTxs))
+	if len(blobTxs) > 0 {
+		blobTxsPacket := blobTransactionsPacket(blobTxs)
+		if err = backend.Handle(peer, &blobTxsPacket); err != nil {
+			return err
+		}
+	}
+	if len(setCodeTxs) > 0 {
+		setCodeTxsPacket := setCodeTransactionsPacket(setCodeTxs)
+		if err = backend.Handle(peer, &setCodeTxsPacket); err != nil {
+			return err
+		}
+	}
+	if len(txs) == 0 {
+		return nil
+	}
+	pooledTxs := eth.PooledTransactionsPacket(txs)
+	return backend.Handle(peer, &pooledTxs)
+}
+
+// decodePooledTransactions decodes the transactions of a pooled transactions response. The blob and set-code
+// transactions, which go-ethereum does not support, are decoded separately and the blob transactions must have
+// their sidecar
+func decodePooledTransactions(rawTxs []rlp.RawValue) ([]*ethtypes.Transaction, []*types.BlobTx, []*types.SetCodeTx, error) {
+	otherTxs := make([]rlp.RawValue, 0, len(rawTxs))
+	var blobTxs []*types.BlobTx
+	for _, rawTx := range rawTxs {
+		if !types.IsBlobTxContent(types.TxContent(rawTx)) {
+			otherTxs = append(otherTxs, rawTx)
+			continue
+		}
+
+		blobTx, err := types.BlobTxFromContent(types.TxContent(rawTx))
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		if blobTx.Sidecar() == nil {
+			return nil, nil, nil, fmt.Errorf("blob transaction %v: %v", blobTx.Hash(), types.ErrBlobTxSidecarMissing)
+		}
+		blobTxs = append(blobTxs, blobTx)
+	}
+
+	txs, setCodeTxs, err := decodeTransactions(otherTxs)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	return txs, blobTxs, setCodeTxs, nil
+}
+
+func handleGetPooledTransactions66(backend Backend, msg Decoder, peer *Peer) error {
+	var query eth.GetPooledTransactionsPacket66
+	if err := msg.Decode(&query); err != nil {
+		return fmt.Errorf("could not decode message: %v: %v", msg, err)
+	}
+
+	txs := backend.GetPooledTransactions(query.GetPooledTransactionsPacket)
+	log.Tracef("%v: requested %v pooled transactions, %v available", peer, len(query.GetPooledTransactionsPacket), len(txs))
+	return peer.ReplyPooledTransactions(query.RequestId, txs)
 }
 
 func handleNewPooledTransactionHashes(backend Backend, msg Decoder, peer *Peer) error {
@@ -219,10 +335,42 @@ func handleNewPooledTransactionHashes68(backend Backend, msg Decoder, peer *Peer
 	if err := msg.Decode(&txs); err != nil {
 		return fmt.Errorf("could not decode message: %v: %v", msg, err)
 	}
+	if len(txs.Hashes) != len(txs.Types) || len(txs.Hashes) != len(txs.Sizes) {
+		return fmt.Errorf("invalid tx announcement: %v hashes, %v types and %v sizes", len(txs.Hashes), len(txs.Types), len(txs.Sizes))
+	}
 
 	log.Tracef("%v: received tx announcement of %v transactions", peer, len(txs.Hashes))
 
-	return backend.Handle(peer, &txs)
+	// the announced transactions are requested when the BDN does not have them, so the transactions which cannot be
+	// decoded or propagated are not announced to the BDN
+	announcement := eth.NewPooledTransactionHashesPacket68{}
+	for i, hash := range txs.Hashes {
+		if !announcedTxSupported(txs.Types[i], txs.Sizes[i]) {
+			log.Tracef("%v: ignoring announcement of tx %v of type %v and size %v", peer, hash, txs.Types[i], txs.Sizes[i])
+			continue
+		}
+		announcement.Types = append(announcement.Types, txs.Types[i])
+		announcement.Sizes = append(announcement.Sizes, txs.Sizes[i])
+		announcement.Hashes = append(announcement.Hashes, hash)
+	}
+	if len(announcement.Hashes) == 0 {
+		return nil
+	}
+
+	return backend.Handle(peer, &announcement)
+}
+
+// announcedTxSupported returns true if the announced transaction can be decoded by the gateway. The blob transactions
+// larger than the blobs limit of a transaction are not requested
+func announcedTxSupported(txType byte, size uint32) bool {
+	switch txType {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType, ethtypes.DynamicFeeTxType, types.SetCodeTxType:
+		return true
+	case types.BlobTxType:
+		return size <= maxBlobTxSize
+	default:
+		return false
+	}
 }
 
 func handleNewBlockHashes(backend Backend, msg Decoder, peer *Peer) error {
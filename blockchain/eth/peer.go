@@ -19,6 +19,7 @@ import (
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/rlp"
 )
 
 const (
@@ -466,6 +467,29 @@ func (ep *Peer) SendTransactions(txs ethtypes.Transactions) error {
 	return ep.send(eth.TransactionsMsg, txs)
 }
 
+// SendRawTransactions pushes a batch of encoded transactions to the peer, for the transaction types which
+// go-ethereum cannot encode
+func (ep *Peer) SendRawTransactions(txs []rlp.RawValue) error {
+	return ep.send(eth.TransactionsMsg, txs)
+}
+
+// AnnounceTransactions announces a batch of transactions to an eth/68 peer, with their types and sizes
+func (ep *Peer) AnnounceTransactions(txTypes []byte, sizes []uint32, txHashes []common.Hash) error {
+	return ep.send(eth.NewPooledTransactionHashesMsg, eth.NewPooledTransactionHashesPacket68{
+		Types:  txTypes,
+		Sizes:  sizes,
+		Hashes: txHashes,
+	})
+}
+
+// ReplyPooledTransactions sends the requested transactions to the peer, in their encoded form
+func (ep *Peer) ReplyPooledTransactions(id uint64, txs []rlp.RawValue) error {
+	return ep.send(eth.PooledTransactionsMsg, eth.PooledTransactionsRLPPacket66{
+		RequestId:                   id,
+		PooledTransactionsRLPPacket: txs,
+	})
+}
+
 // RequestTransactions requests a batch of announced transactions from the peer
 func (ep *Peer) RequestTransactions(txHashes []common.Hash) error {
 	packet := eth.GetPooledTransactionsPacket(txHashes)
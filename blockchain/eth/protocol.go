@@ -139,7 +139,7 @@ var eth66 = map[uint64]msgHandler{
 	eth.NodeDataMsg:              handleUnimplemented,
 	eth.GetReceiptsMsg:           handleUnimplemented,
 	eth.ReceiptsMsg:              handleUnimplemented,
-	eth.GetPooledTransactionsMsg: handleUnimplemented,
+	eth.GetPooledTransactionsMsg: handleGetPooledTransactions66,
 	eth.PooledTransactionsMsg:    handlePooledTransactions66,
 }
 
@@ -154,7 +154,7 @@ var eth67 = map[uint64]msgHandler{
 	eth.BlockBodiesMsg:                handleBlockBodies66,
 	eth.GetReceiptsMsg:                handleUnimplemented,
 	eth.ReceiptsMsg:                   handleUnimplemented,
-	eth.GetPooledTransactionsMsg:      handleUnimplemented,
+	eth.GetPooledTransactionsMsg:      handleGetPooledTransactions66,
 	eth.PooledTransactionsMsg:         handlePooledTransactions66,
 }
 
@@ -169,7 +169,7 @@ var eth68 = map[uint64]msgHandler{
 	eth.BlockBodiesMsg:                handleBlockBodies66,
 	eth.GetReceiptsMsg:                handleUnimplemented,
 	eth.ReceiptsMsg:                   handleUnimplemented,
-	eth.GetPooledTransactionsMsg:      handleUnimplemented,
+	eth.GetPooledTransactionsMsg:      handleGetPooledTransactions66,
 	eth.PooledTransactionsMsg:         handlePooledTransactions66,
 }
 
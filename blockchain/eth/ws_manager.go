@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strconv"
+	"strings"
 	"sync"
 	"time"
 
@@ -13,6 +15,7 @@ import (
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
 	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
 // WSManager implements the blockchain.WSManager interface for Ethereum
@@ -249,11 +252,71 @@ func (m *WSManager) ConstructRPCCallPayload(method string, callParams map[string
 	case "eth_getTransactionCount":
 		payload := []interface{}{callParams["address"], tag}
 		return payload, nil
+	case "eth_getLogs":
+		// the logs of the block of the tag only
+		filter := map[string]interface{}{"fromBlock": tag, "toBlock": tag}
+		if address, ok := callParams["address"]; ok {
+			filter["address"] = strings.Split(address, ",")
+		}
+		if topics, ok := callParams["topics"]; ok {
+			filter["topics"] = logsTopicsPayload(topics)
+		}
+		return []interface{}{filter}, nil
+	case "eth_estimateGas":
+		return []interface{}{callObjectPayload(callParams), tag}, nil
+	case "eth_feeHistory":
+		blockCount, err := strconv.ParseUint(callParams["blockCount"], 0, 64)
+		if err != nil || blockCount == 0 {
+			return nil, fmt.Errorf("invalid blockCount %v, it must be a positive number", callParams["blockCount"])
+		}
+		var rewardPercentiles []float64
+		if percentiles, ok := callParams["rewardPercentiles"]; ok && percentiles != "" {
+			for _, percentile := range strings.Split(percentiles, ",") {
+				value, err := strconv.ParseFloat(strings.TrimSpace(percentile), 64)
+				if err != nil || value < 0 || value > 100 {
+					return nil, fmt.Errorf("invalid rewardPercentiles %v, it must be a comma separated list of numbers between 0 and 100", percentiles)
+				}
+				rewardPercentiles = append(rewardPercentiles, value)
+			}
+		}
+		return []interface{}{hexutil.EncodeUint64(blockCount), tag, rewardPercentiles}, nil
+	case "debug_traceCall":
+		payload := []interface{}{callObjectPayload(callParams), tag}
+		if tracer, ok := callParams["tracer"]; ok {
+			payload = append(payload, map[string]string{"tracer": tracer})
+		}
+		return payload, nil
 	default:
 		return nil, fmt.Errorf("unexpectedly failed to match method %v", method)
 	}
 }
 
+// callObjectPayload returns the transaction call object of the payload fields
+func callObjectPayload(callParams map[string]string) map[string]string {
+	callObject := make(map[string]string)
+	for _, field := range callObjectFields {
+		if value, ok := callParams[field]; ok {
+			callObject[field] = value
+		}
+	}
+	return callObject
+}
+
+// logsTopicsPayload returns the topics filter of eth_getLogs, the topics are comma separated by position and the
+// alternatives of a position are separated by |, an empty position matches any topic
+func logsTopicsPayload(topics string) []interface{} {
+	var payload []interface{}
+	for _, position := range strings.Split(topics, ",") {
+		position = strings.TrimSpace(position)
+		if position == "" {
+			payload = append(payload, nil)
+			continue
+		}
+		payload = append(payload, strings.Split(position, "|"))
+	}
+	return payload
+}
+
 // UpdateNodeSyncStatus sends update on NodeSyncStatus channel if overall sync status has changed
 func (m *WSManager) UpdateNodeSyncStatus(nodeEndpoint types.NodeEndpoint, syncStatus blockchain.NodeSyncStatus) {
 	m.lock.Lock()
@@ -0,0 +1,46 @@
+package eth
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWSManager_ConstructRPCCallPayload(t *testing.T) {
+	m := &WSManager{}
+	tag := "0xa"
+
+	payload, err := m.ConstructRPCCallPayload("eth_getLogs", map[string]string{
+		"address": "0x01,0x02",
+		"topics":  "0xaa,,0xbb|0xcc",
+	}, tag)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]interface{}{
+		"fromBlock": tag,
+		"toBlock":   tag,
+		"address":   []string{"0x01", "0x02"},
+		"topics":    []interface{}{[]string{"0xaa"}, nil, []string{"0xbb", "0xcc"}},
+	}}, payload)
+
+	payload, err = m.ConstructRPCCallPayload("eth_estimateGas", map[string]string{"to": "0x01", "value": "0x1", "pos": "0x0"}, tag)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]string{"to": "0x01", "value": "0x1"}, tag}, payload)
+
+	payload, err = m.ConstructRPCCallPayload("eth_feeHistory", map[string]string{"blockCount": "4", "rewardPercentiles": "25, 75"}, tag)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{"0x4", tag, []float64{25, 75}}, payload)
+	_, err = m.ConstructRPCCallPayload("eth_feeHistory", map[string]string{"blockCount": "0"}, tag)
+	assert.Error(t, err)
+	_, err = m.ConstructRPCCallPayload("eth_feeHistory", map[string]string{"blockCount": "0x4", "rewardPercentiles": "101"}, tag)
+	assert.Error(t, err)
+
+	payload, err = m.ConstructRPCCallPayload("debug_traceCall", map[string]string{"to": "0x01", "data": "0x12", "tracer": "callTracer"}, tag)
+	require.NoError(t, err)
+	assert.Equal(t, []interface{}{map[string]string{"to": "0x01", "data": "0x12"}, tag, map[string]string{"tracer": "callTracer"}}, payload)
+
+	for _, method := range m.ValidRPCCallMethods() {
+		_, ok := m.RequiredPayloadFieldsForRPCMethod(method)
+		assert.True(t, ok, method)
+	}
+}
@@ -36,9 +36,9 @@ type RPCResponse struct {
 	Params  interface{} `json:"params"`
 }
 
-var validRPCCallPayloadFields = []string{"data", "from", "to", "gasPrice", "gas", "address", "pos"}
+var validRPCCallPayloadFields = []string{"data", "from", "to", "gasPrice", "gas", "value", "address", "pos", "topics", "blockCount", "rewardPercentiles", "tracer"}
 
-var validRPCCallMethods = []string{"eth_call", "eth_getBalance", "eth_getTransactionCount", "eth_getCode", "eth_getStorageAt", "eth_blockNumber"}
+var validRPCCallMethods = []string{"eth_call", "eth_getBalance", "eth_getTransactionCount", "eth_getCode", "eth_getStorageAt", "eth_blockNumber", "eth_getLogs", "eth_estimateGas", "eth_feeHistory", "debug_traceCall"}
 
 var commandMethodsToRequiredPayloadFields = map[string][]string{
 	"eth_call":                {"data"},
@@ -47,8 +47,15 @@ var commandMethodsToRequiredPayloadFields = map[string][]string{
 	"eth_getCode":             {"address"},
 	"eth_getStorageAt":        {"address", "pos"},
 	"eth_blockNumber":         {},
+	"eth_getLogs":             {},
+	"eth_estimateGas":         {},
+	"eth_feeHistory":          {"blockCount"},
+	"debug_traceCall":         {"data"},
 }
 
+// callObjectFields are the payload fields of the transaction call object of eth_estimateGas and debug_traceCall
+var callObjectFields = []string{"from", "to", "gas", "gasPrice", "value", "data"}
+
 // NewWSProvider - returns a new instance of WSProvider
 func NewWSProvider(ethWSUri string, peerEndpoint types.NodeEndpoint, timeout time.Duration) blockchain.WSProvider {
 	var ws WSProvider
@@ -162,6 +169,30 @@ func (ws *WSProvider) CallRPC(method string, payload []interface{}, options bloc
 	return response, err
 }
 
+// BatchCallRPC - executes Ethereum RPC calls in one batch request, the returned error is the error of the request. The
+// request is sent at least once and retried, the calls failing on the node are not
+func (ws *WSProvider) BatchCallRPC(calls []*blockchain.RPCBatchCall, options blockchain.RPCOptions) error {
+	batch := make([]rpc.BatchElem, len(calls))
+	for i, call := range calls {
+		batch[i] = rpc.BatchElem{Method: call.Method, Args: call.Payload, Result: &call.Result}
+	}
+
+	var err error
+	for retries := 0; ; retries++ {
+		if err = ws.client.BatchCall(batch); err == nil || retries+1 >= options.RetryAttempts {
+			break
+		}
+		time.Sleep(options.RetryInterval)
+	}
+	if err != nil {
+		return err
+	}
+	for i, call := range calls {
+		call.Error = batch[i].Error
+	}
+	return nil
+}
+
 // SendTransaction sends signed transaction in payload to node via CallRPC
 func (ws *WSProvider) SendTransaction(rawTx string, options blockchain.RPCOptions) (interface{}, error) {
 	return ws.CallRPC("eth_sendRawTransaction", []interface{}{rawTx}, options)
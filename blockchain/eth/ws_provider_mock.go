@@ -102,6 +102,15 @@ func (m *MockWSProvider) CallRPC(method string, payload []interface{}, options b
 	return "response", nil
 }
 
+// BatchCallRPC sets a fake response with no error to the calls
+func (m *MockWSProvider) BatchCallRPC(calls []*blockchain.RPCBatchCall, options blockchain.RPCOptions) error {
+	for _, call := range calls {
+		m.NumRPCCalls++
+		call.Result = "response"
+	}
+	return nil
+}
+
 // SendTransaction returns fake response with no error
 func (m *MockWSProvider) SendTransaction(rawTx string, options blockchain.RPCOptions) (interface{}, error) {
 	m.TxSent = append(m.TxSent, rawTx)
@@ -0,0 +1,29 @@
+package eth
+
+import (
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testEthService struct{}
+
+func (s *testEthService) ChainId() string { return "0x1" }
+
+func TestWSProvider_BatchCallRPC(t *testing.T) {
+	server := rpc.NewServer()
+	require.NoError(t, server.RegisterName("eth", &testEthService{}))
+	defer server.Stop()
+	ws := &WSProvider{client: rpc.DialInProc(server)}
+	defer ws.client.Close()
+
+	// the request is sent once without retries
+	calls := []*blockchain.RPCBatchCall{{Method: "eth_chainId"}, {Method: "eth_unknown"}}
+	require.NoError(t, ws.BatchCallRPC(calls, blockchain.RPCOptions{}))
+	assert.NoError(t, calls[0].Error)
+	assert.Equal(t, "0x1", calls[0].Result)
+	assert.Error(t, calls[1].Error)
+}
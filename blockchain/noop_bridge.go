@@ -112,6 +112,16 @@ func (n NoOpBxBridge) SendConfirmedBlockToGateway(block *types.BxBlock, peerEndp
 	return nil
 }
 
+// SendBlobSidecarToGateway is a no-op
+func (n NoOpBxBridge) SendBlobSidecarToGateway(sidecar *types.BlobSidecar, peerEndpoint types.NodeEndpoint) error {
+	return nil
+}
+
+// ReceiveBlobSidecarFromNode is a no-op
+func (n NoOpBxBridge) ReceiveBlobSidecarFromNode() <-chan BlobSidecarFromNode {
+	return make(chan BlobSidecarFromNode)
+}
+
 // SendBlockchainStatusRequest is a no-op
 func (n NoOpBxBridge) SendBlockchainStatusRequest() error { return nil }
 
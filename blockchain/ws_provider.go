@@ -25,6 +25,14 @@ type RPCOptions struct {
 // DefaultRPCOptions - provides default options for CallRPC
 var DefaultRPCOptions = RPCOptions{RetryAttempts: 5, RetryInterval: 10 * time.Millisecond}
 
+// RPCBatchCall is a call of a batch sent by WSProvider.BatchCallRPC, which sets its result or its error
+type RPCBatchCall struct {
+	Method  string
+	Payload []interface{}
+	Result  interface{}
+	Error   error
+}
+
 // Subscription represents a client RPC subscription
 type Subscription struct {
 	Sub interface{}
@@ -44,6 +52,7 @@ type WSProvider interface {
 	SyncStatus() NodeSyncStatus
 	Subscribe(responseChannel interface{}, feedName string, args ...interface{}) (*Subscription, error)
 	CallRPC(method string, payload []interface{}, options RPCOptions) (interface{}, error)
+	BatchCallRPC(calls []*RPCBatchCall, options RPCOptions) error
 	FetchTransaction(payload []interface{}, options RPCOptions) (interface{}, error)
 	FetchBlock(payload []interface{}, options RPCOptions) (interface{}, error)
 	FetchTransactionReceipt(payload []interface{}, options RPCOptions) (interface{}, error)
@@ -100,12 +100,12 @@ func (m *MEVSearcher) ToMEVBundle() (*MEVBundle, error) {
 	for i := range params[0].Txs {
 		txs[i] = params[0].Txs[i].String()
 
-		tx, err := gwUtils.ParseRawTransaction(params[0].Txs[i])
+		txHash, err := gwUtils.RawTransactionHash(params[0].Txs[i])
 		if err != nil {
 			return nil, fmt.Errorf("failed to parse raw transaction: %v", err)
 		}
 
-		bundleHash.Write(tx.Hash().Bytes())
+		bundleHash.Write(txHash.Bytes())
 	}
 
 	revertingTxHashes := make([]string, len(params[0].RevertingTxHashes))
@@ -7,9 +7,11 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"os/signal"
 	"path"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/bloXroute-Labs/gateway/v2/config"
@@ -18,6 +20,7 @@ import (
 	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
 	"github.com/bloXroute-Labs/gateway/v2/rpc"
 	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
+	"github.com/bloXroute-Labs/gateway/v2/servers"
 	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -134,6 +137,29 @@ func main() {
 				Before: beforeBxCli,
 				Action: cmdTxReceipts,
 			},
+			{
+				Name:  "logs",
+				Usage: "provides a stream of the logs matching the addresses and topics in each newly mined block",
+				Flags: []cli.Flag{
+					&cli.StringSliceFlag{
+						Name:     "include",
+						Required: false,
+					},
+					&cli.StringSliceFlag{
+						Name:  "address",
+						Usage: "contract addresses emitting the logs",
+					},
+					&cli.StringSliceFlag{
+						Name:  "topic",
+						Usage: "topics of each position in order, alternatives separated by '|', empty to match any topic",
+					},
+					&cli.StringFlag{
+						Name: "auth-header",
+					},
+				},
+				Before: beforeBxCli,
+				Action: cmdLogs,
+			},
 			{
 				Name:  "blxrtx",
 				Usage: "send paid transaction",
@@ -292,6 +318,33 @@ func main() {
 				Before: beforeBxCli,
 				Action: cmdShortIDs,
 			},
+			{
+				Name:  "replay",
+				Usage: "serves a feed recording of the gateway as a websocket and gRPC endpoint",
+				Flags: []cli.Flag{
+					&cli.StringFlag{
+						Name:     "file",
+						Usage:    "recording file, with the .jsonl or .pb extension",
+						Required: true,
+					},
+					&cli.Float64Flag{
+						Name:  "speed",
+						Usage: "multiplier of the recorded pace, 0 replays the recording without waiting",
+						Value: 1,
+					},
+					&cli.IntFlag{
+						Name:  "ws-port",
+						Usage: "port of the websocket endpoint, 0 disables it",
+						Value: 28333,
+					},
+					&cli.IntFlag{
+						Name:  "grpc-port",
+						Usage: "port of the gRPC endpoint serving the protobuf recordings, 0 disables it",
+						Value: 5001,
+					},
+				},
+				Action: cmdReplay,
+			},
 		},
 		Flags: []cli.Flag{
 			utils.GRPCHostFlag,
@@ -425,6 +478,16 @@ func removeEmptyLines(s []string) []string {
 	return result
 }
 
+func cmdReplay(ctx *cli.Context) error {
+	replayServer, err := servers.NewReplayServer(ctx.String("file"), ctx.Float64("speed"))
+	if err != nil {
+		return fmt.Errorf("could not open the recording: %v", err)
+	}
+	runCtx, cancel := signal.NotifyContext(ctx.Context, os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	return replayServer.Run(runCtx, ctx.Int("ws-port"), ctx.Int("grpc-port"))
+}
+
 func cmdStop(ctx *cli.Context) error {
 	err := rpc.GatewayConsoleCall(
 		config.NewGRPCFromCLI(ctx),
@@ -639,6 +702,44 @@ func cmdTxReceipts(ctx *cli.Context) error {
 	return nil
 }
 
+func cmdLogs(ctx *cli.Context) error {
+	var topics []*pb.LogTopics
+	for _, topic := range ctx.StringSlice("topic") {
+		alternatives := &pb.LogTopics{}
+		if topic != "" {
+			alternatives.Topics = strings.Split(topic, "|")
+		}
+		topics = append(topics, alternatives)
+	}
+	err := rpc.GatewayConsoleCall(
+		config.NewGRPCFromCLI(ctx),
+		func(callCtx context.Context, client pb.GatewayClient) (interface{}, error) {
+			stream, err := client.Logs(callCtx, &pb.LogsRequest{Includes: ctx.StringSlice("include"), Addresses: ctx.StringSlice("address"), Topics: topics, AuthHeader: ctx.String("auth-header")})
+			if err != nil {
+				return nil, err
+			}
+			for {
+				txLog, err := stream.Recv()
+				if err == io.EOF {
+					fmt.Println("logs error EOF: ", err)
+					break
+				}
+				if err != nil {
+					fmt.Println("logs error in recv: ", err)
+					break
+				}
+				fmt.Println(txLog)
+			}
+			return nil, nil
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("err subscribing to logs: %v", err)
+	}
+
+	return nil
+}
+
 func cmdBlxrTX(ctx *cli.Context) error {
 	err := rpc.GatewayConsoleCall(
 		config.NewGRPCFromCLI(ctx),
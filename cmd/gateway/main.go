@@ -50,6 +50,12 @@ func main() {
 			utils.TxTraceEnabledFlag,
 			utils.TxTraceMaxFileSizeFlag,
 			utils.TxTraceMaxBackupFilesFlag,
+			utils.RecordFeedsFlag,
+			utils.RecordDirFlag,
+			utils.RecordFormatFlag,
+			utils.RecordMaxFileSizeFlag,
+			utils.RecordMaxBackupFilesFlag,
+			utils.RecordRotationIntervalFlag,
 			utils.AvoidPrioritySendingFlag,
 			utils.RelayHostsFlag,
 			utils.DataDirFlag,
@@ -78,6 +84,7 @@ func main() {
 			utils.ManageWSServer,
 			utils.LogNetworkContentFlag,
 			utils.WSTLSFlag,
+			utils.WSCompressionFlag,
 			utils.MEVBuildersFilePathFlag,
 			utils.MEVMaxProfitBuilder,
 			utils.MEVBundleMethodNameFlag,
@@ -91,11 +98,15 @@ func main() {
 			utils.TransactionHoldDuration,
 			utils.TransactionPassedDueDuration,
 			utils.EnableBlockchainRPCMethodSupport,
+			utils.EthOnBlockMulticallFlag,
+			utils.RPCProxyFlag,
+			utils.RPCProxyMethodsFlag,
 			utils.DialRatio,
 			utils.NumRecommendedPeers,
 			utils.NoTxsToBlockchain,
 			utils.NoBlocks,
 			utils.NoStats,
+			utils.ABIDirFlag,
 		},
 		Action: runGateway,
 	}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 	"time"
 
 	"github.com/bloXroute-Labs/gateway/v2/logger"
@@ -41,8 +42,10 @@ type Bx struct {
 	WebsocketTLSEnabled bool
 	WebsocketHost       string
 	WebsocketPort       int
-	ManageWSServer      bool
-	HTTPPort            int
+	// WebsocketCompression enables the permessage-deflate compression with the websocket clients supporting it
+	WebsocketCompression bool
+	ManageWSServer       bool
+	HTTPPort             int
 
 	BlocksOnly          bool
 	AllTransactions     bool
@@ -60,11 +63,18 @@ type Bx struct {
 	NoTxsToBlockchain            bool
 	NoBlocks                     bool
 	NoStats                      bool
+	ABIDir                       string
+	// RPCProxyMethods are the read only blockchain RPC methods forwarded to the synced nodes, none if the proxy is disabled
+	RPCProxyMethods []string
+	// EthOnBlockMulticall aggregates the eth_call calls of the ethOnBlock subscriptions with the Multicall3 contract
+	EthOnBlockMulticall bool
 
 	*GRPC
 	*Env
 	*logger.Config
 	*TxTraceLog
+
+	FeedRecording *FeedRecording
 }
 
 // NewBxFromCLI builds bx node configuration from the CLI context
@@ -106,6 +116,14 @@ func NewBxFromCLI(ctx *cli.Context) (*Bx, error) {
 		}
 	}
 
+	var rpcProxyMethods []string
+	if ctx.Bool(utils.RPCProxyFlag.Name) {
+		rpcProxyMethods = ctx.StringSlice(utils.RPCProxyMethodsFlag.Name)
+		if err = validateRPCProxyMethods(rpcProxyMethods); err != nil {
+			return nil, err
+		}
+	}
+
 	bxConfig := &Bx{
 		Host:               ctx.String(utils.HostFlag.Name),
 		OverrideExternalIP: ctx.IsSet(utils.ExternalIPFlag.Name),
@@ -120,11 +138,12 @@ func NewBxFromCLI(ctx *cli.Context) (*Bx, error) {
 		FluentDEnabled:     ctx.Bool(utils.FluentDFlag.Name),
 		FluentDHost:        ctx.String(utils.FluentdHostFlag.Name),
 
-		WebsocketEnabled:    ctx.Bool(utils.WSFlag.Name),
-		WebsocketTLSEnabled: ctx.Bool(utils.WSTLSFlag.Name),
-		WebsocketHost:       ctx.String(utils.WSHostFlag.Name),
-		WebsocketPort:       ctx.Int(utils.WSPortFlag.Name),
-		ManageWSServer:      ctx.Bool(utils.ManageWSServer.Name),
+		WebsocketEnabled:     ctx.Bool(utils.WSFlag.Name),
+		WebsocketTLSEnabled:  ctx.Bool(utils.WSTLSFlag.Name),
+		WebsocketHost:        ctx.String(utils.WSHostFlag.Name),
+		WebsocketPort:        ctx.Int(utils.WSPortFlag.Name),
+		WebsocketCompression: ctx.Bool(utils.WSCompressionFlag.Name),
+		ManageWSServer:       ctx.Bool(utils.ManageWSServer.Name),
 
 		HTTPPort: ctx.Int(utils.HTTPPortFlag.Name),
 
@@ -140,15 +159,20 @@ func NewBxFromCLI(ctx *cli.Context) (*Bx, error) {
 		ForwardTransactionMethod:   ctx.String(utils.ForwardTransactionMethod.Name),
 		EnableDynamicPeers:         ctx.Bool(utils.EnableDynamicPeers.Name),
 		EnableBlockchainRPC:        ctx.Bool(utils.EnableBlockchainRPCMethodSupport.Name),
+		RPCProxyMethods:            rpcProxyMethods,
+		EthOnBlockMulticall:        ctx.Bool(utils.EthOnBlockMulticallFlag.Name),
 		PendingTxsSourceFromNode:   ctx.Bool(utils.PendingTxsSourceFromNode.Name),
 		NoTxsToBlockchain:          ctx.Bool(utils.NoTxsToBlockchain.Name),
 		NoBlocks:                   ctx.Bool(utils.NoBlocks.Name),
 		NoStats:                    ctx.Bool(utils.NoStats.Name),
+		ABIDir:                     ctx.String(utils.ABIDirFlag.Name),
 
 		GRPC:       grpcConfig,
 		Env:        env,
 		Config:     log,
 		TxTraceLog: txTraceLog,
+
+		FeedRecording: NewFeedRecordingFromCLI(ctx),
 	}
 
 	if bxConfig.BlocksOnly && bxConfig.AllTransactions {
@@ -158,6 +182,40 @@ func NewBxFromCLI(ctx *cli.Context) (*Bx, error) {
 	return bxConfig, nil
 }
 
+// rpcProxyWriteMethods are the blockchain RPC methods changing the state of the node, which are never proxied
+var rpcProxyWriteMethods = map[string]bool{
+	"eth_sendRawTransaction":          true,
+	"eth_sendTransaction":             true,
+	"eth_sign":                        true,
+	"eth_signTransaction":             true,
+	"eth_signTypedData":               true,
+	"eth_submitWork":                  true,
+	"eth_submitHashrate":              true,
+	"eth_subscribe":                   true,
+	"eth_unsubscribe":                 true,
+	"eth_newFilter":                   true,
+	"eth_newBlockFilter":              true,
+	"eth_newPendingTransactionFilter": true,
+	"eth_uninstallFilter":             true,
+	"eth_getFilterChanges":            true,
+}
+
+// validateRPCProxyMethods returns an error if a method of the proxy is not a read only eth_, net_ or web3_ method
+func validateRPCProxyMethods(methods []string) error {
+	if len(methods) == 0 {
+		return errors.New("--rpc-proxy requires at least one method in --rpc-proxy-methods")
+	}
+	for _, method := range methods {
+		if !strings.HasPrefix(method, "eth_") && !strings.HasPrefix(method, "net_") && !strings.HasPrefix(method, "web3_") {
+			return fmt.Errorf("invalid --rpc-proxy-methods method %v, only eth_, net_ and web3_ methods can be proxied", method)
+		}
+		if rpcProxyWriteMethods[method] {
+			return fmt.Errorf("invalid --rpc-proxy-methods method %v, only read only methods can be proxied", method)
+		}
+	}
+	return nil
+}
+
 // GRPC represents Go RPC configuration details
 type GRPC struct {
 	Enabled     bool
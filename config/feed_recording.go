@@ -0,0 +1,41 @@
+package config
+
+import (
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/urfave/cli/v2"
+)
+
+// FeedRecording represents the configuration of the feeds recorded to local files
+type FeedRecording struct {
+	Feeds          []types.FeedType
+	Dir            string
+	Format         string
+	MaxFileSize    int
+	MaxBackupFiles int
+	// RotationInterval is the interval of the rotation of the recording file, 0 rotates it by size only
+	RotationInterval time.Duration
+}
+
+// NewFeedRecordingFromCLI builds the feed recording configuration from the CLI context
+func NewFeedRecordingFromCLI(ctx *cli.Context) *FeedRecording {
+	var feeds []types.FeedType
+	for _, feed := range ctx.StringSlice(utils.RecordFeedsFlag.Name) {
+		feeds = append(feeds, types.FeedType(feed))
+	}
+	return &FeedRecording{
+		Feeds:            feeds,
+		Dir:              ctx.String(utils.RecordDirFlag.Name),
+		Format:           ctx.String(utils.RecordFormatFlag.Name),
+		MaxFileSize:      ctx.Int(utils.RecordMaxFileSizeFlag.Name),
+		MaxBackupFiles:   ctx.Int(utils.RecordMaxBackupFilesFlag.Name),
+		RotationInterval: ctx.Duration(utils.RecordRotationIntervalFlag.Name),
+	}
+}
+
+// Enabled returns true if any feed is recorded
+func (r *FeedRecording) Enabled() bool {
+	return r != nil && len(r.Feeds) > 0
+}
@@ -28,6 +28,9 @@ const SyncChunkSize = 500 * 1024
 // TxStoreMaxSize - If number of Txs in TxStore is above TxStoreMaxSize cleanup will bring it back to TxStoreMaxSize (per network)
 const TxStoreMaxSize = 200000
 
+// TxStoreMaxBlobsSize - If the size of the blob Txs in TxStore is above TxStoreMaxBlobsSize cleanup will bring it back to TxStoreMaxBlobsSize (per network)
+const TxStoreMaxBlobsSize = 256 * 1024 * 1024
+
 // BlockRecoveryTimeout - max time to wait for block recovery before canceling block
 const BlockRecoveryTimeout = 10 * time.Second
 
@@ -70,6 +73,9 @@ const MaxEthTxReceiptCallRetries = 5
 // EthTxReceiptCallRetrySleepInterval - duration of sleep between RPC call retry attempts for txReceipts feed
 const EthTxReceiptCallRetrySleepInterval = 2 * time.Millisecond
 
+// Multicall3Address - address of the Multicall3 contract, deployed at the same address on most EVM chains
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
 // TaskCompletedEvent - sent as notification on onBlock feed after all RPC calls are completed
 const TaskCompletedEvent = "TaskCompletedEvent"
 
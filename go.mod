@@ -32,12 +32,12 @@ require (
 	github.com/urfave/cli/v2 v2.23.7
 	github.com/wk8/go-ordered-map v1.0.0
 	github.com/wk8/go-ordered-map/v2 v2.1.6
-	github.com/zhouzhuojie/conditions v0.2.3
 	go.uber.org/atomic v1.10.0
 	golang.org/x/crypto v0.10.0
 	golang.org/x/sync v0.3.0
 	google.golang.org/grpc v1.55.0
 	google.golang.org/protobuf v1.30.0
+	gopkg.in/natefinch/lumberjack.v2 v2.2.1
 	gotest.tools v2.2.0+incompatible
 )
 
@@ -204,7 +204,6 @@ require (
 	google.golang.org/genproto/googleapis/rpc v0.0.0-20230530153820-e85fd2cbaebc // indirect
 	gopkg.in/cenkalti/backoff.v1 v1.1.0 // indirect
 	gopkg.in/inf.v0 v0.9.1 // indirect
-	gopkg.in/natefinch/lumberjack.v2 v2.2.1 // indirect
 	gopkg.in/natefinch/npipe.v2 v2.0.0-20160621034901-c1b8fa8bdcce // indirect
 	gopkg.in/yaml.v2 v2.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
@@ -30,6 +30,7 @@ const (
 	RPCStopMonitoringTx           RPCRequestType = "stop_monitor_transaction"
 	RPCFeeBumpTx                  RPCRequestType = "blxr_tx_fee_bump"
 	RPCChangeNewPendingTxFromNode RPCRequestType = "new_pending_txs_source_from_node"
+	RPCRegisterABI                RPCRequestType = "blxr_register_abi"
 	RPCEthSubscribe               RPCRequestType = "eth_subscribe"
 	RPCEthSendRawTransaction      RPCRequestType = "eth_sendRawTransaction"
 	RPCEthUnsubscribe             RPCRequestType = "eth_unsubscribe"
@@ -41,11 +42,21 @@ const (
 	RPCEthSendMegaBundle RPCRequestType = "eth_sendMegabundle"
 	RPCEthCallBundle     RPCRequestType = "eth_callBundle"
 	RPCEthCancelBundle   RPCRequestType = "eth_cancelBundle"
+
+	RPCEthSendPrivateTransaction RPCRequestType = "eth_sendPrivateTransaction"
 )
 
 // RPCMethodToRPCRequestType maps gRPC methods to RPCRequestType
 var RPCMethodToRPCRequestType = map[string]RPCRequestType{
-	"/gateway.Gateway/BlxrTx": RPCTx,
+	"/gateway.Gateway/BlxrTx":                  RPCTx,
+	"/gateway.Gateway/BlxrBatchTX":             RPCBatchTx,
+	"/gateway.Gateway/BlxrSubmitBundle":        RPCBundleSubmission,
+	"/gateway.Gateway/QuotaUsage":              RPCQuotaUsage,
+	"/gateway.Gateway/Ping":                    RPCPing,
+	"/gateway.Gateway/Unsubscribe":             RPCUnsubscribe,
+	"/gateway.Gateway/StartMonitorTransaction": RPCStartMonitoringTx,
+	"/gateway.Gateway/StopMonitorTransaction":  RPCStopMonitoringTx,
+	"/gateway.Gateway/RegisterAbi":             RPCRegisterABI,
 }
 
 // RPCTxPayload is the payload of blxr_tx requests
@@ -62,6 +73,25 @@ type RPCTxPayload struct {
 	FrontRunningProtection  bool           `json:"front_running_protection"`
 }
 
+// RPCFeeBumpTxPayload is the payload of blxr_tx_fee_bump request
+type RPCFeeBumpTxPayload struct {
+	Transaction             string `json:"transaction"`
+	OriginalSenderAccountID string `json:"original_sender_account_id"`
+	NodeValidation          bool   `json:"node_validation"`
+	FrontRunningProtection  bool   `json:"front_running_protection"`
+}
+
+// RPCPrivateTxPayload is the payload of blxr_private_tx request
+type RPCPrivateTxPayload struct {
+	Transaction             string `json:"transaction"`
+	OriginalSenderAccountID string `json:"original_sender_account_id"`
+}
+
+// RPCPrivateTxBalancePayload is the payload of private_tx_balance request
+type RPCPrivateTxBalancePayload struct {
+	OriginalSenderAccountID string `json:"original_sender_account_id"`
+}
+
 // RPCBatchTxPayload is the payload of blxr_batch_tx request
 type RPCBatchTxPayload struct {
 	Transactions            []string `json:"transactions"`
@@ -70,6 +100,17 @@ type RPCBatchTxPayload struct {
 	OriginalSenderAccountID string   `json:"original_sender_account_id"`
 }
 
+// RPCMonitorTxPayload is the payload of start_monitor_transaction and stop_monitor_transaction requests
+type RPCMonitorTxPayload struct {
+	SubscriptionID    string   `json:"subscription_id"`
+	TransactionHashes []string `json:"transaction_hashes"`
+}
+
+// RPCRegisterABIPayload is the payload of blxr_register_abi request
+type RPCRegisterABIPayload struct {
+	ABI json.RawMessage `json:"abi"`
+}
+
 type rpcTxJSON struct {
 	Transaction             string         `json:"transaction"`
 	MevBundleTx             bool           `json:"mev_bundle_tx"`
@@ -165,6 +206,45 @@ func (p RPCBundleSubmissionPayload) Validate() error {
 
 }
 
+// RPCBundleSimulationPayload is the payload of blxr_simulate_bundle request
+type RPCBundleSimulationPayload struct {
+	BlockchainNetwork       string   `json:"blockchain_network"`
+	Transaction             []string `json:"transaction"`
+	BlockNumber             string   `json:"block_number"`
+	StateBlockNumber        string   `json:"state_block_number"`
+	Timestamp               int64    `json:"timestamp"`
+	OriginalSenderAccountID string   `json:"original_sender_account_id"`
+}
+
+// Validate doing validation for blxr_simulate_bundle payload
+func (p RPCBundleSimulationPayload) Validate() error {
+	if len(p.Transaction) == 0 {
+		return errors.New("bundle missing txs")
+	}
+	if p.Timestamp < 0 {
+		return errors.New("timestamp must be greater than or equal to 0")
+	}
+	if p.BlockNumber != "" {
+		if _, err := hexutil.DecodeUint64(p.BlockNumber); err != nil {
+			return fmt.Errorf("blockNumber must be hex, %v", err)
+		}
+	}
+	if p.StateBlockNumber != "" && p.StateBlockNumber != "latest" {
+		if _, err := hexutil.DecodeUint64(p.StateBlockNumber); err != nil {
+			return fmt.Errorf("stateBlockNumber must be hex or latest, %v", err)
+		}
+	}
+	return nil
+}
+
+// RPCCallBundle is the payload of eth_callBundle request
+type RPCCallBundle struct {
+	Txs              []string `json:"txs"`
+	BlockNumber      string   `json:"blockNumber"`
+	StateBlockNumber string   `json:"stateBlockNumber"`
+	Timestamp        int64    `json:"timestamp,omitempty"`
+}
+
 // RPCMEVSearcherPayload is the payload of blxr_searcher request
 // Depreceted: use RPCBundleSubmissionPayload instead. Will be removed in the future.
 type RPCMEVSearcherPayload struct {
@@ -188,6 +268,12 @@ type RPCSendBundle struct {
 	EnforcePayout     bool     `json:"enforcePayout,omitempty"`
 }
 
+// RPCSendPrivateTransaction is the payload of eth_sendPrivateTransaction sent to MEV builders
+type RPCSendPrivateTransaction struct {
+	Tx             string `json:"tx"`
+	MaxBlockNumber string `json:"maxBlockNumber,omitempty"`
+}
+
 // RPCCancelBundlePayload custom json-rpc required to cancel flashbots bundle
 type RPCCancelBundlePayload struct {
 	ReplacementUUID string `json:"replacementUuid"`
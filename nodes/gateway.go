@@ -32,6 +32,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/sourcegraph/jsonrpc2"
 	"go.uber.org/atomic"
+	"google.golang.org/grpc/peer"
 
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
 	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
@@ -79,6 +80,9 @@ type gateway struct {
 	isBDN              bool
 	bdnStats           *bxmessage.BdnPerformanceStats
 	blockProcessor     services.BlockProcessor
+	txStatusMonitor    *services.TxStatusMonitor
+	mempoolEvents      *services.MempoolEvents
+	privateTxService   *services.PrivateTxService
 	pendingTxs         services.HashHistory
 	possiblePendingTxs services.HashHistory
 	txTrace            loggers.TxTrace
@@ -98,6 +102,7 @@ type gateway struct {
 	seenMEVMinerBundles   services.HashHistory
 	seenMEVSearchers      services.HashHistory
 	seenBlockConfirmation services.HashHistory
+	seenBlobSidecars      services.HashHistory
 
 	mevClient           *http.Client
 	mevBundleDispatcher *bundle.Dispatcher
@@ -182,6 +187,7 @@ func NewGateway(parent context.Context, bxConfig *config.Bx, bridge blockchain.B
 		seenMEVMinerBundles:          services.NewHashHistory("mevMinerBundle", 30*time.Minute),
 		seenMEVSearchers:             services.NewHashHistory("mevSearcher", 30*time.Minute),
 		seenBlockConfirmation:        services.NewHashHistory("blockConfirmation", 30*time.Minute),
+		seenBlobSidecars:             services.NewHashHistory("blobSidecars", 15*time.Minute),
 		clock:                        clock,
 		timeStarted:                  clock.Now(),
 		gatewayPeers:                 GeneratePeers(peersInfo),
@@ -221,6 +227,7 @@ func NewGateway(parent context.Context, bxConfig *config.Bx, bridge blockchain.B
 
 	g.asyncMsgChannel = services.NewAsyncMsgChannel(g)
 	g.mevBundleDispatcher = bundle.NewDispatcher(bxConfig.MEVBuilders, bxConfig.MEVMaxProfitBuilder, bxConfig.ProcessMegaBundle)
+	g.privateTxService = services.NewPrivateTxService(clock, g.sendPrivateTx)
 
 	// create tx store service pass to eth client
 	g.bdnStats = bxmessage.NewBDNStats(blockchainPeers, recommendedPeers)
@@ -241,6 +248,42 @@ func (g *gateway) msgAdapter(msg bxmessage.Message, source connections.Conn, wai
 	}
 }
 
+// sendPrivateTx sends a private transaction to the MEV builders and, on BSC and Polygon, to the next validator.
+// Re-broadcasts to the next validator bypass the TxStore which already holds the transaction
+func (g *gateway) sendPrivateTx(tx *services.PrivateTx, rebroadcast bool) error {
+	if !g.mevBundleDispatcher.HasBuilders() && !tx.NextValidator {
+		return errors.New("private transactions require MEV builders to be configured using mev-builders-file-path")
+	}
+
+	if g.mevBundleDispatcher.HasBuilders() {
+		if err := g.mevBundleDispatcher.DispatchPrivateTx(tx.Hash.String(), tx.RawTx, 0); err != nil {
+			return err
+		}
+	}
+
+	if !tx.NextValidator {
+		return nil
+	}
+
+	networkNum := g.sdn.NetworkNum()
+	msg := bxmessage.NewTx(tx.Hash, tx.Content, networkNum, types.TFPaidTx|types.TFLocalRegion|types.TFNextValidator, tx.AccountID)
+	pending, err := servers.ProcessNextValidatorTx(msg, 0, g.nextValidatorMap, g.validatorStatusMap, networkNum, tx.Source, make(map[string]servers.PendingNextValidatorTxInfo))
+	if err != nil {
+		return err
+	}
+	if pending {
+		log.Debugf("next validator is not accessible, private transaction %v will be sent on the next block", tx.Hash)
+		return nil
+	}
+
+	if !rebroadcast {
+		return g.HandleMsg(msg, tx.Source, connections.RunForeground)
+	}
+	msg.SetTimestamp(g.clock.Now())
+	g.broadcast(msg, tx.Source, utils.RelayTransaction)
+	return nil
+}
+
 func (g *gateway) isSyncWithRelay() bool {
 	return g.syncedWithRelay.Load()
 }
@@ -251,9 +294,15 @@ func (g *gateway) setSyncWithRelay() {
 
 func (g *gateway) setupTxStore() {
 	assigner := services.NewEmptyShortIDAssigner()
-	g.TxStore = services.NewEthTxStore(g.clock, 30*time.Minute, 3*24*time.Hour, 10*time.Minute,
+	maxTxAge := 3 * 24 * time.Hour
+	cleanupFreq := 10 * time.Minute
+	txStore := services.NewEthTxStore(g.clock, 30*time.Minute, maxTxAge, cleanupFreq,
 		assigner, services.NewHashHistory("seenTxs", 30*time.Minute), nil, *g.sdn.Networks(), services.NoOpBloomFilter{})
+	g.TxStore = txStore
 	g.blockProcessor = services.NewBlockProcessor(g.TxStore)
+	g.txStatusMonitor = services.NewTxStatusMonitor(g.clock, g.TxStore, g.notify)
+	g.mempoolEvents = services.NewMempoolEvents(g.clock, g.TxStore, maxTxAge+cleanupFreq, g.notify)
+	txStore.SetEvictionHandler(g.mempoolEvents.OnEvicted)
 }
 
 // InitSDN initialize SDN, get account model
@@ -399,6 +448,7 @@ func (g *gateway) Run() error {
 	}
 
 	go g.TxStore.Start()
+	go g.txStatusMonitor.Start()
 	go g.updateValidatorStateMap()
 
 	if g.BxConfig.NoStats {
@@ -420,16 +470,31 @@ func (g *gateway) Run() error {
 		g.wsManager, accountModel, g.sdn.FetchCustomerAccountModel,
 		sslCert.PrivateCertFile(), sslCert.PrivateKeyFile(), *g.BxConfig, g.stats, g.nextValidatorMap, g.validatorStatusMap,
 	)
+	g.feedManager.SetTxStatusMonitor(g.txStatusMonitor)
+	g.feedManager.SetTxStore(g.TxStore)
+	g.feedManager.SetPrivateTxService(g.privateTxService)
 
 	g.grpcHandler = servers.NewGrpcHandler(g.feedManager)
 
-	// start feed manager if websocket or gRPC is enabled
-	if g.BxConfig.WebsocketEnabled || g.BxConfig.WebsocketTLSEnabled || g.BxConfig.GRPC.Enabled {
+	// start feed manager if websocket or gRPC is enabled, or feeds are recorded
+	if g.BxConfig.WebsocketEnabled || g.BxConfig.WebsocketTLSEnabled || g.BxConfig.GRPC.Enabled || g.BxConfig.FeedRecording.Enabled() {
 		g.feedManager.Start()
 	}
 
+	if g.BxConfig.FeedRecording.Enabled() {
+		recorder, err := servers.NewFeedRecorder(g.feedManager, g.BxConfig.FeedRecording)
+		if err != nil {
+			return fmt.Errorf("failed to create the feed recorder: %v", err)
+		}
+		go func() {
+			if err := recorder.Run(g.context); err != nil {
+				log.Errorf("feed recording stopped: %v", err)
+			}
+		}()
+	}
+
 	if g.BxConfig.WebsocketEnabled || g.BxConfig.WebsocketTLSEnabled {
-		clientHandler := servers.NewClientHandler(g.feedManager, nil, servers.NewHTTPServer(g.feedManager, g.BxConfig.HTTPPort), g.BxConfig.EnableBlockchainRPC, g.sdn.GetQuotaUsage, log.WithFields(log.Fields{
+		clientHandler := servers.NewClientHandler(g.feedManager, nil, servers.NewHTTPServer(g.feedManager, g.BxConfig.HTTPPort, g.sdn.GetQuotaUsage, g.BxConfig.EnableBlockchainRPC, &g.BxConfig.PendingTxsSourceFromNode, g.authorize), g.BxConfig.EnableBlockchainRPC, g.sdn.GetQuotaUsage, log.WithFields(log.Fields{
 			"component": "gatewayClientHandler",
 		}), &g.BxConfig.PendingTxsSourceFromNode, g.authorize)
 		go clientHandler.ManageWSServer(g.BxConfig.ManageWSServer)
@@ -804,9 +869,19 @@ func (g *gateway) generateFutureValidatorInfo(block *types.BxBlock) []*types.Fut
 }
 
 func (g *gateway) publishBlock(bxBlock *types.BxBlock, nodeSource *connections.Blockchain, info []*types.FutureValidatorInfo, isBlockchainBlock bool) error {
-
 	// publishing a block means extracting the sender for all the block transactions which is heavy.
-	// if there are no active block related feed subscribers we can skip this.
+	// the block services follow their transactions on every block, so the block is converted for them even if there
+	// are no active block related feed subscribers
+	ethNotification, beaconNotification, err := g.newBlockNotifications(bxBlock, info)
+	if err != nil {
+		return fmt.Errorf("cannot create block notifications: %v", err)
+	}
+	if ethNotification != nil {
+		g.txStatusMonitor.OnBlock(ethNotification, isBlockchainBlock)
+		g.mempoolEvents.OnBlock(ethNotification)
+		g.privateTxService.OnBlock(ethNotification)
+	}
+
 	if !g.feedManager.NeedBlocks() {
 		return nil
 	}
@@ -835,62 +910,61 @@ func (g *gateway) publishBlock(bxBlock *types.BxBlock, nodeSource *connections.B
 		}
 	}
 
-	if err := g.notifyBlockFeeds(bxBlock, nodeSource, info, isBlockchainBlock); err != nil {
-		return fmt.Errorf("cannot notify block feeds: %v", err)
-	}
+	g.notifyBlockFeeds(bxBlock, nodeSource, ethNotification, beaconNotification, isBlockchainBlock)
 
 	return nil
 }
 
-func (g *gateway) notifyBlockFeeds(bxBlock *types.BxBlock, nodeSource *connections.Blockchain, info []*types.FutureValidatorInfo, isBlockchainBlock bool) error {
+// newBlockNotifications converts the block to its ETH notification, and to its beacon notification if it is a
+// beacon block
+func (g *gateway) newBlockNotifications(bxBlock *types.BxBlock, info []*types.FutureValidatorInfo) (*types.EthBlockNotification, types.BlockNotification, error) {
 	// Not optimal. Block -> BxBlock -> Block
 	block, err := g.bridge.BlockBDNtoBlockchain(bxBlock)
 	if err != nil {
-		return fmt.Errorf("cannot convert BxBlock to blockchain block: %v", err)
+		return nil, nil, fmt.Errorf("cannot convert BxBlock to blockchain block: %v", err)
 	}
 
-	notifyEthBlockFeeds := func(block *ethtypes.Block, nodeSource *connections.Blockchain, info []*types.FutureValidatorInfo, isBlockchainBlock bool) error {
-		ethNotification, err := types.NewEthBlockNotification(common.Hash(bxBlock.Hash()), block, info)
+	switch b := block.(type) {
+	case interfaces.ReadOnlySignedBeaconBlock:
+		beaconNotification, err := types.NewBeaconBlockNotification(b)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
 
-		if g.bdnBlocks.SetIfAbsent(bxBlock.Hash().String(), 15*time.Minute) {
-			// Send ETH notifications to BDN feed even if source is blockchain
-			notification := ethNotification.Clone()
-			notification.SetNotificationType(types.BDNBlocksFeed)
-			g.notify(notification)
-
-			// Waits response from node WS provider
-			// Because it is in goroutine time will not be present in handleDuration
-			go g.notifyTxReceiptsAndOnBlockFeeds(nodeSource, ethNotification)
-		} else {
-			log.Tracef("duplicate ETH block %v from %v for bdnBlocks", block.Hash(), nodeSource)
+		ethBlock, err := eth.BeaconBlockToEthBlock(b)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		if isBlockchainBlock {
-			if g.newBlocks.SetIfAbsent(bxBlock.Hash().String(), 15*time.Minute) {
-				g.bestBlockHeight = int(block.Number().Int64())
-				g.bdnBlocksSkipCount = 0
-
-				notification := ethNotification.Clone()
-				notification.SetNotificationType(types.NewBlocksFeed)
-				g.notify(notification)
-			} else {
-				log.Tracef("duplicate ETH block %v from %v for newBlocks", block.Hash(), nodeSource)
-			}
+		ethNotification, err := types.NewEthBlockNotification(common.Hash(bxBlock.Hash()), ethBlock, info)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ethNotification, beaconNotification, nil
+	case types.BeaconBlock:
+		beaconNotification, err := types.NewNativeBeaconBlockNotification(b)
+		if err != nil {
+			return nil, nil, err
 		}
 
-		return nil
-	}
-
-	switch b := block.(type) {
-	case interfaces.ReadOnlySignedBeaconBlock:
-		beaconNotification, err := types.NewBeaconBlockNotification(b)
+		ethNotification, err := types.NewEthBlockNotificationFromPayload(common.Hash(bxBlock.Hash()), b.ExecutionPayload(), info)
 		if err != nil {
-			return err
+			return nil, nil, err
 		}
+		return ethNotification, beaconNotification, nil
+	case *eth.BlockInfo:
+		ethNotification, err := types.NewEthBlockNotification(common.Hash(bxBlock.Hash()), b.Block, info)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ethNotification, nil, nil
+	}
 
+	return nil, nil, nil
+}
+
+func (g *gateway) notifyBlockFeeds(bxBlock *types.BxBlock, nodeSource *connections.Blockchain, ethNotification *types.EthBlockNotification, beaconNotification types.BlockNotification, isBlockchainBlock bool) {
+	if beaconNotification != nil {
 		if g.bdnBlocks.SetIfAbsent(bxBlock.BeaconHash().String(), 15*time.Minute) {
 			// Send beacon notifications to BDN feed even if source is blockchain
 			notification := beaconNotification.Clone()
@@ -905,22 +979,38 @@ func (g *gateway) notifyBlockFeeds(bxBlock *types.BxBlock, nodeSource *connectio
 				g.notify(notification)
 			}
 		}
+		g.notifyBlobSidecars(bxBlock.BlobSidecars)
+	}
 
-		ethBlock, err := eth.BeaconBlockToEthBlock(b)
-		if err != nil {
-			return err
-		}
+	if ethNotification == nil {
+		return
+	}
 
-		if err := notifyEthBlockFeeds(ethBlock, nodeSource, info, isBlockchainBlock); err != nil {
-			return err
-		}
-	case *eth.BlockInfo:
-		if err := notifyEthBlockFeeds(b.Block, nodeSource, info, isBlockchainBlock); err != nil {
-			return err
-		}
+	if g.bdnBlocks.SetIfAbsent(bxBlock.Hash().String(), 15*time.Minute) {
+		// Send ETH notifications to BDN feed even if source is blockchain
+		notification := ethNotification.Clone()
+		notification.SetNotificationType(types.BDNBlocksFeed)
+		g.notify(notification)
+
+		// Waits response from node WS provider
+		// Because it is in goroutine time will not be present in handleDuration
+		go g.notifyTxReceiptsAndOnBlockFeeds(nodeSource, ethNotification)
+	} else {
+		log.Tracef("duplicate ETH block %v from %v for bdnBlocks", bxBlock.Hash(), nodeSource)
 	}
 
-	return nil
+	if isBlockchainBlock {
+		if g.newBlocks.SetIfAbsent(bxBlock.Hash().String(), 15*time.Minute) {
+			g.bestBlockHeight = int(ethNotification.Header.GetNumber())
+			g.bdnBlocksSkipCount = 0
+
+			notification := ethNotification.Clone()
+			notification.SetNotificationType(types.NewBlocksFeed)
+			g.notify(notification)
+		} else {
+			log.Tracef("duplicate ETH block %v from %v for newBlocks", bxBlock.Hash(), nodeSource)
+		}
+	}
 }
 
 func (g *gateway) notifyTxReceiptsAndOnBlockFeeds(nodeSource *connections.Blockchain, ethNotification *types.EthBlockNotification) {
@@ -946,6 +1036,11 @@ func (g *gateway) notifyTxReceiptsAndOnBlockFeeds(nodeSource *connections.Blockc
 	notification.SetNotificationType(types.TxReceiptsFeed)
 	notification.SetSource(&sourceEndpoint)
 	g.notify(notification)
+
+	notification = ethNotification.Clone()
+	notification.SetNotificationType(types.LogsFeed)
+	notification.SetSource(&sourceEndpoint)
+	g.notify(notification)
 }
 
 func (g *gateway) publishPendingTx(txHash types.SHA256Hash, bxTx *types.BxTransaction, fromNode bool) {
@@ -1063,6 +1158,8 @@ func (g *gateway) handleBridgeMessages() error {
 					}
 				}, fmt.Sprintf("ReceiveConfirmedBlockFromNode hash=[%s]", confirmBlock.Block.Hash()), confirmBlock.PeerEndpoint.String(), 1)
 			}
+		case blobSidecar := <-g.bridge.ReceiveBlobSidecarFromNode():
+			g.notifyBlobSidecars([]*types.BlobSidecar{blobSidecar.Sidecar})
 		case blockchainBlock := <-g.bridge.ReceiveBlockFromNode():
 			if !g.BxConfig.NoBlocks {
 				traceIfSlow(func() { g.handleBlockFromBlockchain(blockchainBlock) },
@@ -1336,6 +1433,10 @@ func (g *gateway) processTransaction(tx *bxmessage.Tx, source connections.Conn)
 		if txResult.NewContent || txResult.Reprocess {
 			validatorsOnlyTxFromCloudAPI := connectionType == utils.CloudAPI && tx.Flags().IsValidatorsOnly()
 			nextValidatorTxFromCloudAPI := connectionType == utils.CloudAPI && tx.Flags().IsNextValidator()
+			if txResult.NewContent {
+				g.txStatusMonitor.OnTransaction(txResult.Transaction)
+				g.mempoolEvents.OnTransaction(txResult)
+			}
 			if txResult.NewContent && !tx.Flags().IsValidatorsOnly() && !tx.Flags().IsNextValidator() {
 				newTxsNotification := types.CreateNewTransactionNotification(txResult.Transaction)
 				g.notify(newTxsNotification)
@@ -1609,8 +1710,26 @@ func (g *gateway) processBlockFromBDN(bxBlock *types.BxBlock) {
 	}
 }
 
+// notifyBlobSidecars notifies the blobSidecars feed of the sidecars which are not notified yet,
+// the sidecars arrive from the beacon nodes and from the BDN with their block
+func (g *gateway) notifyBlobSidecars(sidecars []*types.BlobSidecar) {
+	for _, sidecar := range sidecars {
+		notification, err := types.NewBlobSidecarNotification(sidecar)
+		if err != nil {
+			log.Errorf("could not create blob sidecar notification: %v", err)
+			continue
+		}
+
+		if !g.seenBlobSidecars.SetIfAbsent(notification.GetHash(), 15*time.Minute) {
+			continue
+		}
+
+		g.notify(notification)
+	}
+}
+
 func (g *gateway) notify(notification types.Notification) {
-	if g.BxConfig.WebsocketEnabled || g.BxConfig.WebsocketTLSEnabled || g.BxConfig.GRPC.Enabled {
+	if g.BxConfig.WebsocketEnabled || g.BxConfig.WebsocketTLSEnabled || g.BxConfig.GRPC.Enabled || g.BxConfig.FeedRecording.Enabled() {
 		select {
 		case g.feedManagerChan <- notification:
 		default:
@@ -2218,6 +2337,127 @@ func (g *gateway) TxReceipts(req *pb.TxReceiptsRequest, stream pb.Gateway_TxRece
 	return g.grpcHandler.TxReceipts(req, stream, g.sdn.AccountModel())
 }
 
+func (g *gateway) Logs(req *pb.LogsRequest, stream pb.Gateway_LogsServer) error {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return err
+	}
+	return g.grpcHandler.Logs(req, stream, g.sdn.AccountModel())
+}
+
+func (g *gateway) MempoolEvents(req *pb.MempoolEventsRequest, stream pb.Gateway_MempoolEventsServer) error {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return err
+	}
+	return g.grpcHandler.MempoolEvents(req, stream, g.sdn.AccountModel())
+}
+
+func (g *gateway) TransactionStatus(req *pb.TransactionStatusRequest, stream pb.Gateway_TransactionStatusServer) error {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return err
+	}
+	return g.grpcHandler.TransactionStatus(req, stream, g.sdn.AccountModel())
+}
+
+func (g *gateway) StartMonitorTransaction(_ context.Context, req *pb.MonitorTransactionRequest) (*pb.MonitorTransactionReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return nil, err
+	}
+	return g.grpcHandler.StartMonitorTransaction(req, g.sdn.AccountModel())
+}
+
+func (g *gateway) StopMonitorTransaction(_ context.Context, req *pb.MonitorTransactionRequest) (*pb.MonitorTransactionReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return nil, err
+	}
+	return g.grpcHandler.StopMonitorTransaction(req, g.sdn.AccountModel())
+}
+
+func (g *gateway) NewBeaconBlocks(req *pb.BeaconBlocksRequest, stream pb.Gateway_NewBeaconBlocksServer) error {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return err
+	}
+	return g.grpcHandler.NewBeaconBlocks(req, stream, g.sdn.AccountModel())
+}
+
+func (g *gateway) BdnBeaconBlocks(req *pb.BeaconBlocksRequest, stream pb.Gateway_BdnBeaconBlocksServer) error {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return err
+	}
+	return g.grpcHandler.BdnBeaconBlocks(req, stream, g.sdn.AccountModel())
+}
+
+func (g *gateway) BlobSidecars(req *pb.BlobSidecarsRequest, stream pb.Gateway_BlobSidecarsServer) error {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return err
+	}
+	return g.grpcHandler.BlobSidecars(req, stream, g.sdn.AccountModel())
+}
+
+func (g *gateway) BlxrSubmitBundle(ctx context.Context, req *pb.BlxrSubmitBundleRequest) (*pb.BlxrSubmitBundleReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, true, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var remoteAddress string
+	if p, ok := peer.FromContext(ctx); ok {
+		remoteAddress = p.Addr.String()
+	}
+	return g.grpcHandler.BlxrSubmitBundle(req, g.sdn.AccountModel(), remoteAddress)
+}
+
+func (g *gateway) QuotaUsage(_ context.Context, req *pb.QuotaUsageRequest) (*pb.QuotaUsageReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return nil, err
+	}
+
+	accountID := g.sdn.AccountModel().AccountID
+	quota, err := g.sdn.GetQuotaUsage(string(accountID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch quota usage: %v", err)
+	}
+	return &pb.QuotaUsageReply{
+		AccountId:   quota.AccountID,
+		QuotaFilled: uint64(quota.QuotaFilled),
+		QuotaLimit:  uint64(quota.QuotaLimit),
+	}, nil
+}
+
+func (g *gateway) Ping(_ context.Context, req *pb.PingRequest) (*pb.PongReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, false, true)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PongReply{Pong: time.Now().UTC().Format(bxgateway.MicroSecTimeFormat)}, nil
+}
+
+func (g *gateway) Unsubscribe(_ context.Context, req *pb.UnsubscribeRequest) (*pb.UnsubscribeReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, true, true)
+	if err != nil {
+		return nil, err
+	}
+	return g.grpcHandler.Unsubscribe(req)
+}
+
+// RegisterAbi registers the ABI used by the filters and the decoded input of all the subscriptions, so it requires
+// the auth header of the node account
+func (g *gateway) RegisterAbi(_ context.Context, req *pb.RegisterAbiRequest) (*pb.RegisterAbiReply, error) {
+	err := g.validateAuthHeader(req.AuthHeader, true, false)
+	if err != nil {
+		return nil, err
+	}
+	return g.grpcHandler.RegisterAbi(req)
+}
+
 func (g *gateway) sendStatsOnInterval(interval time.Duration) {
 	ticker := g.clock.Ticker(interval)
 	for {
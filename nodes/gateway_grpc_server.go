@@ -71,6 +71,7 @@ func (ggs *gatewayGRPCServer) run() {
 		grpc.InitialConnWindowSize(windowSize),
 		grpc.UnaryInterceptor(ggs.authenticate),
 		grpc.ChainUnaryInterceptor(ggs.authenticate, ggs.reqSDKStats),
+		grpc.ChainStreamInterceptor(ggs.streamSDKStats),
 	}
 
 	ggs.server = grpc.NewServer(serverOptions...)
@@ -114,6 +115,25 @@ func (ggs *gatewayGRPCServer) reqSDKStats(ctx context.Context, req interface{},
 	return resp, err
 }
 
+// streamSDKStats logs the SDK stats of the gRPC feeds when the stream ends, the feed type is logged as the method
+func (ggs *gatewayGRPCServer) streamSDKStats(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	md, ok := metadata.FromIncomingContext(stream.Context())
+	start := time.Now()
+
+	err := handler(srv, stream)
+
+	if ok && len(md.Get(types.SDKVersionHeaderKey)) > 0 {
+		method := string(types.RPCStreamToFeedType[info.FullMethod])
+		if method == "" {
+			// in case the stream is not mapped
+			method = info.FullMethod
+		}
+		go ggs.sdkStat(md, method, start)
+	}
+
+	return err
+}
+
 func (ggs *gatewayGRPCServer) sdkStat(md metadata.MD, method string, start time.Time) {
 	var blockchain, sourceCode, version string
 
@@ -2,6 +2,7 @@ package nodes
 
 import (
 	"context"
+	"encoding/base64"
 	"runtime"
 	"testing"
 	"time"
@@ -15,6 +16,7 @@ import (
 	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
 	"github.com/bloXroute-Labs/gateway/v2/rpc"
 	"github.com/bloXroute-Labs/gateway/v2/servers"
+	"github.com/bloXroute-Labs/gateway/v2/services/statistics"
 	"github.com/bloXroute-Labs/gateway/v2/test"
 	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
 	"github.com/bloXroute-Labs/gateway/v2/types"
@@ -22,6 +24,8 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func spawnGRPCServer(t *testing.T, port int, user string, password string) (*gateway, blockchain.Bridge, *gatewayGRPCServer) {
@@ -286,3 +290,113 @@ func TestGatewayGRPCBdnBlocks(t *testing.T) {
 
 	require.NoError(t, err)
 }
+
+func TestGatewayGRPCUnsubscribe(t *testing.T) {
+	port := test.NextTestPort()
+
+	g, _, s := spawnGRPCServer(t, port, "", "")
+	defer s.Stop()
+	g.BxConfig.WebsocketEnabled = true
+
+	require.NoError(t, g.feedManager.Start())
+
+	clientConfig := config.NewGRPC("127.0.0.1", port, "", "")
+	err := rpc.GatewayConsoleCall(clientConfig, func(ctx context.Context, client pb.GatewayClient) (interface{}, error) {
+		pong, err := client.Ping(ctx, &pb.PingRequest{AuthHeader: "Og=="})
+		require.NoError(t, err)
+		require.NotEmpty(t, pong.GetPong())
+
+		newBlocksStream, err := client.NewBlocks(ctx, &pb.BlocksRequest{AuthHeader: "Og=="})
+		require.NoError(t, err)
+
+		var subscriptionID string
+		require.Eventually(t, func() bool {
+			subscriptions, err := client.Subscriptions(ctx, &pb.SubscriptionsRequest{AuthHeader: "Og=="})
+			require.NoError(t, err)
+			if len(subscriptions.GetSubscriptions()) != 1 {
+				return false
+			}
+			subscriptionID = subscriptions.GetSubscriptions()[0].GetSubscriptionId()
+			return true
+		}, time.Second, time.Millisecond)
+		require.NotEmpty(t, subscriptionID)
+
+		reply, err := client.Unsubscribe(ctx, &pb.UnsubscribeRequest{SubscriptionId: subscriptionID, AuthHeader: "Og=="})
+		require.NoError(t, err)
+		require.True(t, reply.GetSuccess())
+
+		// the stream serving the subscription ends
+		_, err = newBlocksStream.Recv()
+		require.Error(t, err)
+
+		reply, err = client.Unsubscribe(ctx, &pb.UnsubscribeRequest{SubscriptionId: subscriptionID, AuthHeader: "Og=="})
+		require.NoError(t, err)
+		require.False(t, reply.GetSuccess())
+		return reply, nil
+	})
+	require.NoError(t, err)
+}
+
+type sdkStatsRecorder struct {
+	statistics.NoStats
+	methods chan string
+}
+
+func (s sdkStatsRecorder) LogSDKInfo(_, method, _, _ string, feed types.FeedConnectionType, _, _ time.Time) {
+	if feed == types.GRPCFeed {
+		s.methods <- method
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s testServerStream) Context() context.Context { return s.ctx }
+
+func TestGatewayGRPCServerStreamSDKStats(t *testing.T) {
+	recorder := sdkStatsRecorder{methods: make(chan string, 2)}
+	s := gatewayGRPCServer{gateway: &gateway{stats: recorder}}
+	handler := func(interface{}, grpc.ServerStream) error { return nil }
+
+	// the streams of the feeds are logged by the feed type
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(types.SDKVersionHeaderKey, "1.0.0"))
+	err := s.streamSDKStats(nil, testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/gateway.Gateway/TxReceipts"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, string(types.TxReceiptsFeed), <-recorder.methods)
+
+	err = s.streamSDKStats(nil, testServerStream{ctx: ctx}, &grpc.StreamServerInfo{FullMethod: "/gateway.Gateway/Unknown"}, handler)
+	require.NoError(t, err)
+	assert.Equal(t, "/gateway.Gateway/Unknown", <-recorder.methods)
+
+	// the streams without SDK headers are not logged
+	err = s.streamSDKStats(nil, testServerStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: "/gateway.Gateway/TxReceipts"}, handler)
+	require.NoError(t, err)
+	time.Sleep(10 * time.Millisecond)
+	assert.Empty(t, recorder.methods)
+}
+
+func TestGatewayGRPCServerStreamsMapped(t *testing.T) {
+	for _, stream := range pb.Gateway_ServiceDesc.Streams {
+		method := "/" + pb.Gateway_ServiceDesc.ServiceName + "/" + stream.StreamName
+		assert.Contains(t, types.RPCStreamToFeedType, method)
+	}
+}
+
+func TestGatewayRegisterAbiRequiresNodeAccount(t *testing.T) {
+	g, _, s := spawnGRPCServer(t, test.NextTestPort(), "", "")
+	defer s.Stop()
+
+	abi := `[{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"value","type":"uint256"}]}]`
+
+	_, err := g.RegisterAbi(context.Background(), &pb.RegisterAbiRequest{Abi: abi})
+	assert.EqualError(t, err, "auth header is missing")
+
+	otherAccount := base64.StdEncoding.EncodeToString([]byte("other-account:secret"))
+	_, err = g.RegisterAbi(context.Background(), &pb.RegisterAbiRequest{Abi: abi, AuthHeader: otherAccount})
+	assert.Error(t, err)
+
+	_, err = g.RegisterAbi(context.Background(), &pb.RegisterAbiRequest{Abi: abi, AuthHeader: g.getHeaderFromGateway()})
+	assert.NoError(t, err)
+}
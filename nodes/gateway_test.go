@@ -107,6 +107,9 @@ func setup(t *testing.T, numPeers int) (blockchain.Bridge, *gateway) {
 		networkNum, types.NetworkID(chainID), g.sdn.NodeModel().NodeID,
 		g.wsManager, g.sdn.AccountModel(), nil,
 		"", "", *g.BxConfig, g.stats, nil, nil)
+	g.feedManager.SetTxStatusMonitor(g.txStatusMonitor)
+	g.feedManager.SetTxStore(g.TxStore)
+	g.feedManager.SetPrivateTxService(g.privateTxService)
 	return bridge, g
 }
 
@@ -741,7 +744,7 @@ func TestGateway_HandleBlockFromBlockchain(t *testing.T) {
 	assert.NotNil(t, err, "unexpected bytes %v", string(msgBytes))
 	close(g.feedManagerChan)
 	wg.Wait()
-	assert.Equal(t, int32(4), atomic.LoadInt32(&count))
+	assert.Equal(t, int32(5), atomic.LoadInt32(&count))
 }
 
 func TestGateway_HandleBlockFromInboundBlockchain(t *testing.T) {
@@ -798,7 +801,7 @@ func TestGateway_HandleBlockFromInboundBlockchain(t *testing.T) {
 	assert.NotNil(t, err, "unexpected bytes %v", string(msgBytes))
 	close(g.feedManagerChan)
 	wg.Wait()
-	assert.Equal(t, int32(3), atomic.LoadInt32(&count))
+	assert.Equal(t, int32(4), atomic.LoadInt32(&count))
 }
 
 func TestGateway_HandleBlockFromBlockchain_TwoRelays(t *testing.T) {
@@ -1077,6 +1080,63 @@ func TestGateway_BlockFeedIfSubscribeOnly(t *testing.T) {
 
 }
 
+func TestGateway_PrivateTxWithoutBlockSubscribers(t *testing.T) {
+	bridge, g := setup(t, 1)
+	require.False(t, g.feedManager.NeedBlocks())
+
+	clock := utils.MockClock{}
+	sent := make(map[types.SHA256Hash]int)
+	g.privateTxService = services.NewPrivateTxService(&clock, func(tx *services.PrivateTx, rebroadcast bool) error {
+		sent[tx.Hash]++
+		return nil
+	})
+
+	newPrivateTx := func(ethTx *ethtypes.Transaction) *services.PrivateTx {
+		hash, err := types.NewSHA256Hash(ethTx.Hash().Bytes())
+		require.NoError(t, err)
+		return &services.PrivateTx{Hash: hash, AccountID: "account"}
+	}
+	// the mock blocks include the legacy transaction with nonce 1
+	minedTx := newPrivateTx(bxmock.NewSignedEthTx(ethtypes.LegacyTxType, 1, nil))
+	expiredTx := newPrivateTx(bxmock.NewSignedEthTx(ethtypes.LegacyTxType, 100, nil))
+	require.NoError(t, g.privateTxService.Submit(minedTx))
+	clock.IncTime(time.Second)
+	require.NoError(t, g.privateTxService.Submit(expiredTx))
+
+	publishBlock := func(height uint64) {
+		bxBlock, err := bridge.BlockBlockchainToBDN(eth.NewBlockInfo(bxmock.NewEthBlock(height, common.Hash{}), nil))
+		require.NoError(t, err)
+		require.NoError(t, g.publishBlock(bxBlock, nil, nil, true))
+	}
+
+	publishBlock(10)
+	balance := g.privateTxService.Balance("account")
+	require.Len(t, balance.Transactions, 2)
+	assert.Equal(t, services.PrivateTxMined, balance.Transactions[0].Status)
+	assert.Equal(t, uint64(10), balance.Transactions[0].BlockHeight)
+	assert.Equal(t, services.PrivateTxPending, balance.Transactions[1].Status)
+
+	for height := uint64(11); height <= 11+services.PrivateTxMaxBlocks; height++ {
+		publishBlock(height)
+	}
+	assert.Equal(t, 1, sent[minedTx.Hash])
+	assert.Equal(t, services.PrivateTxMaxBlocks+1, sent[expiredTx.Hash])
+	balance = g.privateTxService.Balance("account")
+	assert.Equal(t, 0, balance.Pending)
+	assert.Equal(t, services.PrivateTxExpired, balance.Transactions[1].Status)
+
+	// the finished transactions are not kept forever
+	clock.IncTime(2 * time.Hour)
+	publishBlock(100)
+	assert.Empty(t, g.privateTxService.Balance("account").Transactions)
+
+	select {
+	case <-g.feedManagerChan:
+		assert.Fail(t, "received unexpected feed notification")
+	default:
+	}
+}
+
 func TestGateway_ValidateHeightBDNBlocksWithoutNode(t *testing.T) {
 	bridge, g := setup(t, 1)
 	g.feedManager.Subscribe(types.BDNBlocksFeed, types.WebSocketFeed, nil, types.ClientInfo{Tier: string(sdnmessage.ATierEnterprise)}, types.ReqOptions{}, false)
@@ -1126,6 +1186,7 @@ func expectFeedNotification(t *testing.T, bridge blockchain.Bridge, g *gateway,
 	expectedNotifications := map[types.FeedType]struct{}{
 		types.BDNBlocksFeed:  {},
 		types.TxReceiptsFeed: {},
+		types.LogsFeed:       {},
 		types.OnBlockFeed:    {},
 	}
 
@@ -1133,7 +1194,7 @@ func expectFeedNotification(t *testing.T, bridge blockchain.Bridge, g *gateway,
 		expectedNotifications[types.NewBlocksFeed] = struct{}{}
 	}
 
-	// TxReceipts and OnBlock runs in goroutine
+	// TxReceipts, Logs and OnBlock runs in goroutine
 	ticker := time.NewTicker(1 * time.Second)
 
 	// It is neccessary to have FailNow to not wait until timeout when something goes wrong
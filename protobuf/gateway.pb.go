@@ -37,6 +37,8 @@ type TxLogs struct {
 	BlockHash        string   `protobuf:"bytes,7,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
 	LogIndex         string   `protobuf:"bytes,8,opt,name=log_index,json=logIndex,proto3" json:"log_index,omitempty"`
 	Removed          bool     `protobuf:"varint,9,opt,name=removed,proto3" json:"removed,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,10,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
 }
 
 func (x *TxLogs) Reset() {
@@ -134,6 +136,13 @@ func (x *TxLogs) GetRemoved() bool {
 	return false
 }
 
+func (x *TxLogs) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return nil
+}
+
 type TxReceiptsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -141,6 +150,13 @@ type TxReceiptsRequest struct {
 
 	Includes   []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
 	AuthHeader string   `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// last sequence received before reconnecting, missed receipts are sent first
+	ResumeFrom uint64 `protobuf:"varint,3,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,4,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
+	// filters on from, to, contract_address, status, gas_used, cumulative_gas_used, effective_gas_price, type,
+	// log_addresses and log_topics, with the syntax of the newTxs filters
+	Filters string `protobuf:"bytes,5,opt,name=filters,proto3" json:"filters,omitempty"`
 }
 
 func (x *TxReceiptsRequest) Reset() {
@@ -189,6 +205,27 @@ func (x *TxReceiptsRequest) GetAuthHeader() string {
 	return ""
 }
 
+func (x *TxReceiptsRequest) GetResumeFrom() uint64 {
+	if x != nil {
+		return x.ResumeFrom
+	}
+	return 0
+}
+
+func (x *TxReceiptsRequest) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
+	}
+	return ""
+}
+
+func (x *TxReceiptsRequest) GetFilters() string {
+	if x != nil {
+		return x.Filters
+	}
+	return ""
+}
+
 type TxReceiptsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -209,6 +246,9 @@ type TxReceiptsReply struct {
 	TransactionIndex  string    `protobuf:"bytes,13,opt,name=transaction_index,json=transactionIndex,proto3" json:"transaction_index,omitempty"`
 	Type              string    `protobuf:"bytes,14,opt,name=type,proto3" json:"type,omitempty"`
 	TxsCount          string    `protobuf:"bytes,15,opt,name=txs_count,json=txsCount,proto3" json:"txs_count,omitempty"`
+	Sequence          uint64    `protobuf:"varint,16,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,17,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
 }
 
 func (x *TxReceiptsReply) Reset() {
@@ -348,16 +388,31 @@ func (x *TxReceiptsReply) GetTxsCount() string {
 	return ""
 }
 
-type CallParams struct {
+func (x *TxReceiptsReply) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *TxReceiptsReply) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return nil
+}
+
+type LogTopics struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params map[string]string `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// alternatives of the topic position, empty to match any topic
+	Topics []string `protobuf:"bytes,1,rep,name=topics,proto3" json:"topics,omitempty"`
 }
 
-func (x *CallParams) Reset() {
-	*x = CallParams{}
+func (x *LogTopics) Reset() {
+	*x = LogTopics{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -365,13 +420,13 @@ func (x *CallParams) Reset() {
 	}
 }
 
-func (x *CallParams) String() string {
+func (x *LogTopics) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CallParams) ProtoMessage() {}
+func (*LogTopics) ProtoMessage() {}
 
-func (x *CallParams) ProtoReflect() protoreflect.Message {
+func (x *LogTopics) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -383,30 +438,33 @@ func (x *CallParams) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CallParams.ProtoReflect.Descriptor instead.
-func (*CallParams) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogTopics.ProtoReflect.Descriptor instead.
+func (*LogTopics) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *CallParams) GetParams() map[string]string {
+func (x *LogTopics) GetTopics() []string {
 	if x != nil {
-		return x.Params
+		return x.Topics
 	}
 	return nil
 }
 
-type EthOnBlockRequest struct {
+type LogsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Includes   []string      `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
-	CallParams []*CallParams `protobuf:"bytes,2,rep,name=call_params,json=callParams,proto3" json:"call_params,omitempty"`
-	AuthHeader string        `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	Includes   []string     `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	Addresses  []string     `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	Topics     []*LogTopics `protobuf:"bytes,3,rep,name=topics,proto3" json:"topics,omitempty"`
+	AuthHeader string       `protobuf:"bytes,4,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,5,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *EthOnBlockRequest) Reset() {
-	*x = EthOnBlockRequest{}
+func (x *LogsRequest) Reset() {
+	*x = LogsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -414,13 +472,13 @@ func (x *EthOnBlockRequest) Reset() {
 	}
 }
 
-func (x *EthOnBlockRequest) String() string {
+func (x *LogsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthOnBlockRequest) ProtoMessage() {}
+func (*LogsRequest) ProtoMessage() {}
 
-func (x *EthOnBlockRequest) ProtoReflect() protoreflect.Message {
+func (x *LogsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -432,45 +490,60 @@ func (x *EthOnBlockRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthOnBlockRequest.ProtoReflect.Descriptor instead.
-func (*EthOnBlockRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LogsRequest.ProtoReflect.Descriptor instead.
+func (*LogsRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *EthOnBlockRequest) GetIncludes() []string {
+func (x *LogsRequest) GetIncludes() []string {
 	if x != nil {
 		return x.Includes
 	}
 	return nil
 }
 
-func (x *EthOnBlockRequest) GetCallParams() []*CallParams {
+func (x *LogsRequest) GetAddresses() []string {
 	if x != nil {
-		return x.CallParams
+		return x.Addresses
 	}
 	return nil
 }
 
-func (x *EthOnBlockRequest) GetAuthHeader() string {
+func (x *LogsRequest) GetTopics() []*LogTopics {
+	if x != nil {
+		return x.Topics
+	}
+	return nil
+}
+
+func (x *LogsRequest) GetAuthHeader() string {
 	if x != nil {
 		return x.AuthHeader
 	}
 	return ""
 }
 
-type EthOnBlockReply struct {
+func (x *LogsRequest) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
+	}
+	return ""
+}
+
+type TransactionStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Response    string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	BlockHeight string `protobuf:"bytes,3,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
-	Tag         string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	Includes          []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	TransactionHashes []string `protobuf:"bytes,2,rep,name=transaction_hashes,json=transactionHashes,proto3" json:"transaction_hashes,omitempty"`
+	AuthHeader        string   `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,4,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *EthOnBlockReply) Reset() {
-	*x = EthOnBlockReply{}
+func (x *TransactionStatusRequest) Reset() {
+	*x = TransactionStatusRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -478,13 +551,13 @@ func (x *EthOnBlockReply) Reset() {
 	}
 }
 
-func (x *EthOnBlockReply) String() string {
+func (x *TransactionStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EthOnBlockReply) ProtoMessage() {}
+func (*TransactionStatusRequest) ProtoMessage() {}
 
-func (x *EthOnBlockReply) ProtoReflect() protoreflect.Message {
+func (x *TransactionStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -496,51 +569,56 @@ func (x *EthOnBlockReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EthOnBlockReply.ProtoReflect.Descriptor instead.
-func (*EthOnBlockReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionStatusRequest.ProtoReflect.Descriptor instead.
+func (*TransactionStatusRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *EthOnBlockReply) GetName() string {
+func (x *TransactionStatusRequest) GetIncludes() []string {
 	if x != nil {
-		return x.Name
+		return x.Includes
 	}
-	return ""
+	return nil
 }
 
-func (x *EthOnBlockReply) GetResponse() string {
+func (x *TransactionStatusRequest) GetTransactionHashes() []string {
 	if x != nil {
-		return x.Response
+		return x.TransactionHashes
 	}
-	return ""
+	return nil
 }
 
-func (x *EthOnBlockReply) GetBlockHeight() string {
+func (x *TransactionStatusRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.BlockHeight
+		return x.AuthHeader
 	}
 	return ""
 }
 
-func (x *EthOnBlockReply) GetTag() string {
+func (x *TransactionStatusRequest) GetBackpressure() string {
 	if x != nil {
-		return x.Tag
+		return x.Backpressure
 	}
 	return ""
 }
 
-type TxsRequest struct {
+type TransactionStatusReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Filters    string   `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
-	Includes   []string `protobuf:"bytes,2,rep,name=includes,proto3" json:"includes,omitempty"`
-	AuthHeader string   `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	SubscriptionId  string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	TransactionHash string `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	Status          string `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	BlockHeight     uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash       string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	ReplacedBy      string `protobuf:"bytes,6,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,7,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
 }
 
-func (x *TxsRequest) Reset() {
-	*x = TxsRequest{}
+func (x *TransactionStatusReply) Reset() {
+	*x = TransactionStatusReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -548,13 +626,13 @@ func (x *TxsRequest) Reset() {
 	}
 }
 
-func (x *TxsRequest) String() string {
+func (x *TransactionStatusReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxsRequest) ProtoMessage() {}
+func (*TransactionStatusReply) ProtoMessage() {}
 
-func (x *TxsRequest) ProtoReflect() protoreflect.Message {
+func (x *TransactionStatusReply) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -566,45 +644,73 @@ func (x *TxsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxsRequest.ProtoReflect.Descriptor instead.
-func (*TxsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use TransactionStatusReply.ProtoReflect.Descriptor instead.
+func (*TransactionStatusReply) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *TxsRequest) GetFilters() string {
+func (x *TransactionStatusReply) GetSubscriptionId() string {
 	if x != nil {
-		return x.Filters
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-func (x *TxsRequest) GetIncludes() []string {
+func (x *TransactionStatusReply) GetTransactionHash() string {
 	if x != nil {
-		return x.Includes
+		return x.TransactionHash
 	}
-	return nil
+	return ""
 }
 
-func (x *TxsRequest) GetAuthHeader() string {
+func (x *TransactionStatusReply) GetStatus() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.Status
 	}
 	return ""
 }
 
-type Tx struct {
+func (x *TransactionStatusReply) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *TransactionStatusReply) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *TransactionStatusReply) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *TransactionStatusReply) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return nil
+}
+
+type MempoolEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	From        []byte `protobuf:"bytes,12,opt,name=from,proto3" json:"from,omitempty"`
-	LocalRegion bool   `protobuf:"varint,14,opt,name=local_region,json=localRegion,proto3" json:"local_region,omitempty"`
-	Time        int64  `protobuf:"varint,15,opt,name=time,proto3" json:"time,omitempty"`
-	RawTx       []byte `protobuf:"bytes,16,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Includes   []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	AuthHeader string   `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,3,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *Tx) Reset() {
-	*x = Tx{}
+func (x *MempoolEventsRequest) Reset() {
+	*x = MempoolEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,13 +718,13 @@ func (x *Tx) Reset() {
 	}
 }
 
-func (x *Tx) String() string {
+func (x *MempoolEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Tx) ProtoMessage() {}
+func (*MempoolEventsRequest) ProtoMessage() {}
 
-func (x *Tx) ProtoReflect() protoreflect.Message {
+func (x *MempoolEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -630,50 +736,49 @@ func (x *Tx) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Tx.ProtoReflect.Descriptor instead.
-func (*Tx) Descriptor() ([]byte, []int) {
+// Deprecated: Use MempoolEventsRequest.ProtoReflect.Descriptor instead.
+func (*MempoolEventsRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Tx) GetFrom() []byte {
+func (x *MempoolEventsRequest) GetIncludes() []string {
 	if x != nil {
-		return x.From
+		return x.Includes
 	}
 	return nil
 }
 
-func (x *Tx) GetLocalRegion() bool {
-	if x != nil {
-		return x.LocalRegion
-	}
-	return false
-}
-
-func (x *Tx) GetTime() int64 {
+func (x *MempoolEventsRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.Time
+		return x.AuthHeader
 	}
-	return 0
+	return ""
 }
 
-func (x *Tx) GetRawTx() []byte {
+func (x *MempoolEventsRequest) GetBackpressure() string {
 	if x != nil {
-		return x.RawTx
+		return x.Backpressure
 	}
-	return nil
+	return ""
 }
 
-type AccessTuple struct {
+type MempoolEventsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	StorageKeys []string `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storage_keys,omitempty"`
+	// replaced, confirmed or dropped
+	Event           string `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	TransactionHash string `protobuf:"bytes,2,opt,name=transaction_hash,json=transactionHash,proto3" json:"transaction_hash,omitempty"`
+	ReplacedBy      string `protobuf:"bytes,3,opt,name=replaced_by,json=replacedBy,proto3" json:"replaced_by,omitempty"`
+	BlockHeight     uint64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	BlockHash       string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,6,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
 }
 
-func (x *AccessTuple) Reset() {
-	*x = AccessTuple{}
+func (x *MempoolEventsReply) Reset() {
+	*x = MempoolEventsReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -681,13 +786,13 @@ func (x *AccessTuple) Reset() {
 	}
 }
 
-func (x *AccessTuple) String() string {
+func (x *MempoolEventsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccessTuple) ProtoMessage() {}
+func (*MempoolEventsReply) ProtoMessage() {}
 
-func (x *AccessTuple) ProtoReflect() protoreflect.Message {
+func (x *MempoolEventsReply) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -699,35 +804,65 @@ func (x *AccessTuple) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
-func (*AccessTuple) Descriptor() ([]byte, []int) {
+// Deprecated: Use MempoolEventsReply.ProtoReflect.Descriptor instead.
+func (*MempoolEventsReply) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *AccessTuple) GetAddress() string {
+func (x *MempoolEventsReply) GetEvent() string {
 	if x != nil {
-		return x.Address
+		return x.Event
 	}
 	return ""
 }
 
-func (x *AccessTuple) GetStorageKeys() []string {
+func (x *MempoolEventsReply) GetTransactionHash() string {
 	if x != nil {
-		return x.StorageKeys
+		return x.TransactionHash
+	}
+	return ""
+}
+
+func (x *MempoolEventsReply) GetReplacedBy() string {
+	if x != nil {
+		return x.ReplacedBy
+	}
+	return ""
+}
+
+func (x *MempoolEventsReply) GetBlockHeight() uint64 {
+	if x != nil {
+		return x.BlockHeight
+	}
+	return 0
+}
+
+func (x *MempoolEventsReply) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *MempoolEventsReply) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
 	}
 	return nil
 }
 
-type TxsReply struct {
+type MonitorTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx []*Tx `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"`
+	SubscriptionId    string   `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	TransactionHashes []string `protobuf:"bytes,2,rep,name=transaction_hashes,json=transactionHashes,proto3" json:"transaction_hashes,omitempty"`
+	AuthHeader        string   `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 }
 
-func (x *TxsReply) Reset() {
-	*x = TxsReply{}
+func (x *MonitorTransactionRequest) Reset() {
+	*x = MonitorTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,13 +870,13 @@ func (x *TxsReply) Reset() {
 	}
 }
 
-func (x *TxsReply) String() string {
+func (x *MonitorTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxsReply) ProtoMessage() {}
+func (*MonitorTransactionRequest) ProtoMessage() {}
 
-func (x *TxsReply) ProtoReflect() protoreflect.Message {
+func (x *MonitorTransactionRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,29 +888,40 @@ func (x *TxsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxsReply.ProtoReflect.Descriptor instead.
-func (*TxsReply) Descriptor() ([]byte, []int) {
+// Deprecated: Use MonitorTransactionRequest.ProtoReflect.Descriptor instead.
+func (*MonitorTransactionRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *TxsReply) GetTx() []*Tx {
+func (x *MonitorTransactionRequest) GetSubscriptionId() string {
 	if x != nil {
-		return x.Tx
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *MonitorTransactionRequest) GetTransactionHashes() []string {
+	if x != nil {
+		return x.TransactionHashes
 	}
 	return nil
 }
 
-type BlocksRequest struct {
+func (x *MonitorTransactionRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type MonitorTransactionReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Includes   []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
-	AuthHeader string   `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 }
 
-func (x *BlocksRequest) Reset() {
-	*x = BlocksRequest{}
+func (x *MonitorTransactionReply) Reset() {
+	*x = MonitorTransactionReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -783,13 +929,13 @@ func (x *BlocksRequest) Reset() {
 	}
 }
 
-func (x *BlocksRequest) String() string {
+func (x *MonitorTransactionReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlocksRequest) ProtoMessage() {}
+func (*MonitorTransactionReply) ProtoMessage() {}
 
-func (x *BlocksRequest) ProtoReflect() protoreflect.Message {
+func (x *MonitorTransactionReply) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -801,51 +947,21 @@ func (x *BlocksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlocksRequest.ProtoReflect.Descriptor instead.
-func (*BlocksRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use MonitorTransactionReply.ProtoReflect.Descriptor instead.
+func (*MonitorTransactionReply) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *BlocksRequest) GetIncludes() []string {
-	if x != nil {
-		return x.Includes
-	}
-	return nil
+type CallParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Params map[string]string `protobuf:"bytes,1,rep,name=params,proto3" json:"params,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
-func (x *BlocksRequest) GetAuthHeader() string {
-	if x != nil {
-		return x.AuthHeader
-	}
-	return ""
-}
-
-type BlockHeader struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	ParentHash       string `protobuf:"bytes,1,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
-	Sha3Uncles       string `protobuf:"bytes,2,opt,name=sha3_uncles,json=sha3Uncles,proto3" json:"sha3_uncles,omitempty"`
-	Miner            string `protobuf:"bytes,3,opt,name=miner,proto3" json:"miner,omitempty"`
-	StateRoot        string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
-	TransactionsRoot string `protobuf:"bytes,5,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactions_root,omitempty"`
-	ReceiptsRoot     string `protobuf:"bytes,6,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
-	LogsBloom        string `protobuf:"bytes,7,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
-	Difficulty       string `protobuf:"bytes,8,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
-	Number           string `protobuf:"bytes,9,opt,name=number,proto3" json:"number,omitempty"`
-	GasLimit         string `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
-	GasUsed          string `protobuf:"bytes,11,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
-	Timestamp        string `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	ExtraData        string `protobuf:"bytes,13,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
-	MixHash          string `protobuf:"bytes,14,opt,name=mix_hash,json=mixHash,proto3" json:"mix_hash,omitempty"`
-	Nonce            string `protobuf:"bytes,15,opt,name=nonce,proto3" json:"nonce,omitempty"`
-	BaseFeePerGas    string `protobuf:"bytes,16,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"base_fee_per_gas,omitempty"`
-	WithdrawalsRoot  string `protobuf:"bytes,17,opt,name=withdrawals_root,json=withdrawalsRoot,proto3" json:"withdrawals_root,omitempty"`
-}
-
-func (x *BlockHeader) Reset() {
-	*x = BlockHeader{}
+func (x *CallParams) Reset() {
+	*x = CallParams{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -853,13 +969,13 @@ func (x *BlockHeader) Reset() {
 	}
 }
 
-func (x *BlockHeader) String() string {
+func (x *CallParams) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlockHeader) ProtoMessage() {}
+func (*CallParams) ProtoMessage() {}
 
-func (x *BlockHeader) ProtoReflect() protoreflect.Message {
+func (x *CallParams) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -871,157 +987,219 @@ func (x *BlockHeader) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
-func (*BlockHeader) Descriptor() ([]byte, []int) {
+// Deprecated: Use CallParams.ProtoReflect.Descriptor instead.
+func (*CallParams) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *BlockHeader) GetParentHash() string {
+func (x *CallParams) GetParams() map[string]string {
 	if x != nil {
-		return x.ParentHash
+		return x.Params
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockHeader) GetSha3Uncles() string {
-	if x != nil {
-		return x.Sha3Uncles
-	}
-	return ""
+type EthOnBlockRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Includes   []string      `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	CallParams []*CallParams `protobuf:"bytes,2,rep,name=call_params,json=callParams,proto3" json:"call_params,omitempty"`
+	AuthHeader string        `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,4,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *BlockHeader) GetMiner() string {
-	if x != nil {
-		return x.Miner
+func (x *EthOnBlockRequest) Reset() {
+	*x = EthOnBlockRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *BlockHeader) GetStateRoot() string {
-	if x != nil {
-		return x.StateRoot
+func (x *EthOnBlockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthOnBlockRequest) ProtoMessage() {}
+
+func (x *EthOnBlockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BlockHeader) GetTransactionsRoot() string {
+// Deprecated: Use EthOnBlockRequest.ProtoReflect.Descriptor instead.
+func (*EthOnBlockRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *EthOnBlockRequest) GetIncludes() []string {
 	if x != nil {
-		return x.TransactionsRoot
+		return x.Includes
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockHeader) GetReceiptsRoot() string {
+func (x *EthOnBlockRequest) GetCallParams() []*CallParams {
 	if x != nil {
-		return x.ReceiptsRoot
+		return x.CallParams
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockHeader) GetLogsBloom() string {
+func (x *EthOnBlockRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.LogsBloom
+		return x.AuthHeader
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetDifficulty() string {
+func (x *EthOnBlockRequest) GetBackpressure() string {
 	if x != nil {
-		return x.Difficulty
+		return x.Backpressure
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetNumber() string {
-	if x != nil {
-		return x.Number
+type EthOnBlockReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Response    string `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	BlockHeight string `protobuf:"bytes,3,opt,name=blockHeight,proto3" json:"blockHeight,omitempty"`
+	Tag         string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,5,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
+	// time from the start of the calls of the block to the response of the node, in milliseconds
+	LatencyMs float64 `protobuf:"fixed64,6,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// endpoint of the node which answered the call
+	Provider string `protobuf:"bytes,7,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (x *EthOnBlockReply) Reset() {
+	*x = EthOnBlockReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *BlockHeader) GetGasLimit() string {
-	if x != nil {
-		return x.GasLimit
+func (x *EthOnBlockReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EthOnBlockReply) ProtoMessage() {}
+
+func (x *EthOnBlockReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BlockHeader) GetGasUsed() string {
+// Deprecated: Use EthOnBlockReply.ProtoReflect.Descriptor instead.
+func (*EthOnBlockReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *EthOnBlockReply) GetName() string {
 	if x != nil {
-		return x.GasUsed
+		return x.Name
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetTimestamp() string {
+func (x *EthOnBlockReply) GetResponse() string {
 	if x != nil {
-		return x.Timestamp
+		return x.Response
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetExtraData() string {
+func (x *EthOnBlockReply) GetBlockHeight() string {
 	if x != nil {
-		return x.ExtraData
+		return x.BlockHeight
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetMixHash() string {
+func (x *EthOnBlockReply) GetTag() string {
 	if x != nil {
-		return x.MixHash
+		return x.Tag
 	}
 	return ""
 }
 
-func (x *BlockHeader) GetNonce() string {
+func (x *EthOnBlockReply) GetSubscriptionStatus() *SubscriptionStatus {
 	if x != nil {
-		return x.Nonce
+		return x.SubscriptionStatus
 	}
-	return ""
+	return nil
 }
 
-func (x *BlockHeader) GetBaseFeePerGas() string {
+func (x *EthOnBlockReply) GetLatencyMs() float64 {
 	if x != nil {
-		return x.BaseFeePerGas
+		return x.LatencyMs
 	}
-	return ""
+	return 0
 }
 
-func (x *BlockHeader) GetWithdrawalsRoot() string {
+func (x *EthOnBlockReply) GetProvider() string {
 	if x != nil {
-		return x.WithdrawalsRoot
+		return x.Provider
 	}
 	return ""
 }
 
-type FutureValidatorInfo struct {
+type TxsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	BlockHeight string `protobuf:"bytes,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
-	WalletId    string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
-	Accessible  string `protobuf:"bytes,3,opt,name=accessible,proto3" json:"accessible,omitempty"`
+	Filters    string   `protobuf:"bytes,1,opt,name=filters,proto3" json:"filters,omitempty"`
+	Includes   []string `protobuf:"bytes,2,rep,name=includes,proto3" json:"includes,omitempty"`
+	AuthHeader string   `protobuf:"bytes,3,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// last sequence received before reconnecting, missed transactions are sent first
+	ResumeFrom uint64 `protobuf:"varint,4,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,5,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *FutureValidatorInfo) Reset() {
-	*x = FutureValidatorInfo{}
+func (x *TxsRequest) Reset() {
+	*x = TxsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[12]
+		mi := &file_gateway_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *FutureValidatorInfo) String() string {
+func (x *TxsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FutureValidatorInfo) ProtoMessage() {}
+func (*TxsRequest) ProtoMessage() {}
 
-func (x *FutureValidatorInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[12]
+func (x *TxsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1032,62 +1210,77 @@ func (x *FutureValidatorInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FutureValidatorInfo.ProtoReflect.Descriptor instead.
-func (*FutureValidatorInfo) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use TxsRequest.ProtoReflect.Descriptor instead.
+func (*TxsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *FutureValidatorInfo) GetBlockHeight() string {
+func (x *TxsRequest) GetFilters() string {
 	if x != nil {
-		return x.BlockHeight
+		return x.Filters
 	}
 	return ""
 }
 
-func (x *FutureValidatorInfo) GetWalletId() string {
+func (x *TxsRequest) GetIncludes() []string {
 	if x != nil {
-		return x.WalletId
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *TxsRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
 	}
 	return ""
 }
 
-func (x *FutureValidatorInfo) GetAccessible() string {
+func (x *TxsRequest) GetResumeFrom() uint64 {
 	if x != nil {
-		return x.Accessible
+		return x.ResumeFrom
+	}
+	return 0
+}
+
+func (x *TxsRequest) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
 	}
 	return ""
 }
 
-type BlocksReply struct {
+type Tx struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	// Deprecated: Do not use.
-	SubscriptionID      string                 `protobuf:"bytes,2,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
-	Header              *BlockHeader           `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
-	FutureValidatorInfo []*FutureValidatorInfo `protobuf:"bytes,4,rep,name=future_validator_info,json=futureValidatorInfo,proto3" json:"future_validator_info,omitempty"`
-	Transaction         []*Tx                  `protobuf:"bytes,5,rep,name=transaction,proto3" json:"transaction,omitempty"`
+	From        []byte `protobuf:"bytes,12,opt,name=from,proto3" json:"from,omitempty"`
+	LocalRegion bool   `protobuf:"varint,14,opt,name=local_region,json=localRegion,proto3" json:"local_region,omitempty"`
+	Time        int64  `protobuf:"varint,15,opt,name=time,proto3" json:"time,omitempty"`
+	RawTx       []byte `protobuf:"bytes,16,opt,name=raw_tx,json=rawTx,proto3" json:"raw_tx,omitempty"`
+	Sequence    uint64 `protobuf:"varint,17,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// set when decoded_input is included and the method is registered
+	DecodedInput *DecodedInput `protobuf:"bytes,18,opt,name=decoded_input,json=decodedInput,proto3" json:"decoded_input,omitempty"`
 }
 
-func (x *BlocksReply) Reset() {
-	*x = BlocksReply{}
+func (x *Tx) Reset() {
+	*x = Tx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[13]
+		mi := &file_gateway_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlocksReply) String() string {
+func (x *Tx) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlocksReply) ProtoMessage() {}
+func (*Tx) ProtoMessage() {}
 
-func (x *BlocksReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[13]
+func (x *Tx) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1098,75 +1291,81 @@ func (x *BlocksReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlocksReply.ProtoReflect.Descriptor instead.
-func (*BlocksReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use Tx.ProtoReflect.Descriptor instead.
+func (*Tx) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *BlocksReply) GetHash() string {
+func (x *Tx) GetFrom() []byte {
 	if x != nil {
-		return x.Hash
+		return x.From
 	}
-	return ""
+	return nil
 }
 
-// Deprecated: Do not use.
-func (x *BlocksReply) GetSubscriptionID() string {
+func (x *Tx) GetLocalRegion() bool {
 	if x != nil {
-		return x.SubscriptionID
+		return x.LocalRegion
 	}
-	return ""
+	return false
 }
 
-func (x *BlocksReply) GetHeader() *BlockHeader {
+func (x *Tx) GetTime() int64 {
 	if x != nil {
-		return x.Header
+		return x.Time
 	}
-	return nil
+	return 0
 }
 
-func (x *BlocksReply) GetFutureValidatorInfo() []*FutureValidatorInfo {
+func (x *Tx) GetRawTx() []byte {
 	if x != nil {
-		return x.FutureValidatorInfo
+		return x.RawTx
 	}
 	return nil
 }
 
-func (x *BlocksReply) GetTransaction() []*Tx {
+func (x *Tx) GetSequence() uint64 {
 	if x != nil {
-		return x.Transaction
+		return x.Sequence
+	}
+	return 0
+}
+
+func (x *Tx) GetDecodedInput() *DecodedInput {
+	if x != nil {
+		return x.DecodedInput
 	}
 	return nil
 }
 
-type DisconnectInboundPeerRequest struct {
+type DecodedInput struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	PeerIp     string `protobuf:"bytes,1,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
-	PeerPort   int64  `protobuf:"varint,2,opt,name=peer_port,json=peerPort,proto3" json:"peer_port,omitempty"`
-	PublicKey  string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
-	AuthHeader string `protobuf:"bytes,4,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	Method    string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Signature string `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+	// JSON encoded arguments by name
+	Args string `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
 }
 
-func (x *DisconnectInboundPeerRequest) Reset() {
-	*x = DisconnectInboundPeerRequest{}
+func (x *DecodedInput) Reset() {
+	*x = DecodedInput{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[14]
+		mi := &file_gateway_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisconnectInboundPeerRequest) String() string {
+func (x *DecodedInput) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectInboundPeerRequest) ProtoMessage() {}
+func (*DecodedInput) ProtoMessage() {}
 
-func (x *DisconnectInboundPeerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[14]
+func (x *DecodedInput) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1177,64 +1376,58 @@ func (x *DisconnectInboundPeerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectInboundPeerRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectInboundPeerRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use DecodedInput.ProtoReflect.Descriptor instead.
+func (*DecodedInput) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *DisconnectInboundPeerRequest) GetPeerIp() string {
+func (x *DecodedInput) GetMethod() string {
 	if x != nil {
-		return x.PeerIp
+		return x.Method
 	}
 	return ""
 }
 
-func (x *DisconnectInboundPeerRequest) GetPeerPort() int64 {
-	if x != nil {
-		return x.PeerPort
-	}
-	return 0
-}
-
-func (x *DisconnectInboundPeerRequest) GetPublicKey() string {
+func (x *DecodedInput) GetSignature() string {
 	if x != nil {
-		return x.PublicKey
+		return x.Signature
 	}
 	return ""
 }
 
-func (x *DisconnectInboundPeerRequest) GetAuthHeader() string {
+func (x *DecodedInput) GetArgs() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.Args
 	}
 	return ""
 }
 
-type DisconnectInboundPeerReply struct {
+type AccessTuple struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Address     string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StorageKeys []string `protobuf:"bytes,2,rep,name=storage_keys,json=storageKeys,proto3" json:"storage_keys,omitempty"`
 }
 
-func (x *DisconnectInboundPeerReply) Reset() {
-	*x = DisconnectInboundPeerReply{}
+func (x *AccessTuple) Reset() {
+	*x = AccessTuple{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[15]
+		mi := &file_gateway_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DisconnectInboundPeerReply) String() string {
+func (x *AccessTuple) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectInboundPeerReply) ProtoMessage() {}
+func (*AccessTuple) ProtoMessage() {}
 
-func (x *DisconnectInboundPeerReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[15]
+func (x *AccessTuple) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1245,43 +1438,52 @@ func (x *DisconnectInboundPeerReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectInboundPeerReply.ProtoReflect.Descriptor instead.
-func (*DisconnectInboundPeerReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use AccessTuple.ProtoReflect.Descriptor instead.
+func (*AccessTuple) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *DisconnectInboundPeerReply) GetStatus() string {
+func (x *AccessTuple) GetAddress() string {
 	if x != nil {
-		return x.Status
+		return x.Address
 	}
 	return ""
 }
 
-type SubscriptionsRequest struct {
+func (x *AccessTuple) GetStorageKeys() []string {
+	if x != nil {
+		return x.StorageKeys
+	}
+	return nil
+}
+
+type TxsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	Tx []*Tx `protobuf:"bytes,1,rep,name=tx,proto3" json:"tx,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,2,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
 }
 
-func (x *SubscriptionsRequest) Reset() {
-	*x = SubscriptionsRequest{}
+func (x *TxsReply) Reset() {
+	*x = TxsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[16]
+		mi := &file_gateway_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscriptionsRequest) String() string {
+func (x *TxsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriptionsRequest) ProtoMessage() {}
+func (*TxsReply) ProtoMessage() {}
 
-func (x *SubscriptionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[16]
+func (x *TxsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1292,51 +1494,54 @@ func (x *SubscriptionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriptionsRequest.ProtoReflect.Descriptor instead.
-func (*SubscriptionsRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use TxsReply.ProtoReflect.Descriptor instead.
+func (*TxsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *SubscriptionsRequest) GetAuthHeader() string {
+func (x *TxsReply) GetTx() []*Tx {
 	if x != nil {
-		return x.AuthHeader
+		return x.Tx
 	}
-	return ""
+	return nil
 }
 
-type Subscription struct {
+func (x *TxsReply) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return nil
+}
+
+// WsNotification is the binary frame of a websocket subscription with the protobuf encoding. A frame may be the
+// concatenation of several encoded WsNotification messages, which are merged when the frame is decoded
+type WsNotification struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AccountId    string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	Tier         string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
-	FeedName     string `protobuf:"bytes,3,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty"`
-	Network      uint32 `protobuf:"varint,4,opt,name=network,proto3" json:"network,omitempty"`
-	RemoteAddr   string `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
-	Include      string `protobuf:"bytes,6,opt,name=include,proto3" json:"include,omitempty"`
-	Filter       string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
-	Age          uint64 `protobuf:"varint,8,opt,name=age,proto3" json:"age,omitempty"`
-	MessagesSent uint64 `protobuf:"varint,9,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	SubscriptionId string       `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Txs            *TxsReply    `protobuf:"bytes,2,opt,name=txs,proto3" json:"txs,omitempty"`
+	Blocks         *BlocksReply `protobuf:"bytes,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
 }
 
-func (x *Subscription) Reset() {
-	*x = Subscription{}
+func (x *WsNotification) Reset() {
+	*x = WsNotification{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[17]
+		mi := &file_gateway_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Subscription) String() string {
+func (x *WsNotification) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Subscription) ProtoMessage() {}
+func (*WsNotification) ProtoMessage() {}
 
-func (x *Subscription) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[17]
+func (x *WsNotification) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1347,99 +1552,162 @@ func (x *Subscription) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
-func (*Subscription) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use WsNotification.ProtoReflect.Descriptor instead.
+func (*WsNotification) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Subscription) GetAccountId() string {
+func (x *WsNotification) GetSubscriptionId() string {
 	if x != nil {
-		return x.AccountId
+		return x.SubscriptionId
 	}
 	return ""
 }
 
-func (x *Subscription) GetTier() string {
+func (x *WsNotification) GetTxs() *TxsReply {
 	if x != nil {
-		return x.Tier
+		return x.Txs
 	}
-	return ""
+	return nil
 }
 
-func (x *Subscription) GetFeedName() string {
+func (x *WsNotification) GetBlocks() *BlocksReply {
 	if x != nil {
-		return x.FeedName
+		return x.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (x *Subscription) GetNetwork() uint32 {
+// FeedRecord is a notification of a recorded feed, the recording files with the protobuf format are made of the
+// records prefixed by their length as a varint
+type FeedRecord struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// time is the time the notification was received in nanoseconds since the epoch
+	Time         int64              `protobuf:"varint,1,opt,name=time,proto3" json:"time,omitempty"`
+	Feed         string             `protobuf:"bytes,2,opt,name=feed,proto3" json:"feed,omitempty"`
+	Txs          *TxsReply          `protobuf:"bytes,3,opt,name=txs,proto3" json:"txs,omitempty"`
+	Blocks       *BlocksReply       `protobuf:"bytes,4,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	TxReceipts   *TxReceiptsReply   `protobuf:"bytes,5,opt,name=tx_receipts,json=txReceipts,proto3" json:"tx_receipts,omitempty"`
+	BeaconBlocks *BeaconBlocksReply `protobuf:"bytes,6,opt,name=beacon_blocks,json=beaconBlocks,proto3" json:"beacon_blocks,omitempty"`
+	// dropped is the number of notifications of the feed dropped from the recording before the record, a record of
+	// dropped notifications has no reply
+	Dropped uint64 `protobuf:"varint,7,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *FeedRecord) Reset() {
+	*x = FeedRecord{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeedRecord) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeedRecord) ProtoMessage() {}
+
+func (x *FeedRecord) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FeedRecord.ProtoReflect.Descriptor instead.
+func (*FeedRecord) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FeedRecord) GetTime() int64 {
 	if x != nil {
-		return x.Network
+		return x.Time
 	}
 	return 0
 }
 
-func (x *Subscription) GetRemoteAddr() string {
+func (x *FeedRecord) GetFeed() string {
 	if x != nil {
-		return x.RemoteAddr
+		return x.Feed
 	}
 	return ""
 }
 
-func (x *Subscription) GetInclude() string {
+func (x *FeedRecord) GetTxs() *TxsReply {
 	if x != nil {
-		return x.Include
+		return x.Txs
 	}
-	return ""
+	return nil
 }
 
-func (x *Subscription) GetFilter() string {
+func (x *FeedRecord) GetBlocks() *BlocksReply {
 	if x != nil {
-		return x.Filter
+		return x.Blocks
 	}
-	return ""
+	return nil
 }
 
-func (x *Subscription) GetAge() uint64 {
+func (x *FeedRecord) GetTxReceipts() *TxReceiptsReply {
 	if x != nil {
-		return x.Age
+		return x.TxReceipts
 	}
-	return 0
+	return nil
 }
 
-func (x *Subscription) GetMessagesSent() uint64 {
+func (x *FeedRecord) GetBeaconBlocks() *BeaconBlocksReply {
 	if x != nil {
-		return x.MessagesSent
+		return x.BeaconBlocks
+	}
+	return nil
+}
+
+func (x *FeedRecord) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
 	}
 	return 0
 }
 
-type SubscriptionsReply struct {
+type BlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	Includes   []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	AuthHeader string   `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// last sequence received before reconnecting, missed blocks are sent first
+	ResumeFrom uint64 `protobuf:"varint,3,opt,name=resume_from,json=resumeFrom,proto3" json:"resume_from,omitempty"`
+	// drop_oldest, drop_newest, coalesce or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,4,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *SubscriptionsReply) Reset() {
-	*x = SubscriptionsReply{}
+func (x *BlocksRequest) Reset() {
+	*x = BlocksRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[18]
+		mi := &file_gateway_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SubscriptionsReply) String() string {
+func (x *BlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscriptionsReply) ProtoMessage() {}
+func (*BlocksRequest) ProtoMessage() {}
 
-func (x *SubscriptionsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[18]
+func (x *BlocksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1450,91 +1718,82 @@ func (x *SubscriptionsReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscriptionsReply.ProtoReflect.Descriptor instead.
-func (*SubscriptionsReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use BlocksRequest.ProtoReflect.Descriptor instead.
+func (*BlocksRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *SubscriptionsReply) GetSubscriptions() []*Subscription {
+func (x *BlocksRequest) GetIncludes() []string {
 	if x != nil {
-		return x.Subscriptions
+		return x.Includes
 	}
 	return nil
 }
 
-type VersionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
-}
-
-func (x *VersionRequest) Reset() {
-	*x = VersionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[19]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *BlocksRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
 	}
+	return ""
 }
 
-func (x *VersionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*VersionRequest) ProtoMessage() {}
-
-func (x *VersionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[19]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BlocksRequest) GetResumeFrom() uint64 {
+	if x != nil {
+		return x.ResumeFrom
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
-func (*VersionRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{19}
+	return 0
 }
 
-func (x *VersionRequest) GetAuthHeader() string {
+func (x *BlocksRequest) GetBackpressure() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.Backpressure
 	}
 	return ""
 }
 
-type VersionReply struct {
+type BlockHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Version   string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	BuildDate string `protobuf:"bytes,2,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	ParentHash       string `protobuf:"bytes,1,opt,name=parent_hash,json=parentHash,proto3" json:"parent_hash,omitempty"`
+	Sha3Uncles       string `protobuf:"bytes,2,opt,name=sha3_uncles,json=sha3Uncles,proto3" json:"sha3_uncles,omitempty"`
+	Miner            string `protobuf:"bytes,3,opt,name=miner,proto3" json:"miner,omitempty"`
+	StateRoot        string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	TransactionsRoot string `protobuf:"bytes,5,opt,name=transactions_root,json=transactionsRoot,proto3" json:"transactions_root,omitempty"`
+	ReceiptsRoot     string `protobuf:"bytes,6,opt,name=receipts_root,json=receiptsRoot,proto3" json:"receipts_root,omitempty"`
+	LogsBloom        string `protobuf:"bytes,7,opt,name=logs_bloom,json=logsBloom,proto3" json:"logs_bloom,omitempty"`
+	Difficulty       string `protobuf:"bytes,8,opt,name=difficulty,proto3" json:"difficulty,omitempty"`
+	Number           string `protobuf:"bytes,9,opt,name=number,proto3" json:"number,omitempty"`
+	GasLimit         string `protobuf:"bytes,10,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	GasUsed          string `protobuf:"bytes,11,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	Timestamp        string `protobuf:"bytes,12,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	ExtraData        string `protobuf:"bytes,13,opt,name=extra_data,json=extraData,proto3" json:"extra_data,omitempty"`
+	MixHash          string `protobuf:"bytes,14,opt,name=mix_hash,json=mixHash,proto3" json:"mix_hash,omitempty"`
+	Nonce            string `protobuf:"bytes,15,opt,name=nonce,proto3" json:"nonce,omitempty"`
+	BaseFeePerGas    string `protobuf:"bytes,16,opt,name=base_fee_per_gas,json=baseFeePerGas,proto3" json:"base_fee_per_gas,omitempty"`
+	WithdrawalsRoot  string `protobuf:"bytes,17,opt,name=withdrawals_root,json=withdrawalsRoot,proto3" json:"withdrawals_root,omitempty"`
+	BlobGasUsed      string `protobuf:"bytes,18,opt,name=blob_gas_used,json=blobGasUsed,proto3" json:"blob_gas_used,omitempty"`
+	ExcessBlobGas    string `protobuf:"bytes,19,opt,name=excess_blob_gas,json=excessBlobGas,proto3" json:"excess_blob_gas,omitempty"`
 }
 
-func (x *VersionReply) Reset() {
-	*x = VersionReply{}
+func (x *BlockHeader) Reset() {
+	*x = BlockHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[20]
+		mi := &file_gateway_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *VersionReply) String() string {
+func (x *BlockHeader) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*VersionReply) ProtoMessage() {}
+func (*BlockHeader) ProtoMessage() {}
 
-func (x *VersionReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[20]
+func (x *BlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1545,121 +1804,156 @@ func (x *VersionReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use VersionReply.ProtoReflect.Descriptor instead.
-func (*VersionReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use BlockHeader.ProtoReflect.Descriptor instead.
+func (*BlockHeader) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *VersionReply) GetVersion() string {
+func (x *BlockHeader) GetParentHash() string {
 	if x != nil {
-		return x.Version
+		return x.ParentHash
 	}
 	return ""
 }
 
-func (x *VersionReply) GetBuildDate() string {
+func (x *BlockHeader) GetSha3Uncles() string {
 	if x != nil {
-		return x.BuildDate
+		return x.Sha3Uncles
 	}
 	return ""
 }
 
-type StopRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *BlockHeader) GetMiner() string {
+	if x != nil {
+		return x.Miner
+	}
+	return ""
+}
 
-	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+func (x *BlockHeader) GetStateRoot() string {
+	if x != nil {
+		return x.StateRoot
+	}
+	return ""
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[21]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *BlockHeader) GetTransactionsRoot() string {
+	if x != nil {
+		return x.TransactionsRoot
 	}
+	return ""
 }
 
-func (x *StopRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BlockHeader) GetReceiptsRoot() string {
+	if x != nil {
+		return x.ReceiptsRoot
+	}
+	return ""
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (x *BlockHeader) GetLogsBloom() string {
+	if x != nil {
+		return x.LogsBloom
+	}
+	return ""
+}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[21]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BlockHeader) GetDifficulty() string {
+	if x != nil {
+		return x.Difficulty
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{21}
+func (x *BlockHeader) GetNumber() string {
+	if x != nil {
+		return x.Number
+	}
+	return ""
 }
 
-func (x *StopRequest) GetAuthHeader() string {
+func (x *BlockHeader) GetGasLimit() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.GasLimit
 	}
 	return ""
 }
 
-type StopReply struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *BlockHeader) GetGasUsed() string {
+	if x != nil {
+		return x.GasUsed
+	}
+	return ""
 }
 
-func (x *StopReply) Reset() {
-	*x = StopReply{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[22]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *BlockHeader) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
 	}
+	return ""
 }
 
-func (x *StopReply) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BlockHeader) GetExtraData() string {
+	if x != nil {
+		return x.ExtraData
+	}
+	return ""
 }
 
-func (*StopReply) ProtoMessage() {}
+func (x *BlockHeader) GetMixHash() string {
+	if x != nil {
+		return x.MixHash
+	}
+	return ""
+}
 
-func (x *StopReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[22]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BlockHeader) GetNonce() string {
+	if x != nil {
+		return x.Nonce
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use StopReply.ProtoReflect.Descriptor instead.
-func (*StopReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{22}
+func (x *BlockHeader) GetBaseFeePerGas() string {
+	if x != nil {
+		return x.BaseFeePerGas
+	}
+	return ""
 }
 
-type PeersRequest struct {
+func (x *BlockHeader) GetWithdrawalsRoot() string {
+	if x != nil {
+		return x.WithdrawalsRoot
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetBlobGasUsed() string {
+	if x != nil {
+		return x.BlobGasUsed
+	}
+	return ""
+}
+
+func (x *BlockHeader) GetExcessBlobGas() string {
+	if x != nil {
+		return x.ExcessBlobGas
+	}
+	return ""
+}
+
+type FutureValidatorInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	AuthHeader string `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	BlockHeight string `protobuf:"bytes,1,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	WalletId    string `protobuf:"bytes,2,opt,name=wallet_id,json=walletId,proto3" json:"wallet_id,omitempty"`
+	Accessible  string `protobuf:"bytes,3,opt,name=accessible,proto3" json:"accessible,omitempty"`
 }
 
-func (x *PeersRequest) Reset() {
-	*x = PeersRequest{}
+func (x *FutureValidatorInfo) Reset() {
+	*x = FutureValidatorInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1667,13 +1961,13 @@ func (x *PeersRequest) Reset() {
 	}
 }
 
-func (x *PeersRequest) String() string {
+func (x *FutureValidatorInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeersRequest) ProtoMessage() {}
+func (*FutureValidatorInfo) ProtoMessage() {}
 
-func (x *PeersRequest) ProtoReflect() protoreflect.Message {
+func (x *FutureValidatorInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1685,37 +1979,50 @@ func (x *PeersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeersRequest.ProtoReflect.Descriptor instead.
-func (*PeersRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FutureValidatorInfo.ProtoReflect.Descriptor instead.
+func (*FutureValidatorInfo) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *PeersRequest) GetType() string {
+func (x *FutureValidatorInfo) GetBlockHeight() string {
 	if x != nil {
-		return x.Type
+		return x.BlockHeight
 	}
 	return ""
 }
 
-func (x *PeersRequest) GetAuthHeader() string {
+func (x *FutureValidatorInfo) GetWalletId() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.WalletId
 	}
 	return ""
 }
 
-type RateSnapshot struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FiveMinute int64 `protobuf:"varint,1,opt,name=fiveMinute,proto3" json:"fiveMinute,omitempty"`
-	OneHour    int64 `protobuf:"varint,2,opt,name=oneHour,proto3" json:"oneHour,omitempty"`
-	OneDay     int64 `protobuf:"varint,3,opt,name=oneDay,proto3" json:"oneDay,omitempty"`
+func (x *FutureValidatorInfo) GetAccessible() string {
+	if x != nil {
+		return x.Accessible
+	}
+	return ""
 }
 
-func (x *RateSnapshot) Reset() {
-	*x = RateSnapshot{}
+type BlocksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	// Deprecated: Do not use.
+	SubscriptionID      string                 `protobuf:"bytes,2,opt,name=subscriptionID,proto3" json:"subscriptionID,omitempty"`
+	Header              *BlockHeader           `protobuf:"bytes,3,opt,name=header,proto3" json:"header,omitempty"`
+	FutureValidatorInfo []*FutureValidatorInfo `protobuf:"bytes,4,rep,name=future_validator_info,json=futureValidatorInfo,proto3" json:"future_validator_info,omitempty"`
+	Transaction         []*Tx                  `protobuf:"bytes,5,rep,name=transaction,proto3" json:"transaction,omitempty"`
+	Sequence            uint64                 `protobuf:"varint,6,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,7,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
+}
+
+func (x *BlocksReply) Reset() {
+	*x = BlocksReply{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1723,13 +2030,13 @@ func (x *RateSnapshot) Reset() {
 	}
 }
 
-func (x *RateSnapshot) String() string {
+func (x *BlocksReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RateSnapshot) ProtoMessage() {}
+func (*BlocksReply) ProtoMessage() {}
 
-func (x *RateSnapshot) ProtoReflect() protoreflect.Message {
+func (x *BlocksReply) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1741,68 +2048,74 @@ func (x *RateSnapshot) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RateSnapshot.ProtoReflect.Descriptor instead.
-func (*RateSnapshot) Descriptor() ([]byte, []int) {
+// Deprecated: Use BlocksReply.ProtoReflect.Descriptor instead.
+func (*BlocksReply) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RateSnapshot) GetFiveMinute() int64 {
+func (x *BlocksReply) GetHash() string {
 	if x != nil {
-		return x.FiveMinute
+		return x.Hash
 	}
-	return 0
+	return ""
 }
 
-func (x *RateSnapshot) GetOneHour() int64 {
+// Deprecated: Do not use.
+func (x *BlocksReply) GetSubscriptionID() string {
 	if x != nil {
-		return x.OneHour
+		return x.SubscriptionID
 	}
-	return 0
+	return ""
 }
 
-func (x *RateSnapshot) GetOneDay() int64 {
+func (x *BlocksReply) GetHeader() *BlockHeader {
 	if x != nil {
-		return x.OneDay
+		return x.Header
+	}
+	return nil
+}
+
+func (x *BlocksReply) GetFutureValidatorInfo() []*FutureValidatorInfo {
+	if x != nil {
+		return x.FutureValidatorInfo
+	}
+	return nil
+}
+
+func (x *BlocksReply) GetTransaction() []*Tx {
+	if x != nil {
+		return x.Transaction
+	}
+	return nil
+}
+
+func (x *BlocksReply) GetSequence() uint64 {
+	if x != nil {
+		return x.Sequence
 	}
 	return 0
 }
 
-type Peer struct {
+func (x *BlocksReply) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return nil
+}
+
+type BeaconBlocksRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ip                       string        `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
-	NodeId                   string        `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
-	Type                     string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
-	State                    string        `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
-	Network                  uint32        `protobuf:"varint,5,opt,name=network,proto3" json:"network,omitempty"`
-	Initiator                bool          `protobuf:"varint,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
-	MinMsFromPeer            int64         `protobuf:"varint,7,opt,name=min_ms_from_peer,json=minMsFromPeer,proto3" json:"min_ms_from_peer,omitempty"`
-	MinMsToPeer              int64         `protobuf:"varint,8,opt,name=min_ms_to_peer,json=minMsToPeer,proto3" json:"min_ms_to_peer,omitempty"`
-	SlowTrafficCount         int64         `protobuf:"varint,9,opt,name=slow_traffic_count,json=slowTrafficCount,proto3" json:"slow_traffic_count,omitempty"`
-	MinMsRoundTrip           int64         `protobuf:"varint,10,opt,name=min_ms_round_trip,json=minMsRoundTrip,proto3" json:"min_ms_round_trip,omitempty"`
-	AccountId                string        `protobuf:"bytes,11,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
-	AccountTier              string        `protobuf:"bytes,12,opt,name=account_tier,json=accountTier,proto3" json:"account_tier,omitempty"`
-	Port                     int64         `protobuf:"varint,13,opt,name=port,proto3" json:"port,omitempty"`
-	Disabled                 bool          `protobuf:"varint,14,opt,name=disabled,proto3" json:"disabled,omitempty"`
-	MevMiner                 string        `protobuf:"bytes,15,opt,name=mev_miner,json=mevMiner,proto3" json:"mev_miner,omitempty"`
-	MevBuilder               string        `protobuf:"bytes,16,opt,name=mev_builder,json=mevBuilder,proto3" json:"mev_builder,omitempty"` // Deprecated
-	Capability               uint32        `protobuf:"varint,17,opt,name=capability,proto3" json:"capability,omitempty"`
-	UnpaidTxBurstLimit       int64         `protobuf:"varint,18,opt,name=unpaid_tx_burst_limit,json=unpaidTxBurstLimit,proto3" json:"unpaid_tx_burst_limit,omitempty"`
-	PaidTxBurstLimit         int64         `protobuf:"varint,19,opt,name=paid_tx_burst_limit,json=paidTxBurstLimit,proto3" json:"paid_tx_burst_limit,omitempty"`
-	UnpaidTxBurstLimitExcess *RateSnapshot `protobuf:"bytes,20,opt,name=unpaid_tx_burst_limit_excess,json=unpaidTxBurstLimitExcess,proto3" json:"unpaid_tx_burst_limit_excess,omitempty"`
-	PaidTxBurstLimitExcess   *RateSnapshot `protobuf:"bytes,21,opt,name=paid_tx_burst_limit_excess,json=paidTxBurstLimitExcess,proto3" json:"paid_tx_burst_limit_excess,omitempty"`
-	PaidTxThroughput         *RateSnapshot `protobuf:"bytes,22,opt,name=paid_tx_throughput,json=paidTxThroughput,proto3" json:"paid_tx_throughput,omitempty"`
-	UnpaidTxThroughput       *RateSnapshot `protobuf:"bytes,23,opt,name=unpaid_tx_throughput,json=unpaidTxThroughput,proto3" json:"unpaid_tx_throughput,omitempty"`
-	Trusted                  string        `protobuf:"bytes,24,opt,name=trusted,proto3" json:"trusted,omitempty"`
-	MevBuilders              []string      `protobuf:"bytes,25,rep,name=mev_builders,json=mevBuilders,proto3" json:"mev_builders,omitempty"`
-	NewTxs                   int64         `protobuf:"varint,26,opt,name=new_txs,json=newTxs,proto3" json:"new_txs,omitempty"`
-	AlreadySeenTxs           int64         `protobuf:"varint,27,opt,name=already_seen_txs,json=alreadySeenTxs,proto3" json:"already_seen_txs,omitempty"`
+	Includes   []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	AuthHeader string   `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// drop_oldest, drop_newest, coalesce or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,3,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
 }
 
-func (x *Peer) Reset() {
-	*x = Peer{}
+func (x *BeaconBlocksRequest) Reset() {
+	*x = BeaconBlocksRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_gateway_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1810,13 +2123,13 @@ func (x *Peer) Reset() {
 	}
 }
 
-func (x *Peer) String() string {
+func (x *BeaconBlocksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Peer) ProtoMessage() {}
+func (*BeaconBlocksRequest) ProtoMessage() {}
 
-func (x *Peer) ProtoReflect() protoreflect.Message {
+func (x *BeaconBlocksRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_gateway_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1828,225 +2141,1664 @@ func (x *Peer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
-func (*Peer) Descriptor() ([]byte, []int) {
+// Deprecated: Use BeaconBlocksRequest.ProtoReflect.Descriptor instead.
+func (*BeaconBlocksRequest) Descriptor() ([]byte, []int) {
 	return file_gateway_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Peer) GetIp() string {
+func (x *BeaconBlocksRequest) GetIncludes() []string {
 	if x != nil {
-		return x.Ip
+		return x.Includes
 	}
-	return ""
+	return nil
 }
 
-func (x *Peer) GetNodeId() string {
+func (x *BeaconBlocksRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.NodeId
+		return x.AuthHeader
 	}
 	return ""
 }
 
-func (x *Peer) GetType() string {
+func (x *BeaconBlocksRequest) GetBackpressure() string {
 	if x != nil {
-		return x.Type
+		return x.Backpressure
 	}
 	return ""
 }
 
-func (x *Peer) GetState() string {
-	if x != nil {
-		return x.State
-	}
-	return ""
+type BeaconBlockHeader struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Slot          uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex uint64 `protobuf:"varint,2,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	ParentRoot    string `protobuf:"bytes,3,opt,name=parent_root,json=parentRoot,proto3" json:"parent_root,omitempty"`
+	StateRoot     string `protobuf:"bytes,4,opt,name=state_root,json=stateRoot,proto3" json:"state_root,omitempty"`
+	BodyRoot      string `protobuf:"bytes,5,opt,name=body_root,json=bodyRoot,proto3" json:"body_root,omitempty"`
 }
 
-func (x *Peer) GetNetwork() uint32 {
-	if x != nil {
-		return x.Network
+func (x *BeaconBlockHeader) Reset() {
+	*x = BeaconBlockHeader{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *Peer) GetInitiator() bool {
-	if x != nil {
-		return x.Initiator
-	}
-	return false
+func (x *BeaconBlockHeader) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Peer) GetMinMsFromPeer() int64 {
-	if x != nil {
-		return x.MinMsFromPeer
+func (*BeaconBlockHeader) ProtoMessage() {}
+
+func (x *BeaconBlockHeader) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Peer) GetMinMsToPeer() int64 {
-	if x != nil {
-		return x.MinMsToPeer
-	}
-	return 0
+// Deprecated: Use BeaconBlockHeader.ProtoReflect.Descriptor instead.
+func (*BeaconBlockHeader) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *Peer) GetSlowTrafficCount() int64 {
+func (x *BeaconBlockHeader) GetSlot() uint64 {
 	if x != nil {
-		return x.SlowTrafficCount
+		return x.Slot
 	}
 	return 0
 }
 
-func (x *Peer) GetMinMsRoundTrip() int64 {
+func (x *BeaconBlockHeader) GetProposerIndex() uint64 {
 	if x != nil {
-		return x.MinMsRoundTrip
+		return x.ProposerIndex
 	}
 	return 0
 }
 
-func (x *Peer) GetAccountId() string {
+func (x *BeaconBlockHeader) GetParentRoot() string {
 	if x != nil {
-		return x.AccountId
+		return x.ParentRoot
 	}
 	return ""
 }
 
-func (x *Peer) GetAccountTier() string {
+func (x *BeaconBlockHeader) GetStateRoot() string {
 	if x != nil {
-		return x.AccountTier
+		return x.StateRoot
 	}
 	return ""
 }
 
-func (x *Peer) GetPort() int64 {
+func (x *BeaconBlockHeader) GetBodyRoot() string {
 	if x != nil {
-		return x.Port
+		return x.BodyRoot
 	}
-	return 0
+	return ""
 }
 
-func (x *Peer) GetDisabled() bool {
-	if x != nil {
-		return x.Disabled
-	}
-	return false
+type BeaconBlocksReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string             `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Hash           string             `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	Version        string             `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	Header         *BeaconBlockHeader `protobuf:"bytes,4,opt,name=header,proto3" json:"header,omitempty"`
+	Slot           uint64             `protobuf:"varint,5,opt,name=slot,proto3" json:"slot,omitempty"`
+	// SSZ encoded beacon block body
+	Body []byte `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,7,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
 }
 
-func (x *Peer) GetMevMiner() string {
-	if x != nil {
-		return x.MevMiner
+func (x *BeaconBlocksReply) Reset() {
+	*x = BeaconBlocksReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Peer) GetMevBuilder() string {
-	if x != nil {
-		return x.MevBuilder
-	}
-	return ""
+func (x *BeaconBlocksReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Peer) GetCapability() uint32 {
-	if x != nil {
-		return x.Capability
+func (*BeaconBlocksReply) ProtoMessage() {}
+
+func (x *BeaconBlocksReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Peer) GetUnpaidTxBurstLimit() int64 {
-	if x != nil {
-		return x.UnpaidTxBurstLimit
-	}
-	return 0
+// Deprecated: Use BeaconBlocksReply.ProtoReflect.Descriptor instead.
+func (*BeaconBlocksReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *Peer) GetPaidTxBurstLimit() int64 {
+func (x *BeaconBlocksReply) GetSubscriptionId() string {
 	if x != nil {
-		return x.PaidTxBurstLimit
+		return x.SubscriptionId
 	}
-	return 0
+	return ""
 }
 
-func (x *Peer) GetUnpaidTxBurstLimitExcess() *RateSnapshot {
+func (x *BeaconBlocksReply) GetHash() string {
 	if x != nil {
-		return x.UnpaidTxBurstLimitExcess
+		return x.Hash
 	}
-	return nil
+	return ""
 }
 
-func (x *Peer) GetPaidTxBurstLimitExcess() *RateSnapshot {
+func (x *BeaconBlocksReply) GetVersion() string {
 	if x != nil {
-		return x.PaidTxBurstLimitExcess
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *Peer) GetPaidTxThroughput() *RateSnapshot {
+func (x *BeaconBlocksReply) GetHeader() *BeaconBlockHeader {
 	if x != nil {
-		return x.PaidTxThroughput
+		return x.Header
 	}
 	return nil
 }
 
-func (x *Peer) GetUnpaidTxThroughput() *RateSnapshot {
+func (x *BeaconBlocksReply) GetSlot() uint64 {
 	if x != nil {
-		return x.UnpaidTxThroughput
+		return x.Slot
 	}
-	return nil
+	return 0
 }
 
-func (x *Peer) GetTrusted() string {
+func (x *BeaconBlocksReply) GetBody() []byte {
 	if x != nil {
-		return x.Trusted
+		return x.Body
 	}
-	return ""
+	return nil
 }
 
-func (x *Peer) GetMevBuilders() []string {
+func (x *BeaconBlocksReply) GetSubscriptionStatus() *SubscriptionStatus {
 	if x != nil {
-		return x.MevBuilders
+		return x.SubscriptionStatus
 	}
 	return nil
 }
 
-func (x *Peer) GetNewTxs() int64 {
-	if x != nil {
-		return x.NewTxs
-	}
-	return 0
+type BlobSidecarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Includes   []string `protobuf:"bytes,1,rep,name=includes,proto3" json:"includes,omitempty"`
+	AuthHeader string   `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// drop_oldest, drop_newest or disconnect (default) when the client does not read the stream fast enough
+	Backpressure string `protobuf:"bytes,3,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
+}
+
+func (x *BlobSidecarsRequest) Reset() {
+	*x = BlobSidecarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobSidecarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobSidecarsRequest) ProtoMessage() {}
+
+func (x *BlobSidecarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobSidecarsRequest.ProtoReflect.Descriptor instead.
+func (*BlobSidecarsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *BlobSidecarsRequest) GetIncludes() []string {
+	if x != nil {
+		return x.Includes
+	}
+	return nil
+}
+
+func (x *BlobSidecarsRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+func (x *BlobSidecarsRequest) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
+	}
+	return ""
+}
+
+type BlobSidecarsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	// the sidecars are keyed by the root of their block and their index
+	BlockRoot     string `protobuf:"bytes,2,opt,name=block_root,json=blockRoot,proto3" json:"block_root,omitempty"`
+	Index         uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+	Slot          uint64 `protobuf:"varint,4,opt,name=slot,proto3" json:"slot,omitempty"`
+	ProposerIndex uint64 `protobuf:"varint,5,opt,name=proposer_index,json=proposerIndex,proto3" json:"proposer_index,omitempty"`
+	KzgCommitment string `protobuf:"bytes,6,opt,name=kzg_commitment,json=kzgCommitment,proto3" json:"kzg_commitment,omitempty"`
+	KzgProof      string `protobuf:"bytes,7,opt,name=kzg_proof,json=kzgProof,proto3" json:"kzg_proof,omitempty"`
+	VersionedHash string `protobuf:"bytes,8,opt,name=versioned_hash,json=versionedHash,proto3" json:"versioned_hash,omitempty"`
+	Blob          []byte `protobuf:"bytes,9,opt,name=blob,proto3" json:"blob,omitempty"`
+	// set on the periodic status messages, sent when notifications were dropped by the backpressure policy
+	SubscriptionStatus *SubscriptionStatus `protobuf:"bytes,10,opt,name=subscription_status,json=subscriptionStatus,proto3" json:"subscription_status,omitempty"`
+}
+
+func (x *BlobSidecarsReply) Reset() {
+	*x = BlobSidecarsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobSidecarsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobSidecarsReply) ProtoMessage() {}
+
+func (x *BlobSidecarsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobSidecarsReply.ProtoReflect.Descriptor instead.
+func (*BlobSidecarsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BlobSidecarsReply) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *BlobSidecarsReply) GetBlockRoot() string {
+	if x != nil {
+		return x.BlockRoot
+	}
+	return ""
+}
+
+func (x *BlobSidecarsReply) GetIndex() uint64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BlobSidecarsReply) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlobSidecarsReply) GetProposerIndex() uint64 {
+	if x != nil {
+		return x.ProposerIndex
+	}
+	return 0
+}
+
+func (x *BlobSidecarsReply) GetKzgCommitment() string {
+	if x != nil {
+		return x.KzgCommitment
+	}
+	return ""
+}
+
+func (x *BlobSidecarsReply) GetKzgProof() string {
+	if x != nil {
+		return x.KzgProof
+	}
+	return ""
+}
+
+func (x *BlobSidecarsReply) GetVersionedHash() string {
+	if x != nil {
+		return x.VersionedHash
+	}
+	return ""
+}
+
+func (x *BlobSidecarsReply) GetBlob() []byte {
+	if x != nil {
+		return x.Blob
+	}
+	return nil
+}
+
+func (x *BlobSidecarsReply) GetSubscriptionStatus() *SubscriptionStatus {
+	if x != nil {
+		return x.SubscriptionStatus
+	}
+	return nil
+}
+
+type DisconnectInboundPeerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PeerIp     string `protobuf:"bytes,1,opt,name=peer_ip,json=peerIp,proto3" json:"peer_ip,omitempty"`
+	PeerPort   int64  `protobuf:"varint,2,opt,name=peer_port,json=peerPort,proto3" json:"peer_port,omitempty"`
+	PublicKey  string `protobuf:"bytes,3,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	AuthHeader string `protobuf:"bytes,4,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *DisconnectInboundPeerRequest) Reset() {
+	*x = DisconnectInboundPeerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectInboundPeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectInboundPeerRequest) ProtoMessage() {}
+
+func (x *DisconnectInboundPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectInboundPeerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectInboundPeerRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *DisconnectInboundPeerRequest) GetPeerIp() string {
+	if x != nil {
+		return x.PeerIp
+	}
+	return ""
+}
+
+func (x *DisconnectInboundPeerRequest) GetPeerPort() int64 {
+	if x != nil {
+		return x.PeerPort
+	}
+	return 0
+}
+
+func (x *DisconnectInboundPeerRequest) GetPublicKey() string {
+	if x != nil {
+		return x.PublicKey
+	}
+	return ""
+}
+
+func (x *DisconnectInboundPeerRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type DisconnectInboundPeerReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *DisconnectInboundPeerReply) Reset() {
+	*x = DisconnectInboundPeerReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DisconnectInboundPeerReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectInboundPeerReply) ProtoMessage() {}
+
+func (x *DisconnectInboundPeerReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectInboundPeerReply.ProtoReflect.Descriptor instead.
+func (*DisconnectInboundPeerReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *DisconnectInboundPeerReply) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type UnsubscribeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SubscriptionId string `protobuf:"bytes,1,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	AuthHeader     string `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *UnsubscribeRequest) Reset() {
+	*x = UnsubscribeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeRequest) ProtoMessage() {}
+
+func (x *UnsubscribeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeRequest.ProtoReflect.Descriptor instead.
+func (*UnsubscribeRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *UnsubscribeRequest) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *UnsubscribeRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type UnsubscribeReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+}
+
+func (x *UnsubscribeReply) Reset() {
+	*x = UnsubscribeReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnsubscribeReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnsubscribeReply) ProtoMessage() {}
+
+func (x *UnsubscribeReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnsubscribeReply.ProtoReflect.Descriptor instead.
+func (*UnsubscribeReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UnsubscribeReply) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type SubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *SubscriptionsRequest) Reset() {
+	*x = SubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionsRequest) ProtoMessage() {}
+
+func (x *SubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*SubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SubscriptionsRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type Subscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AccountId      string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Tier           string `protobuf:"bytes,2,opt,name=tier,proto3" json:"tier,omitempty"`
+	FeedName       string `protobuf:"bytes,3,opt,name=feed_name,json=feedName,proto3" json:"feed_name,omitempty"`
+	Network        uint32 `protobuf:"varint,4,opt,name=network,proto3" json:"network,omitempty"`
+	RemoteAddr     string `protobuf:"bytes,5,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+	Include        string `protobuf:"bytes,6,opt,name=include,proto3" json:"include,omitempty"`
+	Filter         string `protobuf:"bytes,7,opt,name=filter,proto3" json:"filter,omitempty"`
+	Age            uint64 `protobuf:"varint,8,opt,name=age,proto3" json:"age,omitempty"`
+	MessagesSent   uint64 `protobuf:"varint,9,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	SubscriptionId string `protobuf:"bytes,10,opt,name=subscription_id,json=subscriptionId,proto3" json:"subscription_id,omitempty"`
+	Backpressure   string `protobuf:"bytes,11,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
+	Dropped        uint64 `protobuf:"varint,12,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *Subscription) Reset() {
+	*x = Subscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Subscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Subscription) ProtoMessage() {}
+
+func (x *Subscription) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Subscription.ProtoReflect.Descriptor instead.
+func (*Subscription) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Subscription) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Subscription) GetTier() string {
+	if x != nil {
+		return x.Tier
+	}
+	return ""
+}
+
+func (x *Subscription) GetFeedName() string {
+	if x != nil {
+		return x.FeedName
+	}
+	return ""
+}
+
+func (x *Subscription) GetNetwork() uint32 {
+	if x != nil {
+		return x.Network
+	}
+	return 0
+}
+
+func (x *Subscription) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+func (x *Subscription) GetInclude() string {
+	if x != nil {
+		return x.Include
+	}
+	return ""
+}
+
+func (x *Subscription) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *Subscription) GetAge() uint64 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *Subscription) GetMessagesSent() uint64 {
+	if x != nil {
+		return x.MessagesSent
+	}
+	return 0
+}
+
+func (x *Subscription) GetSubscriptionId() string {
+	if x != nil {
+		return x.SubscriptionId
+	}
+	return ""
+}
+
+func (x *Subscription) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
+	}
+	return ""
+}
+
+func (x *Subscription) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type SubscriptionStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Backpressure string `protobuf:"bytes,1,opt,name=backpressure,proto3" json:"backpressure,omitempty"`
+	// total number of notifications dropped since the subscription was opened
+	Dropped uint64 `protobuf:"varint,2,opt,name=dropped,proto3" json:"dropped,omitempty"`
+}
+
+func (x *SubscriptionStatus) Reset() {
+	*x = SubscriptionStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionStatus) ProtoMessage() {}
+
+func (x *SubscriptionStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionStatus.ProtoReflect.Descriptor instead.
+func (*SubscriptionStatus) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SubscriptionStatus) GetBackpressure() string {
+	if x != nil {
+		return x.Backpressure
+	}
+	return ""
+}
+
+func (x *SubscriptionStatus) GetDropped() uint64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+type SubscriptionsReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Subscriptions []*Subscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *SubscriptionsReply) Reset() {
+	*x = SubscriptionsReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscriptionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscriptionsReply) ProtoMessage() {}
+
+func (x *SubscriptionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscriptionsReply.ProtoReflect.Descriptor instead.
+func (*SubscriptionsReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *SubscriptionsReply) GetSubscriptions() []*Subscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+type VersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *VersionRequest) Reset() {
+	*x = VersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionRequest) ProtoMessage() {}
+
+func (x *VersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionRequest.ProtoReflect.Descriptor instead.
+func (*VersionRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *VersionRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type VersionReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Version   string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	BuildDate string `protobuf:"bytes,2,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+}
+
+func (x *VersionReply) Reset() {
+	*x = VersionReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionReply) ProtoMessage() {}
+
+func (x *VersionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionReply.ProtoReflect.Descriptor instead.
+func (*VersionReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *VersionReply) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionReply) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *StopRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type StopReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *StopReply) Reset() {
+	*x = StopReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StopReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopReply) ProtoMessage() {}
+
+func (x *StopReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopReply.ProtoReflect.Descriptor instead.
+func (*StopReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{41}
+}
+
+type PeersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type       string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	AuthHeader string `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *PeersRequest) Reset() {
+	*x = PeersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeersRequest) ProtoMessage() {}
+
+func (x *PeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeersRequest.ProtoReflect.Descriptor instead.
+func (*PeersRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *PeersRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PeersRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type RateSnapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FiveMinute int64 `protobuf:"varint,1,opt,name=fiveMinute,proto3" json:"fiveMinute,omitempty"`
+	OneHour    int64 `protobuf:"varint,2,opt,name=oneHour,proto3" json:"oneHour,omitempty"`
+	OneDay     int64 `protobuf:"varint,3,opt,name=oneDay,proto3" json:"oneDay,omitempty"`
+}
+
+func (x *RateSnapshot) Reset() {
+	*x = RateSnapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RateSnapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RateSnapshot) ProtoMessage() {}
+
+func (x *RateSnapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RateSnapshot.ProtoReflect.Descriptor instead.
+func (*RateSnapshot) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *RateSnapshot) GetFiveMinute() int64 {
+	if x != nil {
+		return x.FiveMinute
+	}
+	return 0
+}
+
+func (x *RateSnapshot) GetOneHour() int64 {
+	if x != nil {
+		return x.OneHour
+	}
+	return 0
+}
+
+func (x *RateSnapshot) GetOneDay() int64 {
+	if x != nil {
+		return x.OneDay
+	}
+	return 0
+}
+
+type Peer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ip                       string        `protobuf:"bytes,1,opt,name=ip,proto3" json:"ip,omitempty"`
+	NodeId                   string        `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3" json:"node_id,omitempty"`
+	Type                     string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	State                    string        `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	Network                  uint32        `protobuf:"varint,5,opt,name=network,proto3" json:"network,omitempty"`
+	Initiator                bool          `protobuf:"varint,6,opt,name=initiator,proto3" json:"initiator,omitempty"`
+	MinMsFromPeer            int64         `protobuf:"varint,7,opt,name=min_ms_from_peer,json=minMsFromPeer,proto3" json:"min_ms_from_peer,omitempty"`
+	MinMsToPeer              int64         `protobuf:"varint,8,opt,name=min_ms_to_peer,json=minMsToPeer,proto3" json:"min_ms_to_peer,omitempty"`
+	SlowTrafficCount         int64         `protobuf:"varint,9,opt,name=slow_traffic_count,json=slowTrafficCount,proto3" json:"slow_traffic_count,omitempty"`
+	MinMsRoundTrip           int64         `protobuf:"varint,10,opt,name=min_ms_round_trip,json=minMsRoundTrip,proto3" json:"min_ms_round_trip,omitempty"`
+	AccountId                string        `protobuf:"bytes,11,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	AccountTier              string        `protobuf:"bytes,12,opt,name=account_tier,json=accountTier,proto3" json:"account_tier,omitempty"`
+	Port                     int64         `protobuf:"varint,13,opt,name=port,proto3" json:"port,omitempty"`
+	Disabled                 bool          `protobuf:"varint,14,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	MevMiner                 string        `protobuf:"bytes,15,opt,name=mev_miner,json=mevMiner,proto3" json:"mev_miner,omitempty"`
+	MevBuilder               string        `protobuf:"bytes,16,opt,name=mev_builder,json=mevBuilder,proto3" json:"mev_builder,omitempty"` // Deprecated
+	Capability               uint32        `protobuf:"varint,17,opt,name=capability,proto3" json:"capability,omitempty"`
+	UnpaidTxBurstLimit       int64         `protobuf:"varint,18,opt,name=unpaid_tx_burst_limit,json=unpaidTxBurstLimit,proto3" json:"unpaid_tx_burst_limit,omitempty"`
+	PaidTxBurstLimit         int64         `protobuf:"varint,19,opt,name=paid_tx_burst_limit,json=paidTxBurstLimit,proto3" json:"paid_tx_burst_limit,omitempty"`
+	UnpaidTxBurstLimitExcess *RateSnapshot `protobuf:"bytes,20,opt,name=unpaid_tx_burst_limit_excess,json=unpaidTxBurstLimitExcess,proto3" json:"unpaid_tx_burst_limit_excess,omitempty"`
+	PaidTxBurstLimitExcess   *RateSnapshot `protobuf:"bytes,21,opt,name=paid_tx_burst_limit_excess,json=paidTxBurstLimitExcess,proto3" json:"paid_tx_burst_limit_excess,omitempty"`
+	PaidTxThroughput         *RateSnapshot `protobuf:"bytes,22,opt,name=paid_tx_throughput,json=paidTxThroughput,proto3" json:"paid_tx_throughput,omitempty"`
+	UnpaidTxThroughput       *RateSnapshot `protobuf:"bytes,23,opt,name=unpaid_tx_throughput,json=unpaidTxThroughput,proto3" json:"unpaid_tx_throughput,omitempty"`
+	Trusted                  string        `protobuf:"bytes,24,opt,name=trusted,proto3" json:"trusted,omitempty"`
+	MevBuilders              []string      `protobuf:"bytes,25,rep,name=mev_builders,json=mevBuilders,proto3" json:"mev_builders,omitempty"`
+	NewTxs                   int64         `protobuf:"varint,26,opt,name=new_txs,json=newTxs,proto3" json:"new_txs,omitempty"`
+	AlreadySeenTxs           int64         `protobuf:"varint,27,opt,name=already_seen_txs,json=alreadySeenTxs,proto3" json:"already_seen_txs,omitempty"`
+}
+
+func (x *Peer) Reset() {
+	*x = Peer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Peer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Peer) ProtoMessage() {}
+
+func (x *Peer) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Peer.ProtoReflect.Descriptor instead.
+func (*Peer) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *Peer) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
+}
+
+func (x *Peer) GetNodeId() string {
+	if x != nil {
+		return x.NodeId
+	}
+	return ""
+}
+
+func (x *Peer) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *Peer) GetState() string {
+	if x != nil {
+		return x.State
+	}
+	return ""
+}
+
+func (x *Peer) GetNetwork() uint32 {
+	if x != nil {
+		return x.Network
+	}
+	return 0
+}
+
+func (x *Peer) GetInitiator() bool {
+	if x != nil {
+		return x.Initiator
+	}
+	return false
+}
+
+func (x *Peer) GetMinMsFromPeer() int64 {
+	if x != nil {
+		return x.MinMsFromPeer
+	}
+	return 0
+}
+
+func (x *Peer) GetMinMsToPeer() int64 {
+	if x != nil {
+		return x.MinMsToPeer
+	}
+	return 0
+}
+
+func (x *Peer) GetSlowTrafficCount() int64 {
+	if x != nil {
+		return x.SlowTrafficCount
+	}
+	return 0
+}
+
+func (x *Peer) GetMinMsRoundTrip() int64 {
+	if x != nil {
+		return x.MinMsRoundTrip
+	}
+	return 0
+}
+
+func (x *Peer) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *Peer) GetAccountTier() string {
+	if x != nil {
+		return x.AccountTier
+	}
+	return ""
+}
+
+func (x *Peer) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *Peer) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *Peer) GetMevMiner() string {
+	if x != nil {
+		return x.MevMiner
+	}
+	return ""
+}
+
+func (x *Peer) GetMevBuilder() string {
+	if x != nil {
+		return x.MevBuilder
+	}
+	return ""
+}
+
+func (x *Peer) GetCapability() uint32 {
+	if x != nil {
+		return x.Capability
+	}
+	return 0
+}
+
+func (x *Peer) GetUnpaidTxBurstLimit() int64 {
+	if x != nil {
+		return x.UnpaidTxBurstLimit
+	}
+	return 0
+}
+
+func (x *Peer) GetPaidTxBurstLimit() int64 {
+	if x != nil {
+		return x.PaidTxBurstLimit
+	}
+	return 0
+}
+
+func (x *Peer) GetUnpaidTxBurstLimitExcess() *RateSnapshot {
+	if x != nil {
+		return x.UnpaidTxBurstLimitExcess
+	}
+	return nil
+}
+
+func (x *Peer) GetPaidTxBurstLimitExcess() *RateSnapshot {
+	if x != nil {
+		return x.PaidTxBurstLimitExcess
+	}
+	return nil
+}
+
+func (x *Peer) GetPaidTxThroughput() *RateSnapshot {
+	if x != nil {
+		return x.PaidTxThroughput
+	}
+	return nil
+}
+
+func (x *Peer) GetUnpaidTxThroughput() *RateSnapshot {
+	if x != nil {
+		return x.UnpaidTxThroughput
+	}
+	return nil
+}
+
+func (x *Peer) GetTrusted() string {
+	if x != nil {
+		return x.Trusted
+	}
+	return ""
+}
+
+func (x *Peer) GetMevBuilders() []string {
+	if x != nil {
+		return x.MevBuilders
+	}
+	return nil
+}
+
+func (x *Peer) GetNewTxs() int64 {
+	if x != nil {
+		return x.NewTxs
+	}
+	return 0
 }
 
 func (x *Peer) GetAlreadySeenTxs() int64 {
 	if x != nil {
-		return x.AlreadySeenTxs
+		return x.AlreadySeenTxs
+	}
+	return 0
+}
+
+type PeersReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+}
+
+func (x *PeersReply) Reset() {
+	*x = PeersReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PeersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PeersReply) ProtoMessage() {}
+
+func (x *PeersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PeersReply.ProtoReflect.Descriptor instead.
+func (*PeersReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *PeersReply) GetPeers() []*Peer {
+	if x != nil {
+		return x.Peers
+	}
+	return nil
+}
+
+type SendTXRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SendTXRequest) Reset() {
+	*x = SendTXRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SendTXRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendTXRequest) ProtoMessage() {}
+
+func (x *SendTXRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendTXRequest.ProtoReflect.Descriptor instead.
+func (*SendTXRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{46}
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *Transaction) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type Transactions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+}
+
+func (x *Transactions) Reset() {
+	*x = Transactions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transactions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transactions) ProtoMessage() {}
+
+func (x *Transactions) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
+func (*Transactions) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *Transactions) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
 	}
-	return 0
+	return nil
 }
 
-type PeersReply struct {
+type BxTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Peers []*Peer `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	Hash     string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	ShortIds []uint64               `protobuf:"varint,2,rep,packed,name=short_ids,json=shortIds,proto3" json:"short_ids,omitempty"`
+	AddTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`
 }
 
-func (x *PeersReply) Reset() {
-	*x = PeersReply{}
+func (x *BxTransaction) Reset() {
+	*x = BxTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[26]
+		mi := &file_gateway_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PeersReply) String() string {
+func (x *BxTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PeersReply) ProtoMessage() {}
+func (*BxTransaction) ProtoMessage() {}
 
-func (x *PeersReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[26]
+func (x *BxTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2057,41 +3809,204 @@ func (x *PeersReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PeersReply.ProtoReflect.Descriptor instead.
-func (*PeersReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use BxTransaction.ProtoReflect.Descriptor instead.
+func (*BxTransaction) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *PeersReply) GetPeers() []*Peer {
+func (x *BxTransaction) GetHash() string {
 	if x != nil {
-		return x.Peers
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *BxTransaction) GetShortIds() []uint64 {
+	if x != nil {
+		return x.ShortIds
 	}
 	return nil
 }
 
-type SendTXRequest struct {
+func (x *BxTransaction) GetAddTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AddTime
+	}
+	return nil
+}
+
+type GetBxTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (x *SendTXRequest) Reset() {
-	*x = SendTXRequest{}
+func (x *GetBxTransactionRequest) Reset() {
+	*x = GetBxTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[27]
+		mi := &file_gateway_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SendTXRequest) String() string {
+func (x *GetBxTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendTXRequest) ProtoMessage() {}
+func (*GetBxTransactionRequest) ProtoMessage() {}
 
-func (x *SendTXRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[27]
+func (x *GetBxTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBxTransactionRequest.ProtoReflect.Descriptor instead.
+func (*GetBxTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *GetBxTransactionRequest) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+type GetBxTransactionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tx *BxTransaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+}
+
+func (x *GetBxTransactionResponse) Reset() {
+	*x = GetBxTransactionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBxTransactionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBxTransactionResponse) ProtoMessage() {}
+
+func (x *GetBxTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBxTransactionResponse.ProtoReflect.Descriptor instead.
+func (*GetBxTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *GetBxTransactionResponse) GetTx() *BxTransaction {
+	if x != nil {
+		return x.Tx
+	}
+	return nil
+}
+
+type TxStoreRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *TxStoreRequest) Reset() {
+	*x = TxStoreRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStoreRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStoreRequest) ProtoMessage() {}
+
+func (x *TxStoreRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxStoreRequest.ProtoReflect.Descriptor instead.
+func (*TxStoreRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TxStoreRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type TxStoreNetworkData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Network      uint64         `protobuf:"varint,4,opt,name=network,proto3" json:"network,omitempty"`
+	TxCount      uint64         `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	ShortIdCount uint64         `protobuf:"varint,2,opt,name=short_id_count,json=shortIdCount,proto3" json:"short_id_count,omitempty"`
+	OldestTx     *BxTransaction `protobuf:"bytes,3,opt,name=oldest_tx,json=oldestTx,proto3" json:"oldest_tx,omitempty"`
+	// blob transactions are counted in tx_count too
+	BlobTxCount uint64 `protobuf:"varint,5,opt,name=blob_tx_count,json=blobTxCount,proto3" json:"blob_tx_count,omitempty"`
+	BlobsSize   uint64 `protobuf:"varint,6,opt,name=blobs_size,json=blobsSize,proto3" json:"blobs_size,omitempty"`
+}
+
+func (x *TxStoreNetworkData) Reset() {
+	*x = TxStoreNetworkData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStoreNetworkData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStoreNetworkData) ProtoMessage() {}
+
+func (x *TxStoreNetworkData) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2102,36 +4017,82 @@ func (x *SendTXRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendTXRequest.ProtoReflect.Descriptor instead.
-func (*SendTXRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use TxStoreNetworkData.ProtoReflect.Descriptor instead.
+func (*TxStoreNetworkData) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *TxStoreNetworkData) GetNetwork() uint64 {
+	if x != nil {
+		return x.Network
+	}
+	return 0
+}
+
+func (x *TxStoreNetworkData) GetTxCount() uint64 {
+	if x != nil {
+		return x.TxCount
+	}
+	return 0
+}
+
+func (x *TxStoreNetworkData) GetShortIdCount() uint64 {
+	if x != nil {
+		return x.ShortIdCount
+	}
+	return 0
+}
+
+func (x *TxStoreNetworkData) GetOldestTx() *BxTransaction {
+	if x != nil {
+		return x.OldestTx
+	}
+	return nil
+}
+
+func (x *TxStoreNetworkData) GetBlobTxCount() uint64 {
+	if x != nil {
+		return x.BlobTxCount
+	}
+	return 0
+}
+
+func (x *TxStoreNetworkData) GetBlobsSize() uint64 {
+	if x != nil {
+		return x.BlobsSize
+	}
+	return 0
 }
 
-type Transaction struct {
+type TxStoreReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Content string `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
+	TxCount      uint64                `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
+	ShortIdCount uint64                `protobuf:"varint,2,opt,name=short_id_count,json=shortIdCount,proto3" json:"short_id_count,omitempty"`
+	NetworkData  []*TxStoreNetworkData `protobuf:"bytes,3,rep,name=network_data,json=networkData,proto3" json:"network_data,omitempty"`
+	BlobTxCount  uint64                `protobuf:"varint,4,opt,name=blob_tx_count,json=blobTxCount,proto3" json:"blob_tx_count,omitempty"`
+	BlobsSize    uint64                `protobuf:"varint,5,opt,name=blobs_size,json=blobsSize,proto3" json:"blobs_size,omitempty"`
 }
 
-func (x *Transaction) Reset() {
-	*x = Transaction{}
+func (x *TxStoreReply) Reset() {
+	*x = TxStoreReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[28]
+		mi := &file_gateway_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transaction) String() string {
+func (x *TxStoreReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transaction) ProtoMessage() {}
+func (*TxStoreReply) ProtoMessage() {}
 
-func (x *Transaction) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[28]
+func (x *TxStoreReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2142,43 +4103,72 @@ func (x *Transaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
-func (*Transaction) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use TxStoreReply.ProtoReflect.Descriptor instead.
+func (*TxStoreReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *Transaction) GetContent() string {
+func (x *TxStoreReply) GetTxCount() uint64 {
 	if x != nil {
-		return x.Content
+		return x.TxCount
 	}
-	return ""
+	return 0
 }
 
-type Transactions struct {
+func (x *TxStoreReply) GetShortIdCount() uint64 {
+	if x != nil {
+		return x.ShortIdCount
+	}
+	return 0
+}
+
+func (x *TxStoreReply) GetNetworkData() []*TxStoreNetworkData {
+	if x != nil {
+		return x.NetworkData
+	}
+	return nil
+}
+
+func (x *TxStoreReply) GetBlobTxCount() uint64 {
+	if x != nil {
+		return x.BlobTxCount
+	}
+	return 0
+}
+
+func (x *TxStoreReply) GetBlobsSize() uint64 {
+	if x != nil {
+		return x.BlobsSize
+	}
+	return 0
+}
+
+type TxAndSender struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transactions []*Transaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Transaction string `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	Sender      []byte `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
 }
 
-func (x *Transactions) Reset() {
-	*x = Transactions{}
+func (x *TxAndSender) Reset() {
+	*x = TxAndSender{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[29]
+		mi := &file_gateway_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transactions) String() string {
+func (x *TxAndSender) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transactions) ProtoMessage() {}
+func (*TxAndSender) ProtoMessage() {}
 
-func (x *Transactions) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[29]
+func (x *TxAndSender) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2189,45 +4179,57 @@ func (x *Transactions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transactions.ProtoReflect.Descriptor instead.
-func (*Transactions) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TxAndSender.ProtoReflect.Descriptor instead.
+func (*TxAndSender) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *Transactions) GetTransactions() []*Transaction {
+func (x *TxAndSender) GetTransaction() string {
 	if x != nil {
-		return x.Transactions
+		return x.Transaction
+	}
+	return ""
+}
+
+func (x *TxAndSender) GetSender() []byte {
+	if x != nil {
+		return x.Sender
 	}
 	return nil
 }
 
-type BxTransaction struct {
+type BlxrBatchTXRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Hash     string                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
-	ShortIds []uint64               `protobuf:"varint,2,rep,packed,name=short_ids,json=shortIds,proto3" json:"short_ids,omitempty"`
-	AddTime  *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=add_time,json=addTime,proto3" json:"add_time,omitempty"`
+	TransactionsAndSenders []*TxAndSender `protobuf:"bytes,1,rep,name=transactions_and_senders,json=transactionsAndSenders,proto3" json:"transactions_and_senders,omitempty"`
+	NonceMonitoring        bool           `protobuf:"varint,2,opt,name=nonce_monitoring,json=nonceMonitoring,proto3" json:"nonce_monitoring,omitempty"`
+	NextValidator          bool           `protobuf:"varint,3,opt,name=next_validator,json=nextValidator,proto3" json:"next_validator,omitempty"`
+	Fallback               int32          `protobuf:"varint,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
+	ValidatorsOnly         bool           `protobuf:"varint,5,opt,name=validators_only,json=validatorsOnly,proto3" json:"validators_only,omitempty"`
+	NodeValidation         bool           `protobuf:"varint,6,opt,name=node_validation,json=nodeValidation,proto3" json:"node_validation,omitempty"`
+	SendingTime            int64          `protobuf:"varint,7,opt,name=sending_time,json=sendingTime,proto3" json:"sending_time,omitempty"`
+	AuthHeader             string         `protobuf:"bytes,8,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 }
 
-func (x *BxTransaction) Reset() {
-	*x = BxTransaction{}
+func (x *BlxrBatchTXRequest) Reset() {
+	*x = BlxrBatchTXRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[30]
+		mi := &file_gateway_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BxTransaction) String() string {
+func (x *BlxrBatchTXRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BxTransaction) ProtoMessage() {}
+func (*BlxrBatchTXRequest) ProtoMessage() {}
 
-func (x *BxTransaction) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[30]
+func (x *BlxrBatchTXRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2238,104 +4240,95 @@ func (x *BxTransaction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BxTransaction.ProtoReflect.Descriptor instead.
-func (*BxTransaction) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use BlxrBatchTXRequest.ProtoReflect.Descriptor instead.
+func (*BlxrBatchTXRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *BxTransaction) GetHash() string {
+func (x *BlxrBatchTXRequest) GetTransactionsAndSenders() []*TxAndSender {
 	if x != nil {
-		return x.Hash
+		return x.TransactionsAndSenders
 	}
-	return ""
+	return nil
 }
 
-func (x *BxTransaction) GetShortIds() []uint64 {
+func (x *BlxrBatchTXRequest) GetNonceMonitoring() bool {
 	if x != nil {
-		return x.ShortIds
+		return x.NonceMonitoring
 	}
-	return nil
+	return false
 }
 
-func (x *BxTransaction) GetAddTime() *timestamppb.Timestamp {
+func (x *BlxrBatchTXRequest) GetNextValidator() bool {
 	if x != nil {
-		return x.AddTime
+		return x.NextValidator
 	}
-	return nil
-}
-
-type GetBxTransactionRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	return false
 }
 
-func (x *GetBxTransactionRequest) Reset() {
-	*x = GetBxTransactionRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *BlxrBatchTXRequest) GetFallback() int32 {
+	if x != nil {
+		return x.Fallback
 	}
+	return 0
 }
 
-func (x *GetBxTransactionRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *BlxrBatchTXRequest) GetValidatorsOnly() bool {
+	if x != nil {
+		return x.ValidatorsOnly
+	}
+	return false
 }
 
-func (*GetBxTransactionRequest) ProtoMessage() {}
-
-func (x *GetBxTransactionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *BlxrBatchTXRequest) GetNodeValidation() bool {
+	if x != nil {
+		return x.NodeValidation
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use GetBxTransactionRequest.ProtoReflect.Descriptor instead.
-func (*GetBxTransactionRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{31}
+func (x *BlxrBatchTXRequest) GetSendingTime() int64 {
+	if x != nil {
+		return x.SendingTime
+	}
+	return 0
 }
 
-func (x *GetBxTransactionRequest) GetHash() string {
+func (x *BlxrBatchTXRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.Hash
+		return x.AuthHeader
 	}
 	return ""
 }
 
-type GetBxTransactionResponse struct {
+type BlxrTxRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Tx *BxTransaction `protobuf:"bytes,1,opt,name=tx,proto3" json:"tx,omitempty"`
+	Transaction     string `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
+	NonceMonitoring bool   `protobuf:"varint,2,opt,name=nonce_monitoring,json=nonceMonitoring,proto3" json:"nonce_monitoring,omitempty"`
+	NextValidator   bool   `protobuf:"varint,3,opt,name=next_validator,json=nextValidator,proto3" json:"next_validator,omitempty"`
+	AuthHeader      string `protobuf:"bytes,4,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 }
 
-func (x *GetBxTransactionResponse) Reset() {
-	*x = GetBxTransactionResponse{}
+func (x *BlxrTxRequest) Reset() {
+	*x = BlxrTxRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[32]
+		mi := &file_gateway_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetBxTransactionResponse) String() string {
+func (x *BlxrTxRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetBxTransactionResponse) ProtoMessage() {}
+func (*BlxrTxRequest) ProtoMessage() {}
 
-func (x *GetBxTransactionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[32]
+func (x *BlxrTxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2346,43 +4339,64 @@ func (x *GetBxTransactionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetBxTransactionResponse.ProtoReflect.Descriptor instead.
-func (*GetBxTransactionResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use BlxrTxRequest.ProtoReflect.Descriptor instead.
+func (*BlxrTxRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *GetBxTransactionResponse) GetTx() *BxTransaction {
+func (x *BlxrTxRequest) GetTransaction() string {
 	if x != nil {
-		return x.Tx
+		return x.Transaction
 	}
-	return nil
+	return ""
+}
+
+func (x *BlxrTxRequest) GetNonceMonitoring() bool {
+	if x != nil {
+		return x.NonceMonitoring
+	}
+	return false
+}
+
+func (x *BlxrTxRequest) GetNextValidator() bool {
+	if x != nil {
+		return x.NextValidator
+	}
+	return false
+}
+
+func (x *BlxrTxRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
 }
 
-type TxStoreRequest struct {
+type BlxrTxReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
 }
 
-func (x *TxStoreRequest) Reset() {
-	*x = TxStoreRequest{}
+func (x *BlxrTxReply) Reset() {
+	*x = BlxrTxReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[33]
+		mi := &file_gateway_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxStoreRequest) String() string {
+func (x *BlxrTxReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxStoreRequest) ProtoMessage() {}
+func (*BlxrTxReply) ProtoMessage() {}
 
-func (x *TxStoreRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[33]
+func (x *BlxrTxReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2393,46 +4407,53 @@ func (x *TxStoreRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxStoreRequest.ProtoReflect.Descriptor instead.
-func (*TxStoreRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use BlxrTxReply.ProtoReflect.Descriptor instead.
+func (*BlxrTxReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *TxStoreRequest) GetAuthHeader() string {
+func (x *BlxrTxReply) GetTxHash() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.TxHash
 	}
 	return ""
 }
 
-type TxStoreNetworkData struct {
+type BlxrSubmitBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Network      uint64         `protobuf:"varint,4,opt,name=network,proto3" json:"network,omitempty"`
-	TxCount      uint64         `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
-	ShortIdCount uint64         `protobuf:"varint,2,opt,name=short_id_count,json=shortIdCount,proto3" json:"short_id_count,omitempty"`
-	OldestTx     *BxTransaction `protobuf:"bytes,3,opt,name=oldest_tx,json=oldestTx,proto3" json:"oldest_tx,omitempty"`
-}
-
-func (x *TxStoreNetworkData) Reset() {
-	*x = TxStoreNetworkData{}
+	Transactions    []string          `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	BlockNumber     string            `protobuf:"bytes,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	MinTimestamp    int64             `protobuf:"varint,3,opt,name=min_timestamp,json=minTimestamp,proto3" json:"min_timestamp,omitempty"`
+	MaxTimestamp    int64             `protobuf:"varint,4,opt,name=max_timestamp,json=maxTimestamp,proto3" json:"max_timestamp,omitempty"`
+	RevertingHashes []string          `protobuf:"bytes,5,rep,name=reverting_hashes,json=revertingHashes,proto3" json:"reverting_hashes,omitempty"`
+	Uuid            string            `protobuf:"bytes,6,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	Frontrunning    bool              `protobuf:"varint,7,opt,name=frontrunning,proto3" json:"frontrunning,omitempty"`
+	MevBuilders     map[string]string `protobuf:"bytes,8,rep,name=mev_builders,json=mevBuilders,proto3" json:"mev_builders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	BundlePrice     int64             `protobuf:"varint,9,opt,name=bundle_price,json=bundlePrice,proto3" json:"bundle_price,omitempty"`
+	EnforcePayout   bool              `protobuf:"varint,10,opt,name=enforce_payout,json=enforcePayout,proto3" json:"enforce_payout,omitempty"`
+	AuthHeader      string            `protobuf:"bytes,11,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *BlxrSubmitBundleRequest) Reset() {
+	*x = BlxrSubmitBundleRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[34]
+		mi := &file_gateway_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxStoreNetworkData) String() string {
+func (x *BlxrSubmitBundleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxStoreNetworkData) ProtoMessage() {}
+func (*BlxrSubmitBundleRequest) ProtoMessage() {}
 
-func (x *TxStoreNetworkData) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[34]
+func (x *BlxrSubmitBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2443,66 +4464,113 @@ func (x *TxStoreNetworkData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxStoreNetworkData.ProtoReflect.Descriptor instead.
-func (*TxStoreNetworkData) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use BlxrSubmitBundleRequest.ProtoReflect.Descriptor instead.
+func (*BlxrSubmitBundleRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *TxStoreNetworkData) GetNetwork() uint64 {
+func (x *BlxrSubmitBundleRequest) GetTransactions() []string {
 	if x != nil {
-		return x.Network
+		return x.Transactions
 	}
-	return 0
+	return nil
 }
 
-func (x *TxStoreNetworkData) GetTxCount() uint64 {
+func (x *BlxrSubmitBundleRequest) GetBlockNumber() string {
 	if x != nil {
-		return x.TxCount
+		return x.BlockNumber
+	}
+	return ""
+}
+
+func (x *BlxrSubmitBundleRequest) GetMinTimestamp() int64 {
+	if x != nil {
+		return x.MinTimestamp
 	}
 	return 0
 }
 
-func (x *TxStoreNetworkData) GetShortIdCount() uint64 {
+func (x *BlxrSubmitBundleRequest) GetMaxTimestamp() int64 {
 	if x != nil {
-		return x.ShortIdCount
+		return x.MaxTimestamp
 	}
 	return 0
 }
 
-func (x *TxStoreNetworkData) GetOldestTx() *BxTransaction {
+func (x *BlxrSubmitBundleRequest) GetRevertingHashes() []string {
 	if x != nil {
-		return x.OldestTx
+		return x.RevertingHashes
 	}
 	return nil
 }
 
-type TxStoreReply struct {
+func (x *BlxrSubmitBundleRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *BlxrSubmitBundleRequest) GetFrontrunning() bool {
+	if x != nil {
+		return x.Frontrunning
+	}
+	return false
+}
+
+func (x *BlxrSubmitBundleRequest) GetMevBuilders() map[string]string {
+	if x != nil {
+		return x.MevBuilders
+	}
+	return nil
+}
+
+func (x *BlxrSubmitBundleRequest) GetBundlePrice() int64 {
+	if x != nil {
+		return x.BundlePrice
+	}
+	return 0
+}
+
+func (x *BlxrSubmitBundleRequest) GetEnforcePayout() bool {
+	if x != nil {
+		return x.EnforcePayout
+	}
+	return false
+}
+
+func (x *BlxrSubmitBundleRequest) GetAuthHeader() string {
+	if x != nil {
+		return x.AuthHeader
+	}
+	return ""
+}
+
+type BlxrSubmitBundleReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxCount      uint64                `protobuf:"varint,1,opt,name=tx_count,json=txCount,proto3" json:"tx_count,omitempty"`
-	ShortIdCount uint64                `protobuf:"varint,2,opt,name=short_id_count,json=shortIdCount,proto3" json:"short_id_count,omitempty"`
-	NetworkData  []*TxStoreNetworkData `protobuf:"bytes,3,rep,name=network_data,json=networkData,proto3" json:"network_data,omitempty"`
+	BundleHash string `protobuf:"bytes,1,opt,name=bundle_hash,json=bundleHash,proto3" json:"bundle_hash,omitempty"`
 }
 
-func (x *TxStoreReply) Reset() {
-	*x = TxStoreReply{}
+func (x *BlxrSubmitBundleReply) Reset() {
+	*x = BlxrSubmitBundleReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[35]
+		mi := &file_gateway_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxStoreReply) String() string {
+func (x *BlxrSubmitBundleReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxStoreReply) ProtoMessage() {}
+func (*BlxrSubmitBundleReply) ProtoMessage() {}
 
-func (x *TxStoreReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[35]
+func (x *BlxrSubmitBundleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2513,58 +4581,92 @@ func (x *TxStoreReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxStoreReply.ProtoReflect.Descriptor instead.
-func (*TxStoreReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use BlxrSubmitBundleReply.ProtoReflect.Descriptor instead.
+func (*BlxrSubmitBundleReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *TxStoreReply) GetTxCount() uint64 {
+func (x *BlxrSubmitBundleReply) GetBundleHash() string {
 	if x != nil {
-		return x.TxCount
+		return x.BundleHash
 	}
-	return 0
+	return ""
 }
 
-func (x *TxStoreReply) GetShortIdCount() uint64 {
-	if x != nil {
-		return x.ShortIdCount
+type QuotaUsageRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+}
+
+func (x *QuotaUsageRequest) Reset() {
+	*x = QuotaUsageRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TxStoreReply) GetNetworkData() []*TxStoreNetworkData {
+func (x *QuotaUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QuotaUsageRequest) ProtoMessage() {}
+
+func (x *QuotaUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QuotaUsageRequest.ProtoReflect.Descriptor instead.
+func (*QuotaUsageRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *QuotaUsageRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.NetworkData
+		return x.AuthHeader
 	}
-	return nil
+	return ""
 }
 
-type TxAndSender struct {
+type QuotaUsageReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction string `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	Sender      []byte `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	AccountId   string `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	QuotaFilled uint64 `protobuf:"varint,2,opt,name=quota_filled,json=quotaFilled,proto3" json:"quota_filled,omitempty"`
+	QuotaLimit  uint64 `protobuf:"varint,3,opt,name=quota_limit,json=quotaLimit,proto3" json:"quota_limit,omitempty"`
 }
 
-func (x *TxAndSender) Reset() {
-	*x = TxAndSender{}
+func (x *QuotaUsageReply) Reset() {
+	*x = QuotaUsageReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[36]
+		mi := &file_gateway_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TxAndSender) String() string {
+func (x *QuotaUsageReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TxAndSender) ProtoMessage() {}
+func (*QuotaUsageReply) ProtoMessage() {}
 
-func (x *TxAndSender) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[36]
+func (x *QuotaUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2575,57 +4677,57 @@ func (x *TxAndSender) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TxAndSender.ProtoReflect.Descriptor instead.
-func (*TxAndSender) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use QuotaUsageReply.ProtoReflect.Descriptor instead.
+func (*QuotaUsageReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *TxAndSender) GetTransaction() string {
+func (x *QuotaUsageReply) GetAccountId() string {
 	if x != nil {
-		return x.Transaction
+		return x.AccountId
 	}
 	return ""
 }
 
-func (x *TxAndSender) GetSender() []byte {
+func (x *QuotaUsageReply) GetQuotaFilled() uint64 {
 	if x != nil {
-		return x.Sender
+		return x.QuotaFilled
 	}
-	return nil
+	return 0
 }
 
-type BlxrBatchTXRequest struct {
+func (x *QuotaUsageReply) GetQuotaLimit() uint64 {
+	if x != nil {
+		return x.QuotaLimit
+	}
+	return 0
+}
+
+type PingRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TransactionsAndSenders []*TxAndSender `protobuf:"bytes,1,rep,name=transactions_and_senders,json=transactionsAndSenders,proto3" json:"transactions_and_senders,omitempty"`
-	NonceMonitoring        bool           `protobuf:"varint,2,opt,name=nonce_monitoring,json=nonceMonitoring,proto3" json:"nonce_monitoring,omitempty"`
-	NextValidator          bool           `protobuf:"varint,3,opt,name=next_validator,json=nextValidator,proto3" json:"next_validator,omitempty"`
-	Fallback               int32          `protobuf:"varint,4,opt,name=fallback,proto3" json:"fallback,omitempty"`
-	ValidatorsOnly         bool           `protobuf:"varint,5,opt,name=validators_only,json=validatorsOnly,proto3" json:"validators_only,omitempty"`
-	NodeValidation         bool           `protobuf:"varint,6,opt,name=node_validation,json=nodeValidation,proto3" json:"node_validation,omitempty"`
-	SendingTime            int64          `protobuf:"varint,7,opt,name=sending_time,json=sendingTime,proto3" json:"sending_time,omitempty"`
-	AuthHeader             string         `protobuf:"bytes,8,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	AuthHeader string `protobuf:"bytes,1,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 }
 
-func (x *BlxrBatchTXRequest) Reset() {
-	*x = BlxrBatchTXRequest{}
+func (x *PingRequest) Reset() {
+	*x = PingRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[37]
+		mi := &file_gateway_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlxrBatchTXRequest) String() string {
+func (x *PingRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlxrBatchTXRequest) ProtoMessage() {}
+func (*PingRequest) ProtoMessage() {}
 
-func (x *BlxrBatchTXRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[37]
+func (x *PingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2636,95 +4738,92 @@ func (x *BlxrBatchTXRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlxrBatchTXRequest.ProtoReflect.Descriptor instead.
-func (*BlxrBatchTXRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use PingRequest.ProtoReflect.Descriptor instead.
+func (*PingRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *BlxrBatchTXRequest) GetTransactionsAndSenders() []*TxAndSender {
+func (x *PingRequest) GetAuthHeader() string {
 	if x != nil {
-		return x.TransactionsAndSenders
+		return x.AuthHeader
 	}
-	return nil
+	return ""
 }
 
-func (x *BlxrBatchTXRequest) GetNonceMonitoring() bool {
-	if x != nil {
-		return x.NonceMonitoring
-	}
-	return false
-}
+type PongReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *BlxrBatchTXRequest) GetNextValidator() bool {
-	if x != nil {
-		return x.NextValidator
-	}
-	return false
+	Pong string `protobuf:"bytes,1,opt,name=pong,proto3" json:"pong,omitempty"`
 }
 
-func (x *BlxrBatchTXRequest) GetFallback() int32 {
-	if x != nil {
-		return x.Fallback
+func (x *PongReply) Reset() {
+	*x = PongReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_gateway_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *BlxrBatchTXRequest) GetValidatorsOnly() bool {
-	if x != nil {
-		return x.ValidatorsOnly
-	}
-	return false
+func (x *PongReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BlxrBatchTXRequest) GetNodeValidation() bool {
-	if x != nil {
-		return x.NodeValidation
+func (*PongReply) ProtoMessage() {}
+
+func (x *PongReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *BlxrBatchTXRequest) GetSendingTime() int64 {
-	if x != nil {
-		return x.SendingTime
-	}
-	return 0
+// Deprecated: Use PongReply.ProtoReflect.Descriptor instead.
+func (*PongReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *BlxrBatchTXRequest) GetAuthHeader() string {
+func (x *PongReply) GetPong() string {
 	if x != nil {
-		return x.AuthHeader
+		return x.Pong
 	}
 	return ""
 }
 
-type BlxrTxRequest struct {
+type RegisterAbiRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transaction     string `protobuf:"bytes,1,opt,name=transaction,proto3" json:"transaction,omitempty"`
-	NonceMonitoring bool   `protobuf:"varint,2,opt,name=nonce_monitoring,json=nonceMonitoring,proto3" json:"nonce_monitoring,omitempty"`
-	NextValidator   bool   `protobuf:"varint,3,opt,name=next_validator,json=nextValidator,proto3" json:"next_validator,omitempty"`
-	AuthHeader      string `protobuf:"bytes,4,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
+	// JSON ABI of the contract
+	Abi        string `protobuf:"bytes,1,opt,name=abi,proto3" json:"abi,omitempty"`
+	AuthHeader string `protobuf:"bytes,2,opt,name=auth_header,json=authHeader,proto3" json:"auth_header,omitempty"`
 }
 
-func (x *BlxrTxRequest) Reset() {
-	*x = BlxrTxRequest{}
+func (x *RegisterAbiRequest) Reset() {
+	*x = RegisterAbiRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[38]
+		mi := &file_gateway_proto_msgTypes[65]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlxrTxRequest) String() string {
+func (x *RegisterAbiRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlxrTxRequest) ProtoMessage() {}
+func (*RegisterAbiRequest) ProtoMessage() {}
 
-func (x *BlxrTxRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[38]
+func (x *RegisterAbiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[65]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2735,64 +4834,50 @@ func (x *BlxrTxRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlxrTxRequest.ProtoReflect.Descriptor instead.
-func (*BlxrTxRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use RegisterAbiRequest.ProtoReflect.Descriptor instead.
+func (*RegisterAbiRequest) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *BlxrTxRequest) GetTransaction() string {
+func (x *RegisterAbiRequest) GetAbi() string {
 	if x != nil {
-		return x.Transaction
+		return x.Abi
 	}
 	return ""
 }
 
-func (x *BlxrTxRequest) GetNonceMonitoring() bool {
-	if x != nil {
-		return x.NonceMonitoring
-	}
-	return false
-}
-
-func (x *BlxrTxRequest) GetNextValidator() bool {
-	if x != nil {
-		return x.NextValidator
-	}
-	return false
-}
-
-func (x *BlxrTxRequest) GetAuthHeader() string {
+func (x *RegisterAbiRequest) GetAuthHeader() string {
 	if x != nil {
 		return x.AuthHeader
 	}
 	return ""
 }
 
-type BlxrTxReply struct {
+type RegisterAbiReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Methods []string `protobuf:"bytes,1,rep,name=methods,proto3" json:"methods,omitempty"`
 }
 
-func (x *BlxrTxReply) Reset() {
-	*x = BlxrTxReply{}
+func (x *RegisterAbiReply) Reset() {
+	*x = RegisterAbiReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[39]
+		mi := &file_gateway_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BlxrTxReply) String() string {
+func (x *RegisterAbiReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BlxrTxReply) ProtoMessage() {}
+func (*RegisterAbiReply) ProtoMessage() {}
 
-func (x *BlxrTxReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[39]
+func (x *RegisterAbiReply) ProtoReflect() protoreflect.Message {
+	mi := &file_gateway_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2803,16 +4888,16 @@ func (x *BlxrTxReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BlxrTxReply.ProtoReflect.Descriptor instead.
-func (*BlxrTxReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use RegisterAbiReply.ProtoReflect.Descriptor instead.
+func (*RegisterAbiReply) Descriptor() ([]byte, []int) {
+	return file_gateway_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *BlxrTxReply) GetTxHash() string {
+func (x *RegisterAbiReply) GetMethods() []string {
 	if x != nil {
-		return x.TxHash
+		return x.Methods
 	}
-	return ""
+	return nil
 }
 
 type TxIndex struct {
@@ -2827,7 +4912,7 @@ type TxIndex struct {
 func (x *TxIndex) Reset() {
 	*x = TxIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[40]
+		mi := &file_gateway_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2840,7 +4925,7 @@ func (x *TxIndex) String() string {
 func (*TxIndex) ProtoMessage() {}
 
 func (x *TxIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[40]
+	mi := &file_gateway_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2853,7 +4938,7 @@ func (x *TxIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxIndex.ProtoReflect.Descriptor instead.
 func (*TxIndex) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{40}
+	return file_gateway_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *TxIndex) GetIdx() int32 {
@@ -2882,7 +4967,7 @@ type ErrorIndex struct {
 func (x *ErrorIndex) Reset() {
 	*x = ErrorIndex{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[41]
+		mi := &file_gateway_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2895,7 +4980,7 @@ func (x *ErrorIndex) String() string {
 func (*ErrorIndex) ProtoMessage() {}
 
 func (x *ErrorIndex) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[41]
+	mi := &file_gateway_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2908,7 +4993,7 @@ func (x *ErrorIndex) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ErrorIndex.ProtoReflect.Descriptor instead.
 func (*ErrorIndex) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{41}
+	return file_gateway_proto_rawDescGZIP(), []int{68}
 }
 
 func (x *ErrorIndex) GetIdx() int32 {
@@ -2937,7 +5022,7 @@ type BlxrBatchTXReply struct {
 func (x *BlxrBatchTXReply) Reset() {
 	*x = BlxrBatchTXReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[42]
+		mi := &file_gateway_proto_msgTypes[69]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2950,7 +5035,7 @@ func (x *BlxrBatchTXReply) String() string {
 func (*BlxrBatchTXReply) ProtoMessage() {}
 
 func (x *BlxrBatchTXReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[42]
+	mi := &file_gateway_proto_msgTypes[69]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2963,7 +5048,7 @@ func (x *BlxrBatchTXReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BlxrBatchTXReply.ProtoReflect.Descriptor instead.
 func (*BlxrBatchTXReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{42}
+	return file_gateway_proto_rawDescGZIP(), []int{69}
 }
 
 func (x *BlxrBatchTXReply) GetTxHashes() []*TxIndex {
@@ -2991,7 +5076,7 @@ type StatusRequest struct {
 func (x *StatusRequest) Reset() {
 	*x = StatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[43]
+		mi := &file_gateway_proto_msgTypes[70]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3004,7 +5089,7 @@ func (x *StatusRequest) String() string {
 func (*StatusRequest) ProtoMessage() {}
 
 func (x *StatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[43]
+	mi := &file_gateway_proto_msgTypes[70]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3017,7 +5102,7 @@ func (x *StatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusRequest.ProtoReflect.Descriptor instead.
 func (*StatusRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{43}
+	return file_gateway_proto_rawDescGZIP(), []int{70}
 }
 
 func (x *StatusRequest) GetAuthHeader() string {
@@ -3039,7 +5124,7 @@ type AccountInfo struct {
 func (x *AccountInfo) Reset() {
 	*x = AccountInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[44]
+		mi := &file_gateway_proto_msgTypes[71]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3052,7 +5137,7 @@ func (x *AccountInfo) String() string {
 func (*AccountInfo) ProtoMessage() {}
 
 func (x *AccountInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[44]
+	mi := &file_gateway_proto_msgTypes[71]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3065,7 +5150,7 @@ func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
 func (*AccountInfo) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{44}
+	return file_gateway_proto_rawDescGZIP(), []int{71}
 }
 
 func (x *AccountInfo) GetAccountId() string {
@@ -3094,7 +5179,7 @@ type QueuesStats struct {
 func (x *QueuesStats) Reset() {
 	*x = QueuesStats{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[45]
+		mi := &file_gateway_proto_msgTypes[72]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3107,7 +5192,7 @@ func (x *QueuesStats) String() string {
 func (*QueuesStats) ProtoMessage() {}
 
 func (x *QueuesStats) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[45]
+	mi := &file_gateway_proto_msgTypes[72]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3120,7 +5205,7 @@ func (x *QueuesStats) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use QueuesStats.ProtoReflect.Descriptor instead.
 func (*QueuesStats) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{45}
+	return file_gateway_proto_rawDescGZIP(), []int{72}
 }
 
 func (x *QueuesStats) GetTxsQueueCount() uint64 {
@@ -3157,7 +5242,7 @@ type NodePerformance struct {
 func (x *NodePerformance) Reset() {
 	*x = NodePerformance{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[46]
+		mi := &file_gateway_proto_msgTypes[73]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3170,7 +5255,7 @@ func (x *NodePerformance) String() string {
 func (*NodePerformance) ProtoMessage() {}
 
 func (x *NodePerformance) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[46]
+	mi := &file_gateway_proto_msgTypes[73]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3183,7 +5268,7 @@ func (x *NodePerformance) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodePerformance.ProtoReflect.Descriptor instead.
 func (*NodePerformance) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{46}
+	return file_gateway_proto_rawDescGZIP(), []int{73}
 }
 
 func (x *NodePerformance) GetSince() string {
@@ -3269,7 +5354,7 @@ type WsConnStatus struct {
 func (x *WsConnStatus) Reset() {
 	*x = WsConnStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[47]
+		mi := &file_gateway_proto_msgTypes[74]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3282,7 +5367,7 @@ func (x *WsConnStatus) String() string {
 func (*WsConnStatus) ProtoMessage() {}
 
 func (x *WsConnStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[47]
+	mi := &file_gateway_proto_msgTypes[74]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3295,7 +5380,7 @@ func (x *WsConnStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WsConnStatus.ProtoReflect.Descriptor instead.
 func (*WsConnStatus) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{47}
+	return file_gateway_proto_rawDescGZIP(), []int{74}
 }
 
 func (x *WsConnStatus) GetAddr() string {
@@ -3339,7 +5424,7 @@ type NodeConnStatus struct {
 func (x *NodeConnStatus) Reset() {
 	*x = NodeConnStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[48]
+		mi := &file_gateway_proto_msgTypes[75]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3352,7 +5437,7 @@ func (x *NodeConnStatus) String() string {
 func (*NodeConnStatus) ProtoMessage() {}
 
 func (x *NodeConnStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[48]
+	mi := &file_gateway_proto_msgTypes[75]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3365,7 +5450,7 @@ func (x *NodeConnStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NodeConnStatus.ProtoReflect.Descriptor instead.
 func (*NodeConnStatus) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{48}
+	return file_gateway_proto_rawDescGZIP(), []int{75}
 }
 
 func (x *NodeConnStatus) GetConnStatus() string {
@@ -3444,7 +5529,7 @@ type BDNConnStatus struct {
 func (x *BDNConnStatus) Reset() {
 	*x = BDNConnStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[49]
+		mi := &file_gateway_proto_msgTypes[76]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3457,7 +5542,7 @@ func (x *BDNConnStatus) String() string {
 func (*BDNConnStatus) ProtoMessage() {}
 
 func (x *BDNConnStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[49]
+	mi := &file_gateway_proto_msgTypes[76]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3470,7 +5555,7 @@ func (x *BDNConnStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BDNConnStatus.ProtoReflect.Descriptor instead.
 func (*BDNConnStatus) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{49}
+	return file_gateway_proto_rawDescGZIP(), []int{76}
 }
 
 func (x *BDNConnStatus) GetStatus() string {
@@ -3508,7 +5593,7 @@ type ConnectionLatency struct {
 func (x *ConnectionLatency) Reset() {
 	*x = ConnectionLatency{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[50]
+		mi := &file_gateway_proto_msgTypes[77]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3521,7 +5606,7 @@ func (x *ConnectionLatency) String() string {
 func (*ConnectionLatency) ProtoMessage() {}
 
 func (x *ConnectionLatency) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[50]
+	mi := &file_gateway_proto_msgTypes[77]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3534,7 +5619,7 @@ func (x *ConnectionLatency) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnectionLatency.ProtoReflect.Descriptor instead.
 func (*ConnectionLatency) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{50}
+	return file_gateway_proto_rawDescGZIP(), []int{77}
 }
 
 func (x *ConnectionLatency) GetMinMsFromPeer() int64 {
@@ -3584,7 +5669,7 @@ type GatewayInfo struct {
 func (x *GatewayInfo) Reset() {
 	*x = GatewayInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[51]
+		mi := &file_gateway_proto_msgTypes[78]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3597,7 +5682,7 @@ func (x *GatewayInfo) String() string {
 func (*GatewayInfo) ProtoMessage() {}
 
 func (x *GatewayInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[51]
+	mi := &file_gateway_proto_msgTypes[78]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3610,7 +5695,7 @@ func (x *GatewayInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GatewayInfo.ProtoReflect.Descriptor instead.
 func (*GatewayInfo) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{51}
+	return file_gateway_proto_rawDescGZIP(), []int{78}
 }
 
 func (x *GatewayInfo) GetVersion() string {
@@ -3691,7 +5776,7 @@ type StatusResponse struct {
 func (x *StatusResponse) Reset() {
 	*x = StatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[52]
+		mi := &file_gateway_proto_msgTypes[79]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3704,7 +5789,7 @@ func (x *StatusResponse) String() string {
 func (*StatusResponse) ProtoMessage() {}
 
 func (x *StatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[52]
+	mi := &file_gateway_proto_msgTypes[79]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3717,7 +5802,7 @@ func (x *StatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StatusResponse.ProtoReflect.Descriptor instead.
 func (*StatusResponse) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{52}
+	return file_gateway_proto_rawDescGZIP(), []int{79}
 }
 
 func (x *StatusResponse) GetGatewayInfo() *GatewayInfo {
@@ -3770,7 +5855,7 @@ type TxResult struct {
 func (x *TxResult) Reset() {
 	*x = TxResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[53]
+		mi := &file_gateway_proto_msgTypes[80]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3783,7 +5868,7 @@ func (x *TxResult) String() string {
 func (*TxResult) ProtoMessage() {}
 
 func (x *TxResult) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[53]
+	mi := &file_gateway_proto_msgTypes[80]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3796,7 +5881,7 @@ func (x *TxResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxResult.ProtoReflect.Descriptor instead.
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{53}
+	return file_gateway_proto_rawDescGZIP(), []int{80}
 }
 
 func (x *TxResult) GetTxHash() string {
@@ -3846,7 +5931,7 @@ type TxHashListRequest struct {
 func (x *TxHashListRequest) Reset() {
 	*x = TxHashListRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[54]
+		mi := &file_gateway_proto_msgTypes[81]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3859,7 +5944,7 @@ func (x *TxHashListRequest) String() string {
 func (*TxHashListRequest) ProtoMessage() {}
 
 func (x *TxHashListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[54]
+	mi := &file_gateway_proto_msgTypes[81]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3872,7 +5957,7 @@ func (x *TxHashListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use TxHashListRequest.ProtoReflect.Descriptor instead.
 func (*TxHashListRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{54}
+	return file_gateway_proto_rawDescGZIP(), []int{81}
 }
 
 func (x *TxHashListRequest) GetAuthHeader() string {
@@ -3900,7 +5985,7 @@ type ShortIDListReply struct {
 func (x *ShortIDListReply) Reset() {
 	*x = ShortIDListReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[55]
+		mi := &file_gateway_proto_msgTypes[82]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3913,7 +5998,7 @@ func (x *ShortIDListReply) String() string {
 func (*ShortIDListReply) ProtoMessage() {}
 
 func (x *ShortIDListReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[55]
+	mi := &file_gateway_proto_msgTypes[82]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3926,7 +6011,7 @@ func (x *ShortIDListReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ShortIDListReply.ProtoReflect.Descriptor instead.
 func (*ShortIDListReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{55}
+	return file_gateway_proto_rawDescGZIP(), []int{82}
 }
 
 func (x *ShortIDListReply) GetShortIDs() []uint32 {
@@ -3955,7 +6040,7 @@ type ProposedBlockRequest struct {
 func (x *ProposedBlockRequest) Reset() {
 	*x = ProposedBlockRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[56]
+		mi := &file_gateway_proto_msgTypes[83]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3968,7 +6053,7 @@ func (x *ProposedBlockRequest) String() string {
 func (*ProposedBlockRequest) ProtoMessage() {}
 
 func (x *ProposedBlockRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[56]
+	mi := &file_gateway_proto_msgTypes[83]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3981,7 +6066,7 @@ func (x *ProposedBlockRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposedBlockRequest.ProtoReflect.Descriptor instead.
 func (*ProposedBlockRequest) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{56}
+	return file_gateway_proto_rawDescGZIP(), []int{83}
 }
 
 func (x *ProposedBlockRequest) GetAuthHeader() string {
@@ -4059,7 +6144,7 @@ type CompressTx struct {
 func (x *CompressTx) Reset() {
 	*x = CompressTx{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[57]
+		mi := &file_gateway_proto_msgTypes[84]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4072,7 +6157,7 @@ func (x *CompressTx) String() string {
 func (*CompressTx) ProtoMessage() {}
 
 func (x *CompressTx) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[57]
+	mi := &file_gateway_proto_msgTypes[84]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4085,7 +6170,7 @@ func (x *CompressTx) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CompressTx.ProtoReflect.Descriptor instead.
 func (*CompressTx) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{57}
+	return file_gateway_proto_rawDescGZIP(), []int{84}
 }
 
 func (x *CompressTx) GetRawData() []byte {
@@ -4114,7 +6199,7 @@ type ProposedBlockReply struct {
 func (x *ProposedBlockReply) Reset() {
 	*x = ProposedBlockReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_gateway_proto_msgTypes[58]
+		mi := &file_gateway_proto_msgTypes[85]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -4127,7 +6212,7 @@ func (x *ProposedBlockReply) String() string {
 func (*ProposedBlockReply) ProtoMessage() {}
 
 func (x *ProposedBlockReply) ProtoReflect() protoreflect.Message {
-	mi := &file_gateway_proto_msgTypes[58]
+	mi := &file_gateway_proto_msgTypes[85]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4140,7 +6225,7 @@ func (x *ProposedBlockReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProposedBlockReply.ProtoReflect.Descriptor instead.
 func (*ProposedBlockReply) Descriptor() ([]byte, []int) {
-	return file_gateway_proto_rawDescGZIP(), []int{58}
+	return file_gateway_proto_rawDescGZIP(), []int{85}
 }
 
 func (x *ProposedBlockReply) GetValidatorReply() string {
@@ -4163,7 +6248,7 @@ var file_gateway_proto_rawDesc = []byte{
 	0x0a, 0x0d, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
 	0x07, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9f, 0x02, 0x0a, 0x06, 0x54, 0x78,
+	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xed, 0x02, 0x0a, 0x06, 0x54, 0x78,
 	0x4c, 0x6f, 0x67, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x16,
 	0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
@@ -4181,168 +6266,415 @@ var file_gateway_proto_rawDesc = []byte{
 	0x48, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x6f, 0x67, 0x5f, 0x69, 0x6e, 0x64, 0x65,
 	0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x49, 0x6e, 0x64, 0x65,
 	0x78, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x22, 0x50, 0x0a, 0x11, 0x54,
-	0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x80, 0x04,
-	0x0a, 0x0f, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x4b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x4b, 0x48, 0x61, 0x73, 0x68,
-	0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e,
-	0x0a, 0x13, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x73,
-	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x6d,
-	0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x2c,
-	0x0a, 0x12, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f,
-	0x75, 0x73, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65,
-	0x63, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x66, 0x72, 0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d,
-	0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x6c,
-	0x6f, 0x67, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73,
-	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12,
-	0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61,
-	0x73, 0x68, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x78, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
-	0x22, 0x80, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12,
-	0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72, 0x61,
-	0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x86, 0x01, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x4f, 0x6e, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63,
-	0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70, 0x61,
-	0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x52,
-	0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x75, 0x0a, 0x0f,
-	0x45, 0x74, 0x68, 0x4f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68,
-	0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x74, 0x61, 0x67, 0x22, 0x63, 0x0a, 0x0a, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x12, 0x4c, 0x0a, 0x13, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0xaf, 0x01, 0x0a, 0x11, 0x54, 0x78,
+	0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0a, 0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x22, 0x0a,
+	0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x22, 0xea, 0x04, 0x0a, 0x0f,
+	0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x4b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x4b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21,
+	0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75,
+	0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x12,
+	0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73,
+	0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x69, 0x76, 0x65, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x6f, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x19,
+	0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x04, 0x6c, 0x6f, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x54, 0x78, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x04, 0x6c, 0x6f, 0x67, 0x73, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x78, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x78, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1a,
+	0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x73, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x23, 0x0a, 0x09, 0x4c, 0x6f, 0x67, 0x54,
+	0x6f, 0x70, 0x69, 0x63, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x22, 0xb8, 0x01,
+	0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x06, 0x74, 0x6f, 0x70, 0x69, 0x63,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x54, 0x6f, 0x70, 0x69, 0x63, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73,
+	0x73, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x18, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x11, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0xb5, 0x02, 0x0a, 0x16, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x21, 0x0a, 0x0c,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x4c, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x77, 0x0a,
+	0x14, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75,
+	0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72,
+	0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0x86, 0x02, 0x0a, 0x12, 0x4d, 0x65, 0x6d, 0x70, 0x6f,
+	0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x12, 0x1f,
+	0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63, 0x65, 0x64, 0x42, 0x79, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x4c, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x94, 0x01, 0x0a, 0x19, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a,
+	0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x12, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x19, 0x0a, 0x17, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f,
+	0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x80, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x37, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x1a, 0x39, 0x0a, 0x0b, 0x50, 0x61, 0x72,
+	0x61, 0x6d, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x3a, 0x02, 0x38, 0x01, 0x22, 0xaa, 0x01, 0x0a, 0x11, 0x45, 0x74, 0x68, 0x4f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e,
+	0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x34, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x70,
+	0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x52, 0x0a, 0x63, 0x61, 0x6c, 0x6c, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a,
+	0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72,
+	0x65, 0x22, 0xfe, 0x01, 0x0a, 0x0f, 0x45, 0x74, 0x68, 0x4f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x4c, 0x0a, 0x13, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x61, 0x74,
+	0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x22, 0xa8, 0x01, 0x0a, 0x0a, 0x54, 0x78, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69,
 	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69,
 	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f,
 	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75,
-	0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x66, 0x0a, 0x02, 0x54, 0x78, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x66, 0x72,
-	0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x67, 0x69,
-	0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52,
-	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x72, 0x61, 0x77,
-	0x5f, 0x74, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x72, 0x61, 0x77, 0x54, 0x78,
-	0x22, 0x4a, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65, 0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f,
-	0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x73, 0x22, 0x27, 0x0a, 0x08,
-	0x54, 0x78, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1b, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54,
-	0x78, 0x52, 0x02, 0x74, 0x78, 0x22, 0x4c, 0x0a, 0x0d, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64,
-	0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x22, 0xa7, 0x04, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61,
-	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74,
-	0x48, 0x61, 0x73, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x33, 0x5f, 0x75, 0x6e, 0x63,
-	0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x33, 0x55,
-	0x6e, 0x63, 0x6c, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
-	0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69,
-	0x70, 0x74, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x6c, 0x6f, 0x67, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x6c, 0x6f, 0x67, 0x73, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64,
-	0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e,
-	0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74,
-	0x12, 0x19, 0x0a, 0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74,
-	0x72, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65,
-	0x78, 0x74, 0x72, 0x61, 0x44, 0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x78, 0x5f,
-	0x68, 0x61, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x78, 0x48,
-	0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x62, 0x61, 0x73,
-	0x65, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47,
-	0x61, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c,
-	0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69,
-	0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x75, 0x0a,
-	0x13, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65,
-	0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63,
-	0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c,
-	0x65, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x69, 0x62, 0x6c, 0x65, 0x22, 0xfc, 0x01, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x2a, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c,
-	0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x46, 0x75, 0x74, 0x75,
-	0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x13, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65,
-	0x63, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69, 0x70, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12, 0x1b, 0x0a,
-	0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x75,
-	0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
-	0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x1a, 0x44, 0x69,
-	0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50,
-	0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73, 0x75,
+	0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x72,
+	0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63,
+	0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0xbe, 0x01,
+	0x0a, 0x02, 0x54, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x21, 0x0a, 0x0c, 0x6c, 0x6f, 0x63, 0x61,
+	0x6c, 0x5f, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12,
+	0x15, 0x0a, 0x06, 0x72, 0x61, 0x77, 0x5f, 0x74, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x05, 0x72, 0x61, 0x77, 0x54, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x5f, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x0c, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x58,
+	0x0a, 0x0c, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x61, 0x72, 0x67, 0x73, 0x22, 0x4a, 0x0a, 0x0b, 0x41, 0x63, 0x63, 0x65,
+	0x73, 0x73, 0x54, 0x75, 0x70, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6b, 0x65, 0x79,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x4b, 0x65, 0x79, 0x73, 0x22, 0x75, 0x0a, 0x08, 0x54, 0x78, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x1b, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x52, 0x02, 0x74, 0x78, 0x12, 0x4c, 0x0a,
+	0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x8c, 0x01, 0x0a, 0x0e,
+	0x57, 0x73, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54,
+	0x78, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x2c, 0x0a, 0x06,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x22, 0x9d, 0x02, 0x0a, 0x0a, 0x46,
+	0x65, 0x65, 0x64, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x66, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x65, 0x65,
+	0x64, 0x12, 0x23, 0x0a, 0x03, 0x74, 0x78, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x52, 0x03, 0x74, 0x78, 0x73, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x52, 0x06, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x12, 0x39, 0x0a, 0x0b, 0x74, 0x78, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x52, 0x0a, 0x74, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x12,
+	0x3f, 0x0a, 0x0d, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x52, 0x0c, 0x62, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73,
+	0x12, 0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x91, 0x01, 0x0a, 0x0d, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
+	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x73,
+	0x75, 0x6d, 0x65, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a,
+	0x72, 0x65, 0x73, 0x75, 0x6d, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61,
+	0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0xf3,
+	0x04, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1f,
+	0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x33, 0x5f, 0x75, 0x6e, 0x63, 0x6c, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x33, 0x55, 0x6e, 0x63, 0x6c, 0x65, 0x73,
+	0x12, 0x14, 0x0a, 0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f,
+	0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x10, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x6f,
+	0x6f, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x72, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x70, 0x74, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x67, 0x73, 0x5f,
+	0x62, 0x6c, 0x6f, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x67,
+	0x73, 0x42, 0x6c, 0x6f, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63,
+	0x75, 0x6c, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66,
+	0x69, 0x63, 0x75, 0x6c, 0x74, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x67,
+	0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x67,
+	0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x72, 0x61, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x78, 0x74, 0x72, 0x61, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x69, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x69, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
+	0x6f, 0x6e, 0x63, 0x65, 0x12, 0x27, 0x0a, 0x10, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x66, 0x65, 0x65,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x62, 0x61, 0x73, 0x65, 0x46, 0x65, 0x65, 0x50, 0x65, 0x72, 0x47, 0x61, 0x73, 0x12, 0x29, 0x0a,
+	0x10, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61, 0x77, 0x61, 0x6c, 0x73, 0x5f, 0x72, 0x6f, 0x6f,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x77, 0x69, 0x74, 0x68, 0x64, 0x72, 0x61,
+	0x77, 0x61, 0x6c, 0x73, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x62,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x47, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f,
+	0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x67, 0x61, 0x73, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x42, 0x6c, 0x6f,
+	0x62, 0x47, 0x61, 0x73, 0x22, 0x75, 0x0a, 0x13, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x21, 0x0a, 0x0c, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x1b,
+	0x0a, 0x09, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x61, 0x63, 0x63, 0x65, 0x73, 0x73, 0x69, 0x62, 0x6c, 0x65, 0x22, 0xe6, 0x02, 0x0a, 0x0b,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x2a, 0x0a, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x02, 0x18, 0x01, 0x52, 0x0e, 0x73, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x44, 0x12, 0x2c, 0x0a, 0x06, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61,
+	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x50, 0x0a, 0x15, 0x66, 0x75, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x69, 0x6e,
+	0x66, 0x6f, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x46, 0x75, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x13, 0x66, 0x75, 0x74, 0x75, 0x72, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2d, 0x0a, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x0b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x52, 0x0b, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x73, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x76, 0x0a, 0x13, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b,
+	0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0xab, 0x01, 0x0a,
+	0x11, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1f, 0x0a,
+	0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1b, 0x0a,
+	0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x22, 0x94, 0x02, 0x0a, 0x11, 0x42,
+	0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x4c, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x12, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x22, 0x76, 0x0a, 0x13, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x69, 0x6e, 0x63, 0x6c,
+	0x75, 0x64, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x61, 0x63,
+	0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x22, 0xf9, 0x02, 0x0a, 0x11, 0x42, 0x6c,
+	0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x63,
+	0x6b, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f,
+	0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x7a, 0x67, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x6b, 0x7a, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6b, 0x7a, 0x67, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x25, 0x0a, 0x0e,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48,
+	0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x4c, 0x0a, 0x13, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x12, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x94, 0x01, 0x0a, 0x1c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x50, 0x65, 0x65, 0x72, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x65, 0x65, 0x72, 0x49, 0x70, 0x12,
+	0x1b, 0x0a, 0x09, 0x70, 0x65, 0x65, 0x72, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x08, 0x70, 0x65, 0x65, 0x72, 0x50, 0x6f, 0x72, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
+	0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x34, 0x0a, 0x1a,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x49, 0x6e, 0x62, 0x6f, 0x75, 0x6e,
+	0x64, 0x50, 0x65, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x22, 0x5e, 0x0a, 0x12, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x27, 0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x22, 0x2c, 0x0a, 0x10, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
 	0x22, 0x37, 0x0a, 0x14, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
 	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x82, 0x02, 0x0a, 0x0c, 0x53, 0x75,
+	0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xe9, 0x02, 0x0a, 0x0c, 0x53, 0x75,
 	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
 	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x65,
@@ -4358,190 +6690,270 @@ var file_gateway_proto_rawDesc = []byte{
 	0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x67, 0x65, 0x18, 0x08,
 	0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x61, 0x67, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73,
 	0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x22, 0x51,
-	0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3b, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x22, 0x31, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65,
-	0x61, 0x64, 0x65, 0x72, 0x22, 0x47, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d,
-	0x0a, 0x0a, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x2e, 0x0a,
-	0x0b, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b,
-	0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x0b, 0x0a,
-	0x09, 0x53, 0x74, 0x6f, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x0c, 0x50, 0x65,
-	0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x27,
+	0x0a, 0x0f, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70,
+	0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62,
+	0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x64,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x64, 0x72,
+	0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x52, 0x0a, 0x12, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x62,
+	0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x62, 0x61, 0x63, 0x6b, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x07, 0x64, 0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x22, 0x51, 0x0a, 0x12, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x3b, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x31, 0x0a, 0x0e,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f,
+	0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22,
-	0x60, 0x0a, 0x0c, 0x52, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12,
-	0x1e, 0x0a, 0x0a, 0x66, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x03, 0x52, 0x0a, 0x66, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x6f, 0x6e, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x07, 0x6f, 0x6e, 0x65, 0x48, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x65,
-	0x44, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x6e, 0x65, 0x44, 0x61,
-	0x79, 0x22, 0xa2, 0x08, 0x0a, 0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f,
-	0x64, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64,
-	0x65, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x74, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x27, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x5f,
-	0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0d, 0x6d, 0x69, 0x6e, 0x4d, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x65, 0x65, 0x72, 0x12, 0x23,
-	0x0a, 0x0e, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x65, 0x65, 0x72,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4d, 0x73, 0x54, 0x6f, 0x50,
-	0x65, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x12, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x66,
-	0x66, 0x69, 0x63, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x10, 0x73, 0x6c, 0x6f, 0x77, 0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e,
-	0x64, 0x5f, 0x74, 0x72, 0x69, 0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x69,
-	0x6e, 0x4d, 0x73, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a,
-	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x12,
-	0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x6d, 0x65, 0x76, 0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x6d, 0x65, 0x76, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
-	0x65, 0x76, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x6d, 0x65, 0x76, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
-	0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x15,
-	0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f,
-	0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x75, 0x6e, 0x70,
-	0x61, 0x69, 0x64, 0x54, 0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12,
-	0x2d, 0x0a, 0x13, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74,
-	0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61,
-	0x69, 0x64, 0x54, 0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x55,
-	0x0a, 0x1c, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73,
-	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x18, 0x14,
+	0x47, 0x0a, 0x0c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x2e, 0x0a, 0x0b, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f,
+	0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75,
+	0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x0b, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x0c, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x60, 0x0a, 0x0c, 0x52, 0x61,
+	0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x69,
+	0x76, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x66, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x6e,
+	0x65, 0x48, 0x6f, 0x75, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x6e, 0x65,
+	0x48, 0x6f, 0x75, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x79, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6f, 0x6e, 0x65, 0x44, 0x61, 0x79, 0x22, 0xa2, 0x08, 0x0a,
+	0x04, 0x50, 0x65, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77,
+	0x6f, 0x72, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x27, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x70, 0x65, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x4d,
+	0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x65, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0e, 0x6d, 0x69, 0x6e,
+	0x5f, 0x6d, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x65, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x4d, 0x73, 0x54, 0x6f, 0x50, 0x65, 0x65, 0x72, 0x12, 0x2c,
+	0x0a, 0x12, 0x73, 0x6c, 0x6f, 0x77, 0x5f, 0x74, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x73, 0x6c, 0x6f, 0x77,
+	0x54, 0x72, 0x61, 0x66, 0x66, 0x69, 0x63, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x29, 0x0a, 0x11,
+	0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x74, 0x72, 0x69,
+	0x70, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x6d, 0x69, 0x6e, 0x4d, 0x73, 0x52, 0x6f,
+	0x75, 0x6e, 0x64, 0x54, 0x72, 0x69, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x74, 0x69, 0x65, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x64, 0x69, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x76,
+	0x5f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65,
+	0x76, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x65, 0x76, 0x5f, 0x62, 0x75,
+	0x69, 0x6c, 0x64, 0x65, 0x72, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x65, 0x76,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x61, 0x62,
+	0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x63, 0x61, 0x70,
+	0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x31, 0x0a, 0x15, 0x75, 0x6e, 0x70, 0x61, 0x69,
+	0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78,
+	0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x2d, 0x0a, 0x13, 0x70, 0x61,
+	0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x18, 0x13, 0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78, 0x42,
+	0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x55, 0x0a, 0x1c, 0x75, 0x6e, 0x70,
+	0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x18, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78,
+	0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x51, 0x0a, 0x1a, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x62, 0x75, 0x72, 0x73,
+	0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x63, 0x65, 0x73, 0x73, 0x18, 0x15,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x18, 0x75, 0x6e, 0x70,
-	0x61, 0x69, 0x64, 0x54, 0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45,
-	0x78, 0x63, 0x65, 0x73, 0x73, 0x12, 0x51, 0x0a, 0x1a, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78,
-	0x5f, 0x62, 0x75, 0x72, 0x73, 0x74, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x5f, 0x65, 0x78, 0x63,
-	0x65, 0x73, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
-	0x52, 0x16, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d,
-	0x69, 0x74, 0x45, 0x78, 0x63, 0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x61, 0x69, 0x64,
-	0x5f, 0x74, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x16,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52,
-	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x10, 0x70, 0x61, 0x69,
-	0x64, 0x54, 0x78, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x47, 0x0a,
-	0x14, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75,
-	0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68,
-	0x6f, 0x74, 0x52, 0x12, 0x75, 0x6e, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78, 0x54, 0x68, 0x72, 0x6f,
-	0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65,
-	0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
-	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x65, 0x76, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73,
-	0x18, 0x19, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x65, 0x76, 0x42, 0x75, 0x69, 0x6c, 0x64,
-	0x65, 0x72, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x1a,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6e, 0x65, 0x77, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x10,
-	0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x78, 0x73,
-	0x18, 0x1b, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0e, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53,
-	0x65, 0x65, 0x6e, 0x54, 0x78, 0x73, 0x22, 0x31, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x23, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x65,
-	0x65, 0x72, 0x52, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x65, 0x6e,
-	0x64, 0x54, 0x58, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e,
-	0x74, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x22, 0x48, 0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x38, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x77, 0x0a,
-	0x0d, 0x42, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61,
-	0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18,
-	0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12,
-	0x35, 0x0a, 0x08, 0x61, 0x64, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61,
-	0x64, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x78, 0x54,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x61, 0x73, 0x68, 0x22, 0x42, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x78, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x26, 0x0a, 0x02, 0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
-	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x02, 0x74, 0x78, 0x22, 0x31, 0x0a, 0x0e, 0x54, 0x78, 0x53,
-	0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61,
-	0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a,
-	0x12, 0x54, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44,
-	0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72,
-	0x74, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33,
-	0x0a, 0x09, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x78, 0x54, 0x72,
-	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x65, 0x73,
-	0x74, 0x54, 0x78, 0x22, 0x8f, 0x01, 0x0a, 0x0c, 0x54, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x24, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61,
-	0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x65, 0x74,
-	0x77, 0x6f, 0x72, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x44, 0x61, 0x74, 0x61, 0x22, 0x47, 0x0a, 0x0b, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x53, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22, 0xe8,
-	0x02, 0x0a, 0x12, 0x42, 0x6c, 0x78, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x58, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
-	0x79, 0x2e, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x16, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x65,
-	0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x6d,
-	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67,
-	0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
-	0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c, 0x62,
-	0x61, 0x63, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
-	0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x27, 0x0a, 0x0f,
-	0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65, 0x6e,
-	0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68,
-	0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61,
-	0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x42, 0x6c,
-	0x78, 0x72, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a,
-	0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
-	0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x4d, 0x6f,
-	0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74,
-	0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x04,
+	0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x16, 0x70, 0x61, 0x69,
+	0x64, 0x54, 0x78, 0x42, 0x75, 0x72, 0x73, 0x74, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x45, 0x78, 0x63,
+	0x65, 0x73, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x74,
+	0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x52, 0x61, 0x74, 0x65, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x10, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78, 0x54, 0x68,
+	0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74, 0x12, 0x47, 0x0a, 0x14, 0x75, 0x6e, 0x70, 0x61,
+	0x69, 0x64, 0x5f, 0x74, 0x78, 0x5f, 0x74, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75, 0x74,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x52, 0x61, 0x74, 0x65, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x52, 0x12, 0x75,
+	0x6e, 0x70, 0x61, 0x69, 0x64, 0x54, 0x78, 0x54, 0x68, 0x72, 0x6f, 0x75, 0x67, 0x68, 0x70, 0x75,
+	0x74, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d,
+	0x65, 0x76, 0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x19, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0b, 0x6d, 0x65, 0x76, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12, 0x17,
+	0x0a, 0x07, 0x6e, 0x65, 0x77, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x6e, 0x65, 0x77, 0x54, 0x78, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x61, 0x6c, 0x72, 0x65, 0x61,
+	0x64, 0x79, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f, 0x74, 0x78, 0x73, 0x18, 0x1b, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0e, 0x61, 0x6c, 0x72, 0x65, 0x61, 0x64, 0x79, 0x53, 0x65, 0x65, 0x6e, 0x54, 0x78,
+	0x73, 0x22, 0x31, 0x0a, 0x0a, 0x50, 0x65, 0x65, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
+	0x23, 0x0a, 0x05, 0x70, 0x65, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x65, 0x65, 0x72, 0x52, 0x05, 0x70,
+	0x65, 0x65, 0x72, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x54, 0x58, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x27, 0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x22, 0x48,
+	0x0a, 0x0c, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x38,
+	0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x77, 0x0a, 0x0d, 0x42, 0x78, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x04,
+	0x52, 0x08, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x73, 0x12, 0x35, 0x0a, 0x08, 0x61, 0x64,
+	0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x61, 0x64, 0x64, 0x54, 0x69, 0x6d,
+	0x65, 0x22, 0x2d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68,
+	0x22, 0x42, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x42, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x26, 0x0a, 0x02,
+	0x74, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x42, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x02, 0x74, 0x78, 0x22, 0x31, 0x0a, 0x0e, 0x54, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74,
+	0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xe7, 0x01, 0x0a, 0x12, 0x54, 0x78, 0x53, 0x74,
+	0x6f, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x12, 0x18,
+	0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x68, 0x6f,
+	0x72, 0x74, 0x49, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x09, 0x6f, 0x6c, 0x64,
+	0x65, 0x73, 0x74, 0x5f, 0x74, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x78, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x6f, 0x6c, 0x64, 0x65, 0x73, 0x74, 0x54, 0x78, 0x12, 0x22,
+	0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x62, 0x54, 0x78, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x5f, 0x73, 0x69, 0x7a, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x53, 0x69, 0x7a,
+	0x65, 0x22, 0xd2, 0x01, 0x0a, 0x0c, 0x54, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x74, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a,
+	0x0e, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x73, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x4e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0b, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x22, 0x0a, 0x0d, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x74, 0x78, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x62,
+	0x54, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x73,
+	0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x6c, 0x6f,
+	0x62, 0x73, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x47, 0x0a, 0x0b, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x22,
+	0xe8, 0x02, 0x0a, 0x12, 0x42, 0x6c, 0x78, 0x72, 0x42, 0x61, 0x74, 0x63, 0x68, 0x54, 0x58, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4e, 0x0a, 0x18, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x54, 0x78, 0x41, 0x6e, 0x64, 0x53, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x52, 0x16,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x6e, 0x64, 0x53,
+	0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e,
+	0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+	0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x66, 0x61, 0x6c, 0x6c,
+	0x62, 0x61, 0x63, 0x6b, 0x12, 0x27, 0x0a, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f,
+	0x72, 0x73, 0x5f, 0x6f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x4f, 0x6e, 0x6c, 0x79, 0x12, 0x27, 0x0a,
+	0x0f, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6e, 0x6f, 0x64, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x64, 0x69, 0x6e,
+	0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x73, 0x65,
+	0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0xa4, 0x01, 0x0a, 0x0d, 0x42,
+	0x6c, 0x78, 0x72, 0x54, 0x78, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29,
+	0x0a, 0x10, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x5f, 0x6d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69,
+	0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72,
+	0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x22, 0x26, 0x0a, 0x0b, 0x42, 0x6c, 0x78, 0x72, 0x54, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x8e, 0x04, 0x0a, 0x17, 0x42, 0x6c,
+	0x78, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x72, 0x61,
+	0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c, 0x6f,
+	0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x0d,
+	0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x69, 0x6e, 0x67, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x69, 0x6e, 0x67, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x75, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x75, 0x75, 0x69, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x66, 0x72, 0x6f, 0x6e, 0x74, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x66, 0x72, 0x6f,
+	0x6e, 0x74, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x54, 0x0a, 0x0c, 0x6d, 0x65, 0x76,
+	0x5f, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x31, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x78, 0x72, 0x53, 0x75,
+	0x62, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x2e, 0x4d, 0x65, 0x76, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x0b, 0x6d, 0x65, 0x76, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x21, 0x0a, 0x0c, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x50, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x5f, 0x70, 0x61,
+	0x79, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x6e, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x50, 0x61, 0x79, 0x6f, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x1a, 0x3e, 0x0a, 0x10, 0x4d, 0x65,
+	0x76, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
+	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x38, 0x0a, 0x15, 0x42, 0x6c,
+	0x78, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x34, 0x0a, 0x11, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x74, 0x0a, 0x0f, 0x51, 0x75,
+	0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1d, 0x0a,
+	0x0a, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x66, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x46, 0x69, 0x6c, 0x6c, 0x65, 0x64, 0x12,
+	0x1f, 0x0a, 0x0b, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x4c, 0x69, 0x6d, 0x69, 0x74,
+	0x22, 0x2e, 0x0a, 0x0b, 0x50, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74, 0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
-	0x22, 0x26, 0x0a, 0x0b, 0x42, 0x6c, 0x78, 0x72, 0x54, 0x78, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12,
-	0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x34, 0x0a, 0x07, 0x54, 0x78, 0x49, 0x6e,
+	0x22, 0x1f, 0x0a, 0x09, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x6f, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6e,
+	0x67, 0x22, 0x47, 0x0a, 0x12, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x62, 0x69,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x75, 0x74,
+	0x68, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x75, 0x74, 0x68, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x10, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x62, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x18,
+	0x0a, 0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x22, 0x34, 0x0a, 0x07, 0x54, 0x78, 0x49, 0x6e,
 	0x64, 0x65, 0x78, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05,
 	0x52, 0x03, 0x69, 0x64, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x34,
@@ -4756,7 +7168,7 @@ var file_gateway_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x69,
 	0x64, 0x61, 0x74, 0x6f, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xcd, 0x09, 0x0a, 0x07, 0x47, 0x61, 0x74,
+	0x65, 0x70, 0x6c, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x32, 0xc7, 0x11, 0x0a, 0x07, 0x47, 0x61, 0x74,
 	0x65, 0x77, 0x61, 0x79, 0x12, 0x38, 0x0a, 0x06, 0x42, 0x6c, 0x78, 0x72, 0x54, 0x78, 0x12, 0x16,
 	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x78, 0x72, 0x54, 0x78, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
@@ -4824,21 +7236,83 @@ var file_gateway_proto_rawDesc = []byte{
 	0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
 	0x2e, 0x54, 0x78, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x73,
-	0x12, 0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x48, 0x61, 0x73,
-	0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67,
-	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49, 0x44, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
-	0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f,
-	0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63,
-	0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x42, 0x5a, 0x40, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x62, 0x6c, 0x6f, 0x58, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x2d, 0x4c, 0x61, 0x62, 0x73, 0x2f, 0x62, 0x78, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2d,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2d, 0x67, 0x6f, 0x2f, 0x62, 0x78, 0x67, 0x61, 0x74,
-	0x65, 0x77, 0x61, 0x79, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x31, 0x0a, 0x04, 0x4c, 0x6f, 0x67, 0x73, 0x12, 0x14, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4c, 0x6f, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78, 0x4c,
+	0x6f, 0x67, 0x73, 0x22, 0x00, 0x30, 0x01, 0x12, 0x43, 0x0a, 0x08, 0x53, 0x68, 0x6f, 0x72, 0x74,
+	0x49, 0x44, 0x73, 0x12, 0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x19, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x53, 0x68, 0x6f, 0x72, 0x74, 0x49,
+	0x44, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4d, 0x0a, 0x0d,
+	0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1d, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x64, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x11, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x12, 0x21, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x70,
+	0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1d, 0x2e, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x4d, 0x65, 0x6d, 0x70, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x61, 0x0a, 0x17, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x4d,
+	0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x60, 0x0a, 0x16,
+	0x53, 0x74, 0x6f, 0x70, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x4d, 0x6f, 0x6e, 0x69, 0x74, 0x6f, 0x72, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f,
+	0x0a, 0x0f, 0x4e, 0x65, 0x77, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x65, 0x61, 0x63,
+	0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12,
+	0x4f, 0x0a, 0x0f, 0x42, 0x64, 0x6e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x73, 0x12, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x65, 0x61,
+	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01,
+	0x12, 0x4c, 0x0a, 0x0c, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73,
+	0x12, 0x1c, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x53,
+	0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
+	0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x30, 0x01, 0x12, 0x56,
+	0x0a, 0x10, 0x42, 0x6c, 0x78, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x20, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42, 0x6c, 0x78,
+	0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x42,
+	0x6c, 0x78, 0x72, 0x53, 0x75, 0x62, 0x6d, 0x69, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x44, 0x0a, 0x0a, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1a, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x51,
+	0x75, 0x6f, 0x74, 0x61, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x18, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x51, 0x75, 0x6f, 0x74, 0x61,
+	0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x32, 0x0a, 0x04,
+	0x50, 0x69, 0x6e, 0x67, 0x12, 0x14, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x50,
+	0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x2e, 0x50, 0x6f, 0x6e, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x47, 0x0a, 0x0b, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12,
+	0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67,
+	0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e, 0x55, 0x6e, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x47, 0x0a, 0x0b, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x62, 0x69, 0x12, 0x1b, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x62, 0x69, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2e,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x41, 0x62, 0x69, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x42, 0x2b, 0x5a, 0x29, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x62, 0x6c, 0x6f, 0x58, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2d, 0x4c, 0x61, 0x62, 0x73, 0x2f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -4853,146 +7327,219 @@ func file_gateway_proto_rawDescGZIP() []byte {
 	return file_gateway_proto_rawDescData
 }
 
-var file_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_gateway_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_gateway_proto_goTypes = []interface{}{
 	(*TxLogs)(nil),                       // 0: gateway.TxLogs
 	(*TxReceiptsRequest)(nil),            // 1: gateway.TxReceiptsRequest
 	(*TxReceiptsReply)(nil),              // 2: gateway.TxReceiptsReply
-	(*CallParams)(nil),                   // 3: gateway.CallParams
-	(*EthOnBlockRequest)(nil),            // 4: gateway.EthOnBlockRequest
-	(*EthOnBlockReply)(nil),              // 5: gateway.EthOnBlockReply
-	(*TxsRequest)(nil),                   // 6: gateway.TxsRequest
-	(*Tx)(nil),                           // 7: gateway.Tx
-	(*AccessTuple)(nil),                  // 8: gateway.AccessTuple
-	(*TxsReply)(nil),                     // 9: gateway.TxsReply
-	(*BlocksRequest)(nil),                // 10: gateway.BlocksRequest
-	(*BlockHeader)(nil),                  // 11: gateway.BlockHeader
-	(*FutureValidatorInfo)(nil),          // 12: gateway.FutureValidatorInfo
-	(*BlocksReply)(nil),                  // 13: gateway.BlocksReply
-	(*DisconnectInboundPeerRequest)(nil), // 14: gateway.DisconnectInboundPeerRequest
-	(*DisconnectInboundPeerReply)(nil),   // 15: gateway.DisconnectInboundPeerReply
-	(*SubscriptionsRequest)(nil),         // 16: gateway.SubscriptionsRequest
-	(*Subscription)(nil),                 // 17: gateway.Subscription
-	(*SubscriptionsReply)(nil),           // 18: gateway.SubscriptionsReply
-	(*VersionRequest)(nil),               // 19: gateway.VersionRequest
-	(*VersionReply)(nil),                 // 20: gateway.VersionReply
-	(*StopRequest)(nil),                  // 21: gateway.StopRequest
-	(*StopReply)(nil),                    // 22: gateway.StopReply
-	(*PeersRequest)(nil),                 // 23: gateway.PeersRequest
-	(*RateSnapshot)(nil),                 // 24: gateway.RateSnapshot
-	(*Peer)(nil),                         // 25: gateway.Peer
-	(*PeersReply)(nil),                   // 26: gateway.PeersReply
-	(*SendTXRequest)(nil),                // 27: gateway.SendTXRequest
-	(*Transaction)(nil),                  // 28: gateway.Transaction
-	(*Transactions)(nil),                 // 29: gateway.Transactions
-	(*BxTransaction)(nil),                // 30: gateway.BxTransaction
-	(*GetBxTransactionRequest)(nil),      // 31: gateway.GetBxTransactionRequest
-	(*GetBxTransactionResponse)(nil),     // 32: gateway.GetBxTransactionResponse
-	(*TxStoreRequest)(nil),               // 33: gateway.TxStoreRequest
-	(*TxStoreNetworkData)(nil),           // 34: gateway.TxStoreNetworkData
-	(*TxStoreReply)(nil),                 // 35: gateway.TxStoreReply
-	(*TxAndSender)(nil),                  // 36: gateway.TxAndSender
-	(*BlxrBatchTXRequest)(nil),           // 37: gateway.BlxrBatchTXRequest
-	(*BlxrTxRequest)(nil),                // 38: gateway.BlxrTxRequest
-	(*BlxrTxReply)(nil),                  // 39: gateway.BlxrTxReply
-	(*TxIndex)(nil),                      // 40: gateway.TxIndex
-	(*ErrorIndex)(nil),                   // 41: gateway.ErrorIndex
-	(*BlxrBatchTXReply)(nil),             // 42: gateway.BlxrBatchTXReply
-	(*StatusRequest)(nil),                // 43: gateway.StatusRequest
-	(*AccountInfo)(nil),                  // 44: gateway.AccountInfo
-	(*QueuesStats)(nil),                  // 45: gateway.QueuesStats
-	(*NodePerformance)(nil),              // 46: gateway.NodePerformance
-	(*WsConnStatus)(nil),                 // 47: gateway.WsConnStatus
-	(*NodeConnStatus)(nil),               // 48: gateway.NodeConnStatus
-	(*BDNConnStatus)(nil),                // 49: gateway.BDNConnStatus
-	(*ConnectionLatency)(nil),            // 50: gateway.ConnectionLatency
-	(*GatewayInfo)(nil),                  // 51: gateway.GatewayInfo
-	(*StatusResponse)(nil),               // 52: gateway.StatusResponse
-	(*TxResult)(nil),                     // 53: gateway.TxResult
-	(*TxHashListRequest)(nil),            // 54: gateway.TxHashListRequest
-	(*ShortIDListReply)(nil),             // 55: gateway.ShortIDListReply
-	(*ProposedBlockRequest)(nil),         // 56: gateway.ProposedBlockRequest
-	(*CompressTx)(nil),                   // 57: gateway.compressTx
-	(*ProposedBlockReply)(nil),           // 58: gateway.ProposedBlockReply
-	nil,                                  // 59: gateway.CallParams.ParamsEntry
-	nil,                                  // 60: gateway.StatusResponse.NodesEntry
-	nil,                                  // 61: gateway.StatusResponse.RelaysEntry
-	(*timestamppb.Timestamp)(nil),        // 62: google.protobuf.Timestamp
+	(*LogTopics)(nil),                    // 3: gateway.LogTopics
+	(*LogsRequest)(nil),                  // 4: gateway.LogsRequest
+	(*TransactionStatusRequest)(nil),     // 5: gateway.TransactionStatusRequest
+	(*TransactionStatusReply)(nil),       // 6: gateway.TransactionStatusReply
+	(*MempoolEventsRequest)(nil),         // 7: gateway.MempoolEventsRequest
+	(*MempoolEventsReply)(nil),           // 8: gateway.MempoolEventsReply
+	(*MonitorTransactionRequest)(nil),    // 9: gateway.MonitorTransactionRequest
+	(*MonitorTransactionReply)(nil),      // 10: gateway.MonitorTransactionReply
+	(*CallParams)(nil),                   // 11: gateway.CallParams
+	(*EthOnBlockRequest)(nil),            // 12: gateway.EthOnBlockRequest
+	(*EthOnBlockReply)(nil),              // 13: gateway.EthOnBlockReply
+	(*TxsRequest)(nil),                   // 14: gateway.TxsRequest
+	(*Tx)(nil),                           // 15: gateway.Tx
+	(*DecodedInput)(nil),                 // 16: gateway.DecodedInput
+	(*AccessTuple)(nil),                  // 17: gateway.AccessTuple
+	(*TxsReply)(nil),                     // 18: gateway.TxsReply
+	(*WsNotification)(nil),               // 19: gateway.WsNotification
+	(*FeedRecord)(nil),                   // 20: gateway.FeedRecord
+	(*BlocksRequest)(nil),                // 21: gateway.BlocksRequest
+	(*BlockHeader)(nil),                  // 22: gateway.BlockHeader
+	(*FutureValidatorInfo)(nil),          // 23: gateway.FutureValidatorInfo
+	(*BlocksReply)(nil),                  // 24: gateway.BlocksReply
+	(*BeaconBlocksRequest)(nil),          // 25: gateway.BeaconBlocksRequest
+	(*BeaconBlockHeader)(nil),            // 26: gateway.BeaconBlockHeader
+	(*BeaconBlocksReply)(nil),            // 27: gateway.BeaconBlocksReply
+	(*BlobSidecarsRequest)(nil),          // 28: gateway.BlobSidecarsRequest
+	(*BlobSidecarsReply)(nil),            // 29: gateway.BlobSidecarsReply
+	(*DisconnectInboundPeerRequest)(nil), // 30: gateway.DisconnectInboundPeerRequest
+	(*DisconnectInboundPeerReply)(nil),   // 31: gateway.DisconnectInboundPeerReply
+	(*UnsubscribeRequest)(nil),           // 32: gateway.UnsubscribeRequest
+	(*UnsubscribeReply)(nil),             // 33: gateway.UnsubscribeReply
+	(*SubscriptionsRequest)(nil),         // 34: gateway.SubscriptionsRequest
+	(*Subscription)(nil),                 // 35: gateway.Subscription
+	(*SubscriptionStatus)(nil),           // 36: gateway.SubscriptionStatus
+	(*SubscriptionsReply)(nil),           // 37: gateway.SubscriptionsReply
+	(*VersionRequest)(nil),               // 38: gateway.VersionRequest
+	(*VersionReply)(nil),                 // 39: gateway.VersionReply
+	(*StopRequest)(nil),                  // 40: gateway.StopRequest
+	(*StopReply)(nil),                    // 41: gateway.StopReply
+	(*PeersRequest)(nil),                 // 42: gateway.PeersRequest
+	(*RateSnapshot)(nil),                 // 43: gateway.RateSnapshot
+	(*Peer)(nil),                         // 44: gateway.Peer
+	(*PeersReply)(nil),                   // 45: gateway.PeersReply
+	(*SendTXRequest)(nil),                // 46: gateway.SendTXRequest
+	(*Transaction)(nil),                  // 47: gateway.Transaction
+	(*Transactions)(nil),                 // 48: gateway.Transactions
+	(*BxTransaction)(nil),                // 49: gateway.BxTransaction
+	(*GetBxTransactionRequest)(nil),      // 50: gateway.GetBxTransactionRequest
+	(*GetBxTransactionResponse)(nil),     // 51: gateway.GetBxTransactionResponse
+	(*TxStoreRequest)(nil),               // 52: gateway.TxStoreRequest
+	(*TxStoreNetworkData)(nil),           // 53: gateway.TxStoreNetworkData
+	(*TxStoreReply)(nil),                 // 54: gateway.TxStoreReply
+	(*TxAndSender)(nil),                  // 55: gateway.TxAndSender
+	(*BlxrBatchTXRequest)(nil),           // 56: gateway.BlxrBatchTXRequest
+	(*BlxrTxRequest)(nil),                // 57: gateway.BlxrTxRequest
+	(*BlxrTxReply)(nil),                  // 58: gateway.BlxrTxReply
+	(*BlxrSubmitBundleRequest)(nil),      // 59: gateway.BlxrSubmitBundleRequest
+	(*BlxrSubmitBundleReply)(nil),        // 60: gateway.BlxrSubmitBundleReply
+	(*QuotaUsageRequest)(nil),            // 61: gateway.QuotaUsageRequest
+	(*QuotaUsageReply)(nil),              // 62: gateway.QuotaUsageReply
+	(*PingRequest)(nil),                  // 63: gateway.PingRequest
+	(*PongReply)(nil),                    // 64: gateway.PongReply
+	(*RegisterAbiRequest)(nil),           // 65: gateway.RegisterAbiRequest
+	(*RegisterAbiReply)(nil),             // 66: gateway.RegisterAbiReply
+	(*TxIndex)(nil),                      // 67: gateway.TxIndex
+	(*ErrorIndex)(nil),                   // 68: gateway.ErrorIndex
+	(*BlxrBatchTXReply)(nil),             // 69: gateway.BlxrBatchTXReply
+	(*StatusRequest)(nil),                // 70: gateway.StatusRequest
+	(*AccountInfo)(nil),                  // 71: gateway.AccountInfo
+	(*QueuesStats)(nil),                  // 72: gateway.QueuesStats
+	(*NodePerformance)(nil),              // 73: gateway.NodePerformance
+	(*WsConnStatus)(nil),                 // 74: gateway.WsConnStatus
+	(*NodeConnStatus)(nil),               // 75: gateway.NodeConnStatus
+	(*BDNConnStatus)(nil),                // 76: gateway.BDNConnStatus
+	(*ConnectionLatency)(nil),            // 77: gateway.ConnectionLatency
+	(*GatewayInfo)(nil),                  // 78: gateway.GatewayInfo
+	(*StatusResponse)(nil),               // 79: gateway.StatusResponse
+	(*TxResult)(nil),                     // 80: gateway.TxResult
+	(*TxHashListRequest)(nil),            // 81: gateway.TxHashListRequest
+	(*ShortIDListReply)(nil),             // 82: gateway.ShortIDListReply
+	(*ProposedBlockRequest)(nil),         // 83: gateway.ProposedBlockRequest
+	(*CompressTx)(nil),                   // 84: gateway.compressTx
+	(*ProposedBlockReply)(nil),           // 85: gateway.ProposedBlockReply
+	nil,                                  // 86: gateway.CallParams.ParamsEntry
+	nil,                                  // 87: gateway.BlxrSubmitBundleRequest.MevBuildersEntry
+	nil,                                  // 88: gateway.StatusResponse.NodesEntry
+	nil,                                  // 89: gateway.StatusResponse.RelaysEntry
+	(*timestamppb.Timestamp)(nil),        // 90: google.protobuf.Timestamp
 }
 var file_gateway_proto_depIdxs = []int32{
-	0,  // 0: gateway.TxReceiptsReply.logs:type_name -> gateway.TxLogs
-	59, // 1: gateway.CallParams.params:type_name -> gateway.CallParams.ParamsEntry
-	3,  // 2: gateway.EthOnBlockRequest.call_params:type_name -> gateway.CallParams
-	7,  // 3: gateway.TxsReply.tx:type_name -> gateway.Tx
-	11, // 4: gateway.BlocksReply.header:type_name -> gateway.BlockHeader
-	12, // 5: gateway.BlocksReply.future_validator_info:type_name -> gateway.FutureValidatorInfo
-	7,  // 6: gateway.BlocksReply.transaction:type_name -> gateway.Tx
-	17, // 7: gateway.SubscriptionsReply.subscriptions:type_name -> gateway.Subscription
-	24, // 8: gateway.Peer.unpaid_tx_burst_limit_excess:type_name -> gateway.RateSnapshot
-	24, // 9: gateway.Peer.paid_tx_burst_limit_excess:type_name -> gateway.RateSnapshot
-	24, // 10: gateway.Peer.paid_tx_throughput:type_name -> gateway.RateSnapshot
-	24, // 11: gateway.Peer.unpaid_tx_throughput:type_name -> gateway.RateSnapshot
-	25, // 12: gateway.PeersReply.peers:type_name -> gateway.Peer
-	28, // 13: gateway.Transactions.transactions:type_name -> gateway.Transaction
-	62, // 14: gateway.BxTransaction.add_time:type_name -> google.protobuf.Timestamp
-	30, // 15: gateway.GetBxTransactionResponse.tx:type_name -> gateway.BxTransaction
-	30, // 16: gateway.TxStoreNetworkData.oldest_tx:type_name -> gateway.BxTransaction
-	34, // 17: gateway.TxStoreReply.network_data:type_name -> gateway.TxStoreNetworkData
-	36, // 18: gateway.BlxrBatchTXRequest.transactions_and_senders:type_name -> gateway.TxAndSender
-	40, // 19: gateway.BlxrBatchTXReply.tx_hashes:type_name -> gateway.TxIndex
-	41, // 20: gateway.BlxrBatchTXReply.tx_errors:type_name -> gateway.ErrorIndex
-	47, // 21: gateway.NodeConnStatus.ws_connection:type_name -> gateway.WsConnStatus
-	46, // 22: gateway.NodeConnStatus.node_performance:type_name -> gateway.NodePerformance
-	50, // 23: gateway.BDNConnStatus.latency:type_name -> gateway.ConnectionLatency
-	51, // 24: gateway.StatusResponse.gateway_info:type_name -> gateway.GatewayInfo
-	60, // 25: gateway.StatusResponse.nodes:type_name -> gateway.StatusResponse.NodesEntry
-	61, // 26: gateway.StatusResponse.relays:type_name -> gateway.StatusResponse.RelaysEntry
-	44, // 27: gateway.StatusResponse.account_info:type_name -> gateway.AccountInfo
-	45, // 28: gateway.StatusResponse.queue_stats:type_name -> gateway.QueuesStats
-	57, // 29: gateway.ProposedBlockRequest.payload:type_name -> gateway.compressTx
-	48, // 30: gateway.StatusResponse.NodesEntry.value:type_name -> gateway.NodeConnStatus
-	49, // 31: gateway.StatusResponse.RelaysEntry.value:type_name -> gateway.BDNConnStatus
-	38, // 32: gateway.Gateway.BlxrTx:input_type -> gateway.BlxrTxRequest
-	37, // 33: gateway.Gateway.BlxrBatchTX:input_type -> gateway.BlxrBatchTXRequest
-	23, // 34: gateway.Gateway.Peers:input_type -> gateway.PeersRequest
-	33, // 35: gateway.Gateway.TxStoreSummary:input_type -> gateway.TxStoreRequest
-	31, // 36: gateway.Gateway.GetTx:input_type -> gateway.GetBxTransactionRequest
-	21, // 37: gateway.Gateway.Stop:input_type -> gateway.StopRequest
-	19, // 38: gateway.Gateway.Version:input_type -> gateway.VersionRequest
-	43, // 39: gateway.Gateway.Status:input_type -> gateway.StatusRequest
-	16, // 40: gateway.Gateway.Subscriptions:input_type -> gateway.SubscriptionsRequest
-	14, // 41: gateway.Gateway.DisconnectInboundPeer:input_type -> gateway.DisconnectInboundPeerRequest
-	6,  // 42: gateway.Gateway.NewTxs:input_type -> gateway.TxsRequest
-	6,  // 43: gateway.Gateway.PendingTxs:input_type -> gateway.TxsRequest
-	10, // 44: gateway.Gateway.NewBlocks:input_type -> gateway.BlocksRequest
-	10, // 45: gateway.Gateway.BdnBlocks:input_type -> gateway.BlocksRequest
-	4,  // 46: gateway.Gateway.EthOnBlock:input_type -> gateway.EthOnBlockRequest
-	1,  // 47: gateway.Gateway.TxReceipts:input_type -> gateway.TxReceiptsRequest
-	54, // 48: gateway.Gateway.ShortIDs:input_type -> gateway.TxHashListRequest
-	56, // 49: gateway.Gateway.ProposedBlock:input_type -> gateway.ProposedBlockRequest
-	39, // 50: gateway.Gateway.BlxrTx:output_type -> gateway.BlxrTxReply
-	42, // 51: gateway.Gateway.BlxrBatchTX:output_type -> gateway.BlxrBatchTXReply
-	26, // 52: gateway.Gateway.Peers:output_type -> gateway.PeersReply
-	35, // 53: gateway.Gateway.TxStoreSummary:output_type -> gateway.TxStoreReply
-	32, // 54: gateway.Gateway.GetTx:output_type -> gateway.GetBxTransactionResponse
-	22, // 55: gateway.Gateway.Stop:output_type -> gateway.StopReply
-	20, // 56: gateway.Gateway.Version:output_type -> gateway.VersionReply
-	52, // 57: gateway.Gateway.Status:output_type -> gateway.StatusResponse
-	18, // 58: gateway.Gateway.Subscriptions:output_type -> gateway.SubscriptionsReply
-	15, // 59: gateway.Gateway.DisconnectInboundPeer:output_type -> gateway.DisconnectInboundPeerReply
-	9,  // 60: gateway.Gateway.NewTxs:output_type -> gateway.TxsReply
-	9,  // 61: gateway.Gateway.PendingTxs:output_type -> gateway.TxsReply
-	13, // 62: gateway.Gateway.NewBlocks:output_type -> gateway.BlocksReply
-	13, // 63: gateway.Gateway.BdnBlocks:output_type -> gateway.BlocksReply
-	5,  // 64: gateway.Gateway.EthOnBlock:output_type -> gateway.EthOnBlockReply
-	2,  // 65: gateway.Gateway.TxReceipts:output_type -> gateway.TxReceiptsReply
-	55, // 66: gateway.Gateway.ShortIDs:output_type -> gateway.ShortIDListReply
-	58, // 67: gateway.Gateway.ProposedBlock:output_type -> gateway.ProposedBlockReply
-	50, // [50:68] is the sub-list for method output_type
-	32, // [32:50] is the sub-list for method input_type
-	32, // [32:32] is the sub-list for extension type_name
-	32, // [32:32] is the sub-list for extension extendee
-	0,  // [0:32] is the sub-list for field type_name
+	36, // 0: gateway.TxLogs.subscription_status:type_name -> gateway.SubscriptionStatus
+	0,  // 1: gateway.TxReceiptsReply.logs:type_name -> gateway.TxLogs
+	36, // 2: gateway.TxReceiptsReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	3,  // 3: gateway.LogsRequest.topics:type_name -> gateway.LogTopics
+	36, // 4: gateway.TransactionStatusReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	36, // 5: gateway.MempoolEventsReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	86, // 6: gateway.CallParams.params:type_name -> gateway.CallParams.ParamsEntry
+	11, // 7: gateway.EthOnBlockRequest.call_params:type_name -> gateway.CallParams
+	36, // 8: gateway.EthOnBlockReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	16, // 9: gateway.Tx.decoded_input:type_name -> gateway.DecodedInput
+	15, // 10: gateway.TxsReply.tx:type_name -> gateway.Tx
+	36, // 11: gateway.TxsReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	18, // 12: gateway.WsNotification.txs:type_name -> gateway.TxsReply
+	24, // 13: gateway.WsNotification.blocks:type_name -> gateway.BlocksReply
+	18, // 14: gateway.FeedRecord.txs:type_name -> gateway.TxsReply
+	24, // 15: gateway.FeedRecord.blocks:type_name -> gateway.BlocksReply
+	2,  // 16: gateway.FeedRecord.tx_receipts:type_name -> gateway.TxReceiptsReply
+	27, // 17: gateway.FeedRecord.beacon_blocks:type_name -> gateway.BeaconBlocksReply
+	22, // 18: gateway.BlocksReply.header:type_name -> gateway.BlockHeader
+	23, // 19: gateway.BlocksReply.future_validator_info:type_name -> gateway.FutureValidatorInfo
+	15, // 20: gateway.BlocksReply.transaction:type_name -> gateway.Tx
+	36, // 21: gateway.BlocksReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	26, // 22: gateway.BeaconBlocksReply.header:type_name -> gateway.BeaconBlockHeader
+	36, // 23: gateway.BeaconBlocksReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	36, // 24: gateway.BlobSidecarsReply.subscription_status:type_name -> gateway.SubscriptionStatus
+	35, // 25: gateway.SubscriptionsReply.subscriptions:type_name -> gateway.Subscription
+	43, // 26: gateway.Peer.unpaid_tx_burst_limit_excess:type_name -> gateway.RateSnapshot
+	43, // 27: gateway.Peer.paid_tx_burst_limit_excess:type_name -> gateway.RateSnapshot
+	43, // 28: gateway.Peer.paid_tx_throughput:type_name -> gateway.RateSnapshot
+	43, // 29: gateway.Peer.unpaid_tx_throughput:type_name -> gateway.RateSnapshot
+	44, // 30: gateway.PeersReply.peers:type_name -> gateway.Peer
+	47, // 31: gateway.Transactions.transactions:type_name -> gateway.Transaction
+	90, // 32: gateway.BxTransaction.add_time:type_name -> google.protobuf.Timestamp
+	49, // 33: gateway.GetBxTransactionResponse.tx:type_name -> gateway.BxTransaction
+	49, // 34: gateway.TxStoreNetworkData.oldest_tx:type_name -> gateway.BxTransaction
+	53, // 35: gateway.TxStoreReply.network_data:type_name -> gateway.TxStoreNetworkData
+	55, // 36: gateway.BlxrBatchTXRequest.transactions_and_senders:type_name -> gateway.TxAndSender
+	87, // 37: gateway.BlxrSubmitBundleRequest.mev_builders:type_name -> gateway.BlxrSubmitBundleRequest.MevBuildersEntry
+	67, // 38: gateway.BlxrBatchTXReply.tx_hashes:type_name -> gateway.TxIndex
+	68, // 39: gateway.BlxrBatchTXReply.tx_errors:type_name -> gateway.ErrorIndex
+	74, // 40: gateway.NodeConnStatus.ws_connection:type_name -> gateway.WsConnStatus
+	73, // 41: gateway.NodeConnStatus.node_performance:type_name -> gateway.NodePerformance
+	77, // 42: gateway.BDNConnStatus.latency:type_name -> gateway.ConnectionLatency
+	78, // 43: gateway.StatusResponse.gateway_info:type_name -> gateway.GatewayInfo
+	88, // 44: gateway.StatusResponse.nodes:type_name -> gateway.StatusResponse.NodesEntry
+	89, // 45: gateway.StatusResponse.relays:type_name -> gateway.StatusResponse.RelaysEntry
+	71, // 46: gateway.StatusResponse.account_info:type_name -> gateway.AccountInfo
+	72, // 47: gateway.StatusResponse.queue_stats:type_name -> gateway.QueuesStats
+	84, // 48: gateway.ProposedBlockRequest.payload:type_name -> gateway.compressTx
+	75, // 49: gateway.StatusResponse.NodesEntry.value:type_name -> gateway.NodeConnStatus
+	76, // 50: gateway.StatusResponse.RelaysEntry.value:type_name -> gateway.BDNConnStatus
+	57, // 51: gateway.Gateway.BlxrTx:input_type -> gateway.BlxrTxRequest
+	56, // 52: gateway.Gateway.BlxrBatchTX:input_type -> gateway.BlxrBatchTXRequest
+	42, // 53: gateway.Gateway.Peers:input_type -> gateway.PeersRequest
+	52, // 54: gateway.Gateway.TxStoreSummary:input_type -> gateway.TxStoreRequest
+	50, // 55: gateway.Gateway.GetTx:input_type -> gateway.GetBxTransactionRequest
+	40, // 56: gateway.Gateway.Stop:input_type -> gateway.StopRequest
+	38, // 57: gateway.Gateway.Version:input_type -> gateway.VersionRequest
+	70, // 58: gateway.Gateway.Status:input_type -> gateway.StatusRequest
+	34, // 59: gateway.Gateway.Subscriptions:input_type -> gateway.SubscriptionsRequest
+	30, // 60: gateway.Gateway.DisconnectInboundPeer:input_type -> gateway.DisconnectInboundPeerRequest
+	14, // 61: gateway.Gateway.NewTxs:input_type -> gateway.TxsRequest
+	14, // 62: gateway.Gateway.PendingTxs:input_type -> gateway.TxsRequest
+	21, // 63: gateway.Gateway.NewBlocks:input_type -> gateway.BlocksRequest
+	21, // 64: gateway.Gateway.BdnBlocks:input_type -> gateway.BlocksRequest
+	12, // 65: gateway.Gateway.EthOnBlock:input_type -> gateway.EthOnBlockRequest
+	1,  // 66: gateway.Gateway.TxReceipts:input_type -> gateway.TxReceiptsRequest
+	4,  // 67: gateway.Gateway.Logs:input_type -> gateway.LogsRequest
+	81, // 68: gateway.Gateway.ShortIDs:input_type -> gateway.TxHashListRequest
+	83, // 69: gateway.Gateway.ProposedBlock:input_type -> gateway.ProposedBlockRequest
+	5,  // 70: gateway.Gateway.TransactionStatus:input_type -> gateway.TransactionStatusRequest
+	7,  // 71: gateway.Gateway.MempoolEvents:input_type -> gateway.MempoolEventsRequest
+	9,  // 72: gateway.Gateway.StartMonitorTransaction:input_type -> gateway.MonitorTransactionRequest
+	9,  // 73: gateway.Gateway.StopMonitorTransaction:input_type -> gateway.MonitorTransactionRequest
+	25, // 74: gateway.Gateway.NewBeaconBlocks:input_type -> gateway.BeaconBlocksRequest
+	25, // 75: gateway.Gateway.BdnBeaconBlocks:input_type -> gateway.BeaconBlocksRequest
+	28, // 76: gateway.Gateway.BlobSidecars:input_type -> gateway.BlobSidecarsRequest
+	59, // 77: gateway.Gateway.BlxrSubmitBundle:input_type -> gateway.BlxrSubmitBundleRequest
+	61, // 78: gateway.Gateway.QuotaUsage:input_type -> gateway.QuotaUsageRequest
+	63, // 79: gateway.Gateway.Ping:input_type -> gateway.PingRequest
+	32, // 80: gateway.Gateway.Unsubscribe:input_type -> gateway.UnsubscribeRequest
+	65, // 81: gateway.Gateway.RegisterAbi:input_type -> gateway.RegisterAbiRequest
+	58, // 82: gateway.Gateway.BlxrTx:output_type -> gateway.BlxrTxReply
+	69, // 83: gateway.Gateway.BlxrBatchTX:output_type -> gateway.BlxrBatchTXReply
+	45, // 84: gateway.Gateway.Peers:output_type -> gateway.PeersReply
+	54, // 85: gateway.Gateway.TxStoreSummary:output_type -> gateway.TxStoreReply
+	51, // 86: gateway.Gateway.GetTx:output_type -> gateway.GetBxTransactionResponse
+	41, // 87: gateway.Gateway.Stop:output_type -> gateway.StopReply
+	39, // 88: gateway.Gateway.Version:output_type -> gateway.VersionReply
+	79, // 89: gateway.Gateway.Status:output_type -> gateway.StatusResponse
+	37, // 90: gateway.Gateway.Subscriptions:output_type -> gateway.SubscriptionsReply
+	31, // 91: gateway.Gateway.DisconnectInboundPeer:output_type -> gateway.DisconnectInboundPeerReply
+	18, // 92: gateway.Gateway.NewTxs:output_type -> gateway.TxsReply
+	18, // 93: gateway.Gateway.PendingTxs:output_type -> gateway.TxsReply
+	24, // 94: gateway.Gateway.NewBlocks:output_type -> gateway.BlocksReply
+	24, // 95: gateway.Gateway.BdnBlocks:output_type -> gateway.BlocksReply
+	13, // 96: gateway.Gateway.EthOnBlock:output_type -> gateway.EthOnBlockReply
+	2,  // 97: gateway.Gateway.TxReceipts:output_type -> gateway.TxReceiptsReply
+	0,  // 98: gateway.Gateway.Logs:output_type -> gateway.TxLogs
+	82, // 99: gateway.Gateway.ShortIDs:output_type -> gateway.ShortIDListReply
+	85, // 100: gateway.Gateway.ProposedBlock:output_type -> gateway.ProposedBlockReply
+	6,  // 101: gateway.Gateway.TransactionStatus:output_type -> gateway.TransactionStatusReply
+	8,  // 102: gateway.Gateway.MempoolEvents:output_type -> gateway.MempoolEventsReply
+	10, // 103: gateway.Gateway.StartMonitorTransaction:output_type -> gateway.MonitorTransactionReply
+	10, // 104: gateway.Gateway.StopMonitorTransaction:output_type -> gateway.MonitorTransactionReply
+	27, // 105: gateway.Gateway.NewBeaconBlocks:output_type -> gateway.BeaconBlocksReply
+	27, // 106: gateway.Gateway.BdnBeaconBlocks:output_type -> gateway.BeaconBlocksReply
+	29, // 107: gateway.Gateway.BlobSidecars:output_type -> gateway.BlobSidecarsReply
+	60, // 108: gateway.Gateway.BlxrSubmitBundle:output_type -> gateway.BlxrSubmitBundleReply
+	62, // 109: gateway.Gateway.QuotaUsage:output_type -> gateway.QuotaUsageReply
+	64, // 110: gateway.Gateway.Ping:output_type -> gateway.PongReply
+	33, // 111: gateway.Gateway.Unsubscribe:output_type -> gateway.UnsubscribeReply
+	66, // 112: gateway.Gateway.RegisterAbi:output_type -> gateway.RegisterAbiReply
+	82, // [82:113] is the sub-list for method output_type
+	51, // [51:82] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_gateway_proto_init() }
@@ -5013,8 +7560,176 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxReceiptsRequest); i {
+		file_gateway_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxReceiptsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxReceiptsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogTopics); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LogsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStatusRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TransactionStatusReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MempoolEventsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MonitorTransactionReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CallParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthOnBlockRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EthOnBlockReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Tx); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5025,8 +7740,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxReceiptsReply); i {
+		file_gateway_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DecodedInput); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5037,8 +7752,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CallParams); i {
+		file_gateway_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AccessTuple); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5049,8 +7764,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthOnBlockRequest); i {
+		file_gateway_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5061,8 +7776,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EthOnBlockReply); i {
+		file_gateway_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WsNotification); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5073,8 +7788,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxsRequest); i {
+		file_gateway_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeedRecord); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5085,8 +7800,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Tx); i {
+		file_gateway_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5097,8 +7812,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AccessTuple); i {
+		file_gateway_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5109,8 +7824,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TxsReply); i {
+		file_gateway_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FutureValidatorInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5121,8 +7836,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlocksRequest); i {
+		file_gateway_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlocksReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5133,8 +7848,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlockHeader); i {
+		file_gateway_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlocksRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5145,8 +7860,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FutureValidatorInfo); i {
+		file_gateway_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlockHeader); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5157,8 +7872,8 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*BlocksReply); i {
+		file_gateway_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BeaconBlocksReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -5169,7 +7884,31 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobSidecarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobSidecarsReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisconnectInboundPeerRequest); i {
 			case 0:
 				return &v.state
@@ -5181,7 +7920,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*DisconnectInboundPeerReply); i {
 			case 0:
 				return &v.state
@@ -5193,7 +7932,31 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnsubscribeReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscriptionsRequest); i {
 			case 0:
 				return &v.state
@@ -5205,7 +7968,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Subscription); i {
 			case 0:
 				return &v.state
@@ -5217,7 +7980,19 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscriptionStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SubscriptionsReply); i {
 			case 0:
 				return &v.state
@@ -5229,7 +8004,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionRequest); i {
 			case 0:
 				return &v.state
@@ -5241,7 +8016,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*VersionReply); i {
 			case 0:
 				return &v.state
@@ -5253,7 +8028,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopRequest); i {
 			case 0:
 				return &v.state
@@ -5265,7 +8040,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StopReply); i {
 			case 0:
 				return &v.state
@@ -5277,7 +8052,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeersRequest); i {
 			case 0:
 				return &v.state
@@ -5289,7 +8064,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RateSnapshot); i {
 			case 0:
 				return &v.state
@@ -5301,7 +8076,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Peer); i {
 			case 0:
 				return &v.state
@@ -5313,7 +8088,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PeersReply); i {
 			case 0:
 				return &v.state
@@ -5325,7 +8100,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*SendTXRequest); i {
 			case 0:
 				return &v.state
@@ -5337,7 +8112,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transaction); i {
 			case 0:
 				return &v.state
@@ -5349,7 +8124,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Transactions); i {
 			case 0:
 				return &v.state
@@ -5361,7 +8136,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BxTransaction); i {
 			case 0:
 				return &v.state
@@ -5373,7 +8148,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBxTransactionRequest); i {
 			case 0:
 				return &v.state
@@ -5385,7 +8160,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetBxTransactionResponse); i {
 			case 0:
 				return &v.state
@@ -5397,7 +8172,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxStoreRequest); i {
 			case 0:
 				return &v.state
@@ -5409,7 +8184,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxStoreNetworkData); i {
 			case 0:
 				return &v.state
@@ -5421,7 +8196,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxStoreReply); i {
 			case 0:
 				return &v.state
@@ -5433,7 +8208,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxAndSender); i {
 			case 0:
 				return &v.state
@@ -5445,7 +8220,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlxrBatchTXRequest); i {
 			case 0:
 				return &v.state
@@ -5457,7 +8232,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlxrTxRequest); i {
 			case 0:
 				return &v.state
@@ -5469,7 +8244,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlxrTxReply); i {
 			case 0:
 				return &v.state
@@ -5481,7 +8256,103 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlxrSubmitBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlxrSubmitBundleReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaUsageRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*QuotaUsageReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PongReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterAbiRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RegisterAbiReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_gateway_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxIndex); i {
 			case 0:
 				return &v.state
@@ -5493,7 +8364,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ErrorIndex); i {
 			case 0:
 				return &v.state
@@ -5505,7 +8376,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BlxrBatchTXReply); i {
 			case 0:
 				return &v.state
@@ -5517,7 +8388,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusRequest); i {
 			case 0:
 				return &v.state
@@ -5529,7 +8400,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AccountInfo); i {
 			case 0:
 				return &v.state
@@ -5541,7 +8412,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*QueuesStats); i {
 			case 0:
 				return &v.state
@@ -5553,7 +8424,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodePerformance); i {
 			case 0:
 				return &v.state
@@ -5565,7 +8436,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*WsConnStatus); i {
 			case 0:
 				return &v.state
@@ -5577,7 +8448,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NodeConnStatus); i {
 			case 0:
 				return &v.state
@@ -5589,7 +8460,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BDNConnStatus); i {
 			case 0:
 				return &v.state
@@ -5601,7 +8472,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ConnectionLatency); i {
 			case 0:
 				return &v.state
@@ -5613,7 +8484,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GatewayInfo); i {
 			case 0:
 				return &v.state
@@ -5625,7 +8496,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*StatusResponse); i {
 			case 0:
 				return &v.state
@@ -5637,7 +8508,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxResult); i {
 			case 0:
 				return &v.state
@@ -5649,7 +8520,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*TxHashListRequest); i {
 			case 0:
 				return &v.state
@@ -5661,7 +8532,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ShortIDListReply); i {
 			case 0:
 				return &v.state
@@ -5673,7 +8544,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposedBlockRequest); i {
 			case 0:
 				return &v.state
@@ -5685,7 +8556,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CompressTx); i {
 			case 0:
 				return &v.state
@@ -5697,7 +8568,7 @@ func file_gateway_proto_init() {
 				return nil
 			}
 		}
-		file_gateway_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+		file_gateway_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*ProposedBlockReply); i {
 			case 0:
 				return &v.state
@@ -5716,7 +8587,7 @@ func file_gateway_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_gateway_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   62,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
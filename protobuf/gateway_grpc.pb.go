@@ -34,8 +34,21 @@ type GatewayClient interface {
 	BdnBlocks(ctx context.Context, in *BlocksRequest, opts ...grpc.CallOption) (Gateway_BdnBlocksClient, error)
 	EthOnBlock(ctx context.Context, in *EthOnBlockRequest, opts ...grpc.CallOption) (Gateway_EthOnBlockClient, error)
 	TxReceipts(ctx context.Context, in *TxReceiptsRequest, opts ...grpc.CallOption) (Gateway_TxReceiptsClient, error)
+	Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Gateway_LogsClient, error)
 	ShortIDs(ctx context.Context, in *TxHashListRequest, opts ...grpc.CallOption) (*ShortIDListReply, error)
 	ProposedBlock(ctx context.Context, in *ProposedBlockRequest, opts ...grpc.CallOption) (*ProposedBlockReply, error)
+	TransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (Gateway_TransactionStatusClient, error)
+	MempoolEvents(ctx context.Context, in *MempoolEventsRequest, opts ...grpc.CallOption) (Gateway_MempoolEventsClient, error)
+	StartMonitorTransaction(ctx context.Context, in *MonitorTransactionRequest, opts ...grpc.CallOption) (*MonitorTransactionReply, error)
+	StopMonitorTransaction(ctx context.Context, in *MonitorTransactionRequest, opts ...grpc.CallOption) (*MonitorTransactionReply, error)
+	NewBeaconBlocks(ctx context.Context, in *BeaconBlocksRequest, opts ...grpc.CallOption) (Gateway_NewBeaconBlocksClient, error)
+	BdnBeaconBlocks(ctx context.Context, in *BeaconBlocksRequest, opts ...grpc.CallOption) (Gateway_BdnBeaconBlocksClient, error)
+	BlobSidecars(ctx context.Context, in *BlobSidecarsRequest, opts ...grpc.CallOption) (Gateway_BlobSidecarsClient, error)
+	BlxrSubmitBundle(ctx context.Context, in *BlxrSubmitBundleRequest, opts ...grpc.CallOption) (*BlxrSubmitBundleReply, error)
+	QuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsageReply, error)
+	Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PongReply, error)
+	Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeReply, error)
+	RegisterAbi(ctx context.Context, in *RegisterAbiRequest, opts ...grpc.CallOption) (*RegisterAbiReply, error)
 }
 
 type gatewayClient struct {
@@ -328,6 +341,38 @@ func (x *gatewayTxReceiptsClient) Recv() (*TxReceiptsReply, error) {
 	return m, nil
 }
 
+func (c *gatewayClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (Gateway_LogsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[6], "/gateway.Gateway/Logs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayLogsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_LogsClient interface {
+	Recv() (*TxLogs, error)
+	grpc.ClientStream
+}
+
+type gatewayLogsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayLogsClient) Recv() (*TxLogs, error) {
+	m := new(TxLogs)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gatewayClient) ShortIDs(ctx context.Context, in *TxHashListRequest, opts ...grpc.CallOption) (*ShortIDListReply, error) {
 	out := new(ShortIDListReply)
 	err := c.cc.Invoke(ctx, "/gateway.Gateway/ShortIDs", in, out, opts...)
@@ -346,6 +391,229 @@ func (c *gatewayClient) ProposedBlock(ctx context.Context, in *ProposedBlockRequ
 	return out, nil
 }
 
+func (c *gatewayClient) TransactionStatus(ctx context.Context, in *TransactionStatusRequest, opts ...grpc.CallOption) (Gateway_TransactionStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[7], "/gateway.Gateway/TransactionStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayTransactionStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_TransactionStatusClient interface {
+	Recv() (*TransactionStatusReply, error)
+	grpc.ClientStream
+}
+
+type gatewayTransactionStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayTransactionStatusClient) Recv() (*TransactionStatusReply, error) {
+	m := new(TransactionStatusReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) MempoolEvents(ctx context.Context, in *MempoolEventsRequest, opts ...grpc.CallOption) (Gateway_MempoolEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[8], "/gateway.Gateway/MempoolEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayMempoolEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_MempoolEventsClient interface {
+	Recv() (*MempoolEventsReply, error)
+	grpc.ClientStream
+}
+
+type gatewayMempoolEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayMempoolEventsClient) Recv() (*MempoolEventsReply, error) {
+	m := new(MempoolEventsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) StartMonitorTransaction(ctx context.Context, in *MonitorTransactionRequest, opts ...grpc.CallOption) (*MonitorTransactionReply, error) {
+	out := new(MonitorTransactionReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/StartMonitorTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) StopMonitorTransaction(ctx context.Context, in *MonitorTransactionRequest, opts ...grpc.CallOption) (*MonitorTransactionReply, error) {
+	out := new(MonitorTransactionReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/StopMonitorTransaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) NewBeaconBlocks(ctx context.Context, in *BeaconBlocksRequest, opts ...grpc.CallOption) (Gateway_NewBeaconBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[9], "/gateway.Gateway/NewBeaconBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayNewBeaconBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_NewBeaconBlocksClient interface {
+	Recv() (*BeaconBlocksReply, error)
+	grpc.ClientStream
+}
+
+type gatewayNewBeaconBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayNewBeaconBlocksClient) Recv() (*BeaconBlocksReply, error) {
+	m := new(BeaconBlocksReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) BdnBeaconBlocks(ctx context.Context, in *BeaconBlocksRequest, opts ...grpc.CallOption) (Gateway_BdnBeaconBlocksClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[10], "/gateway.Gateway/BdnBeaconBlocks", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayBdnBeaconBlocksClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_BdnBeaconBlocksClient interface {
+	Recv() (*BeaconBlocksReply, error)
+	grpc.ClientStream
+}
+
+type gatewayBdnBeaconBlocksClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayBdnBeaconBlocksClient) Recv() (*BeaconBlocksReply, error) {
+	m := new(BeaconBlocksReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) BlobSidecars(ctx context.Context, in *BlobSidecarsRequest, opts ...grpc.CallOption) (Gateway_BlobSidecarsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gateway_ServiceDesc.Streams[11], "/gateway.Gateway/BlobSidecars", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gatewayBlobSidecarsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gateway_BlobSidecarsClient interface {
+	Recv() (*BlobSidecarsReply, error)
+	grpc.ClientStream
+}
+
+type gatewayBlobSidecarsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gatewayBlobSidecarsClient) Recv() (*BlobSidecarsReply, error) {
+	m := new(BlobSidecarsReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *gatewayClient) BlxrSubmitBundle(ctx context.Context, in *BlxrSubmitBundleRequest, opts ...grpc.CallOption) (*BlxrSubmitBundleReply, error) {
+	out := new(BlxrSubmitBundleReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/BlxrSubmitBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) QuotaUsage(ctx context.Context, in *QuotaUsageRequest, opts ...grpc.CallOption) (*QuotaUsageReply, error) {
+	out := new(QuotaUsageReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/QuotaUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) Ping(ctx context.Context, in *PingRequest, opts ...grpc.CallOption) (*PongReply, error) {
+	out := new(PongReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/Ping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) Unsubscribe(ctx context.Context, in *UnsubscribeRequest, opts ...grpc.CallOption) (*UnsubscribeReply, error) {
+	out := new(UnsubscribeReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/Unsubscribe", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayClient) RegisterAbi(ctx context.Context, in *RegisterAbiRequest, opts ...grpc.CallOption) (*RegisterAbiReply, error) {
+	out := new(RegisterAbiReply)
+	err := c.cc.Invoke(ctx, "/gateway.Gateway/RegisterAbi", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GatewayServer is the server API for Gateway service.
 // All implementations must embed UnimplementedGatewayServer
 // for forward compatibility
@@ -366,8 +634,21 @@ type GatewayServer interface {
 	BdnBlocks(*BlocksRequest, Gateway_BdnBlocksServer) error
 	EthOnBlock(*EthOnBlockRequest, Gateway_EthOnBlockServer) error
 	TxReceipts(*TxReceiptsRequest, Gateway_TxReceiptsServer) error
+	Logs(*LogsRequest, Gateway_LogsServer) error
 	ShortIDs(context.Context, *TxHashListRequest) (*ShortIDListReply, error)
 	ProposedBlock(context.Context, *ProposedBlockRequest) (*ProposedBlockReply, error)
+	TransactionStatus(*TransactionStatusRequest, Gateway_TransactionStatusServer) error
+	MempoolEvents(*MempoolEventsRequest, Gateway_MempoolEventsServer) error
+	StartMonitorTransaction(context.Context, *MonitorTransactionRequest) (*MonitorTransactionReply, error)
+	StopMonitorTransaction(context.Context, *MonitorTransactionRequest) (*MonitorTransactionReply, error)
+	NewBeaconBlocks(*BeaconBlocksRequest, Gateway_NewBeaconBlocksServer) error
+	BdnBeaconBlocks(*BeaconBlocksRequest, Gateway_BdnBeaconBlocksServer) error
+	BlobSidecars(*BlobSidecarsRequest, Gateway_BlobSidecarsServer) error
+	BlxrSubmitBundle(context.Context, *BlxrSubmitBundleRequest) (*BlxrSubmitBundleReply, error)
+	QuotaUsage(context.Context, *QuotaUsageRequest) (*QuotaUsageReply, error)
+	Ping(context.Context, *PingRequest) (*PongReply, error)
+	Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeReply, error)
+	RegisterAbi(context.Context, *RegisterAbiRequest) (*RegisterAbiReply, error)
 	mustEmbedUnimplementedGatewayServer()
 }
 
@@ -423,12 +704,51 @@ func (UnimplementedGatewayServer) EthOnBlock(*EthOnBlockRequest, Gateway_EthOnBl
 func (UnimplementedGatewayServer) TxReceipts(*TxReceiptsRequest, Gateway_TxReceiptsServer) error {
 	return status.Errorf(codes.Unimplemented, "method TxReceipts not implemented")
 }
+func (UnimplementedGatewayServer) Logs(*LogsRequest, Gateway_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
 func (UnimplementedGatewayServer) ShortIDs(context.Context, *TxHashListRequest) (*ShortIDListReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShortIDs not implemented")
 }
 func (UnimplementedGatewayServer) ProposedBlock(context.Context, *ProposedBlockRequest) (*ProposedBlockReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ProposedBlock not implemented")
 }
+func (UnimplementedGatewayServer) TransactionStatus(*TransactionStatusRequest, Gateway_TransactionStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method TransactionStatus not implemented")
+}
+func (UnimplementedGatewayServer) MempoolEvents(*MempoolEventsRequest, Gateway_MempoolEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method MempoolEvents not implemented")
+}
+func (UnimplementedGatewayServer) StartMonitorTransaction(context.Context, *MonitorTransactionRequest) (*MonitorTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StartMonitorTransaction not implemented")
+}
+func (UnimplementedGatewayServer) StopMonitorTransaction(context.Context, *MonitorTransactionRequest) (*MonitorTransactionReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StopMonitorTransaction not implemented")
+}
+func (UnimplementedGatewayServer) NewBeaconBlocks(*BeaconBlocksRequest, Gateway_NewBeaconBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method NewBeaconBlocks not implemented")
+}
+func (UnimplementedGatewayServer) BdnBeaconBlocks(*BeaconBlocksRequest, Gateway_BdnBeaconBlocksServer) error {
+	return status.Errorf(codes.Unimplemented, "method BdnBeaconBlocks not implemented")
+}
+func (UnimplementedGatewayServer) BlobSidecars(*BlobSidecarsRequest, Gateway_BlobSidecarsServer) error {
+	return status.Errorf(codes.Unimplemented, "method BlobSidecars not implemented")
+}
+func (UnimplementedGatewayServer) BlxrSubmitBundle(context.Context, *BlxrSubmitBundleRequest) (*BlxrSubmitBundleReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlxrSubmitBundle not implemented")
+}
+func (UnimplementedGatewayServer) QuotaUsage(context.Context, *QuotaUsageRequest) (*QuotaUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method QuotaUsage not implemented")
+}
+func (UnimplementedGatewayServer) Ping(context.Context, *PingRequest) (*PongReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Ping not implemented")
+}
+func (UnimplementedGatewayServer) Unsubscribe(context.Context, *UnsubscribeRequest) (*UnsubscribeReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Unsubscribe not implemented")
+}
+func (UnimplementedGatewayServer) RegisterAbi(context.Context, *RegisterAbiRequest) (*RegisterAbiReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterAbi not implemented")
+}
 func (UnimplementedGatewayServer) mustEmbedUnimplementedGatewayServer() {}
 
 // UnsafeGatewayServer may be embedded to opt out of forward compatibility for this service.
@@ -748,6 +1068,27 @@ func (x *gatewayTxReceiptsServer) Send(m *TxReceiptsReply) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _Gateway_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).Logs(m, &gatewayLogsServer{stream})
+}
+
+type Gateway_LogsServer interface {
+	Send(*TxLogs) error
+	grpc.ServerStream
+}
+
+type gatewayLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayLogsServer) Send(m *TxLogs) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Gateway_ShortIDs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(TxHashListRequest)
 	if err := dec(in); err != nil {
@@ -784,6 +1125,237 @@ func _Gateway_ProposedBlock_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gateway_TransactionStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TransactionStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).TransactionStatus(m, &gatewayTransactionStatusServer{stream})
+}
+
+type Gateway_TransactionStatusServer interface {
+	Send(*TransactionStatusReply) error
+	grpc.ServerStream
+}
+
+type gatewayTransactionStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayTransactionStatusServer) Send(m *TransactionStatusReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_MempoolEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(MempoolEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).MempoolEvents(m, &gatewayMempoolEventsServer{stream})
+}
+
+type Gateway_MempoolEventsServer interface {
+	Send(*MempoolEventsReply) error
+	grpc.ServerStream
+}
+
+type gatewayMempoolEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayMempoolEventsServer) Send(m *MempoolEventsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_StartMonitorTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).StartMonitorTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/StartMonitorTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).StartMonitorTransaction(ctx, req.(*MonitorTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_StopMonitorTransaction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MonitorTransactionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).StopMonitorTransaction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/StopMonitorTransaction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).StopMonitorTransaction(ctx, req.(*MonitorTransactionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_NewBeaconBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BeaconBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).NewBeaconBlocks(m, &gatewayNewBeaconBlocksServer{stream})
+}
+
+type Gateway_NewBeaconBlocksServer interface {
+	Send(*BeaconBlocksReply) error
+	grpc.ServerStream
+}
+
+type gatewayNewBeaconBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayNewBeaconBlocksServer) Send(m *BeaconBlocksReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_BdnBeaconBlocks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BeaconBlocksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).BdnBeaconBlocks(m, &gatewayBdnBeaconBlocksServer{stream})
+}
+
+type Gateway_BdnBeaconBlocksServer interface {
+	Send(*BeaconBlocksReply) error
+	grpc.ServerStream
+}
+
+type gatewayBdnBeaconBlocksServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayBdnBeaconBlocksServer) Send(m *BeaconBlocksReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_BlobSidecars_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BlobSidecarsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GatewayServer).BlobSidecars(m, &gatewayBlobSidecarsServer{stream})
+}
+
+type Gateway_BlobSidecarsServer interface {
+	Send(*BlobSidecarsReply) error
+	grpc.ServerStream
+}
+
+type gatewayBlobSidecarsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gatewayBlobSidecarsServer) Send(m *BlobSidecarsReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Gateway_BlxrSubmitBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlxrSubmitBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).BlxrSubmitBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/BlxrSubmitBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).BlxrSubmitBundle(ctx, req.(*BlxrSubmitBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_QuotaUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuotaUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).QuotaUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/QuotaUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).QuotaUsage(ctx, req.(*QuotaUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_Ping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).Ping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/Ping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Ping(ctx, req.(*PingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_Unsubscribe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).Unsubscribe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/Unsubscribe",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).Unsubscribe(ctx, req.(*UnsubscribeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Gateway_RegisterAbi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterAbiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayServer).RegisterAbi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gateway.Gateway/RegisterAbi",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayServer).RegisterAbi(ctx, req.(*RegisterAbiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Gateway_ServiceDesc is the grpc.ServiceDesc for Gateway service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -839,6 +1411,34 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ProposedBlock",
 			Handler:    _Gateway_ProposedBlock_Handler,
 		},
+		{
+			MethodName: "StartMonitorTransaction",
+			Handler:    _Gateway_StartMonitorTransaction_Handler,
+		},
+		{
+			MethodName: "StopMonitorTransaction",
+			Handler:    _Gateway_StopMonitorTransaction_Handler,
+		},
+		{
+			MethodName: "BlxrSubmitBundle",
+			Handler:    _Gateway_BlxrSubmitBundle_Handler,
+		},
+		{
+			MethodName: "QuotaUsage",
+			Handler:    _Gateway_QuotaUsage_Handler,
+		},
+		{
+			MethodName: "Ping",
+			Handler:    _Gateway_Ping_Handler,
+		},
+		{
+			MethodName: "Unsubscribe",
+			Handler:    _Gateway_Unsubscribe_Handler,
+		},
+		{
+			MethodName: "RegisterAbi",
+			Handler:    _Gateway_RegisterAbi_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -871,6 +1471,36 @@ var Gateway_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Gateway_TxReceipts_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Logs",
+			Handler:       _Gateway_Logs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "TransactionStatus",
+			Handler:       _Gateway_TransactionStatus_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "MempoolEvents",
+			Handler:       _Gateway_MempoolEvents_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "NewBeaconBlocks",
+			Handler:       _Gateway_NewBeaconBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BdnBeaconBlocks",
+			Handler:       _Gateway_BdnBeaconBlocks_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "BlobSidecars",
+			Handler:       _Gateway_BlobSidecars_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "gateway.proto",
 }
@@ -70,17 +70,12 @@ func ParseRawTransactionGroup(transactions []string, trimTxHashPrefix bool, chai
 	sanctionedAddressMap := make(map[string]bool)
 
 	for i, tx := range transactions {
-		transaction, err := ParseRawTransaction(tx)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse %d transaction error: %v", i, err)
-		}
-
-		_, err = ethtypes.NewLondonSigner(big.NewInt(chainID)).Sender(transaction)
+		transactionHash, blockedAddresses, err := parseGroupTransaction(tx, i, chainID)
 		if err != nil {
 			return nil, err
 		}
 
-		txHash := transaction.Hash().Hex()
+		txHash := transactionHash.Hex()
 		trimmedHash := strings.TrimPrefix(txHash, "0x")
 
 		if trimTxHashPrefix {
@@ -95,11 +90,11 @@ func ParseRawTransactionGroup(transactions []string, trimTxHashPrefix bool, chai
 			rawTxs = append(rawTxs, "0x"+tx)
 		}
 
-		bundleHash.Write(transaction.Hash().Bytes())
+		bundleHash.Write(transactionHash.Bytes())
 
-		if addresses, shouldBlock := ofac.ShouldBlockTransaction(transaction); shouldBlock {
+		if len(blockedAddresses) > 0 {
 			blockedTxHashes = append(blockedTxHashes, trimmedHash)
-			for _, address := range addresses {
+			for _, address := range blockedAddresses {
 				if _, found := sanctionedAddressMap[address]; !found {
 					sanctionedAddressMap[address] = true
 					sanctionedAddresses = append(sanctionedAddresses, address)
@@ -117,6 +112,42 @@ func ParseRawTransactionGroup(transactions []string, trimTxHashPrefix bool, chai
 	}, nil
 }
 
+// parseGroupTransaction parses the transaction of a group and validates its sender, it returns the hash of the
+// transaction and its addresses on the sanction list. The set-code transactions are not supported by go-ethereum,
+// so they are parsed separately
+func parseGroupTransaction(tx string, index int, chainID int64) (common.Hash, []string, error) {
+	if txBytes, err := types.DecodeHex(tx); err == nil && types.IsSetCodeTx(txBytes) {
+		setCodeTx, err := types.DecodeSetCodeTx(txBytes)
+		if err != nil {
+			return common.Hash{}, nil, fmt.Errorf("unable to parse %d transaction error: %v", index, err)
+		}
+
+		if setCodeTx.ChainId().Cmp(big.NewInt(chainID)) != 0 {
+			return common.Hash{}, nil, fmt.Errorf("%w: have %d want %d", ethtypes.ErrInvalidChainId, setCodeTx.ChainId(), chainID)
+		}
+		sender, err := setCodeTx.Sender()
+		if err != nil {
+			return common.Hash{}, nil, err
+		}
+
+		blockedAddresses, _ := ofac.ShouldBlockSetCodeTransaction(sender, setCodeTx)
+		return setCodeTx.Hash(), blockedAddresses, nil
+	}
+
+	transaction, err := ParseRawTransaction(tx)
+	if err != nil {
+		return common.Hash{}, nil, fmt.Errorf("unable to parse %d transaction error: %v", index, err)
+	}
+
+	_, err = ethtypes.NewLondonSigner(big.NewInt(chainID)).Sender(transaction)
+	if err != nil {
+		return common.Hash{}, nil, err
+	}
+
+	blockedAddresses, _ := ofac.ShouldBlockTransaction(transaction)
+	return transaction.Hash(), blockedAddresses, nil
+}
+
 // parseBundle is a function used by the blxr_submit_bundle handler on the gateway
 // includes OFAC checks
 func parseBundle(transactions []string, chainID int64) (*GatewayParsedBundle, error) {
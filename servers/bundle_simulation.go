@@ -0,0 +1,345 @@
+package servers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	simulateMethod            = "eth_simulateV1"
+	simulationBlockInterval   = 12
+	baseFeeChangeDenominator  = 8
+	baseFeeElasticityMultiple = 2
+)
+
+var (
+	// coinbaseProbeAddress holds coinbaseProbeCode during the simulation. The code returns the balance of the block
+	// coinbase and is called around each bundle transaction to measure the coinbase diff of the transaction
+	coinbaseProbeAddress = common.HexToAddress("0x00000000000000000000000000000000000b10c5")
+	// COINBASE BALANCE PUSH1 0 MSTORE PUSH1 32 PUSH1 0 RETURN
+	coinbaseProbeCode = hexutil.Bytes{0x41, 0x31, 0x60, 0x00, 0x52, 0x60, 0x20, 0x60, 0x00, 0xf3}
+)
+
+// BundleSimulationResult is the result of a bundle simulation, in the eth_callBundle response format
+type BundleSimulationResult struct {
+	BundleHash        string                     `json:"bundleHash"`
+	BundleGasPrice    string                     `json:"bundleGasPrice"`
+	CoinbaseDiff      string                     `json:"coinbaseDiff"`
+	EthSentToCoinbase string                     `json:"ethSentToCoinbase"`
+	GasFees           string                     `json:"gasFees"`
+	StateBlockNumber  uint64                     `json:"stateBlockNumber"`
+	BlockNumber       uint64                     `json:"blockNumber"`
+	TotalGasUsed      uint64                     `json:"totalGasUsed"`
+	Results           []BundleTxSimulationResult `json:"results"`
+}
+
+// BundleTxSimulationResult is the simulation result of a single bundle transaction
+type BundleTxSimulationResult struct {
+	TxHash            string `json:"txHash"`
+	FromAddress       string `json:"fromAddress"`
+	ToAddress         string `json:"toAddress,omitempty"`
+	GasUsed           uint64 `json:"gasUsed"`
+	GasPrice          string `json:"gasPrice"`
+	GasFees           string `json:"gasFees"`
+	CoinbaseDiff      string `json:"coinbaseDiff"`
+	EthSentToCoinbase string `json:"ethSentToCoinbase"`
+	Value             string `json:"value,omitempty"`
+	Error             string `json:"error,omitempty"`
+	Revert            string `json:"revert,omitempty"`
+}
+
+type simulationHeader struct {
+	Number    hexutil.Uint64 `json:"number"`
+	Timestamp hexutil.Uint64 `json:"timestamp"`
+	GasLimit  hexutil.Uint64 `json:"gasLimit"`
+	GasUsed   hexutil.Uint64 `json:"gasUsed"`
+	BaseFee   *hexutil.Big   `json:"baseFeePerGas"`
+	Miner     common.Address `json:"miner"`
+}
+
+type simulatedCall struct {
+	ReturnData hexutil.Bytes  `json:"returnData"`
+	GasUsed    hexutil.Uint64 `json:"gasUsed"`
+	Status     hexutil.Uint64 `json:"status"`
+	Error      *struct {
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+type simulatedBlock struct {
+	Calls []simulatedCall `json:"calls"`
+}
+
+// simulateBundle applies the bundle transactions in order on top of the state block using the node simulation API
+func simulateBundle(provider blockchain.WSProvider, chainID int64, payload *jsonrpc.RPCBundleSimulationPayload) (*BundleSimulationResult, error) {
+	if err := payload.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidPayload, err)
+	}
+
+	signer := ethtypes.LatestSignerForChainID(big.NewInt(chainID))
+	bundleHash := sha3.NewLegacyKeccak256()
+	txs := make([]*ethtypes.Transaction, 0, len(payload.Transaction))
+	senders := make([]common.Address, 0, len(payload.Transaction))
+	for i, rawTx := range payload.Transaction {
+		tx, err := ParseRawTransaction(rawTx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: unable to parse %d transaction error: %v", errUnableToParseBundle, i, err)
+		}
+		sender, err := ethtypes.Sender(signer, tx)
+		if err != nil {
+			return nil, fmt.Errorf("%w: invalid sender of %d transaction: %v", errUnableToParseBundle, i, err)
+		}
+		bundleHash.Write(tx.Hash().Bytes())
+		txs = append(txs, tx)
+		senders = append(senders, sender)
+	}
+
+	stateBlock := payload.StateBlockNumber
+	if stateBlock == "" {
+		stateBlock = "latest"
+	}
+	var parent simulationHeader
+	if err := callSimulationRPC(provider, "eth_getBlockByNumber", []interface{}{stateBlock, false}, &parent); err != nil {
+		return nil, fmt.Errorf("failed to fetch state block %v: %v", stateBlock, err)
+	}
+
+	blockNumber := uint64(parent.Number) + 1
+	if payload.BlockNumber != "" {
+		blockNumber, _ = hexutil.DecodeUint64(payload.BlockNumber)
+	}
+	timestamp := uint64(parent.Timestamp) + simulationBlockInterval
+	if payload.Timestamp > 0 {
+		timestamp = uint64(payload.Timestamp)
+	}
+	baseFee := nextBaseFee(&parent)
+
+	blockOverrides := map[string]interface{}{
+		"number":       hexutil.Uint64(blockNumber),
+		"time":         hexutil.Uint64(timestamp),
+		"feeRecipient": parent.Miner,
+	}
+	if baseFee != nil {
+		blockOverrides["baseFeePerGas"] = (*hexutil.Big)(baseFee)
+	}
+
+	probe := map[string]interface{}{"to": coinbaseProbeAddress}
+	calls := []interface{}{probe}
+	for i, tx := range txs {
+		calls = append(calls, simulationCallArgs(tx, senders[i]), probe)
+	}
+
+	params := []interface{}{
+		map[string]interface{}{
+			"blockStateCalls": []interface{}{
+				map[string]interface{}{
+					"blockOverrides": blockOverrides,
+					"stateOverrides": map[common.Address]interface{}{
+						coinbaseProbeAddress: map[string]interface{}{"code": coinbaseProbeCode},
+					},
+					"calls": calls,
+				},
+			},
+			"validation": false,
+		},
+		stateBlock,
+	}
+
+	var blocks []simulatedBlock
+	if err := callSimulationRPC(provider, simulateMethod, params, &blocks); err != nil {
+		return nil, fmt.Errorf("failed to simulate bundle: %v", err)
+	}
+	if len(blocks) != 1 || len(blocks[0].Calls) != len(calls) {
+		return nil, errors.New("failed to simulate bundle: unexpected response from node")
+	}
+
+	result := &BundleSimulationResult{
+		BundleHash:       "0x" + common.Bytes2Hex(bundleHash.Sum(nil)),
+		StateBlockNumber: uint64(parent.Number),
+		BlockNumber:      blockNumber,
+		Results:          make([]BundleTxSimulationResult, 0, len(txs)),
+	}
+
+	simulated := blocks[0].Calls
+	startBalance := new(big.Int).SetBytes(simulated[0].ReturnData)
+	balance := startBalance
+	totalGasFees := new(big.Int)
+	totalSentToCoinbase := new(big.Int)
+	for i, tx := range txs {
+		call := simulated[2*i+1]
+		balanceAfter := new(big.Int).SetBytes(simulated[2*i+2].ReturnData)
+
+		gasUsed := uint64(call.GasUsed)
+		gasPrice := effectiveGasPrice(tx, baseFee)
+		gasFees := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(gasUsed))
+		minerFees := new(big.Int).Set(gasFees)
+		if baseFee != nil {
+			minerFees.Sub(minerFees, new(big.Int).Mul(baseFee, new(big.Int).SetUint64(gasUsed)))
+		}
+		coinbaseDiff := new(big.Int).Sub(balanceAfter, balance)
+		sentToCoinbase := new(big.Int).Sub(coinbaseDiff, minerFees)
+
+		txResult := BundleTxSimulationResult{
+			TxHash:            tx.Hash().Hex(),
+			FromAddress:       senders[i].Hex(),
+			GasUsed:           gasUsed,
+			GasPrice:          gasPrice.String(),
+			GasFees:           gasFees.String(),
+			CoinbaseDiff:      coinbaseDiff.String(),
+			EthSentToCoinbase: sentToCoinbase.String(),
+		}
+		if tx.To() != nil {
+			txResult.ToAddress = tx.To().Hex()
+		}
+		if call.Status == 1 {
+			txResult.Value = call.ReturnData.String()
+		} else {
+			txResult.Error = "execution reverted"
+			if call.Error != nil && call.Error.Message != "" {
+				txResult.Error = call.Error.Message
+			}
+			if reason, err := abi.UnpackRevert(call.ReturnData); err == nil {
+				txResult.Revert = reason
+			}
+		}
+		if baseFee != nil && tx.GasFeeCap().Cmp(baseFee) < 0 && txResult.Error == "" {
+			txResult.Error = fmt.Sprintf("max fee per gas %v is less than block base fee %v", tx.GasFeeCap(), baseFee)
+		}
+
+		result.Results = append(result.Results, txResult)
+		result.TotalGasUsed += gasUsed
+		totalGasFees.Add(totalGasFees, gasFees)
+		totalSentToCoinbase.Add(totalSentToCoinbase, sentToCoinbase)
+		balance = balanceAfter
+	}
+
+	coinbaseDiff := new(big.Int).Sub(balance, startBalance)
+	result.CoinbaseDiff = coinbaseDiff.String()
+	result.GasFees = totalGasFees.String()
+	result.EthSentToCoinbase = totalSentToCoinbase.String()
+	bundleGasPrice := new(big.Int)
+	if result.TotalGasUsed > 0 {
+		bundleGasPrice.Div(coinbaseDiff, new(big.Int).SetUint64(result.TotalGasUsed))
+	}
+	result.BundleGasPrice = bundleGasPrice.String()
+	return result, nil
+}
+
+// bundleSimulationParams parses blxr_simulate_bundle params, or eth_callBundle params which are converted to
+// blxr_simulate_bundle params
+func bundleSimulationParams(method jsonrpc.RPCRequestType, rawParams json.RawMessage) (*jsonrpc.RPCBundleSimulationPayload, error) {
+	if method != jsonrpc.RPCEthCallBundle {
+		var params jsonrpc.RPCBundleSimulationPayload
+		if err := json.Unmarshal(rawParams, &params); err != nil {
+			return nil, err
+		}
+		return &params, nil
+	}
+
+	var callBundle []jsonrpc.RPCCallBundle
+	if err := json.Unmarshal(rawParams, &callBundle); err != nil {
+		return nil, err
+	}
+	if len(callBundle) != 1 {
+		return nil, errors.New("received invalid number of call bundle payload, must be 1 element")
+	}
+	return &jsonrpc.RPCBundleSimulationPayload{
+		Transaction:      callBundle[0].Txs,
+		BlockNumber:      callBundle[0].BlockNumber,
+		StateBlockNumber: callBundle[0].StateBlockNumber,
+		Timestamp:        callBundle[0].Timestamp,
+	}, nil
+}
+
+func callSimulationRPC(provider blockchain.WSProvider, method string, params []interface{}, result interface{}) error {
+	response, err := provider.CallRPC(method, params, blockchain.DefaultRPCOptions)
+	if err != nil {
+		return err
+	}
+	if response == nil {
+		return fmt.Errorf("empty %v response", method)
+	}
+	b, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, result)
+}
+
+func simulationCallArgs(tx *ethtypes.Transaction, from common.Address) map[string]interface{} {
+	args := map[string]interface{}{
+		"from":  from,
+		"gas":   hexutil.Uint64(tx.Gas()),
+		"value": (*hexutil.Big)(tx.Value()),
+		"input": hexutil.Bytes(tx.Data()),
+		"nonce": hexutil.Uint64(tx.Nonce()),
+	}
+	if tx.To() != nil {
+		args["to"] = tx.To()
+	}
+	switch tx.Type() {
+	case ethtypes.LegacyTxType, ethtypes.AccessListTxType:
+		args["gasPrice"] = (*hexutil.Big)(tx.GasPrice())
+	default:
+		args["maxFeePerGas"] = (*hexutil.Big)(tx.GasFeeCap())
+		args["maxPriorityFeePerGas"] = (*hexutil.Big)(tx.GasTipCap())
+	}
+	if len(tx.AccessList()) > 0 {
+		args["accessList"] = tx.AccessList()
+	}
+	return args
+}
+
+// effectiveGasPrice returns the price per gas paid by the transaction in a block with the provided base fee
+func effectiveGasPrice(tx *ethtypes.Transaction, baseFee *big.Int) *big.Int {
+	if baseFee == nil {
+		return tx.GasPrice()
+	}
+	tip := tx.EffectiveGasTipValue(baseFee)
+	if tip.Sign() < 0 {
+		tip = new(big.Int)
+	}
+	return tip.Add(tip, baseFee)
+}
+
+// nextBaseFee calculates the EIP-1559 base fee of the block following parent
+func nextBaseFee(parent *simulationHeader) *big.Int {
+	if parent.BaseFee == nil {
+		return nil
+	}
+	parentBaseFee := parent.BaseFee.ToInt()
+	gasTarget := uint64(parent.GasLimit) / baseFeeElasticityMultiple
+	if gasTarget == 0 || uint64(parent.GasUsed) == gasTarget {
+		return new(big.Int).Set(parentBaseFee)
+	}
+
+	if uint64(parent.GasUsed) > gasTarget {
+		delta := new(big.Int).SetUint64(uint64(parent.GasUsed) - gasTarget)
+		delta.Mul(delta, parentBaseFee)
+		delta.Div(delta, new(big.Int).SetUint64(gasTarget))
+		delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+		if delta.Sign() == 0 {
+			delta.SetUint64(1)
+		}
+		return delta.Add(delta, parentBaseFee)
+	}
+
+	delta := new(big.Int).SetUint64(gasTarget - uint64(parent.GasUsed))
+	delta.Mul(delta, parentBaseFee)
+	delta.Div(delta, new(big.Int).SetUint64(gasTarget))
+	delta.Div(delta, big.NewInt(baseFeeChangeDenominator))
+	baseFee := new(big.Int).Sub(parentBaseFee, delta)
+	if baseFee.Sign() < 0 {
+		baseFee.SetUint64(0)
+	}
+	return baseFee
+}
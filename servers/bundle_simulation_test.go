@@ -0,0 +1,148 @@
+package servers
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/bloXroute-Labs/gateway/v2/blockchain/eth"
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type simulationWSProvider struct {
+	blockchain.WSProvider
+	simulateParams []interface{}
+	calls          []interface{}
+}
+
+func (p *simulationWSProvider) CallRPC(method string, payload []interface{}, _ blockchain.RPCOptions) (interface{}, error) {
+	switch method {
+	case "eth_getBlockByNumber":
+		return map[string]interface{}{
+			"number":        "0x64",
+			"timestamp":     "0x100",
+			"gasLimit":      "0x1c9c380",
+			"gasUsed":       "0xe4e1c0",
+			"baseFeePerGas": "0x64",
+			"miner":         "0x0000000000000000000000000000000000000c0b",
+		}, nil
+	case simulateMethod:
+		p.simulateParams = payload
+		var response []interface{}
+		b, _ := json.Marshal(p.calls)
+		_ = json.Unmarshal(b, &response)
+		return []interface{}{map[string]interface{}{"calls": response}}, nil
+	}
+	return nil, nil
+}
+
+func newSimulationTx(t *testing.T, nonce uint64) string {
+	to := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	tx, err := ethtypes.SignNewTx(crypto.ToECDSAUnsafe(common.FromHex("dae2cb3b03f8a1bbaedae4d43e159360c8d07ffab119d5d7311a81a9d4f53bd1")), ethtypes.NewLondonSigner(bxmock.ChainID), &ethtypes.DynamicFeeTx{
+		ChainID:   bxmock.ChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(20),
+		GasFeeCap: big.NewInt(200),
+		Gas:       100000,
+		To:        &to,
+	})
+	require.NoError(t, err)
+	b, err := tx.MarshalBinary()
+	require.NoError(t, err)
+	return hexutil.Encode(b)
+}
+
+func probeResult(balance int64) simulatedCall {
+	return simulatedCall{ReturnData: common.LeftPadBytes(big.NewInt(balance).Bytes(), 32), Status: 1}
+}
+
+func TestSimulateBundle(t *testing.T) {
+	stringType, _ := abi.NewType("string", "", nil)
+	revertData, err := abi.Arguments{{Type: stringType}}.Pack("not profitable")
+	require.NoError(t, err)
+	revertData = append(crypto.Keccak256([]byte("Error(string)"))[:4], revertData...)
+
+	reverted := simulatedCall{ReturnData: revertData, GasUsed: 30000, Status: 0}
+	provider := &simulationWSProvider{
+		WSProvider: eth.NewMockWSProvider("", types.NodeEndpoint{}, 0),
+		calls: []interface{}{
+			probeResult(1000),
+			simulatedCall{ReturnData: []byte{0x01}, GasUsed: 21000, Status: 1},
+			// tip of 20 per gas and a direct payment of 5000
+			probeResult(1000 + 21000*20 + 5000),
+			reverted,
+			probeResult(1000 + 21000*20 + 5000 + 30000*20),
+		},
+	}
+
+	result, err := simulateBundle(provider, bxmock.ChainID.Int64(), &jsonrpc.RPCBundleSimulationPayload{
+		Transaction: []string{newSimulationTx(t, 1), newSimulationTx(t, 2)},
+	})
+	require.NoError(t, err)
+
+	// calls are sent in order, with a coinbase probe around each bundle transaction
+	request := provider.simulateParams[0].(map[string]interface{})
+	blockStateCall := request["blockStateCalls"].([]interface{})[0].(map[string]interface{})
+	assert.Len(t, blockStateCall["calls"], 5)
+	assert.Equal(t, hexutil.Uint64(101), blockStateCall["blockOverrides"].(map[string]interface{})["number"])
+	assert.Equal(t, "latest", provider.simulateParams[1])
+
+	assert.Equal(t, uint64(100), result.StateBlockNumber)
+	assert.Equal(t, uint64(101), result.BlockNumber)
+	assert.Equal(t, uint64(51000), result.TotalGasUsed)
+	assert.Equal(t, "1025000", result.CoinbaseDiff)
+	assert.Equal(t, "5000", result.EthSentToCoinbase)
+	assert.Equal(t, "6120000", result.GasFees)
+	assert.Equal(t, "20", result.BundleGasPrice)
+
+	require.Len(t, result.Results, 2)
+	assert.Equal(t, "120", result.Results[0].GasPrice)
+	assert.Equal(t, "425000", result.Results[0].CoinbaseDiff)
+	assert.Equal(t, "5000", result.Results[0].EthSentToCoinbase)
+	assert.Equal(t, "0x01", result.Results[0].Value)
+	assert.Empty(t, result.Results[0].Error)
+
+	assert.Equal(t, uint64(30000), result.Results[1].GasUsed)
+	assert.Equal(t, "600000", result.Results[1].CoinbaseDiff)
+	assert.Equal(t, "execution reverted", result.Results[1].Error)
+	assert.Equal(t, "not profitable", result.Results[1].Revert)
+}
+
+func TestBundleSimulationParams(t *testing.T) {
+	params, err := bundleSimulationParams(jsonrpc.RPCEthCallBundle, json.RawMessage(`[{"txs":["0x01"],"blockNumber":"0x10","stateBlockNumber":"latest"}]`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0x01"}, params.Transaction)
+	assert.Equal(t, "0x10", params.BlockNumber)
+	assert.Equal(t, "latest", params.StateBlockNumber)
+
+	_, err = bundleSimulationParams(jsonrpc.RPCEthCallBundle, json.RawMessage(`[]`))
+	assert.Error(t, err)
+
+	params, err = bundleSimulationParams(jsonrpc.RPCBundleSimulation, json.RawMessage(`{"transaction":["0x01"],"block_number":"0x10"}`))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"0x01"}, params.Transaction)
+}
+
+func TestNextBaseFee(t *testing.T) {
+	parent := simulationHeader{GasLimit: 30000000, GasUsed: 15000000, BaseFee: (*hexutil.Big)(big.NewInt(1000))}
+	assert.Equal(t, big.NewInt(1000), nextBaseFee(&parent))
+
+	parent.GasUsed = 30000000
+	assert.Equal(t, big.NewInt(1125), nextBaseFee(&parent))
+
+	parent.GasUsed = 0
+	assert.Equal(t, big.NewInt(875), nextBaseFee(&parent))
+
+	parent.BaseFee = nil
+	assert.Nil(t, nextBaseFee(&parent))
+}
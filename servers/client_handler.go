@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"strings"
 	"time"
@@ -16,7 +17,9 @@ import (
 	"github.com/bloXroute-Labs/gateway/v2/connections"
 	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
 	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
+	"github.com/bloXroute-Labs/gateway/v2/services"
 	"github.com/bloXroute-Labs/gateway/v2/services/statistics"
 	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils"
@@ -30,8 +33,7 @@ import (
 	"github.com/gorilla/websocket"
 	uuid "github.com/satori/go.uuid"
 	"github.com/sourcegraph/jsonrpc2"
-	websocketjsonrpc2 "github.com/sourcegraph/jsonrpc2/websocket"
-	"github.com/zhouzhuojie/conditions"
+	"google.golang.org/protobuf/proto"
 )
 
 // ClientHandler is a struct for gateway client handler object
@@ -48,14 +50,23 @@ type ClientHandler struct {
 
 // MultiTransactions - response for MultiTransactions subscription
 type MultiTransactions struct {
-	Subscription string     `json:"subscription"`
-	Result       []TxResult `json:"result"`
+	Subscription string            `json:"subscription"`
+	Result       []json.RawMessage `json:"result"`
+	Sequence     uint64            `json:"sequence,omitempty"`
+
+	// binary are the encoded transactions of the subscriptions with the protobuf encoding
+	binary [][]byte
+}
+
+func (m *MultiTransactions) len() int {
+	return len(m.Result) + len(m.binary)
 }
 
-// TxResponse - response of the jsonrpc params
+// TxResponse - response of the jsonrpc params, the result is an encoded TxResult
 type TxResponse struct {
-	Subscription string   `json:"subscription"`
-	Result       TxResult `json:"result"`
+	Subscription string          `json:"subscription"`
+	Result       json.RawMessage `json:"result"`
+	Sequence     uint64          `json:"sequence,omitempty"`
 }
 
 // EthSubscribeTxResponse - response of the jsonrpc params
@@ -77,6 +88,8 @@ type TxResult struct {
 	LocalRegion *bool       `json:"localRegion,omitempty"`
 	Time        *string     `json:"time,omitempty"`
 	RawTx       *string     `json:"rawTx,omitempty"`
+
+	DecodedInput *services.DecodedInput `json:"decodedInput,omitempty"`
 }
 
 // TxResultWithEthTx - request of jsonrpc params with an eth type transaction
@@ -90,12 +103,21 @@ type TxResultWithEthTx struct {
 
 // BlockResponse - response of the jsonrpc params
 type BlockResponse struct {
-	Subscription string             `json:"subscription"`
-	Result       types.Notification `json:"result"`
+	Subscription string `json:"subscription"`
+	// Result is the notification, or its encoded payload
+	Result   interface{} `json:"result"`
+	Sequence uint64      `json:"sequence,omitempty"`
+}
+
+// SubscriptionStatusResponse - params of the periodic status of a subscription which dropped notifications
+type SubscriptionStatusResponse struct {
+	Subscription string                   `json:"subscription"`
+	Status       types.SubscriptionStatus `json:"status"`
 }
 
 type handlerObj struct {
 	FeedManager              *FeedManager
+	stream                   *wsObjectStream
 	ClientReq                *clientReq
 	remoteAddress            string
 	connectionAccount        sdnmessage.Account
@@ -111,11 +133,50 @@ type handlerObj struct {
 type clientReq struct {
 	includes []string
 	feed     types.FeedType
-	expr     conditions.Expr
+	filter   *txFilter
 	calls    *map[string]*RPCCall
 	MultiTxs bool
+	// resumeFrom is the last sequence received by the client before reconnecting
+	resumeFrom uint64
+	// abis decodes the calldata for the ABI filters and decoded_input
+	abis *services.ABIRegistry
+	// logs is the address and topics filter and the reorg tracking of a logs subscription
+	logs *logsSubscription
+	// backpressure is the policy applied when the client does not read the notifications fast enough
+	backpressure types.BackpressurePolicy
+	// sortedIncludes is the payload cache key of the includes
+	sortedIncludes string
+	// encoding selects the JSON-RPC text frames or the protobuf binary frames
+	encoding wsEncoding
+	// receiptFilter is the filter of a txReceipts subscription
+	receiptFilter *receiptFilter
+}
+
+// filters returns the source of the filter of the subscription
+func (c *clientReq) filters() string {
+	switch {
+	case c.filter != nil:
+		return c.filter.String()
+	case c.receiptFilter != nil:
+		return c.receiptFilter.String()
+	}
+	return ""
 }
 
+// wsEncoding is the encoding of the notifications of a websocket subscription
+type wsEncoding string
+
+// wsEncoding enumeration
+const (
+	// jsonWSEncoding - JSON-RPC notifications in text frames
+	jsonWSEncoding wsEncoding = "json"
+	// protobufWSEncoding - protobuf encoded WsNotification messages in binary frames
+	protobufWSEncoding wsEncoding = "protobuf"
+)
+
+// protobufEncodedFeeds are the feeds which can be received in binary frames
+var protobufEncodedFeeds = []types.FeedType{types.NewTxsFeed, types.PendingTxsFeed, types.NewBlocksFeed, types.BDNBlocksFeed}
+
 type subscriptionRequest struct {
 	feed    types.FeedType
 	options subscriptionOptions
@@ -123,10 +184,15 @@ type subscriptionRequest struct {
 
 // subscriptionOptions includes subscription options
 type subscriptionOptions struct {
-	Include    []string            `json:"Include"`
-	Filters    string              `json:"Filters"`
-	CallParams []map[string]string `json:"Call-Params"`
-	MultiTxs   bool                `json:"MultiTxs"`
+	Include      []string            `json:"Include"`
+	Filters      string              `json:"Filters"`
+	CallParams   []map[string]string `json:"Call-Params"`
+	MultiTxs     bool                `json:"MultiTxs"`
+	ResumeFrom   uint64              `json:"resume_from"`
+	Address      interface{}         `json:"address"`
+	Topics       []interface{}       `json:"topics"`
+	Backpressure string              `json:"backpressure"`
+	Encoding     string              `json:"encoding"`
 }
 
 var (
@@ -156,29 +222,49 @@ type rpcTxResponse struct {
 	TxHash string `json:"txHash"`
 }
 
+type rpcFeeBumpTxResponse struct {
+	TxHash         string `json:"txHash"`
+	ReplacedTxHash string `json:"replacedTxHash"`
+}
+
+type rpcPrivateTxResponse struct {
+	TxHash string `json:"txHash"`
+}
+
 type rpcBatchTxResponse struct {
 	TxHashes []string `json:"txHashes"`
 }
 
+type rpcRegisterABIResponse struct {
+	Methods []string `json:"methods"`
+}
+
 var upgrader = websocket.Upgrader{}
 
 var txContentFields = []string{"tx_contents.nonce", "tx_contents.tx_hash",
 	"tx_contents.gas_price", "tx_contents.gas", "tx_contents.to", "tx_contents.value", "tx_contents.input",
 	"tx_contents.v", "tx_contents.r", "tx_contents.s", "tx_contents.from", "tx_contents.type", "tx_contents.access_list",
-	"tx_contents.chain_id", "tx_contents.max_priority_fee_per_gas", "tx_contents.max_fee_per_gas"}
+	"tx_contents.chain_id", "tx_contents.max_priority_fee_per_gas", "tx_contents.max_fee_per_gas",
+	"tx_contents.max_fee_per_blob_gas", "tx_contents.blob_versioned_hashes", "tx_contents.authorization_list"}
 
-var validTxParams = append(txContentFields, "tx_contents", "tx_hash", "local_region", "time", "raw_tx")
+var validTxParams = append(txContentFields, "tx_contents", "tx_hash", "local_region", "time", "raw_tx", "decoded_input")
 
 var validBlockParams = append(txContentFields, "hash", "header", "transactions", "uncles", "future_validator_info")
 
-var validOnBlockParams = []string{"name", "response", "block_height", "tag"}
+var validOnBlockParams = []string{"name", "response", "block_height", "tag", "latency_ms", "provider"}
 
 var validBeaconBlockParams = []string{"hash", "header", "slot", "body"}
 
+var validBlobSidecarParams = []string{"block_root", "index", "slot", "proposer_index", "kzg_commitment", "kzg_proof", "versioned_hash", "blob"}
+
 var validTxReceiptParams = []string{"block_hash", "block_number", "contract_address",
 	"cumulative_gas_used", "effective_gas_price", "from", "gas_used", "logs", "logs_bloom",
 	"status", "to", "transaction_hash", "transaction_index", "type", "txs_count"}
 
+var validTxStatusParams = []string{"transaction_hash", "status", "block_height", "block_hash", "replaced_by"}
+
+var validMempoolEventParams = []string{"event", "transaction_hash", "replaced_by", "block_height", "block_hash"}
+
 var validParams = map[types.FeedType][]string{
 	types.NewTxsFeed:     validTxParams,
 	types.BDNBlocksFeed:  validBlockParams,
@@ -186,20 +272,20 @@ var validParams = map[types.FeedType][]string{
 	types.PendingTxsFeed: validTxParams,
 	types.OnBlockFeed:    validOnBlockParams,
 	types.TxReceiptsFeed: validTxReceiptParams,
+	types.LogsFeed:       validLogParams,
+
+	types.TransactionStatusFeed: validTxStatusParams,
+	types.MempoolEventsFeed:     validMempoolEventParams,
 
 	// Beacon
 	types.NewBeaconBlocksFeed: validBeaconBlockParams,
 	types.BDNBeaconBlocksFeed: validBeaconBlockParams,
+	types.BlobSidecarsFeed:    validBlobSidecarParams,
 }
 
 var defaultTxParams = append(txContentFields, "tx_hash", "local_region", "time")
 
-var availableFilters = []string{"gas", "gas_price", "value", "to", "from", "method_id", "type", "chain_id", "max_fee_per_gas", "max_priority_fee_per_gas"}
-
-var operators = []string{"=", ">", "<", "!=", ">=", "<=", "in"}
-var operands = []string{"and", "or"}
-
-var availableFeeds = []types.FeedType{types.NewTxsFeed, types.NewBlocksFeed, types.BDNBlocksFeed, types.PendingTxsFeed, types.OnBlockFeed, types.TxReceiptsFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed}
+var availableFeeds = []types.FeedType{types.NewTxsFeed, types.NewBlocksFeed, types.BDNBlocksFeed, types.PendingTxsFeed, types.OnBlockFeed, types.TxReceiptsFeed, types.LogsFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed, types.TransactionStatusFeed, types.MempoolEventsFeed, types.BlobSidecarsFeed}
 
 // PayloadData - Struct that corresponds to the structure of mevSearcher payload
 type PayloadData struct {
@@ -214,49 +300,16 @@ type PayloadData struct {
 
 // NewWSServer creates and returns a new websocket server managed by FeedManager
 func NewWSServer(feedManager *FeedManager, getQuotaUsage func(accountID string) (*connections.QuotaResponseBody, error), enableBlockchainRPC bool, pendingTxsSourceFromNode *bool, authorize func(accountID types.AccountID, secretHash string, allowAccessToInternalGateway bool) (sdnmessage.Account, error)) *http.Server {
+	// permessage-deflate is used only with the clients offering it
+	wsUpgrader := &websocket.Upgrader{EnableCompression: feedManager.cfg.WebsocketCompression}
 	handler := http.NewServeMux()
 	wsHandler := func(responseWriter http.ResponseWriter, request *http.Request) {
-		// if enable client handler - skip authorization
-		serverAccountID := feedManager.accountModel.AccountID
-		connectionAccountModel := sdnmessage.Account{}
-		var err error
-		var accountID types.AccountID
-		var secretHash string
-		if !enableBlockchainRPC {
-			authHeader := request.Header.Get("Authorization")
-			switch {
-			case authHeader != "":
-				accountID, secretHash, err = utils.GetAccountIDSecretHashFromHeader(authHeader)
-				if err != nil {
-					log.Errorf("remoteAddr: %v requestURI: %v - %v.", request.RemoteAddr, request.RequestURI, err.Error())
-					errorWithDelay(responseWriter, request, "failed parsing the authorization header")
-					return
-				}
-			case feedManager.cfg.WebsocketTLSEnabled:
-				if request.TLS != nil && len(request.TLS.PeerCertificates) > 0 {
-					accountID, err = utils.GetAccountIDFromBxCertificate(request.TLS.PeerCertificates[0].Extensions)
-					if err != nil {
-						errorWithDelay(responseWriter, request, fmt.Errorf("failed to get account_id extension, %w", err).Error())
-						return
-					}
-				}
-			default:
-				errorWithDelay(responseWriter, request, fmt.Errorf("missing authorization from method: %v", request.Method).Error())
-				return
-			}
-			connectionAccountModel, err = authorize(accountID, secretHash, true)
-			if err != nil {
-				errorWithDelay(responseWriter, request, err.Error())
-				return
-			}
-		} else {
-			connectionAccountModel, err = feedManager.getCustomerAccountModel(serverAccountID)
-			if err != nil {
-				log.Errorf("failed to get customer account model, account id: %v, remote addr: %v, error: %v",
-					serverAccountID, request.RemoteAddr, err)
-			}
+		connectionAccountModel, err := authorizeRequest(feedManager, request, enableBlockchainRPC, authorize)
+		if err != nil {
+			errorWithDelay(responseWriter, request, err.Error())
+			return
 		}
-		handleWSClientConnection(feedManager, responseWriter, request, connectionAccountModel, getQuotaUsage, enableBlockchainRPC, pendingTxsSourceFromNode)
+		handleWSClientConnection(feedManager, wsUpgrader, responseWriter, request, connectionAccountModel, getQuotaUsage, enableBlockchainRPC, pendingTxsSourceFromNode)
 	}
 
 	handler.HandleFunc("/ws", wsHandler)
@@ -269,6 +322,44 @@ func NewWSServer(feedManager *FeedManager, getQuotaUsage func(accountID string)
 	return &server
 }
 
+// authorizeRequest returns the account of the client of the request, identified by the authorization header or by the
+// certificate of the client when TLS is enabled. If enableBlockchainRPC is set the client is not authorized, it gets the
+// account of the gateway
+func authorizeRequest(feedManager *FeedManager, request *http.Request, enableBlockchainRPC bool, authorize func(accountID types.AccountID, secretHash string, allowAccessToInternalGateway bool) (sdnmessage.Account, error)) (sdnmessage.Account, error) {
+	if enableBlockchainRPC {
+		serverAccountID := feedManager.accountModel.AccountID
+		connectionAccountModel, err := feedManager.getCustomerAccountModel(serverAccountID)
+		if err != nil {
+			log.Errorf("failed to get customer account model, account id: %v, remote addr: %v, error: %v",
+				serverAccountID, request.RemoteAddr, err)
+		}
+		return connectionAccountModel, nil
+	}
+
+	var err error
+	var accountID types.AccountID
+	var secretHash string
+	authHeader := request.Header.Get("Authorization")
+	switch {
+	case authHeader != "":
+		accountID, secretHash, err = utils.GetAccountIDSecretHashFromHeader(authHeader)
+		if err != nil {
+			log.Errorf("remoteAddr: %v requestURI: %v - %v.", request.RemoteAddr, request.RequestURI, err.Error())
+			return sdnmessage.Account{}, errors.New("failed parsing the authorization header")
+		}
+	case feedManager.cfg.WebsocketTLSEnabled:
+		if request.TLS != nil && len(request.TLS.PeerCertificates) > 0 {
+			accountID, err = utils.GetAccountIDFromBxCertificate(request.TLS.PeerCertificates[0].Extensions)
+			if err != nil {
+				return sdnmessage.Account{}, fmt.Errorf("failed to get account_id extension, %w", err)
+			}
+		}
+	default:
+		return sdnmessage.Account{}, fmt.Errorf("missing authorization from method: %v", request.Method)
+	}
+	return authorize(accountID, secretHash, true)
+}
+
 func errorWithDelay(w http.ResponseWriter, r *http.Request, msg string) {
 	// sleep for 10 seconds to prevent the client (bot) to reissue the same requests in a loop
 	c, err := upgrader.Upgrade(w, r, nil)
@@ -283,9 +374,9 @@ func errorWithDelay(w http.ResponseWriter, r *http.Request, msg string) {
 }
 
 // handleWsClientConnection - when new http connection is made we get here upgrade to ws, and start handling
-func handleWSClientConnection(feedManager *FeedManager, w http.ResponseWriter, r *http.Request, accountModel sdnmessage.Account, getQuotaUsage func(accountID string) (*connections.QuotaResponseBody, error), enableBlockchainRPC bool, pendingTxsSourceFromNode *bool) {
+func handleWSClientConnection(feedManager *FeedManager, wsUpgrader *websocket.Upgrader, w http.ResponseWriter, r *http.Request, accountModel sdnmessage.Account, getQuotaUsage func(accountID string) (*connections.QuotaResponseBody, error), enableBlockchainRPC bool, pendingTxsSourceFromNode *bool) {
 	log.Debugf("new web-socket connection from %v", r.RemoteAddr)
-	connection, err := upgrader.Upgrade(w, r, nil)
+	connection, err := wsUpgrader.Upgrade(w, r, nil)
 	if err != nil {
 		log.Errorf("error upgrading HTTP server connection to the WebSocket protocol - %v", err.Error())
 		http.Error(w, "error upgrading HTTP server connection to the WebSocket protocol", http.StatusUpgradeRequired)
@@ -298,8 +389,10 @@ func handleWSClientConnection(feedManager *FeedManager, w http.ResponseWriter, r
 		"remoteAddr": r.RemoteAddr,
 	})
 
+	stream := newWSObjectStream(connection)
 	handler := &handlerObj{
 		FeedManager:              feedManager,
+		stream:                   stream,
 		remoteAddress:            r.RemoteAddr,
 		connectionAccount:        accountModel,
 		getQuotaUsage:            getQuotaUsage,
@@ -310,9 +403,12 @@ func handleWSClientConnection(feedManager *FeedManager, w http.ResponseWriter, r
 		headers:                  types.SDKMetaFromHeaders(r.Header),
 		stats:                    feedManager.stats,
 	}
+	stream.handleBatch = func(message json.RawMessage) json.RawMessage {
+		return handler.handleBatch(r.Context(), message)
+	}
 
 	asyncHandler := jsonrpc2.AsyncHandler(handler)
-	_ = jsonrpc2.NewConn(r.Context(), websocketjsonrpc2.NewObjectStream(connection), asyncHandler)
+	_ = jsonrpc2.NewConn(r.Context(), stream, asyncHandler)
 }
 
 func (ch *ClientHandler) runWSServer() {
@@ -414,23 +510,15 @@ func (h *handlerObj) validateFeed(feedName types.FeedType, feedStreaming sdnmess
 }
 
 func filterAndInclude(clientReq *clientReq, tx *types.NewTransactionNotification, remoteAddress string, accountID types.AccountID) *TxResult {
-	hasTxContent := false
-	if clientReq.expr != nil {
-		txFilters := tx.Filters(clientReq.expr.Args())
-		if txFilters == nil {
-			return nil
-		}
-		// Evaluate if we should send the tx
-		shouldSend, err := conditions.Evaluate(clientReq.expr, txFilters)
-		if err != nil {
-			log.Errorf("error evaluate Filters. feed: %v. method: %v. Filters: %v. remote address: %v. account id: %v error - %v tx: %v.",
-				clientReq.feed, clientReq.includes[0], clientReq.expr.String(), remoteAddress, accountID, err.Error(), txFilters)
-			return nil
-		}
-		if !shouldSend {
-			return nil
-		}
+	if clientReq.filter != nil && !matchTxFilter(clientReq.filter, tx, clientReq.abis) {
+		return nil
 	}
+	return includeTxFields(clientReq, tx)
+}
+
+// includeTxFields returns the fields of the transaction included by the request
+func includeTxFields(clientReq *clientReq, tx *types.NewTransactionNotification) *TxResult {
+	hasTxContent := false
 	var response TxResult
 	for _, param := range clientReq.includes {
 		if strings.Contains(param, "tx_contents") {
@@ -449,6 +537,10 @@ func filterAndInclude(clientReq *clientReq, tx *types.NewTransactionNotification
 		case "raw_tx":
 			rawTx := hexutil.Encode(tx.RawTx())
 			response.RawTx = &rawTx
+		case "decoded_input":
+			if clientReq.abis != nil {
+				response.DecodedInput, _ = clientReq.abis.Decode(tx.Input())
+			}
 		}
 	}
 	if hasTxContent {
@@ -462,11 +554,40 @@ func filterAndInclude(clientReq *clientReq, tx *types.NewTransactionNotification
 	return &response
 }
 
-func (h *handlerObj) subscribeMultiTxs(ctx context.Context, feedChan chan types.Notification, subscriptionID string, clientReq *clientReq, conn *jsonrpc2.Conn, req *jsonrpc2.Request, feedName types.FeedType) error {
+// appendTxPayload appends the transaction to the multi transactions response if it matches the filter of the request
+func (h *handlerObj) appendTxPayload(multiTxsResponse *MultiTransactions, clientReq *clientReq, tx *types.NewTransactionNotification) {
+	if clientReq.encoding == protobufWSEncoding {
+		payload, err := h.FeedManager.protobufTxPayload(clientReq, tx)
+		if err != nil {
+			h.log.Errorf("failed to encode transaction %v: %v", tx.GetHash(), err)
+			return
+		}
+		if payload != nil {
+			multiTxsResponse.binary = append(multiTxsResponse.binary, payload)
+		}
+		return
+	}
+
+	result, err := h.FeedManager.txPayload(clientReq, tx)
+	if err != nil {
+		h.log.Errorf("failed to encode transaction %v: %v", tx.GetHash(), err)
+		return
+	}
+	if result != nil {
+		multiTxsResponse.Result = append(multiTxsResponse.Result, result)
+	}
+}
+
+func (h *handlerObj) subscribeMultiTxs(ctx context.Context, sub *ClientSubscriptionHandlingInfo, subscriptionID string, clientReq *clientReq, conn *jsonrpc2.Conn, req *jsonrpc2.Request, feedName types.FeedType) error {
+	feedChan := sub.FeedChan
 	for {
 		select {
 		case <-conn.DisconnectNotify():
 			return nil
+		case status := <-sub.StatusChan:
+			if err := h.sendSubscriptionStatus(ctx, subscriptionID, conn, status); err != nil {
+				return err
+			}
 		case notification, ok := <-feedChan:
 			continueProcessing := true
 			multiTxsResponse := MultiTransactions{Subscription: subscriptionID}
@@ -479,16 +600,11 @@ func (h *handlerObj) subscribeMultiTxs(ctx context.Context, feedChan chan types.
 			switch feedName {
 			case types.NewTxsFeed:
 				tx := (notification).(*types.NewTransactionNotification)
-				response := filterAndInclude(clientReq, tx, h.remoteAddress, h.connectionAccount.AccountID)
-				if response != nil {
-					multiTxsResponse.Result = append(multiTxsResponse.Result, *response)
-				}
+				h.appendTxPayload(&multiTxsResponse, clientReq, tx)
+				multiTxsResponse.Sequence = tx.Sequence()
 			case types.PendingTxsFeed:
 				tx := (notification).(*types.PendingTransactionNotification)
-				response := filterAndInclude(clientReq, &tx.NewTransactionNotification, h.remoteAddress, h.connectionAccount.AccountID)
-				if response != nil {
-					multiTxsResponse.Result = append(multiTxsResponse.Result, *response)
-				}
+				h.appendTxPayload(&multiTxsResponse, clientReq, &tx.NewTransactionNotification)
 			}
 			for continueProcessing {
 				select {
@@ -504,24 +620,24 @@ func (h *handlerObj) subscribeMultiTxs(ctx context.Context, feedChan chan types.
 					switch feedName {
 					case types.NewTxsFeed:
 						tx := (notification).(*types.NewTransactionNotification)
-						response := filterAndInclude(clientReq, tx, h.remoteAddress, h.connectionAccount.AccountID)
-						if response != nil {
-							multiTxsResponse.Result = append(multiTxsResponse.Result, *response)
-						}
+						h.appendTxPayload(&multiTxsResponse, clientReq, tx)
+						multiTxsResponse.Sequence = tx.Sequence()
 					case types.PendingTxsFeed:
 						tx := (notification).(*types.PendingTransactionNotification)
-						response := filterAndInclude(clientReq, &tx.NewTransactionNotification, h.remoteAddress, h.connectionAccount.AccountID)
-						if response != nil {
-							multiTxsResponse.Result = append(multiTxsResponse.Result, *response)
-						}
+						h.appendTxPayload(&multiTxsResponse, clientReq, &tx.NewTransactionNotification)
 					}
-					if len(multiTxsResponse.Result) >= 50 {
+					if multiTxsResponse.len() >= 50 {
 						continueProcessing = false
 					}
 				default:
 					continueProcessing = false
 				}
 			}
+			if len(multiTxsResponse.binary) > 0 {
+				if err := h.sendBinary(subscriptionID, multiTxsResponse.binary...); err != nil {
+					return err
+				}
+			}
 			if len(multiTxsResponse.Result) > 0 {
 				err := conn.Notify(ctx, "subscribe", multiTxsResponse)
 				if err != nil {
@@ -572,6 +688,9 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 			case types.BDNBlocksFeed:
 			case types.NewBeaconBlocksFeed:
 			case types.BDNBeaconBlocksFeed:
+			case types.BlobSidecarsFeed:
+			case types.TransactionStatusFeed:
+			case types.MempoolEventsFeed:
 			case types.NewBlocksFeed:
 				// Blocks in consensus come not from websocket
 				if h.FeedManager.networkNum == bxgateway.RopstenNum || h.FeedManager.networkNum == bxgateway.GoerliNum || h.FeedManager.networkNum == bxgateway.MainnetNum {
@@ -585,13 +704,12 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 				return
 			}
 		}
-		var filters string
-		if request.expr != nil {
-			filters = request.expr.String()
-		}
+		filters := request.filters()
 		ro := types.ReqOptions{
-			Filters:  filters,
-			Includes: strings.Join(request.includes, ","),
+			Filters:      filters,
+			Includes:     strings.Join(request.includes, ","),
+			ResumeFrom:   request.resumeFrom,
+			Backpressure: request.backpressure,
 		}
 		sub, errSubscribe := h.FeedManager.Subscribe(request.feed, types.WebSocketFeed, conn, ci, ro, false)
 		if errSubscribe != nil {
@@ -622,7 +740,7 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 				log.Debugf("multi tx support only in new txs or pending txs, subscription id %v, account id %v, remote addr %v", subscriptionID, h.connectionAccount.AccountID, h.remoteAddress)
 				return
 			}
-			err := h.subscribeMultiTxs(ctx, sub.FeedChan, subscriptionID, request, conn, req, feedName)
+			err := h.subscribeMultiTxs(ctx, sub, subscriptionID, request, conn, req, feedName)
 			if err != nil {
 				log.Errorf("error while processing %v (%v) with multi tx argument, %v", feedName, subscriptionID, err)
 				return
@@ -636,6 +754,10 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 			case errMsg := <-sub.ErrMsgChan:
 				SendErrorMsg(ctx, jsonrpc.InvalidParams, errMsg, conn, req.ID)
 				return
+			case status := <-sub.StatusChan:
+				if h.sendSubscriptionStatus(ctx, subscriptionID, conn, status) != nil {
+					return
+				}
 			case notification, ok := <-(sub.FeedChan):
 				if !ok {
 					if h.FeedManager.SubscriptionExists(subscriptionID) {
@@ -655,8 +777,8 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 					if h.sendTxNotification(ctx, subscriptionID, request, conn, &tx.NewTransactionNotification) != nil {
 						return
 					}
-				case types.BDNBlocksFeed, types.NewBlocksFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed:
-					if h.sendNotification(ctx, subscriptionID, request, conn, notification) != nil {
+				case types.BDNBlocksFeed, types.NewBlocksFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed, types.BlobSidecarsFeed, types.TransactionStatusFeed, types.MempoolEventsFeed:
+					if h.sendFeedNotification(ctx, subscriptionID, request, conn, notification) != nil {
 						return
 					}
 				case types.TxReceiptsFeed:
@@ -664,7 +786,17 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 					sendTxReceiptsNotification := func(notification *types.TxReceiptNotification) error {
 						return h.sendNotification(ctx, subscriptionID, request, conn, notification)
 					}
-					err = handleTxReceipts(h.FeedManager, block, sendTxReceiptsNotification)
+					err = handleTxReceipts(h.FeedManager, block, request.receiptFilter, sendTxReceiptsNotification)
+					if err != nil {
+						SendErrorMsg(ctx, jsonrpc.InvalidRequest, err.Error(), conn, req.ID)
+						return
+					}
+				case types.LogsFeed:
+					block := notification.(*types.EthBlockNotification)
+					sendLogsNotification := func(notification *types.LogNotification) error {
+						return h.sendNotification(ctx, subscriptionID, request, conn, notification)
+					}
+					err = handleLogs(h.FeedManager, block, request.logs, sendLogsNotification)
 					if err != nil {
 						SendErrorMsg(ctx, jsonrpc.InvalidRequest, err.Error(), conn, req.ID)
 						return
@@ -710,6 +842,32 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 			SendErrorMsg(ctx, jsonrpc.InternalError, string(rune(websocket.CloseMessage)), conn, req.ID)
 			return
 		}
+	case jsonrpc.RPCStartMonitoringTx, jsonrpc.RPCStopMonitoringTx:
+		if req.Params == nil {
+			err := fmt.Errorf("params is missing in the request")
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		var params jsonrpc.RPCMonitorTxPayload
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			h.log.Errorf("unmarshal req.Params error - %v", err.Error())
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		var err error
+		if jsonrpc.RPCRequestType(req.Method) == jsonrpc.RPCStartMonitoringTx {
+			err = h.FeedManager.StartMonitoringTransactions(params.SubscriptionID, h.connectionAccount.AccountID, params.TransactionHashes)
+		} else {
+			err = h.FeedManager.StopMonitoringTransactions(params.SubscriptionID, h.connectionAccount.AccountID, params.TransactionHashes)
+		}
+		if err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		if err = reply(ctx, conn, req.ID, "true"); err != nil {
+			h.log.Errorf("%v reply error - %v", req.Method, err)
+		}
 	case jsonrpc.RPCTx:
 		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
 			err := fmt.Errorf("blxr_tx is not allowed when account authentication is different from the node account")
@@ -763,6 +921,111 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 			return
 		}
 		h.log.Infof("blxr_tx: Hash - 0x%v", response.TxHash)
+	case jsonrpc.RPCFeeBumpTx:
+		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
+			err := fmt.Errorf("blxr_tx_fee_bump is not allowed when account authentication is different from the node account")
+			h.log.Errorf("%v. account auth: %v, node account: %v ", err, h.connectionAccount.AccountID, h.FeedManager.accountModel.AccountID)
+			SendErrorMsg(ctx, jsonrpc.InvalidRequest, err.Error(), conn, req.ID)
+			return
+		}
+		if req.Params == nil {
+			err := fmt.Errorf("params is missing in the request")
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		var params jsonrpc.RPCFeeBumpTxPayload
+		err := json.Unmarshal(*req.Params, &params)
+		if err != nil {
+			h.log.Errorf("unmarshal req.Params error - %v", err.Error())
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		var ws connections.RPCConn
+		if h.connectionAccount.AccountID == types.BloxrouteAccountID {
+			// Tx sent from cloud services, need to update account ID of the connection to be the origin sender
+			ws = connections.NewRPCConn(types.AccountID(params.OriginalSenderAccountID), h.remoteAddress, h.FeedManager.networkNum, utils.CloudAPI)
+		} else {
+			ws = connections.NewRPCConn(h.connectionAccount.AccountID, h.remoteAddress, h.FeedManager.networkNum, utils.Websocket)
+		}
+
+		txHash, replacedTxHash, err := h.FeedManager.SubmitFeeBumpTransaction(params.Transaction, ws, params.NodeValidation, params.FrontRunningProtection)
+		if err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		response := rpcFeeBumpTxResponse{
+			TxHash:         txHash,
+			ReplacedTxHash: replacedTxHash,
+		}
+		if err = reply(ctx, conn, req.ID, response); err != nil {
+			h.log.Errorf("%v reply error - %v", jsonrpc.RPCFeeBumpTx, err)
+			return
+		}
+		h.log.Infof("blxr_tx_fee_bump: Hash - 0x%v, replaced - 0x%v", response.TxHash, response.ReplacedTxHash)
+	case jsonrpc.RPCPrivateTx:
+		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
+			err := fmt.Errorf("blxr_private_tx is not allowed when account authentication is different from the node account")
+			h.log.Errorf("%v. account auth: %v, node account: %v ", err, h.connectionAccount.AccountID, h.FeedManager.accountModel.AccountID)
+			SendErrorMsg(ctx, jsonrpc.InvalidRequest, err.Error(), conn, req.ID)
+			return
+		}
+		if req.Params == nil {
+			err := fmt.Errorf("params is missing in the request")
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		var params jsonrpc.RPCPrivateTxPayload
+		err := json.Unmarshal(*req.Params, &params)
+		if err != nil {
+			h.log.Errorf("unmarshal req.Params error - %v", err.Error())
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		var ws connections.RPCConn
+		if h.connectionAccount.AccountID == types.BloxrouteAccountID {
+			// Tx sent from cloud services, need to update account ID of the connection to be the origin sender
+			ws = connections.NewRPCConn(types.AccountID(params.OriginalSenderAccountID), h.remoteAddress, h.FeedManager.networkNum, utils.CloudAPI)
+		} else {
+			ws = connections.NewRPCConn(h.connectionAccount.AccountID, h.remoteAddress, h.FeedManager.networkNum, utils.Websocket)
+		}
+
+		txHash, err := h.FeedManager.SubmitPrivateTransaction(params.Transaction, ws)
+		if err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		response := rpcPrivateTxResponse{
+			TxHash: txHash,
+		}
+		if err = reply(ctx, conn, req.ID, response); err != nil {
+			h.log.Errorf("%v reply error - %v", jsonrpc.RPCPrivateTx, err)
+			return
+		}
+		h.log.Infof("blxr_private_tx: Hash - 0x%v", response.TxHash)
+	case jsonrpc.RPCPrivateTxBalance:
+		accountID := h.connectionAccount.AccountID
+		if h.connectionAccount.AccountID == types.BloxrouteAccountID && req.Params != nil {
+			var params jsonrpc.RPCPrivateTxBalancePayload
+			if err := json.Unmarshal(*req.Params, &params); err != nil {
+				h.log.Errorf("unmarshal req.Params error - %v", err.Error())
+				SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+				return
+			}
+			accountID = types.AccountID(params.OriginalSenderAccountID)
+		}
+
+		balance, err := h.FeedManager.PrivateTxBalance(accountID)
+		if err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidRequest, err.Error(), conn, req.ID)
+			return
+		}
+		if err = reply(ctx, conn, req.ID, balance); err != nil {
+			h.log.Errorf("%v reply error - %v", jsonrpc.RPCPrivateTxBalance, err)
+		}
 	case jsonrpc.RPCBatchTx:
 		var txHashes []string
 		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
@@ -814,6 +1077,30 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 			return
 		}
 		h.log.Infof("blxr_batch_tx: Hashes - %v", response.TxHashes)
+	case jsonrpc.RPCRegisterABI:
+		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
+			err := fmt.Errorf("%v is not allowed when account authentication is different from the node account", jsonrpc.RPCRegisterABI)
+			SendErrorMsg(ctx, jsonrpc.AccountIDError, err.Error(), conn, req.ID)
+			return
+		}
+		if req.Params == nil {
+			err := fmt.Errorf("params is missing in the request")
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		var params jsonrpc.RPCRegisterABIPayload
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		methods, err := h.FeedManager.RegisterABI(params.ABI)
+		if err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, fmt.Sprintf("failed to register ABI: %v", err), conn, req.ID)
+			return
+		}
+		if err = reply(ctx, conn, req.ID, rpcRegisterABIResponse{Methods: methods}); err != nil {
+			h.log.Errorf("%v reply error - %v", jsonrpc.RPCRegisterABI, err)
+		}
 	case jsonrpc.RPCPing:
 		response := rpcPingResponse{
 			Pong: time.Now().UTC().Format(bxgateway.MicroSecTimeFormat),
@@ -906,6 +1193,35 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 		}
 
 		h.handleMEVBundle(ctx, conn, req, &params)
+	case jsonrpc.RPCBundleSimulation, jsonrpc.RPCEthCallBundle:
+		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
+			err := fmt.Errorf("%v is not allowed when account authentication is different from the node account", req.Method)
+			h.log.Errorf("%v. account auth: %v, node account: %v ", err, h.connectionAccount.AccountID, h.FeedManager.accountModel.AccountID)
+			SendErrorMsg(ctx, jsonrpc.AccountIDError, err.Error(), conn, req.ID)
+			return
+		}
+
+		if req.Params == nil {
+			err := fmt.Errorf("params is missing in the request")
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		params, err := bundleSimulationParams(jsonrpc.RPCRequestType(req.Method), *req.Params)
+		if err != nil {
+			h.log.Errorf("failed to unmarshal req.Params for %v, error: %v", req.Method, err.Error())
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+
+		result, err := h.FeedManager.SimulateBundle(params)
+		if err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		if err = reply(ctx, conn, req.ID, result); err != nil {
+			h.log.Errorf("%v reply error - %v", req.Method, err)
+		}
 	case jsonrpc.RPCChangeNewPendingTxFromNode:
 		if h.FeedManager.accountModel.AccountID != h.connectionAccount.AccountID {
 			err := fmt.Errorf("new_pending_txs_source_from_node is not allowed when account authentication is different from the node account")
@@ -929,6 +1245,10 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 		}
 
 	default:
+		if h.FeedManager.rpcProxy.allowed(req.Method) {
+			h.handleRPCProxy(ctx, conn, req)
+			return
+		}
 		if !h.enableBlockchainRPC {
 			err := fmt.Errorf("got unsupported method name: %v", req.Method)
 			SendErrorMsg(ctx, jsonrpc.MethodNotFound, err.Error(), conn, req.ID)
@@ -1062,6 +1382,55 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 						}
 					}
 				}
+			case "logs":
+				var params interface{}
+				if len(rpcParams) > 1 {
+					params = rpcParams[1]
+				}
+				logsFilter, err := newLogFilterFromParams(params)
+				if err != nil {
+					SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+					return
+				}
+				logs := newLogsSubscription(logsFilter)
+
+				sub, errSubscribe := h.FeedManager.Subscribe(types.LogsFeed, types.WebSocketFeed, conn, ci, types.ReqOptions{}, true)
+				if errSubscribe != nil {
+					SendErrorMsg(ctx, jsonrpc.InvalidParams, errSubscribe.Error(), conn, req.ID)
+					return
+				}
+
+				subscriptionID := sub.SubscriptionID
+				defer h.FeedManager.Unsubscribe(subscriptionID, false, "")
+				if err = reply(ctx, conn, req.ID, subscriptionID); err != nil {
+					h.log.Errorf("error reply to %v with subscriptionID: %v : %v ", h.remoteAddress, subscriptionID, err)
+					SendErrorMsg(ctx, jsonrpc.InternalError, string(rune(websocket.CloseMessage)), conn, req.ID)
+					return
+				}
+
+				for {
+					select {
+					case <-conn.DisconnectNotify():
+						return
+					case errMsg := <-sub.ErrMsgChan:
+						SendErrorMsg(ctx, jsonrpc.InvalidParams, errMsg, conn, req.ID)
+						return
+					case notification, ok := <-sub.FeedChan:
+						if !ok {
+							if h.FeedManager.SubscriptionExists(subscriptionID) {
+								SendErrorMsg(ctx, jsonrpc.InternalError, string(rune(websocket.CloseMessage)), conn, req.ID)
+							}
+							return
+						}
+						block := notification.(*types.EthBlockNotification)
+						sendLogsNotification := func(notification *types.LogNotification) error {
+							return h.sendEthSubscribeNotification(ctx, subscriptionID, conn, notification.EthFormat())
+						}
+						if err = handleLogs(h.FeedManager, block, logs, sendLogsNotification); err != nil {
+							return
+						}
+					}
+				}
 			case "newHeads":
 				request := &clientReq{}
 				request.feed = types.NewBlocksFeed
@@ -1100,8 +1469,15 @@ func (h *handlerObj) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonr
 							return
 						}
 						bxBlock := (notification).(*types.EthBlockNotification)
-						NewHeadsBlock := types.NewHeadsBlockFromEthBlockNotification(bxBlock)
-						if h.sendNotification(ctx, subscriptionID, request, conn, NewHeadsBlock) != nil {
+						newHeads := func() interface{} {
+							return types.NewHeadsBlockFromEthBlockNotification(bxBlock).WithFields(request.includes)
+						}
+						result, err := h.FeedManager.notificationPayload(request, bxBlock, ethPayloadFormat, newHeads)
+						if err != nil {
+							h.log.Errorf("failed to encode newHeads of block %v: %v", bxBlock.GetHash(), err)
+							continue
+						}
+						if h.sendPayload(ctx, subscriptionID, conn, result, 0) != nil {
 							return
 						}
 					}
@@ -1266,6 +1642,7 @@ func (h *handlerObj) handleMEVBundle(ctx context.Context, conn *jsonrpc2.Conn, r
 func (h *handlerObj) sendNotification(ctx context.Context, subscriptionID string, clientReq *clientReq, conn *jsonrpc2.Conn, notification types.Notification) error {
 	response := BlockResponse{
 		Subscription: subscriptionID,
+		Sequence:     types.NotificationSequence(notification),
 	}
 	content := notification.WithFields(clientReq.includes)
 	response.Result = content
@@ -1277,18 +1654,106 @@ func (h *handlerObj) sendNotification(ctx context.Context, subscriptionID string
 	return nil
 }
 
+// sendSubscriptionStatus - notify the client how many notifications were dropped by the backpressure policy
+func (h *handlerObj) sendSubscriptionStatus(ctx context.Context, subscriptionID string, conn *jsonrpc2.Conn, status types.SubscriptionStatus) error {
+	response := SubscriptionStatusResponse{
+		Subscription: subscriptionID,
+		Status:       status,
+	}
+	err := conn.Notify(ctx, "subscribe", response)
+	if err != nil {
+		h.log.Errorf("error notify status to subscriptionID: %v : %v ", subscriptionID, err.Error())
+		return err
+	}
+	return nil
+}
+
+// sendFeedNotification - notify the client of a notification sent by the feed manager to all the subscriptions of the
+// feed, its payload is shared by the subscriptions with the same includes
+func (h *handlerObj) sendFeedNotification(ctx context.Context, subscriptionID string, clientReq *clientReq, conn *jsonrpc2.Conn, notification types.Notification) error {
+	if clientReq.encoding == protobufWSEncoding {
+		payload, err := h.FeedManager.protobufBlockPayload(clientReq, notification.(*types.EthBlockNotification))
+		if err != nil {
+			h.log.Errorf("failed to encode %v notification %v: %v", notification.NotificationType(), notification.GetHash(), err)
+			return nil
+		}
+		return h.sendBinary(subscriptionID, payload)
+	}
+
+	withFields := func() interface{} {
+		return notification.WithFields(clientReq.includes)
+	}
+	result, err := h.FeedManager.notificationPayload(clientReq, notification, bxPayloadFormat, withFields)
+	if err != nil {
+		h.log.Errorf("failed to encode %v notification %v: %v", notification.NotificationType(), notification.GetHash(), err)
+		return nil
+	}
+	return h.sendPayload(ctx, subscriptionID, conn, result, types.NotificationSequence(notification))
+}
+
+// sendBinary - write a binary frame of the subscription, made of encoded WsNotification payloads
+func (h *handlerObj) sendBinary(subscriptionID string, payloads ...[]byte) error {
+	if h.stream == nil {
+		return errors.New("binary frames are not supported by the connection")
+	}
+	frame, err := proto.Marshal(&pb.WsNotification{SubscriptionId: subscriptionID})
+	if err != nil {
+		return err
+	}
+	for _, payload := range payloads {
+		frame = append(frame, payload...)
+	}
+	if err = h.stream.WriteBinary(frame); err != nil {
+		h.log.Errorf("error writing binary frame to subscriptionID: %v : %v ", subscriptionID, err)
+		return err
+	}
+	return nil
+}
+
+// sendPayload - notify the client of an encoded notification payload
+func (h *handlerObj) sendPayload(ctx context.Context, subscriptionID string, conn *jsonrpc2.Conn, result json.RawMessage, sequence uint64) error {
+	response := BlockResponse{
+		Subscription: subscriptionID,
+		Result:       result,
+		Sequence:     sequence,
+	}
+	err := conn.Notify(ctx, "subscribe", response)
+	if err != nil {
+		h.log.Errorf("error reply to subscriptionID: %v : %v ", subscriptionID, err.Error())
+		return err
+	}
+	return nil
+}
+
 // sendTxNotification - build a response according to client request and notify client
 func (h *handlerObj) sendTxNotification(ctx context.Context, subscriptionID string, clientReq *clientReq, conn *jsonrpc2.Conn, tx *types.NewTransactionNotification) error {
-	result := filterAndInclude(clientReq, tx, h.remoteAddress, h.connectionAccount.AccountID)
+	if clientReq.encoding == protobufWSEncoding {
+		payload, err := h.FeedManager.protobufTxPayload(clientReq, tx)
+		if err != nil {
+			h.log.Errorf("failed to encode transaction %v: %v", tx.GetHash(), err)
+			return nil
+		}
+		if payload == nil {
+			return nil
+		}
+		return h.sendBinary(subscriptionID, payload)
+	}
+
+	result, err := h.FeedManager.txPayload(clientReq, tx)
+	if err != nil {
+		h.log.Errorf("failed to encode transaction %v: %v", tx.GetHash(), err)
+		return nil
+	}
 	if result == nil {
 		return nil
 	}
 	response := TxResponse{
 		Subscription: subscriptionID,
-		Result:       *result,
+		Result:       result,
+		Sequence:     tx.Sequence(),
 	}
 
-	err := conn.Notify(ctx, "subscribe", response)
+	err = conn.Notify(ctx, "subscribe", response)
 	if err != nil {
 		h.log.Errorf("error notify to subscriptionID: %v : %v ", subscriptionID, err.Error())
 		return err
@@ -1299,8 +1764,8 @@ func (h *handlerObj) sendTxNotification(ctx context.Context, subscriptionID stri
 
 // sendTxNotificationEthSubscribeFormat - build a response according to client request and notify client
 func (h *handlerObj) sendTxNotificationEthFormat(ctx context.Context, subscriptionID string, clientReq *clientReq, conn *jsonrpc2.Conn, tx *types.NewTransactionNotification) error {
-	result := filterAndInclude(clientReq, tx, h.remoteAddress, h.connectionAccount.AccountID)
-	if result == nil {
+	// only the hash is sent, the included fields are not needed
+	if clientReq.filter != nil && !matchTxFilter(clientReq.filter, tx, clientReq.abis) {
 		return nil
 	}
 	response := EthSubscribeTxResponse{
@@ -1358,7 +1823,7 @@ func (h *handlerObj) createClientReq(req *jsonrpc2.Request) (*clientReq, error)
 		return nil, fmt.Errorf("got unsupported feed name %v. possible feeds are %v", request.feed, availableFeeds)
 	}
 	if h.connectionAccount.AccountID != h.FeedManager.accountModel.AccountID &&
-		(request.feed == types.OnBlockFeed || request.feed == types.TxReceiptsFeed) {
+		(request.feed == types.OnBlockFeed || request.feed == types.TxReceiptsFeed || request.feed == types.LogsFeed) {
 		err = fmt.Errorf("%v feed is not available via cloud services. %v feed is only supported on gateways", request.feed, request.feed)
 		h.log.Errorf("%v. caller account ID: %v, node account ID: %v ", err, h.connectionAccount.AccountID, h.FeedManager.accountModel.AccountID)
 		return nil, err
@@ -1386,6 +1851,14 @@ func (h *handlerObj) createClientReq(req *jsonrpc2.Request) (*clientReq, error)
 			requestedFields = validParams[types.OnBlockFeed]
 		case types.TxReceiptsFeed:
 			requestedFields = validParams[types.TxReceiptsFeed]
+		case types.LogsFeed:
+			requestedFields = validParams[types.LogsFeed]
+		case types.TransactionStatusFeed:
+			requestedFields = validParams[types.TransactionStatusFeed]
+		case types.MempoolEventsFeed:
+			requestedFields = validParams[types.MempoolEventsFeed]
+		case types.BlobSidecarsFeed:
+			requestedFields = validParams[types.BlobSidecarsFeed]
 		}
 	}
 	for _, param := range request.options.Include {
@@ -1422,6 +1895,22 @@ func (h *handlerObj) createClientReq(req *jsonrpc2.Request) (*clientReq, error)
 			if !utils.Exists(param, validParams[types.TxReceiptsFeed]) {
 				return nil, fmt.Errorf("got unsupported param %v", param)
 			}
+		case types.LogsFeed:
+			if !utils.Exists(param, validParams[types.LogsFeed]) {
+				return nil, fmt.Errorf("got unsupported param %v", param)
+			}
+		case types.TransactionStatusFeed:
+			if !utils.Exists(param, validParams[types.TransactionStatusFeed]) {
+				return nil, fmt.Errorf("got unsupported param %v", param)
+			}
+		case types.MempoolEventsFeed:
+			if !utils.Exists(param, validParams[types.MempoolEventsFeed]) {
+				return nil, fmt.Errorf("got unsupported param %v", param)
+			}
+		case types.BlobSidecarsFeed:
+			if !utils.Exists(param, validParams[types.BlobSidecarsFeed]) {
+				return nil, fmt.Errorf("got unsupported param %v", param)
+			}
 		}
 		if param == "tx_contents" {
 			requestedFields = append(requestedFields, txContentFields...)
@@ -1430,24 +1919,33 @@ func (h *handlerObj) createClientReq(req *jsonrpc2.Request) (*clientReq, error)
 	}
 	request.options.Include = requestedFields
 
-	var expr conditions.Expr
-	if request.options.Filters != "" {
-		expr, err = createFiltersExpression(request.options.Filters)
+	var txsFilter *txFilter
+	var receiptsFilter *receiptFilter
+	if request.options.Filters != "" && request.feed == types.TxReceiptsFeed {
+		receiptsFilter, err = compileReceiptFilter(request.options.Filters)
+		if err != nil {
+			h.log.Debugf("error when creating receipt filters. request id: %v. method: %v. params: %s. remote address: %v account id: %v error - %v",
+				req.ID, req.Method, *req.Params, h.remoteAddress, h.connectionAccount.AccountID, err.Error())
+			return nil, fmt.Errorf("error creating Filters- %v", err.Error())
+		}
+	} else if request.options.Filters != "" {
+		txsFilter, err = compileTxFilter(request.options.Filters, h.FeedManager.abiRegistry)
 		if err != nil {
 			h.log.Debugf("error when creating filters. request id: %v. method: %v. params: %s. remote address: %v account id: %v error - %v",
 				req.ID, req.Method, *req.Params, h.remoteAddress, h.connectionAccount.AccountID, err.Error())
 			return nil, fmt.Errorf("error creating Filters- %v", err.Error())
 		}
 
-		if expr != nil {
-			h.log.Infof("GetTxContentAndFilters string - %s, GetTxContentAndFilters args - %s", expr, expr.Args())
-		}
+		h.log.Infof("GetTxContentAndFilters string - %s, GetTxContentAndFilters args - %s", txsFilter, txsFilter.Vars())
 	}
 
 	// check if valid feed
 	var filters []string
-	if expr != nil {
-		filters = expr.Args()
+	if txsFilter != nil {
+		filters = txsFilter.Vars()
+	}
+	if receiptsFilter != nil {
+		filters = receiptsFilter.Vars()
 	}
 
 	feedStreaming := sdnmessage.BDNFeedService{}
@@ -1456,12 +1954,14 @@ func (h *handlerObj) createClientReq(req *jsonrpc2.Request) (*clientReq, error)
 		feedStreaming = h.connectionAccount.NewTransactionStreaming
 	case types.PendingTxsFeed:
 		feedStreaming = h.connectionAccount.PendingTransactionStreaming
-	case types.BDNBlocksFeed, types.NewBlocksFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed:
+	case types.BDNBlocksFeed, types.NewBlocksFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed, types.BlobSidecarsFeed:
 		feedStreaming = h.connectionAccount.NewBlockStreaming
 	case types.OnBlockFeed:
 		feedStreaming = h.connectionAccount.OnBlockFeed
-	case types.TxReceiptsFeed:
+	case types.TxReceiptsFeed, types.LogsFeed:
 		feedStreaming = h.connectionAccount.TransactionReceiptFeed
+	case types.TransactionStatusFeed, types.MempoolEventsFeed:
+		feedStreaming = h.connectionAccount.TransactionStateFeed
 	}
 	err = h.validateFeed(request.feed, feedStreaming, request.options.Include, filters)
 	if err != nil {
@@ -1481,11 +1981,40 @@ func (h *handlerObj) createClientReq(req *jsonrpc2.Request) (*clientReq, error)
 		}
 	}
 
+	backpressure, err := types.NewBackpressurePolicy(request.options.Backpressure)
+	if err != nil {
+		return nil, err
+	}
+
+	encoding := jsonWSEncoding
+	switch wsEncoding(request.options.Encoding) {
+	case "", jsonWSEncoding:
+	case protobufWSEncoding:
+		if !types.Exists(request.feed, protobufEncodedFeeds) {
+			return nil, fmt.Errorf("%v feed can not be encoded with protobuf, the feeds supporting it are %v", request.feed, protobufEncodedFeeds)
+		}
+		encoding = protobufWSEncoding
+	default:
+		return nil, fmt.Errorf("invalid encoding %v, valid encodings are %v and %v", request.options.Encoding, jsonWSEncoding, protobufWSEncoding)
+	}
+
 	clientRequest := &clientReq{}
+	if request.feed == types.LogsFeed {
+		logsFilter, err := newLogFilter(request.options.Address, request.options.Topics)
+		if err != nil {
+			return nil, err
+		}
+		clientRequest.logs = newLogsSubscription(logsFilter)
+	}
 	clientRequest.includes = request.options.Include
 	clientRequest.feed = request.feed
-	clientRequest.expr = expr
+	clientRequest.filter = txsFilter
+	clientRequest.receiptFilter = receiptsFilter
 	clientRequest.MultiTxs = request.options.MultiTxs
+	clientRequest.resumeFrom = request.options.ResumeFrom
+	clientRequest.backpressure = backpressure
+	clientRequest.encoding = encoding
+	clientRequest.abis = h.FeedManager.abiRegistry
 	clientRequest.calls = &calls
 	return clientRequest, nil
 }
@@ -1536,8 +2065,29 @@ func (h *handlerObj) handleSingleTransaction(ctx context.Context, conn *jsonrpc2
 	return tx.Hash().String(), true
 }
 
-// ValidateTxFromExternalSource validate transaction from external source (ws / grpc), return bool indicates if tx is pending reevaluation
-func ValidateTxFromExternalSource(transaction string, txBytes []byte, validatorsOnly bool, gatewayChainID types.NetworkID, nextValidator bool, fallback uint16, nextValidatorMap *orderedmap.OrderedMap, validatorStatusMap *syncmap.SyncMap[string, bool], networkNum types.NetworkNum, accountID types.AccountID, nodeValidationRequested bool, wsManager blockchain.WSManager, source connections.Conn, pendingBSCNextValidatorTxHashToInfo map[string]PendingNextValidatorTxInfo, frontRunningProtection bool) (*bxmessage.Tx, bool, error) {
+// decodeExternalTx decodes a transaction submitted by a client, and returns its hash, chain ID and BDN content
+func decodeExternalTx(txBytes []byte) (common.Hash, *big.Int, types.TxContent, error) {
+	if types.IsBlobTx(txBytes) {
+		// the blob transactions are propagated with their blobs, so they must be submitted in their network form
+		blobTx, err := types.DecodeBlobTx(txBytes)
+		if err != nil {
+			return common.Hash{}, nil, nil, err
+		}
+		if blobTx.Sidecar() == nil {
+			return common.Hash{}, nil, nil, types.ErrBlobTxSidecarMissing
+		}
+		txContent, err := blobTx.Content()
+		return blobTx.Hash(), blobTx.ChainId(), txContent, err
+	}
+	if types.IsSetCodeTx(txBytes) {
+		setCodeTx, err := types.DecodeSetCodeTx(txBytes)
+		if err != nil {
+			return common.Hash{}, nil, nil, err
+		}
+		txContent, err := setCodeTx.Content()
+		return setCodeTx.Hash(), setCodeTx.ChainId(), txContent, err
+	}
+
 	// Ethereum's transactions encoding for RPC interfaces is slightly different from the RLP encoded format, so decode + re-encode the transaction for consistency.
 	// Specifically, note `UnmarshalBinary` should be used for RPC interfaces, and rlp.DecodeBytes should be used for the wire protocol.
 	var ethTx ethtypes.Transaction
@@ -1546,23 +2096,28 @@ func ValidateTxFromExternalSource(transaction string, txBytes []byte, validators
 		// If UnmarshalBinary failed, we will try RLP in case user made mistake
 		e := rlp.DecodeBytes(txBytes, &ethTx)
 		if e != nil {
-			return nil, false, err
+			return common.Hash{}, nil, nil, err
 		}
 		log.Warnf("Ethereum transaction was in RLP format instead of binary," +
 			" transaction has been processed anyway, but it'd be best to use the Ethereum binary standard encoding")
 	}
 
-	if ethTx.ChainId().Int64() != 0 && gatewayChainID != 0 && types.NetworkID(ethTx.ChainId().Int64()) != gatewayChainID {
-		log.Debugf("chainID mismatch for hash %v - tx chainID %v , gateway networkNum %v networkChainID %v", ethTx.Hash().String(), ethTx.ChainId().Int64(), networkNum, gatewayChainID)
-		return nil, false, fmt.Errorf("chainID mismatch for hash %v, expect %v got %v, make sure the tx is sent with the right blockchain network", ethTx.Hash().String(), gatewayChainID, ethTx.ChainId().Int64())
-	}
-
 	txContent, err := rlp.EncodeToBytes(&ethTx)
+	return ethTx.Hash(), ethTx.ChainId(), txContent, err
+}
 
+// ValidateTxFromExternalSource validate transaction from external source (ws / grpc), return bool indicates if tx is pending reevaluation
+func ValidateTxFromExternalSource(transaction string, txBytes []byte, validatorsOnly bool, gatewayChainID types.NetworkID, nextValidator bool, fallback uint16, nextValidatorMap *orderedmap.OrderedMap, validatorStatusMap *syncmap.SyncMap[string, bool], networkNum types.NetworkNum, accountID types.AccountID, nodeValidationRequested bool, wsManager blockchain.WSManager, source connections.Conn, pendingBSCNextValidatorTxHashToInfo map[string]PendingNextValidatorTxInfo, frontRunningProtection bool) (*bxmessage.Tx, bool, error) {
+	txHash, txChainID, txContent, err := decodeExternalTx(txBytes)
 	if err != nil {
 		return nil, false, err
 	}
 
+	if txChainID.Int64() != 0 && gatewayChainID != 0 && types.NetworkID(txChainID.Int64()) != gatewayChainID {
+		log.Debugf("chainID mismatch for hash %v - tx chainID %v , gateway networkNum %v networkChainID %v", txHash.String(), txChainID.Int64(), networkNum, gatewayChainID)
+		return nil, false, fmt.Errorf("chainID mismatch for hash %v, expect %v got %v, make sure the tx is sent with the right blockchain network", txHash.String(), gatewayChainID, txChainID.Int64())
+	}
+
 	var txFlags = types.TFPaidTx | types.TFLocalRegion
 	if validatorsOnly {
 		txFlags |= types.TFValidatorsOnly
@@ -1577,7 +2132,7 @@ func ValidateTxFromExternalSource(transaction string, txBytes []byte, validators
 	}
 
 	var hash types.SHA256Hash
-	copy(hash[:], ethTx.Hash().Bytes())
+	copy(hash[:], txHash.Bytes())
 
 	// should set the account of the sender, not the account of the gateway itself
 	tx := bxmessage.NewTx(hash, txContent, networkNum, txFlags, accountID)
@@ -2,17 +2,25 @@ package servers
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/bloXroute-Labs/gateway/v2"
+	"github.com/bloXroute-Labs/gateway/v2/connections"
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
 	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
 	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
+	"github.com/bloXroute-Labs/gateway/v2/services"
 	"github.com/bloXroute-Labs/gateway/v2/types"
-	"github.com/zhouzhuojie/conditions"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 	"google.golang.org/grpc/peer"
 )
 
@@ -58,6 +66,8 @@ func (*GrpcHandler) generateBlockReplyHeader(h *types.Header) *pb.BlockHeader {
 	if h.WithdrawalsHash != nil {
 		blockReplyHeader.WithdrawalsRoot = h.WithdrawalsHash.String()
 	}
+	blockReplyHeader.BlobGasUsed = h.BlobGasUsed
+	blockReplyHeader.ExcessBlobGas = h.ExcessBlobGas
 	if h.BaseFee != nil {
 		blockReplyHeader.BaseFeePerGas = strconv.FormatInt(int64(*h.BaseFee), 10)
 	}
@@ -87,6 +97,20 @@ func (g *GrpcHandler) generateBlockReply(n *types.EthBlockNotification) *pb.Bloc
 	return blockReply
 }
 
+func generateLogReply(n *types.LogNotification) *pb.TxLogs {
+	return &pb.TxLogs{
+		Address:          n.Log.Address,
+		Topics:           n.Log.Topics,
+		Data:             n.Log.Data,
+		BlockNumber:      n.Log.BlockNumber,
+		TransactionHash:  n.Log.TransactionHash,
+		TransactionIndex: n.Log.TransactionIndex,
+		BlockHash:        n.Log.BlockHash,
+		LogIndex:         n.Log.LogIndex,
+		Removed:          n.IsRemoved(),
+	}
+}
+
 func generateTxReceiptReply(n *types.TxReceiptNotification) *pb.TxReceiptsReply {
 	txReceiptsReply := &pb.TxReceiptsReply{
 		BlocKHash:         n.Receipt.BlockHash,
@@ -103,6 +127,7 @@ func generateTxReceiptReply(n *types.TxReceiptNotification) *pb.TxReceiptsReply
 		TransactionIndex:  n.Receipt.TransactionIndex,
 		Type:              n.Receipt.TxType,
 		TxsCount:          n.Receipt.TxsCount,
+		Sequence:          n.Sequence(),
 	}
 
 	for _, receiptLog := range n.Receipt.Logs {
@@ -139,15 +164,31 @@ func generateEthOnBlockReply(n *types.OnBlockNotification) *pb.EthOnBlockReply {
 		Response:    n.Response,
 		BlockHeight: n.BlockHeight,
 		Tag:         n.Tag,
+		LatencyMs:   n.LatencyMs,
+		Provider:    n.Provider,
 	}
 }
 
-func makeTransaction(transaction types.NewTransactionNotification) *pb.Tx {
+func makeTransaction(transaction types.NewTransactionNotification, decodedInput *services.DecodedInput) *pb.Tx {
 	tx := &pb.Tx{
 		From:        transaction.Sender().Bytes(),
 		LocalRegion: transaction.LocalRegion(),
 		Time:        time.Now().UnixNano(),
 		RawTx:       transaction.RawTx(),
+		Sequence:    transaction.Sequence(),
+	}
+
+	if decodedInput != nil {
+		args, err := json.Marshal(decodedInput.Args)
+		if err != nil {
+			log.Errorf("failed to marshal decoded input of tx %v: %v", transaction.GetHash(), err)
+			return tx
+		}
+		tx.DecodedInput = &pb.DecodedInput{
+			Method:    decodedInput.Method,
+			Signature: decodedInput.Signature,
+			Args:      string(args),
+		}
 	}
 
 	return tx
@@ -163,7 +204,7 @@ func (g *GrpcHandler) PendingTxs(req *pb.TxsRequest, stream pb.Gateway_PendingTx
 	return g.handleTransactions(req, stream, types.PendingTxsFeed, account)
 }
 
-func processTx(clientReq *clientReq, notification types.Notification, multiTxsResponse *[]*pb.Tx, remoteAddress string, accountID types.AccountID, feedType types.FeedType) {
+func (g *GrpcHandler) processTx(clientReq *clientReq, notification types.Notification, multiTxsResponse *[]*pb.Tx, feedType types.FeedType) {
 	var transaction *types.NewTransactionNotification
 	switch feedType {
 	case types.NewTxsFeed:
@@ -173,17 +214,16 @@ func processTx(clientReq *clientReq, notification types.Notification, multiTxsRe
 		transaction = &tx.NewTransactionNotification
 	}
 
-	txResult := filterAndInclude(clientReq, transaction, remoteAddress, accountID)
-	if txResult != nil {
-		*multiTxsResponse = append(*multiTxsResponse, makeTransaction(*transaction))
+	if tx := g.feedManager.grpcTx(clientReq, transaction); tx != nil {
+		*multiTxsResponse = append(*multiTxsResponse, tx)
 	}
 }
 
 func (g *GrpcHandler) handleTransactions(req *pb.TxsRequest, stream pb.Gateway_NewTxsServer, feedType types.FeedType, account sdnmessage.Account) error {
-	var expr conditions.Expr
+	var txsFilter *txFilter
 	if req.GetFilters() != "" {
 		var err error
-		expr, err = createFiltersExpression(req.GetFilters())
+		txsFilter, err = compileTxFilter(req.GetFilters(), g.feedManager.abiRegistry)
 		if err != nil {
 			return err
 		}
@@ -197,13 +237,19 @@ func (g *GrpcHandler) handleTransactions(req *pb.TxsRequest, stream pb.Gateway_N
 	if p, ok := peer.FromContext(stream.Context()); ok {
 		ci.RemoteAddress = p.Addr.String()
 	}
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
 	ro := types.ReqOptions{
-		Filters: req.GetFilters(),
+		Filters:      req.GetFilters(),
+		ResumeFrom:   req.GetResumeFrom(),
+		Backpressure: backpressure,
 	}
 
 	sub, err := g.feedManager.Subscribe(feedType, types.GRPCFeed, nil, ci, ro, false)
 	if err != nil {
-		return errors.New("failed to subscribe to gRPC pendingTxs")
+		return fmt.Errorf("failed to subscribe to gRPC %v: %v", feedType, err)
 	}
 	defer func() {
 		err = g.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
@@ -212,22 +258,31 @@ func (g *GrpcHandler) handleTransactions(req *pb.TxsRequest, stream pb.Gateway_N
 		}
 	}()
 
-	clientReq := &clientReq{includes: req.GetIncludes(), expr: expr, feed: feedType}
+	clientReq := &clientReq{includes: req.GetIncludes(), filter: txsFilter, feed: feedType, abis: g.feedManager.abiRegistry}
 
 	var txsResponse []*pb.Tx
-	for notification := range sub.FeedChan {
-		processTx(clientReq, notification, &txsResponse, ci.RemoteAddress, account.AccountID, feedType)
-
-		if len(sub.FeedChan) == 0 || len(txsResponse) == maxTxsInSingleResponse {
-			err = stream.Send(&pb.TxsReply{Tx: txsResponse})
-			if err != nil {
+	for {
+		select {
+		case status := <-sub.StatusChan:
+			if err = stream.Send(&pb.TxsReply{SubscriptionStatus: generateSubscriptionStatusReply(status)}); err != nil {
 				return err
 			}
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				return nil
+			}
+			g.processTx(clientReq, notification, &txsResponse, feedType)
 
-			txsResponse = txsResponse[:0]
+			if len(sub.FeedChan) == 0 || len(txsResponse) == maxTxsInSingleResponse {
+				err = stream.Send(&pb.TxsReply{Tx: txsResponse})
+				if err != nil {
+					return err
+				}
+
+				txsResponse = txsResponse[:0]
+			}
 		}
 	}
-	return nil
 }
 
 // NewBlocks handler for stream of new blocks
@@ -252,7 +307,11 @@ func (g *GrpcHandler) EthOnBlock(req *pb.EthOnBlockRequest, stream pb.Gateway_Et
 		ci.RemoteAddress = p.Addr.String()
 	}
 
-	sub, err := g.feedManager.Subscribe(types.OnBlockFeed, types.GRPCFeed, nil, ci, types.ReqOptions{}, false)
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+	sub, err := g.feedManager.Subscribe(types.OnBlockFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Backpressure: backpressure}, false)
 	if err != nil {
 		return errors.New("failed to subscribe to gRPC ethOnBlock")
 	}
@@ -282,9 +341,18 @@ func (g *GrpcHandler) EthOnBlock(req *pb.EthOnBlockRequest, stream pb.Gateway_Et
 	}
 
 	for {
-		notification, ok := <-sub.FeedChan
-		if !ok {
-			return fmt.Errorf("error when reading new block from gRPC ethOnBlock")
+		var notification types.Notification
+		select {
+		case status := <-sub.StatusChan:
+			if err = stream.Send(&pb.EthOnBlockReply{SubscriptionStatus: generateSubscriptionStatusReply(status)}); err != nil {
+				return err
+			}
+			continue
+		case n, ok := <-sub.FeedChan:
+			if !ok {
+				return fmt.Errorf("error when reading new block from gRPC ethOnBlock")
+			}
+			notification = n
 		}
 
 		block := notification.(*types.EthBlockNotification)
@@ -303,14 +371,27 @@ func (g *GrpcHandler) EthOnBlock(req *pb.EthOnBlockRequest, stream pb.Gateway_Et
 
 // TxReceipts handler for stream of all transaction receipts in each newly mined block
 func (g *GrpcHandler) TxReceipts(req *pb.TxReceiptsRequest, stream pb.Gateway_TxReceiptsServer, account sdnmessage.Account) error {
+	var receiptsFilter *receiptFilter
+	if req.GetFilters() != "" {
+		var err error
+		receiptsFilter, err = compileReceiptFilter(req.GetFilters())
+		if err != nil {
+			return err
+		}
+	}
+
 	ci := types.ClientInfo{
 		AccountID: account.AccountID,
 		Tier:      string(account.TierName),
 		MetaInfo:  types.SDKMetaFromContext(stream.Context()),
 	}
-	sub, err := g.feedManager.Subscribe(types.TxReceiptsFeed, types.GRPCFeed, nil, ci, types.ReqOptions{}, false)
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+	sub, err := g.feedManager.Subscribe(types.TxReceiptsFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Filters: req.GetFilters(), ResumeFrom: req.GetResumeFrom(), Backpressure: backpressure}, false)
 	if err != nil {
-		return errors.New("failed to subscribe to gRPC txReceipts")
+		return fmt.Errorf("failed to subscribe to gRPC txReceipts: %v", err)
 	}
 	defer func(feedManager *FeedManager, subscriptionID string, closeClientConnection bool, errMsg string) {
 		err = feedManager.Unsubscribe(subscriptionID, closeClientConnection, errMsg)
@@ -327,9 +408,18 @@ func (g *GrpcHandler) TxReceipts(req *pb.TxReceiptsRequest, stream pb.Gateway_Tx
 	}
 
 	for {
-		notification, ok := <-sub.FeedChan
-		if !ok {
-			return fmt.Errorf("error when reading new block from gRPC txReceipts")
+		var notification types.Notification
+		select {
+		case status := <-sub.StatusChan:
+			if err = stream.Send(&pb.TxReceiptsReply{SubscriptionStatus: generateSubscriptionStatusReply(status)}); err != nil {
+				return err
+			}
+			continue
+		case n, ok := <-sub.FeedChan:
+			if !ok {
+				return fmt.Errorf("error when reading new block from gRPC txReceipts")
+			}
+			notification = n
 		}
 
 		block := notification.(*types.EthBlockNotification)
@@ -339,13 +429,555 @@ func (g *GrpcHandler) TxReceipts(req *pb.TxReceiptsRequest, stream pb.Gateway_Tx
 			return stream.Send(grpcTxReceiptsNotificationReply)
 		}
 
-		err = handleTxReceipts(g.feedManager, block, sendTxReceiptsGrpcNotification)
+		err = handleTxReceipts(g.feedManager, block, receiptsFilter, sendTxReceiptsGrpcNotification)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// Logs handler for stream of the logs matching the addresses and topics in each newly mined block
+func (g *GrpcHandler) Logs(req *pb.LogsRequest, stream pb.Gateway_LogsServer, account sdnmessage.Account) error {
+	addresses := make([]interface{}, 0, len(req.GetAddresses()))
+	for _, address := range req.GetAddresses() {
+		addresses = append(addresses, address)
+	}
+	topics := make([]interface{}, 0, len(req.GetTopics()))
+	for _, alternatives := range req.GetTopics() {
+		if len(alternatives.GetTopics()) == 0 {
+			topics = append(topics, nil)
+			continue
+		}
+		topic := make([]interface{}, 0, len(alternatives.GetTopics()))
+		for _, alternative := range alternatives.GetTopics() {
+			topic = append(topic, alternative)
+		}
+		topics = append(topics, topic)
+	}
+	logsFilter, err := newLogFilter(addresses, topics)
+	if err != nil {
+		return err
+	}
+	logs := newLogsSubscription(logsFilter)
+
+	includes := req.GetIncludes()
+	for _, include := range includes {
+		if !utils.Exists(include, validLogParams) {
+			return fmt.Errorf("got unsupported param %v", include)
+		}
+	}
+	if len(includes) == 0 {
+		includes = validLogParams
+	}
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+
+	ci := types.ClientInfo{
+		AccountID: account.AccountID,
+		Tier:      string(account.TierName),
+		MetaInfo:  types.SDKMetaFromContext(stream.Context()),
+	}
+	sub, err := g.feedManager.Subscribe(types.LogsFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Backpressure: backpressure}, false)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to gRPC logs: %v", err)
+	}
+	defer func(feedManager *FeedManager, subscriptionID string, closeClientConnection bool, errMsg string) {
+		err = feedManager.Unsubscribe(subscriptionID, closeClientConnection, errMsg)
+		if err != nil {
+			return
+		}
+	}(g.feedManager, sub.SubscriptionID, false, "")
+
+	for {
+		var notification types.Notification
+		select {
+		case status := <-sub.StatusChan:
+			if err = stream.Send(&pb.TxLogs{SubscriptionStatus: generateSubscriptionStatusReply(status)}); err != nil {
+				return err
+			}
+			continue
+		case n, ok := <-sub.FeedChan:
+			if !ok {
+				return fmt.Errorf("error when reading new block from gRPC logs")
+			}
+			notification = n
+		}
+
+		block := notification.(*types.EthBlockNotification)
+		sendLogsGrpcNotification := func(notification *types.LogNotification) error {
+			logNotificationReply := notification.WithFields(includes).(*types.LogNotification)
+			return stream.Send(generateLogReply(logNotificationReply))
+		}
+
+		err = handleLogs(g.feedManager, block, logs, sendLogsGrpcNotification)
 		if err != nil {
 			return err
 		}
 	}
 }
 
+// TransactionStatus handles the gRPC transactionStatus feed
+func (g *GrpcHandler) TransactionStatus(req *pb.TransactionStatusRequest, stream pb.Gateway_TransactionStatusServer, account sdnmessage.Account) error {
+	ci := types.ClientInfo{
+		AccountID: account.AccountID,
+		Tier:      string(account.TierName),
+		MetaInfo:  types.SDKMetaFromContext(stream.Context()),
+	}
+
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		ci.RemoteAddress = p.Addr.String()
+	}
+
+	includes := req.GetIncludes()
+	for _, include := range includes {
+		if !utils.Exists(include, validTxStatusParams) {
+			return fmt.Errorf("got unsupported param %v", include)
+		}
+	}
+	if len(includes) == 0 {
+		includes = validTxStatusParams
+	}
+
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+
+	sub, err := g.feedManager.Subscribe(types.TransactionStatusFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Includes: strings.Join(includes, ","), Backpressure: backpressure}, false)
+	if err != nil {
+		return errors.New("failed to subscribe to gRPC transactionStatus")
+	}
+	defer g.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
+
+	// the first reply only carries the subscription ID, it is required to monitor more transactions later
+	if err = stream.Send(&pb.TransactionStatusReply{SubscriptionId: sub.SubscriptionID}); err != nil {
+		return err
+	}
+
+	if len(req.GetTransactionHashes()) > 0 {
+		if err = g.feedManager.StartMonitoringTransactions(sub.SubscriptionID, account.AccountID, req.GetTransactionHashes()); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case status := <-sub.StatusChan:
+			err = stream.Send(&pb.TransactionStatusReply{
+				SubscriptionId:     sub.SubscriptionID,
+				SubscriptionStatus: generateSubscriptionStatusReply(status),
+			})
+			if err != nil {
+				return err
+			}
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				return errors.New("error when reading new notification for gRPC transactionStatus")
+			}
+
+			txStatus := notification.WithFields(includes).(*types.TransactionStatusNotification)
+			err = stream.Send(&pb.TransactionStatusReply{
+				SubscriptionId:  sub.SubscriptionID,
+				TransactionHash: txStatus.TransactionHash,
+				Status:          string(txStatus.Status),
+				BlockHeight:     txStatus.BlockHeight,
+				BlockHash:       txStatus.BlockHash,
+				ReplacedBy:      txStatus.ReplacedBy,
+			})
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// MempoolEvents handles the gRPC mempoolEvents feed
+func (g *GrpcHandler) MempoolEvents(req *pb.MempoolEventsRequest, stream pb.Gateway_MempoolEventsServer, account sdnmessage.Account) error {
+	ci := types.ClientInfo{
+		AccountID: account.AccountID,
+		Tier:      string(account.TierName),
+		MetaInfo:  types.SDKMetaFromContext(stream.Context()),
+	}
+
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		ci.RemoteAddress = p.Addr.String()
+	}
+
+	includes := req.GetIncludes()
+	for _, include := range includes {
+		if !utils.Exists(include, validMempoolEventParams) {
+			return fmt.Errorf("got unsupported param %v", include)
+		}
+	}
+	if len(includes) == 0 {
+		includes = validMempoolEventParams
+	}
+
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+
+	sub, err := g.feedManager.Subscribe(types.MempoolEventsFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Includes: strings.Join(includes, ","), Backpressure: backpressure}, false)
+	if err != nil {
+		return errors.New("failed to subscribe to gRPC mempoolEvents")
+	}
+	defer g.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
+
+	for {
+		select {
+		case status := <-sub.StatusChan:
+			if err = stream.Send(&pb.MempoolEventsReply{SubscriptionStatus: generateSubscriptionStatusReply(status)}); err != nil {
+				return err
+			}
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				return errors.New("error when reading new notification for gRPC mempoolEvents")
+			}
+
+			event := notification.WithFields(includes).(*types.MempoolEventNotification)
+			err = stream.Send(&pb.MempoolEventsReply{
+				Event:           string(event.Event),
+				TransactionHash: event.TransactionHash,
+				ReplacedBy:      event.ReplacedBy,
+				BlockHeight:     event.BlockHeight,
+				BlockHash:       event.BlockHash,
+			})
+			if err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// StartMonitorTransaction adds transactions to a gRPC transactionStatus subscription
+func (g *GrpcHandler) StartMonitorTransaction(req *pb.MonitorTransactionRequest, account sdnmessage.Account) (*pb.MonitorTransactionReply, error) {
+	if err := g.feedManager.StartMonitoringTransactions(req.GetSubscriptionId(), account.AccountID, req.GetTransactionHashes()); err != nil {
+		return nil, err
+	}
+	return &pb.MonitorTransactionReply{}, nil
+}
+
+// StopMonitorTransaction removes transactions from a gRPC transactionStatus subscription
+func (g *GrpcHandler) StopMonitorTransaction(req *pb.MonitorTransactionRequest, account sdnmessage.Account) (*pb.MonitorTransactionReply, error) {
+	if err := g.feedManager.StopMonitoringTransactions(req.GetSubscriptionId(), account.AccountID, req.GetTransactionHashes()); err != nil {
+		return nil, err
+	}
+	return &pb.MonitorTransactionReply{}, nil
+}
+
+// NewBeaconBlocks handler for stream of new beacon blocks
+func (g *GrpcHandler) NewBeaconBlocks(req *pb.BeaconBlocksRequest, stream pb.Gateway_NewBeaconBlocksServer, account sdnmessage.Account) error {
+	return g.handleBeaconBlocks(req, stream, types.NewBeaconBlocksFeed, account)
+}
+
+// BdnBeaconBlocks handler for stream of BDN beacon blocks
+func (g *GrpcHandler) BdnBeaconBlocks(req *pb.BeaconBlocksRequest, stream pb.Gateway_BdnBeaconBlocksServer, account sdnmessage.Account) error {
+	return g.handleBeaconBlocks(req, stream, types.BDNBeaconBlocksFeed, account)
+}
+
+// BlobSidecars handler for stream of blob sidecars
+func (g *GrpcHandler) BlobSidecars(req *pb.BlobSidecarsRequest, stream pb.Gateway_BlobSidecarsServer, account sdnmessage.Account) error {
+	ci := types.ClientInfo{
+		AccountID: account.AccountID,
+		Tier:      string(account.TierName),
+		MetaInfo:  types.SDKMetaFromContext(stream.Context()),
+	}
+
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		ci.RemoteAddress = p.Addr.String()
+	}
+
+	includes := req.GetIncludes()
+	for _, include := range includes {
+		if !utils.Exists(include, validBlobSidecarParams) {
+			return fmt.Errorf("got unsupported param %v", include)
+		}
+	}
+	if len(includes) == 0 {
+		includes = validBlobSidecarParams
+	}
+
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+
+	sub, err := g.feedManager.Subscribe(types.BlobSidecarsFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Includes: strings.Join(includes, ","), Backpressure: backpressure}, false)
+	if err != nil {
+		return errors.New("failed to subscribe to gRPC blobSidecars")
+	}
+	defer g.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
+
+	for {
+		select {
+		case status := <-sub.StatusChan:
+			err = stream.Send(&pb.BlobSidecarsReply{
+				SubscriptionId:     sub.SubscriptionID,
+				SubscriptionStatus: generateSubscriptionStatusReply(status),
+			})
+			if err != nil {
+				return err
+			}
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				// closed by Unsubscribe
+				return nil
+			}
+
+			reply := generateBlobSidecarReply(notification.(*types.BlobSidecarNotification), includes)
+			reply.SubscriptionId = sub.SubscriptionID
+
+			if err = stream.Send(reply); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+// generateBlobSidecarReply returns the included fields of the blob sidecar, the numbers and the blob are taken from the sidecar
+func generateBlobSidecarReply(notification *types.BlobSidecarNotification, includes []string) *pb.BlobSidecarsReply {
+	sidecar := notification.Sidecar()
+	header := sidecar.GetSignedBlockHeader().GetHeader()
+
+	reply := &pb.BlobSidecarsReply{}
+	for _, include := range includes {
+		switch include {
+		case "block_root":
+			reply.BlockRoot = notification.BlockRoot
+		case "index":
+			reply.Index = sidecar.Index
+		case "slot":
+			reply.Slot = uint64(header.GetSlot())
+		case "proposer_index":
+			reply.ProposerIndex = uint64(header.GetProposerIndex())
+		case "kzg_commitment":
+			reply.KzgCommitment = notification.KzgCommitment
+		case "kzg_proof":
+			reply.KzgProof = notification.KzgProof
+		case "versioned_hash":
+			reply.VersionedHash = notification.VersionedHash
+		case "blob":
+			reply.Blob = sidecar.Blob
+		}
+	}
+
+	return reply
+}
+
+// BlxrSubmitBundle submits a MEV bundle the same way blxr_submit_bundle does over websocket
+func (g *GrpcHandler) BlxrSubmitBundle(req *pb.BlxrSubmitBundleRequest, account sdnmessage.Account, remoteAddress string) (*pb.BlxrSubmitBundleReply, error) {
+	params := &jsonrpc.RPCBundleSubmissionPayload{
+		BlockchainNetwork: bxgateway.Mainnet,
+		MEVBuilders:       req.GetMevBuilders(),
+		Frontrunning:      req.GetFrontrunning(),
+		Transaction:       req.GetTransactions(),
+		BlockNumber:       req.GetBlockNumber(),
+		MinTimestamp:      int(req.GetMinTimestamp()),
+		MaxTimestamp:      int(req.GetMaxTimestamp()),
+		RevertingHashes:   req.GetRevertingHashes(),
+		UUID:              req.GetUuid(),
+		BundlePrice:       req.GetBundlePrice(),
+		EnforcePayout:     req.GetEnforcePayout(),
+	}
+
+	// If MEVBuilders request parameter is empty, only send to default builders.
+	if len(params.MEVBuilders) == 0 {
+		params.MEVBuilders = map[string]string{
+			bxgateway.BloxrouteBuilderName: "",
+			bxgateway.FlashbotsBuilderName: "",
+		}
+	}
+
+	mevBundle, bundleHash, err := mevBundleFromRequest(params)
+	reply := &pb.BlxrSubmitBundleReply{}
+	if params.UUID == "" {
+		reply.BundleHash = bundleHash
+	}
+	if err != nil {
+		if errors.Is(err, errBlockedTxHashes) {
+			return reply, nil
+		}
+		return nil, err
+	}
+	mevBundle.SetNetworkNum(g.feedManager.networkNum)
+
+	if !account.TierName.IsElite() {
+		log.Tracef("%s rejected for non EnterpriseElite account %v tier %v", mevBundle, account.AccountID, account.TierName)
+		return nil, errors.New("EnterpriseElite account is required in order to send bundle")
+	}
+
+	grpc := connections.NewRPCConn(account.AccountID, remoteAddress, g.feedManager.networkNum, utils.GRPC)
+	if err = g.feedManager.node.HandleMsg(mevBundle, grpc, connections.RunForeground); err != nil {
+		// err here is not possible right now but anyway we don't want expose reason of internal error to the client
+		log.Errorf("failed to process %s: %v", mevBundle, err)
+		return nil, errors.New("failed to process bundle")
+	}
+
+	return reply, nil
+}
+
+// Unsubscribe closes a subscription, the stream serving it ends
+func (g *GrpcHandler) Unsubscribe(req *pb.UnsubscribeRequest) (*pb.UnsubscribeReply, error) {
+	if req.GetSubscriptionId() == "" {
+		return nil, errors.New("subscription ID is missing in the request")
+	}
+	if err := g.feedManager.Unsubscribe(req.GetSubscriptionId(), false, ""); err != nil {
+		log.Infof("subscription id %v was not found", req.GetSubscriptionId())
+		return &pb.UnsubscribeReply{Success: false}, nil
+	}
+	return &pb.UnsubscribeReply{Success: true}, nil
+}
+
+// RegisterAbi registers the methods of the contract ABI for the filters and decoded_input of the transactions feeds
+func (g *GrpcHandler) RegisterAbi(req *pb.RegisterAbiRequest) (*pb.RegisterAbiReply, error) {
+	methods, err := g.feedManager.RegisterABI([]byte(req.GetAbi()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to register ABI: %v", err)
+	}
+	return &pb.RegisterAbiReply{Methods: methods}, nil
+}
+
+func (g *GrpcHandler) handleBeaconBlocks(req *pb.BeaconBlocksRequest, stream pb.Gateway_BdnBeaconBlocksServer, feedType types.FeedType, account sdnmessage.Account) error {
+	ci := types.ClientInfo{
+		AccountID: account.AccountID,
+		Tier:      string(account.TierName),
+		MetaInfo:  types.SDKMetaFromContext(stream.Context()),
+	}
+
+	if p, ok := peer.FromContext(stream.Context()); ok {
+		ci.RemoteAddress = p.Addr.String()
+	}
+
+	includes := req.GetIncludes()
+	for _, include := range includes {
+		if !utils.Exists(include, validBeaconBlockParams) {
+			return fmt.Errorf("got unsupported param %v", include)
+		}
+	}
+	if len(includes) == 0 {
+		includes = validBeaconBlockParams
+	}
+
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
+	if err != nil {
+		return err
+	}
+
+	sub, err := g.feedManager.Subscribe(feedType, types.GRPCFeed, nil, ci, types.ReqOptions{Includes: strings.Join(includes, ","), Backpressure: backpressure}, false)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to gRPC %v", feedType)
+	}
+	defer g.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
+
+	for {
+		select {
+		case status := <-sub.StatusChan:
+			err = stream.Send(&pb.BeaconBlocksReply{
+				SubscriptionId:     sub.SubscriptionID,
+				SubscriptionStatus: generateSubscriptionStatusReply(status),
+			})
+			if err != nil {
+				return err
+			}
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				// closed by Unsubscribe
+				return nil
+			}
+
+			reply, err := generateBeaconBlockReply(notification, includes)
+			if err != nil {
+				log.Errorf("failed to generate gRPC %v reply: %v", feedType, err)
+				continue
+			}
+			reply.SubscriptionId = sub.SubscriptionID
+
+			if err = stream.Send(reply); err != nil {
+				return err
+			}
+		case <-stream.Context().Done():
+			return nil
+		}
+	}
+}
+
+type grpcBeaconBlock interface {
+	GetSlot() primitives.Slot
+	GetProposerIndex() primitives.ValidatorIndex
+	GetParentRoot() []byte
+	GetStateRoot() []byte
+}
+
+type grpcBeaconBlockBody interface {
+	MarshalSSZ() ([]byte, error)
+	HashTreeRoot() ([32]byte, error)
+}
+
+func generateBeaconBlockReply(notification types.Notification, includes []string) (*pb.BeaconBlocksReply, error) {
+	var (
+		hash     string
+		blockVer int
+		block    grpcBeaconBlock
+		body     grpcBeaconBlockBody
+	)
+
+	switch n := notification.(type) {
+	case *types.ElectraBlockNotification:
+		hash, blockVer = n.Hash, types.VersionElectra
+		block, body = n.GetBlock(), n.GetBlock().GetBody()
+	case *types.DenebBlockNotification:
+		hash, blockVer = n.Hash, types.VersionDeneb
+		block, body = n.GetBlock(), n.GetBlock().GetBody()
+	case *types.CapellaBlockNotification:
+		hash, blockVer = n.Hash, version.Capella
+		block, body = n.GetBlock(), n.GetBlock().GetBody()
+	case *types.BellatrixBlockNotification:
+		hash, blockVer = n.Hash, version.Bellatrix
+		block, body = n.GetBlock(), n.GetBlock().GetBody()
+	default:
+		return nil, fmt.Errorf("unexpected beacon block notification %T", notification)
+	}
+
+	reply := &pb.BeaconBlocksReply{Version: types.BeaconVersionString(blockVer)}
+	for _, include := range includes {
+		switch include {
+		case "hash":
+			reply.Hash = hash
+		case "slot":
+			reply.Slot = uint64(block.GetSlot())
+		case "header":
+			bodyRoot, err := body.HashTreeRoot()
+			if err != nil {
+				return nil, err
+			}
+			reply.Header = &pb.BeaconBlockHeader{
+				Slot:          uint64(block.GetSlot()),
+				ProposerIndex: uint64(block.GetProposerIndex()),
+				ParentRoot:    hexutil.Encode(block.GetParentRoot()),
+				StateRoot:     hexutil.Encode(block.GetStateRoot()),
+				BodyRoot:      hexutil.Encode(bodyRoot[:]),
+			}
+		case "body":
+			ssz, err := body.MarshalSSZ()
+			if err != nil {
+				return nil, err
+			}
+			reply.Body = ssz
+		}
+	}
+
+	return reply, nil
+}
+
 func (g *GrpcHandler) handleBlocks(req *pb.BlocksRequest, stream pb.Gateway_BdnBlocksServer, feedType types.FeedType, account sdnmessage.Account) error {
 	ci := types.ClientInfo{
 		AccountID: account.AccountID,
@@ -357,9 +989,14 @@ func (g *GrpcHandler) handleBlocks(req *pb.BlocksRequest, stream pb.Gateway_BdnB
 		ci.RemoteAddress = p.Addr.String()
 	}
 
-	sub, err := g.feedManager.Subscribe(feedType, types.GRPCFeed, nil, ci, types.ReqOptions{}, false)
+	backpressure, err := types.NewBackpressurePolicy(req.GetBackpressure())
 	if err != nil {
-		return errors.New("failed to subscribe to gRPC bdnBlocks")
+		return err
+	}
+
+	sub, err := g.feedManager.Subscribe(feedType, types.GRPCFeed, nil, ci, types.ReqOptions{ResumeFrom: req.GetResumeFrom(), Backpressure: backpressure}, false)
+	if err != nil {
+		return fmt.Errorf("failed to subscribe to gRPC %v: %v", feedType, err)
 	}
 	defer g.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
 
@@ -372,6 +1009,10 @@ func (g *GrpcHandler) handleBlocks(req *pb.BlocksRequest, stream pb.Gateway_BdnB
 
 	for {
 		select {
+		case status := <-sub.StatusChan:
+			if err = stream.Send(&pb.BlocksReply{SubscriptionStatus: generateSubscriptionStatusReply(status)}); err != nil {
+				return err
+			}
 		case notification, ok := <-sub.FeedChan:
 			if !ok {
 				return errors.New("error when reading new notification for gRPC bdnBlocks")
@@ -380,6 +1021,7 @@ func (g *GrpcHandler) handleBlocks(req *pb.BlocksRequest, stream pb.Gateway_BdnB
 			blocks := notification.WithFields(includes).(*types.EthBlockNotification)
 			blocksReply := g.generateBlockReply(blocks)
 			blocksReply.SubscriptionID = sub.SubscriptionID
+			blocksReply.Sequence = types.NotificationSequence(notification)
 
 			err = stream.Send(blocksReply)
 			if err != nil {
@@ -389,6 +1031,13 @@ func (g *GrpcHandler) handleBlocks(req *pb.BlocksRequest, stream pb.Gateway_BdnB
 	}
 }
 
+func generateSubscriptionStatusReply(status types.SubscriptionStatus) *pb.SubscriptionStatus {
+	return &pb.SubscriptionStatus{
+		Backpressure: string(status.Backpressure),
+		Dropped:      status.Dropped,
+	}
+}
+
 func interfaceToString(value interface{}) string {
 	if stringValue, ok := value.(string); ok {
 		return stringValue
@@ -154,7 +154,7 @@ func TestClientHandler(t *testing.T) {
 
 	var group errgroup.Group
 	sourceFromNode := false
-	clientHandler := NewClientHandler(fm, nil, NewHTTPServer(fm, cfg.HTTPPort), true, nil, log.WithFields(log.Fields{
+	clientHandler := NewClientHandler(fm, nil, NewHTTPServer(fm, cfg.HTTPPort, getMockQuotaUsage, true, nil, mockAuthorize), true, nil, log.WithFields(log.Fields{
 		"component": "gatewayClientHandler",
 	}), &sourceFromNode, mockAuthorize)
 	go clientHandler.ManageWSServer(cfg.ManageWSServer)
@@ -167,10 +167,11 @@ func TestClientHandler(t *testing.T) {
 	BscWsURLs := fmt.Sprintf("ws://%s/ws", urlBSC)
 	blockchainPeersBSC, blockchainPeersInfoBSC := test.GenerateBlockchainPeersInfo(1)
 
-	fmBSC := NewFeedManager(context.Background(), g, feedChan, services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 56, types.NodeID("nodeID"), eth.NewEthWSManager(blockchainPeersInfoBSC, eth.NewMockWSProvider, bxgateway.WSProviderTimeout, false), gwAccount, getMockCustomerAccountModel, "", "", cfgBSC, stats, nil, nil)
+	// the BSC feed manager has its own feed, so it does not take the notifications of the first one
+	fmBSC := NewFeedManager(context.Background(), g, make(chan types.Notification), services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 56, types.NodeID("nodeID"), eth.NewEthWSManager(blockchainPeersInfoBSC, eth.NewMockWSProvider, bxgateway.WSProviderTimeout, false), gwAccount, getMockCustomerAccountModel, "", "", cfgBSC, stats, nil, nil)
 	p4 := providers[blockchainPeersBSC[0].IPPort()]
 	assert.NotNil(t, p4)
-	clientHandlerBSC := NewClientHandler(fmBSC, nil, NewHTTPServer(fmBSC, cfg.HTTPPort+1), false, getMockQuotaUsage, log.WithFields(log.Fields{
+	clientHandlerBSC := NewClientHandler(fmBSC, nil, NewHTTPServer(fmBSC, cfg.HTTPPort+1, getMockQuotaUsage, false, nil, mockAuthorize), false, getMockQuotaUsage, log.WithFields(log.Fields{
 		"component": "gatewayClientHandlerBSC",
 	}), &sourceFromNode, mockAuthorize)
 	go clientHandlerBSC.ManageWSServer(false)
@@ -240,7 +241,7 @@ func TestClientHandler(t *testing.T) {
 		})
 		// restart bc last test shut down ws server
 		fm = NewFeedManager(context.Background(), g, make(chan types.Notification), services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 1, types.NodeID("nodeID"), eth.NewEthWSManager(blockchainPeersInfo, eth.NewMockWSProvider, bxgateway.WSProviderTimeout, false), gwAccount, getMockCustomerAccountModel, "", "", cfg, stats, nil, nil)
-		clientHandler = NewClientHandler(fm, nil, NewHTTPServer(fm, cfg.HTTPPort), true, getMockQuotaUsage, log.WithFields(log.Fields{
+		clientHandler = NewClientHandler(fm, nil, NewHTTPServer(fm, cfg.HTTPPort, getMockQuotaUsage, true, nil, mockAuthorize), true, getMockQuotaUsage, log.WithFields(log.Fields{
 			"component": "gatewayClientHandler",
 		}), &sourceFromNode, mockAuthorize)
 		go clientHandler.ManageWSServer(cfg.ManageWSServer)
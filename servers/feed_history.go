@@ -0,0 +1,106 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+)
+
+// number of notifications kept for each resumable feed
+var feedHistorySize = map[types.FeedType]int{
+	types.NewTxsFeed:     10000,
+	types.NewBlocksFeed:  100,
+	types.TxReceiptsFeed: 100,
+}
+
+// txReceiptIndexBits is the number of the low bits of a receipt sequence holding the position of the transaction in
+// its block, so each receipt has its own sequence, following the sequences of the receipts of the previous blocks
+const txReceiptIndexBits = 16
+
+// ErrResumeGap is returned when a client resumes a subscription from a sequence which is no longer kept
+var ErrResumeGap = errors.New("notifications were missed")
+
+// feedHistory is a ring buffer of the latest notifications of a feed. Each notification gets a monotonic sequence,
+// starting from 1, so a client reconnecting can get the notifications it missed.
+// feedHistory is not thread safe, it is guarded by the FeedManager lock
+type feedHistory struct {
+	notifications []types.SequencedNotification
+	// lastSequence is the sequence of the latest notification
+	lastSequence uint64
+}
+
+func newFeedHistory(size int) *feedHistory {
+	return &feedHistory{notifications: make([]types.SequencedNotification, size)}
+}
+
+// add assigns the next sequence to the notification and keeps it, overwriting the oldest one if the buffer is full
+func (h *feedHistory) add(notification types.SequencedNotification) {
+	h.lastSequence++
+	notification.SetSequence(h.lastSequence)
+	h.notifications[h.lastSequence%uint64(len(h.notifications))] = notification
+}
+
+// since returns the notifications following the given sequence, oldest first
+func (h *feedHistory) since(sequence uint64) ([]types.Notification, error) {
+	if sequence > h.lastSequence {
+		return nil, fmt.Errorf("%w: sequence %v is ahead of the feed, latest sequence is %v", ErrResumeGap, sequence, h.lastSequence)
+	}
+
+	var oldest uint64 = 1
+	if h.lastSequence > uint64(len(h.notifications)) {
+		oldest = h.lastSequence - uint64(len(h.notifications)) + 1
+	}
+	if sequence+1 < oldest {
+		return nil, fmt.Errorf("%w: sequence %v is out of the resume window, oldest sequence kept is %v", ErrResumeGap, sequence, oldest)
+	}
+
+	missed := make([]types.Notification, 0, h.lastSequence-sequence)
+	for seq := sequence + 1; seq <= h.lastSequence; seq++ {
+		missed = append(missed, h.notifications[seq%uint64(len(h.notifications))])
+	}
+	return missed, nil
+}
+
+// txReceiptSequence returns the sequence of the receipt of the transaction at txIndex in the block of blockSequence
+func txReceiptSequence(blockSequence, txIndex uint64) uint64 {
+	return blockSequence<<txReceiptIndexBits | (txIndex + 1)
+}
+
+// splitTxReceiptSequence returns the sequence of the block of the receipt, and the number of the block transactions
+// up to the transaction of the receipt
+func splitTxReceiptSequence(sequence uint64) (blockSequence uint64, txCount uint64) {
+	return sequence >> txReceiptIndexBits, sequence & (1<<txReceiptIndexBits - 1)
+}
+
+// missedNotifications returns the notifications of the feed following the sequence. The txReceipts feed keeps the
+// blocks, and can be resumed in the middle of a block: the block is replayed with the transactions following
+// the one of the last receipt received
+func (f *FeedManager) missedNotifications(feedName types.FeedType, sequence uint64) ([]types.Notification, error) {
+	history := f.history[feedName]
+	if feedName != types.TxReceiptsFeed {
+		return history.since(sequence)
+	}
+
+	blockSequence, txCount := splitTxReceiptSequence(sequence)
+	if txCount == 0 {
+		return history.since(blockSequence)
+	}
+	if blockSequence == 0 {
+		return nil, fmt.Errorf("%w: sequence %v is not a receipt sequence", ErrResumeGap, sequence)
+	}
+
+	missed, err := history.since(blockSequence - 1)
+	if err != nil {
+		return nil, err
+	}
+	if block, ok := missed[0].(*types.EthBlockNotification); ok {
+		remaining := block.Clone().(*types.EthBlockNotification)
+		remaining.Transactions = nil
+		if txCount < uint64(len(block.Transactions)) {
+			remaining.Transactions = block.Transactions[txCount:]
+		}
+		missed[0] = remaining
+	}
+	return missed, nil
+}
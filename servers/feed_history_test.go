@@ -0,0 +1,162 @@
+package servers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/bloXroute-Labs/gateway/v2/config"
+	"github.com/bloXroute-Labs/gateway/v2/services"
+	"github.com/bloXroute-Labs/gateway/v2/services/statistics"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newSequencedBlockNotification(t *testing.T, height uint64) *types.EthBlockNotification {
+	block := bxmock.NewEthBlock(height, common.Hash{})
+	notification, err := types.NewEthBlockNotification(block.Hash(), block, nil)
+	require.NoError(t, err)
+	notification.SetNotificationType(types.NewBlocksFeed)
+	return notification
+}
+
+func TestFeedHistory(t *testing.T) {
+	history := newFeedHistory(3)
+
+	missed, err := history.since(0)
+	require.NoError(t, err)
+	assert.Empty(t, missed)
+
+	for height := uint64(1); height <= 5; height++ {
+		history.add(newSequencedBlockNotification(t, height))
+	}
+
+	missed, err = history.since(2)
+	require.NoError(t, err)
+	require.Len(t, missed, 3)
+	for i, notification := range missed {
+		assert.Equal(t, uint64(3+i), types.NotificationSequence(notification))
+	}
+
+	missed, err = history.since(5)
+	require.NoError(t, err)
+	assert.Empty(t, missed)
+
+	_, err = history.since(1)
+	assert.True(t, errors.Is(err, ErrResumeGap))
+
+	_, err = history.since(6)
+	assert.True(t, errors.Is(err, ErrResumeGap))
+}
+
+func TestFeedManager_Resume(t *testing.T) {
+	feedChan := make(chan types.Notification)
+	gwAccount, _ := getMockCustomerAccountModel("gw")
+	fm := NewFeedManager(context.Background(), bxmock.MockBxListener{}, feedChan, services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 1, types.NodeID("nodeID"), nil, gwAccount, getMockCustomerAccountModel, "", "", config.Bx{}, statistics.NoStats{}, nil, nil)
+	fm.history[types.NewBlocksFeed] = newFeedHistory(3)
+	require.NoError(t, fm.Start())
+
+	ci := types.ClientInfo{AccountID: gwAccount.AccountID, RemoteAddress: "127.0.0.1:1234"}
+	live, err := fm.Subscribe(types.NewBlocksFeed, types.GRPCFeed, nil, ci, types.ReqOptions{}, false)
+	require.NoError(t, err)
+
+	for height := uint64(1); height <= 5; height++ {
+		feedChan <- newSequencedBlockNotification(t, height)
+		notification := <-live.FeedChan
+		assert.Equal(t, height, types.NotificationSequence(notification))
+	}
+
+	// the missed notifications come first, then the new ones
+	resumed, err := fm.Subscribe(types.NewBlocksFeed, types.GRPCFeed, nil, ci, types.ReqOptions{ResumeFrom: 3}, false)
+	require.NoError(t, err)
+	feedChan <- newSequencedBlockNotification(t, 6)
+	for sequence := uint64(4); sequence <= 6; sequence++ {
+		notification := <-resumed.FeedChan
+		assert.Equal(t, sequence, types.NotificationSequence(notification))
+	}
+
+	_, err = fm.Subscribe(types.NewBlocksFeed, types.GRPCFeed, nil, ci, types.ReqOptions{ResumeFrom: 2}, false)
+	assert.True(t, errors.Is(err, ErrResumeGap))
+
+	_, err = fm.Subscribe(types.PendingTxsFeed, types.GRPCFeed, nil, ci, types.ReqOptions{ResumeFrom: 2}, false)
+	assert.Error(t, err)
+}
+
+func TestFeedManager_ResumeTxReceipts(t *testing.T) {
+	fm := &FeedManager{history: map[types.FeedType]*feedHistory{types.TxReceiptsFeed: newFeedHistory(3)}}
+	for height := uint64(1); height <= 3; height++ {
+		block := bxmock.NewEthBlock(height, common.Hash{})
+		notification, err := types.NewEthBlockNotification(block.Hash(), block, nil)
+		require.NoError(t, err)
+		notification.SetNotificationType(types.TxReceiptsFeed)
+		fm.history[types.TxReceiptsFeed].add(notification)
+	}
+	block := fm.history[types.TxReceiptsFeed].notifications[2].(*types.EthBlockNotification)
+	require.Greater(t, len(block.Transactions), 2)
+
+	// each receipt has its own sequence following the receipts of the previous block
+	first := txReceiptSequence(2, 0)
+	second := txReceiptSequence(2, 1)
+	assert.Greater(t, second, first)
+	assert.Greater(t, first, txReceiptSequence(1, 1000))
+
+	// resuming in the middle of a block replays the transactions following the last receipt received
+	missed, err := fm.missedNotifications(types.TxReceiptsFeed, second)
+	require.NoError(t, err)
+	require.Len(t, missed, 2)
+	replayed := missed[0].(*types.EthBlockNotification)
+	assert.Equal(t, uint64(2), replayed.Sequence())
+	assert.Equal(t, block.Transactions[2:], replayed.Transactions)
+	assert.Len(t, block.Transactions, len(replayed.Transactions)+2)
+	assert.Equal(t, uint64(3), types.NotificationSequence(missed[1]))
+
+	// the block sequence resumes from the following block
+	missed, err = fm.missedNotifications(types.TxReceiptsFeed, 2<<txReceiptIndexBits)
+	require.NoError(t, err)
+	require.Len(t, missed, 1)
+	assert.Equal(t, uint64(3), types.NotificationSequence(missed[0]))
+
+	// the last receipt of the block replays the block without transactions
+	missed, err = fm.missedNotifications(types.TxReceiptsFeed, txReceiptSequence(2, uint64(len(block.Transactions)-1)))
+	require.NoError(t, err)
+	require.Len(t, missed, 2)
+	assert.Empty(t, missed[0].(*types.EthBlockNotification).Transactions)
+
+	_, err = fm.missedNotifications(types.TxReceiptsFeed, 1)
+	assert.True(t, errors.Is(err, ErrResumeGap))
+}
+
+type receiptsWSProvider struct {
+	blockchain.WSProvider
+}
+
+func (p receiptsWSProvider) FetchTransactionReceipt(payload []interface{}, _ blockchain.RPCOptions) (interface{}, error) {
+	time.Sleep(time.Duration(rand.Intn(5)) * time.Millisecond)
+	return map[string]interface{}{"transactionHash": payload[0]}, nil
+}
+
+func TestFetchTxReceipts_Ordered(t *testing.T) {
+	block := &types.EthBlockNotification{}
+	for i := 0; i < 20; i++ {
+		block.Transactions = append(block.Transactions, map[string]interface{}{"hash": fmt.Sprintf("0x%x", i)})
+	}
+
+	// the receipts are fetched concurrently, but handled in the order of the transactions, one at a time
+	var hashes []interface{}
+	err := fetchTxReceipts(receiptsWSProvider{}, block, func(receipt map[string]interface{}) error {
+		hashes = append(hashes, receipt["transactionHash"])
+		return nil
+	})
+	require.NoError(t, err)
+	require.Len(t, hashes, len(block.Transactions))
+	for i, tx := range block.Transactions {
+		assert.Equal(t, tx["hash"], hashes[i])
+	}
+}
@@ -2,9 +2,11 @@ package servers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bloXroute-Labs/gateway/v2"
@@ -12,6 +14,7 @@ import (
 	"github.com/bloXroute-Labs/gateway/v2/bxmessage"
 	"github.com/bloXroute-Labs/gateway/v2/config"
 	"github.com/bloXroute-Labs/gateway/v2/connections"
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
 	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
 	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
@@ -20,11 +23,17 @@ import (
 	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils/orderedmap"
 	"github.com/bloXroute-Labs/gateway/v2/utils/syncmap"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/sourcegraph/jsonrpc2"
 )
 
 const (
 	pendingNextValidatorListInitCapacity = 100
+	// subscriptionStatusInterval is how often the clients are told how many notifications were dropped by the
+	// backpressure policy of their subscription
+	subscriptionStatusInterval = 10 * time.Second
 )
 
 // ClientSubscription contains client subscription feed and connection
@@ -39,6 +48,15 @@ type ClientSubscription struct {
 	timeOpenedFeed     time.Time
 	messagesSent       uint64
 	errMsgChan         chan string
+	statusChan         chan types.SubscriptionStatus
+	backpressure       *backpressureStats
+}
+
+// backpressureStats counts the notifications dropped by the backpressure policy of a subscription
+type backpressureStats struct {
+	dropped atomic.Uint64
+	// reported is the dropped count of the last status sent to the client, only accessed by the run loop
+	reported uint64
 }
 
 // ClientSubscriptionHandlingInfo contains all info needed by subscription handler
@@ -46,6 +64,7 @@ type ClientSubscriptionHandlingInfo struct {
 	SubscriptionID     string
 	FeedChan           chan types.Notification
 	ErrMsgChan         chan string
+	StatusChan         chan types.SubscriptionStatus
 	PermissionRespChan chan *sdnmessage.SubscriptionPermissionMessage
 }
 
@@ -78,6 +97,13 @@ type FeedManager struct {
 	validatorStatusMap                  *syncmap.SyncMap[string, bool]
 	pendingBSCNextValidatorTxHashToInfo map[string]PendingNextValidatorTxInfo
 	pendingBSCNextValidatorTxsMapLock   sync.Mutex
+	txStatusMonitor                     *services.TxStatusMonitor
+	txStore                             services.TxStore
+	privateTxService                    *services.PrivateTxService
+	history                             map[types.FeedType]*feedHistory
+	abiRegistry                         *services.ABIRegistry
+	payloads                            *payloadCache
+	rpcProxy                            *rpcProxy
 
 	context context.Context
 	cancel  context.CancelFunc
@@ -118,13 +144,45 @@ func NewFeedManager(parent context.Context, node connections.BxListener, wsFeedC
 		stats:                               stats,
 		log:                                 logger,
 		pendingBSCNextValidatorTxHashToInfo: make(map[string]PendingNextValidatorTxInfo),
+		history:                             make(map[types.FeedType]*feedHistory),
+		abiRegistry:                         services.NewABIRegistry(),
+		payloads:                            newPayloadCache(payloadCacheSize),
+	}
+	if len(cfg.RPCProxyMethods) > 0 && wsManager != nil {
+		newServer.rpcProxy = newRPCProxy(wsManager, cfg.RPCProxyMethods)
+	}
+	for _, feedType := range types.ResumableFeeds {
+		newServer.history[feedType] = newFeedHistory(feedHistorySize[feedType])
+	}
+	if cfg.ABIDir != "" {
+		if err := newServer.abiRegistry.LoadDir(cfg.ABIDir); err != nil {
+			logger.Errorf("failed to load ABIs from %v: %v", cfg.ABIDir, err)
+		}
 	}
 	return newServer
 }
 
+// SetTxStatusMonitor sets the monitor of the transactionStatus feed and the start/stop_monitor_transaction methods
+func (f *FeedManager) SetTxStatusMonitor(txStatusMonitor *services.TxStatusMonitor) {
+	f.txStatusMonitor = txStatusMonitor
+}
+
+// SetTxStore sets the TxStore used to validate the fee bump transactions
+func (f *FeedManager) SetTxStore(txStore services.TxStore) {
+	f.txStore = txStore
+}
+
+// SetPrivateTxService sets the service of the blxr_private_tx and private_tx_balance methods
+func (f *FeedManager) SetPrivateTxService(privateTxService *services.PrivateTxService) {
+	f.privateTxService = privateTxService
+}
+
 // Start - start feed manager
 func (f *FeedManager) Start() error {
 	go f.run()
+	if f.rpcProxy != nil {
+		go f.rpcProxy.run(f.context)
+	}
 	return nil
 }
 
@@ -156,6 +214,16 @@ func (f *FeedManager) checkForDuplicateFeed(clientSubscription *ClientSubscripti
 func (f *FeedManager) Subscribe(feedName types.FeedType, feedConnectionType types.FeedConnectionType,
 	conn *jsonrpc2.Conn, ci types.ClientInfo, ro types.ReqOptions, ethSubscribe bool) (*ClientSubscriptionHandlingInfo, error) {
 
+	if ro.ResumeFrom != 0 && !types.Exists(feedName, types.ResumableFeeds) {
+		return nil, fmt.Errorf("%v feed can not be resumed, resumable feeds are %v", feedName, types.ResumableFeeds)
+	}
+	if ro.Backpressure == "" {
+		ro.Backpressure = types.BackpressureDisconnect
+	}
+	if ro.Backpressure == types.BackpressureCoalesce && !types.Exists(feedName, types.CoalescableFeeds) {
+		return nil, fmt.Errorf("%v feed can not be coalesced, coalescable feeds are %v", feedName, types.CoalescableFeeds)
+	}
+
 	id := f.subscriptionServices.GenerateSubscriptionID(ethSubscribe)
 	clientSubscription := ClientSubscription{
 		feed:               make(chan types.Notification, bxgateway.BxNotificationChannelSize),
@@ -165,6 +233,8 @@ func (f *FeedManager) Subscribe(feedName types.FeedType, feedConnectionType type
 		network:            f.networkNum,
 		timeOpenedFeed:     time.Now(),
 		errMsgChan:         make(chan string, 1),
+		statusChan:         make(chan types.SubscriptionStatus, 1),
+		backpressure:       &backpressureStats{},
 		ClientInfo:         ci,
 		ReqOptions:         ro,
 	}
@@ -190,15 +260,30 @@ func (f *FeedManager) Subscribe(feedName types.FeedType, feedConnectionType type
 	}
 
 	f.lock.Lock()
+	if ro.ResumeFrom != 0 {
+		// the missed notifications are queued before any new one, the feed channel is extended to hold them
+		missed, err := f.missedNotifications(feedName, ro.ResumeFrom)
+		if err != nil {
+			f.lock.Unlock()
+			f.subscriptionServices.SendUnsubscribeNotification(&subscriptionModel)
+			return nil, err
+		}
+		clientSubscription.feed = make(chan types.Notification, bxgateway.BxNotificationChannelSize+len(missed))
+		for _, notification := range missed {
+			clientSubscription.feed <- notification
+		}
+		f.log.Debugf("subscription %v resumed %v from sequence %v, %v notifications replayed", id, feedName, ro.ResumeFrom, len(missed))
+	}
 	f.idToClientSubscription[id] = clientSubscription
 	f.lock.Unlock()
 
-	f.log.Infof("%v subscribed to %v id %v with includes [%v] and filter [%v]", ci.RemoteAddress, feedName, id, ro.Includes, ro.Filters)
+	f.log.Infof("%v subscribed to %v id %v with includes [%v], filter [%v] and backpressure %v", ci.RemoteAddress, feedName, id, ro.Includes, ro.Filters, ro.Backpressure)
 
 	handlingInfo := ClientSubscriptionHandlingInfo{
 		SubscriptionID:     id,
 		FeedChan:           clientSubscription.feed,
 		ErrMsgChan:         clientSubscription.errMsgChan,
+		StatusChan:         clientSubscription.statusChan,
 		PermissionRespChan: permissionRespChannel,
 	}
 	return &handlingInfo, nil
@@ -250,6 +335,10 @@ func (f *FeedManager) Unsubscribe(subscriptionID string, closeClientConnection b
 		f.networkNum,
 		clientSub.AccountID,
 		sdnmessage.AccountTier(clientSub.Tier))
+	if clientSub.feedType == types.TransactionStatusFeed && f.txStatusMonitor != nil {
+		_ = f.txStatusMonitor.StopMonitoring(subscriptionID, nil)
+	}
+
 	close(clientSub.feed)
 	delete(f.idToClientSubscription, subscriptionID)
 	if closeClientConnection && clientSub.connection != nil {
@@ -285,37 +374,118 @@ func (f *FeedManager) run() {
 	f.log.Infof("feedManager is Starting for network %v", f.networkNum)
 	defer f.log.Infof("feedManager stopped for network %v", f.networkNum)
 
+	statusTicker := time.NewTicker(subscriptionStatusInterval)
+	defer statusTicker.Stop()
+
 	for {
 		select {
+		case <-statusTicker.C:
+			f.sendSubscriptionStatus()
 		case notification, ok := <-f.feed:
 			if !ok {
 				f.log.Errorf("can't pull from ws feed channel. Terminating")
 				break
 			}
-			f.lock.RLock()
-			for uid, clientSub := range f.idToClientSubscription {
-				if (clientSub.feedConnectionType == types.WebSocketFeed || clientSub.feedConnectionType == types.GRPCFeed) && clientSub.feedType == notification.NotificationType() {
-					select {
-					case clientSub.feed <- notification:
-						// Offer: I took this out as we are locking the map in read and can't write.
-						// also, do we need to update the map after we update the counter?
-						// if entry, ok := f.idToClientSubscription[uid]; ok {
-						//	entry.messagesSent++
-						//	f.idToClientSubscription[uid] = entry
-						// }
-					default:
-						f.log.Errorf("can't send %v to channel %v without blocking. Ignored hash %v and unsubscribing", clientSub.feedType, uid, notification.GetHash())
-						go func(subscriptionID string) {
-							// running as go-routine since we are holding the lock. Closing the connection since we can't write
-							if err := f.Unsubscribe(subscriptionID, true, ""); err != nil {
-								f.log.Debugf("unable to Unsubscribe %v - %v", subscriptionID, err)
-							}
-							// TODO: mark clientSub as "being closed" to prevent multiple Unsubscribe
-						}(uid)
-					}
+			if history, ok := f.history[notification.NotificationType()]; ok {
+				// the notifications of the resumable feeds are kept and delivered holding the write lock,
+				// so a resuming subscription gets each of them either replayed or delivered
+				f.lock.Lock()
+				if sequenced, ok := notification.(types.SequencedNotification); ok {
+					history.add(sequenced)
 				}
+				f.deliverAll(notification)
+				f.lock.Unlock()
+			} else {
+				f.lock.RLock()
+				f.deliverAll(notification)
+				f.lock.RUnlock()
 			}
-			f.lock.RUnlock()
+		}
+	}
+}
+
+// deliverAll sends the notification to the subscriptions of its feed. It is called holding the lock
+func (f *FeedManager) deliverAll(notification types.Notification) {
+	for uid, clientSub := range f.idToClientSubscription {
+		if (clientSub.feedConnectionType == types.WebSocketFeed || clientSub.feedConnectionType == types.GRPCFeed || clientSub.feedConnectionType == types.SSEFeed || clientSub.feedConnectionType == types.RecorderFeed) && clientSub.feedType == notification.NotificationType() {
+			// transaction status notifications are only sent to the subscriptions monitoring the transaction
+			if txStatus, ok := notification.(*types.TransactionStatusNotification); ok && !txStatus.IsSubscribed(uid) {
+				continue
+			}
+			f.deliver(uid, clientSub, notification)
+		}
+	}
+}
+
+// deliver sends the notification to the subscription, applying its backpressure policy if the feed channel is full.
+// It is called holding the lock, so the feed channel can not be closed meanwhile
+func (f *FeedManager) deliver(uid string, clientSub ClientSubscription, notification types.Notification) {
+	if clientSub.Backpressure == types.BackpressureCoalesce {
+		// the blocks not read yet by the client are replaced by the latest one
+		f.dropQueued(clientSub, len(clientSub.feed))
+	}
+
+	select {
+	case clientSub.feed <- notification:
+		// Offer: I took this out as we are locking the map in read and can't write.
+		// also, do we need to update the map after we update the counter?
+		// if entry, ok := f.idToClientSubscription[uid]; ok {
+		//	entry.messagesSent++
+		//	f.idToClientSubscription[uid] = entry
+		// }
+		return
+	default:
+	}
+
+	switch clientSub.Backpressure {
+	case types.BackpressureDropNewest:
+		clientSub.backpressure.dropped.Add(1)
+	case types.BackpressureDropOldest, types.BackpressureCoalesce:
+		f.dropQueued(clientSub, 1)
+		select {
+		case clientSub.feed <- notification:
+		default:
+			clientSub.backpressure.dropped.Add(1)
+		}
+	default:
+		f.log.Errorf("can't send %v to channel %v without blocking. Ignored hash %v and unsubscribing", clientSub.feedType, uid, notification.GetHash())
+		go func(subscriptionID string) {
+			// running as go-routine since we are holding the lock. Closing the connection since we can't write
+			if err := f.Unsubscribe(subscriptionID, true, ""); err != nil {
+				f.log.Debugf("unable to Unsubscribe %v - %v", subscriptionID, err)
+			}
+			// TODO: mark clientSub as "being closed" to prevent multiple Unsubscribe
+		}(uid)
+	}
+}
+
+// dropQueued drops up to count of the oldest notifications waiting in the feed channel of the subscription
+func (f *FeedManager) dropQueued(clientSub ClientSubscription, count int) {
+	for i := 0; i < count; i++ {
+		select {
+		case <-clientSub.feed:
+			clientSub.backpressure.dropped.Add(1)
+		default:
+			// the client read the remaining notifications meanwhile
+			return
+		}
+	}
+}
+
+// sendSubscriptionStatus tells the clients of the subscriptions which dropped notifications since their last status
+// how many notifications were dropped. A status is skipped if the client did not read the previous one yet
+func (f *FeedManager) sendSubscriptionStatus() {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+	for _, clientSub := range f.idToClientSubscription {
+		dropped := clientSub.backpressure.dropped.Load()
+		if dropped == clientSub.backpressure.reported {
+			continue
+		}
+		select {
+		case clientSub.statusChan <- types.SubscriptionStatus{Backpressure: clientSub.Backpressure, Dropped: dropped}:
+			clientSub.backpressure.reported = dropped
+		default:
 		}
 	}
 }
@@ -331,6 +501,139 @@ func (f *FeedManager) SubscriptionExists(subscriptionID string) bool {
 	return false
 }
 
+// SubmitFeeBumpTransaction validates that the transaction replaces a transaction already known by the gateway with the
+// same sender and nonce, and propagates it with high priority. It returns the hashes of the new and the replaced transactions
+func (f *FeedManager) SubmitFeeBumpTransaction(transaction string, source connections.Conn, nodeValidation bool, frontRunningProtection bool) (string, string, error) {
+	if f.txStore == nil {
+		return "", "", fmt.Errorf("fee bump is not supported by this gateway")
+	}
+
+	txBytes, err := types.DecodeHex(transaction)
+	if err != nil {
+		return "", "", err
+	}
+	var rawEthTx ethtypes.Transaction
+	if err = rawEthTx.UnmarshalBinary(txBytes); err != nil {
+		if rlp.DecodeBytes(txBytes, &rawEthTx) != nil {
+			return "", "", err
+		}
+	}
+	var hash types.SHA256Hash
+	copy(hash[:], rawEthTx.Hash().Bytes())
+	ethTx, err := types.NewEthTransaction(hash, &rawEthTx, types.EmptySender)
+	if err != nil {
+		return "", "", err
+	}
+
+	replacedHash, err := f.txStore.ValidateFeeBump(ethTx, f.networkNum)
+	if err != nil {
+		return "", "", err
+	}
+
+	tx, _, err := ValidateTxFromExternalSource(transaction, txBytes, false, f.chainID, false, 0, nil, nil, f.networkNum, source.GetAccountID(), nodeValidation, f.nodeWSManager, source, nil, frontRunningProtection)
+	if err != nil {
+		return "", "", err
+	}
+	tx.SetPriority(bxmessage.HighPriority)
+
+	if err = f.node.HandleMsg(tx, source, connections.RunForeground); err != nil {
+		return "", "", fmt.Errorf("failed to propagate fee bump transaction %v: %v", tx.Hash(), err)
+	}
+	return tx.Hash().String(), replacedHash.String(), nil
+}
+
+// SubmitPrivateTransaction sends the transaction to the MEV builders, and on BSC and Polygon to the next validator,
+// without exposing it to the public mempool. The transaction is re-broadcast until it is mined
+func (f *FeedManager) SubmitPrivateTransaction(transaction string, source connections.Conn) (string, error) {
+	if f.privateTxService == nil {
+		return "", fmt.Errorf("private transactions are not supported by this gateway")
+	}
+
+	txBytes, err := types.DecodeHex(transaction)
+	if err != nil {
+		return "", err
+	}
+	tx, _, err := ValidateTxFromExternalSource(transaction, txBytes, false, f.chainID, false, 0, nil, nil, f.networkNum, source.GetAccountID(), false, f.nodeWSManager, source, nil, false)
+	if err != nil {
+		return "", err
+	}
+
+	privateTx := &services.PrivateTx{
+		Hash:          tx.Hash(),
+		RawTx:         hexutil.Encode(txBytes),
+		Content:       tx.Content(),
+		AccountID:     source.GetAccountID(),
+		NextValidator: f.networkNum == bxgateway.BSCMainnetNum || f.networkNum == bxgateway.PolygonMainnetNum,
+		Source:        source,
+	}
+	if err = f.privateTxService.Submit(privateTx); err != nil {
+		return "", err
+	}
+	return tx.Hash().String(), nil
+}
+
+// PrivateTxBalance returns the private transactions submitted by the account
+func (f *FeedManager) PrivateTxBalance(accountID types.AccountID) (services.PrivateTxBalance, error) {
+	if f.privateTxService == nil {
+		return services.PrivateTxBalance{}, fmt.Errorf("private transactions are not supported by this gateway")
+	}
+	return f.privateTxService.Balance(accountID), nil
+}
+
+// RegisterABI registers the methods of the contract ABI, so their arguments can be used in filters and decoded_input
+func (f *FeedManager) RegisterABI(abiJSON []byte) ([]string, error) {
+	return f.abiRegistry.Register(abiJSON)
+}
+
+// SimulateBundle simulates the bundle on top of the state block using a synced blockchain node
+func (f *FeedManager) SimulateBundle(payload *jsonrpc.RPCBundleSimulationPayload) (*BundleSimulationResult, error) {
+	if f.nodeWSManager == nil {
+		return nil, errors.New("failed to simulate bundle, no synced blockchain node is connected over websocket")
+	}
+	provider, ok := f.nodeWSManager.SyncedProvider()
+	if !ok {
+		return nil, errors.New("failed to simulate bundle, no synced blockchain node is connected over websocket")
+	}
+	return simulateBundle(provider, int64(f.chainID), payload)
+}
+
+// StartMonitoringTransactions registers transaction hashes to be monitored by a transactionStatus subscription
+func (f *FeedManager) StartMonitoringTransactions(subscriptionID string, accountID types.AccountID, txHashes []string) error {
+	if err := f.validateTxStatusSubscription(subscriptionID, accountID); err != nil {
+		return err
+	}
+	if len(txHashes) == 0 {
+		return fmt.Errorf("transaction_hashes is a required field")
+	}
+	return f.txStatusMonitor.Monitor(subscriptionID, txHashes)
+}
+
+// StopMonitoringTransactions removes monitored transaction hashes from a transactionStatus subscription.
+// If no hashes are provided all the transactions monitored by the subscription are removed
+func (f *FeedManager) StopMonitoringTransactions(subscriptionID string, accountID types.AccountID, txHashes []string) error {
+	if err := f.validateTxStatusSubscription(subscriptionID, accountID); err != nil {
+		return err
+	}
+	return f.txStatusMonitor.StopMonitoring(subscriptionID, txHashes)
+}
+
+func (f *FeedManager) validateTxStatusSubscription(subscriptionID string, accountID types.AccountID) error {
+	if f.txStatusMonitor == nil {
+		return fmt.Errorf("%v feed is not supported", types.TransactionStatusFeed)
+	}
+
+	f.lock.RLock()
+	clientSub, exists := f.idToClientSubscription[subscriptionID]
+	f.lock.RUnlock()
+	if !exists || clientSub.AccountID != accountID {
+		return fmt.Errorf("subscription %v was not found", subscriptionID)
+	}
+	if clientSub.feedType != types.TransactionStatusFeed {
+		return fmt.Errorf("subscription %v is not a %v subscription", subscriptionID, types.TransactionStatusFeed)
+	}
+	return nil
+}
+
 // NeedBlocks checks if feedManager should receive block notifications
 func (f *FeedManager) NeedBlocks() bool {
 	f.lock.RLock()
@@ -348,17 +651,20 @@ func (f *FeedManager) GetGrpcSubscriptionReply() *pb.SubscriptionsReply {
 	resp := &pb.SubscriptionsReply{}
 	f.lock.RLock()
 	defer f.lock.RUnlock()
-	for _, clientData := range f.idToClientSubscription {
+	for subscriptionID, clientData := range f.idToClientSubscription {
 		subscribe := &pb.Subscription{
-			AccountId:    string(clientData.AccountID),
-			Tier:         clientData.Tier,
-			FeedName:     string(clientData.feedType),
-			Network:      uint32(clientData.network),
-			RemoteAddr:   clientData.RemoteAddress,
-			Include:      clientData.Includes,
-			Filter:       clientData.Filters,
-			Age:          uint64(time.Since(clientData.timeOpenedFeed).Seconds()),
-			MessagesSent: clientData.messagesSent,
+			AccountId:      string(clientData.AccountID),
+			Tier:           clientData.Tier,
+			FeedName:       string(clientData.feedType),
+			Network:        uint32(clientData.network),
+			RemoteAddr:     clientData.RemoteAddress,
+			Include:        clientData.Includes,
+			Filter:         clientData.Filters,
+			Age:            uint64(time.Since(clientData.timeOpenedFeed).Seconds()),
+			MessagesSent:   clientData.messagesSent,
+			SubscriptionId: subscriptionID,
+			Backpressure:   string(clientData.Backpressure),
+			Dropped:        clientData.backpressure.dropped.Load(),
 		}
 		resp.Subscriptions = append(resp.Subscriptions, subscribe)
 	}
@@ -0,0 +1,106 @@
+package servers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2"
+	"github.com/bloXroute-Labs/gateway/v2/config"
+	"github.com/bloXroute-Labs/gateway/v2/services"
+	"github.com/bloXroute-Labs/gateway/v2/services/statistics"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFeedManager_Backpressure(t *testing.T) {
+	gwAccount, _ := getMockCustomerAccountModel("gw")
+	fm := NewFeedManager(context.Background(), bxmock.MockBxListener{}, make(chan types.Notification), services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 1, types.NodeID("nodeID"), nil, gwAccount, getMockCustomerAccountModel, "", "", config.Bx{}, statistics.NoStats{}, nil, nil)
+	ci := types.ClientInfo{AccountID: gwAccount.AccountID, RemoteAddress: "127.0.0.1:1234"}
+
+	blocks := make([]types.Notification, 0, bxgateway.BxNotificationChannelSize+2)
+	for height := uint64(1); height <= bxgateway.BxNotificationChannelSize+2; height++ {
+		blocks = append(blocks, newSequencedBlockNotification(t, height))
+	}
+	height := func(notification types.Notification) uint64 {
+		return notification.(*types.EthBlockNotification).Header.GetNumber()
+	}
+	subscribe := func(policy types.BackpressurePolicy) (*ClientSubscriptionHandlingInfo, ClientSubscription) {
+		sub, err := fm.Subscribe(types.NewBlocksFeed, types.GRPCFeed, nil, ci, types.ReqOptions{Backpressure: policy}, false)
+		require.NoError(t, err)
+		return sub, fm.idToClientSubscription[sub.SubscriptionID]
+	}
+
+	t.Run("drop newest", func(t *testing.T) {
+		sub, clientSub := subscribe(types.BackpressureDropNewest)
+		for _, block := range blocks {
+			fm.deliver(sub.SubscriptionID, clientSub, block)
+		}
+		assert.Equal(t, uint64(2), clientSub.backpressure.dropped.Load())
+		require.Len(t, sub.FeedChan, bxgateway.BxNotificationChannelSize)
+		assert.Equal(t, uint64(1), height(<-sub.FeedChan))
+	})
+
+	t.Run("drop oldest", func(t *testing.T) {
+		sub, clientSub := subscribe(types.BackpressureDropOldest)
+		for _, block := range blocks {
+			fm.deliver(sub.SubscriptionID, clientSub, block)
+		}
+		assert.Equal(t, uint64(2), clientSub.backpressure.dropped.Load())
+		require.Len(t, sub.FeedChan, bxgateway.BxNotificationChannelSize)
+		assert.Equal(t, uint64(3), height(<-sub.FeedChan))
+	})
+
+	t.Run("coalesce", func(t *testing.T) {
+		sub, clientSub := subscribe(types.BackpressureCoalesce)
+		for _, block := range blocks[:3] {
+			fm.deliver(sub.SubscriptionID, clientSub, block)
+		}
+		assert.Equal(t, uint64(2), clientSub.backpressure.dropped.Load())
+		require.Len(t, sub.FeedChan, 1)
+		assert.Equal(t, uint64(3), height(<-sub.FeedChan))
+
+		for _, feed := range []types.FeedType{types.NewTxsFeed, types.TxReceiptsFeed, types.LogsFeed, types.OnBlockFeed} {
+			_, err := fm.Subscribe(feed, types.GRPCFeed, nil, ci, types.ReqOptions{Backpressure: types.BackpressureCoalesce}, false)
+			assert.Error(t, err, feed)
+		}
+	})
+
+	t.Run("status", func(t *testing.T) {
+		sub, clientSub := subscribe(types.BackpressureDropNewest)
+		fm.sendSubscriptionStatus()
+		assert.Empty(t, sub.StatusChan)
+
+		for _, block := range blocks {
+			fm.deliver(sub.SubscriptionID, clientSub, block)
+		}
+		fm.sendSubscriptionStatus()
+		require.Len(t, sub.StatusChan, 1)
+		assert.Equal(t, types.SubscriptionStatus{Backpressure: types.BackpressureDropNewest, Dropped: 2}, <-sub.StatusChan)
+
+		// the status is only sent again when more notifications are dropped
+		fm.sendSubscriptionStatus()
+		assert.Empty(t, sub.StatusChan)
+
+		for _, reply := range fm.GetGrpcSubscriptionReply().GetSubscriptions() {
+			if reply.GetSubscriptionId() == sub.SubscriptionID {
+				assert.Equal(t, string(types.BackpressureDropNewest), reply.GetBackpressure())
+				assert.Equal(t, uint64(2), reply.GetDropped())
+			}
+		}
+	})
+
+	t.Run("disconnect", func(t *testing.T) {
+		sub, clientSub := subscribe("")
+		assert.Equal(t, types.BackpressureDisconnect, clientSub.Backpressure)
+		fm.lock.RLock()
+		for _, block := range blocks {
+			fm.deliver(sub.SubscriptionID, clientSub, block)
+		}
+		fm.lock.RUnlock()
+		assert.Eventually(t, func() bool { return !fm.SubscriptionExists(sub.SubscriptionID) }, time.Second, 10*time.Millisecond)
+		assert.Zero(t, clientSub.backpressure.dropped.Load())
+	})
+}
@@ -0,0 +1,398 @@
+package servers
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/config"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/natefinch/lumberjack.v2"
+)
+
+// RecordingFormat is the format of the feed recording files
+type RecordingFormat string
+
+// RecordingFormat enumeration
+const (
+	// JSONRecordingFormat - a JSON FeedRecord per line, its result is the result of the websocket notification
+	JSONRecordingFormat RecordingFormat = "json"
+	// ProtobufRecordingFormat - pb.FeedRecord messages prefixed by their length, their replies are the gRPC replies
+	ProtobufRecordingFormat RecordingFormat = "protobuf"
+)
+
+// recordingFileName is the name of the recording file, the rotated files get the time of the rotation in their name
+const recordingFileName = "feeds"
+
+// RecordedFeeds are the feeds which can be recorded
+var RecordedFeeds = []types.FeedType{types.NewTxsFeed, types.NewBlocksFeed, types.BDNBlocksFeed, types.TxReceiptsFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed}
+
+// Extension returns the extension of the recording files with the format
+func (f RecordingFormat) Extension() string {
+	if f == ProtobufRecordingFormat {
+		return ".pb"
+	}
+	return ".jsonl"
+}
+
+// NewRecordingFormat returns the recording format of the name
+func NewRecordingFormat(name string) (RecordingFormat, error) {
+	switch format := RecordingFormat(name); format {
+	case JSONRecordingFormat, ProtobufRecordingFormat:
+		return format, nil
+	default:
+		return "", fmt.Errorf("invalid recording format %v, valid formats are %v and %v", name, JSONRecordingFormat, ProtobufRecordingFormat)
+	}
+}
+
+// FeedRecord is a recorded notification of a feed
+type FeedRecord struct {
+	Time   time.Time       `json:"time"`
+	Feed   types.FeedType  `json:"feed"`
+	Result json.RawMessage `json:"result,omitempty"`
+	// Dropped is the number of notifications of the feed dropped from the recording before the record, a record of
+	// dropped notifications has no result
+	Dropped uint64 `json:"dropped,omitempty"`
+	// Reply is the record of the protobuf recordings, its reply is set according to the feed
+	Reply *pb.FeedRecord `json:"-"`
+}
+
+// FeedRecorder writes the notifications of the recorded feeds to a local file, rotated by size and optionally by
+// time. The records of all the feeds are written to the same file in the order they are received. The notifications
+// dropped when the recorder falls behind are counted, and a record of their number marks the gap in the recording
+type FeedRecorder struct {
+	feedManager      *FeedManager
+	feeds            []types.FeedType
+	format           RecordingFormat
+	rotationInterval time.Duration
+	file             *lumberjack.Logger
+	lock             sync.Mutex
+	writer           *bufio.Writer
+	dropped          atomic.Uint64
+	log              *log.Entry
+}
+
+// NewFeedRecorder creates a new FeedRecorder of the feeds of the feed manager
+func NewFeedRecorder(feedManager *FeedManager, cfg *config.FeedRecording) (*FeedRecorder, error) {
+	format, err := NewRecordingFormat(cfg.Format)
+	if err != nil {
+		return nil, err
+	}
+	for _, feed := range cfg.Feeds {
+		if !types.Exists(feed, RecordedFeeds) {
+			return nil, fmt.Errorf("%v feed can not be recorded, recorded feeds are %v", feed, RecordedFeeds)
+		}
+	}
+	if err = os.MkdirAll(cfg.Dir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create the recording directory %v: %v", cfg.Dir, err)
+	}
+
+	file := &lumberjack.Logger{
+		Filename:   filepath.Join(cfg.Dir, recordingFileName+format.Extension()),
+		MaxSize:    cfg.MaxFileSize,
+		MaxBackups: cfg.MaxBackupFiles,
+	}
+	return &FeedRecorder{
+		feedManager:      feedManager,
+		feeds:            cfg.Feeds,
+		format:           format,
+		rotationInterval: cfg.RotationInterval,
+		file:             file,
+		writer:           bufio.NewWriter(file),
+		log:              log.WithField("component", "feedRecorder"),
+	}, nil
+}
+
+// Run records the feeds until the context is done
+func (r *FeedRecorder) Run(ctx context.Context) error {
+	ci := types.ClientInfo{
+		AccountID:     r.feedManager.accountModel.AccountID,
+		Tier:          string(r.feedManager.accountModel.TierName),
+		RemoteAddress: "feedRecorder",
+	}
+	// the recorder must not slow down the feeds, the oldest notifications are dropped if it falls behind and the
+	// gaps are recorded
+	ro := types.ReqOptions{Backpressure: types.BackpressureDropOldest}
+
+	subscriptions := make([]*ClientSubscriptionHandlingInfo, 0, len(r.feeds))
+	defer func() {
+		for _, sub := range subscriptions {
+			_ = r.feedManager.Unsubscribe(sub.SubscriptionID, false, "")
+		}
+	}()
+	var wg sync.WaitGroup
+	for _, feed := range r.feeds {
+		sub, err := r.feedManager.Subscribe(feed, types.RecorderFeed, nil, ci, ro, false)
+		if err != nil {
+			return fmt.Errorf("failed to subscribe to %v feed: %v", feed, err)
+		}
+		subscriptions = append(subscriptions, sub)
+
+		wg.Add(1)
+		go func(feed types.FeedType, sub *ClientSubscriptionHandlingInfo) {
+			defer wg.Done()
+			r.recordFeed(ctx, feed, sub)
+		}(feed, sub)
+	}
+	r.log.Infof("recording feeds %v to %v", r.feeds, r.file.Filename)
+
+	var rotation <-chan time.Time
+	if r.rotationInterval > 0 {
+		ticker := time.NewTicker(r.rotationInterval)
+		defer ticker.Stop()
+		rotation = ticker.C
+	}
+	flush := time.NewTicker(time.Second)
+	defer flush.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wg.Wait()
+			return r.close()
+		case <-flush.C:
+			r.lock.Lock()
+			if err := r.writer.Flush(); err != nil {
+				r.log.Errorf("failed to flush the recording: %v", err)
+			}
+			r.lock.Unlock()
+		case <-rotation:
+			r.lock.Lock()
+			err := r.writer.Flush()
+			if err == nil {
+				err = r.file.Rotate()
+			}
+			r.lock.Unlock()
+			if err != nil {
+				r.log.Errorf("failed to rotate the recording: %v", err)
+			}
+		}
+	}
+}
+
+func (r *FeedRecorder) recordFeed(ctx context.Context, feed types.FeedType, sub *ClientSubscriptionHandlingInfo) {
+	var dropped uint64
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case status := <-sub.StatusChan:
+			// the status has the total number of notifications dropped by the subscription
+			if status.Dropped <= dropped {
+				continue
+			}
+			r.log.Warnf("%v notifications of the %v feed were dropped from the recording", status.Dropped-dropped, feed)
+			if err := r.RecordDropped(feed, status.Dropped-dropped); err != nil {
+				r.log.Errorf("failed to record the dropped %v notifications: %v", feed, err)
+			}
+			dropped = status.Dropped
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				return
+			}
+			if err := r.Record(feed, notification); err != nil {
+				r.log.Errorf("failed to record %v notification %v: %v", feed, notification.GetHash(), err)
+			}
+		}
+	}
+}
+
+// Record writes the records of the notification of the feed, the txReceipts feed gets a record per receipt of the block
+func (r *FeedRecorder) Record(feed types.FeedType, notification types.Notification) error {
+	now := time.Now()
+	clientReq := &clientReq{feed: feed, includes: recordedFields(feed)}
+
+	if feed == types.TxReceiptsFeed {
+		block, ok := notification.(*types.EthBlockNotification)
+		if !ok {
+			return fmt.Errorf("unexpected %v notification %T", feed, notification)
+		}
+		return handleTxReceipts(r.feedManager, block, nil, func(receipt *types.TxReceiptNotification) error {
+			if r.format == ProtobufRecordingFormat {
+				return r.write(&FeedRecord{Time: now, Feed: feed, Reply: &pb.FeedRecord{TxReceipts: generateTxReceiptReply(receipt)}})
+			}
+			result, err := json.Marshal(receipt.WithFields(clientReq.includes))
+			if err != nil {
+				return err
+			}
+			return r.write(&FeedRecord{Time: now, Feed: feed, Result: result})
+		})
+	}
+
+	record := &FeedRecord{Time: now, Feed: feed}
+	var err error
+	switch r.format {
+	case ProtobufRecordingFormat:
+		record.Reply, err = r.protobufRecord(clientReq, notification)
+	default:
+		if tx, ok := notification.(*types.NewTransactionNotification); ok {
+			record.Result, err = r.feedManager.txPayload(clientReq, tx)
+		} else {
+			withFields := func() interface{} {
+				return notification.WithFields(clientReq.includes)
+			}
+			record.Result, err = r.feedManager.notificationPayload(clientReq, notification, bxPayloadFormat, withFields)
+		}
+	}
+	if err != nil {
+		return err
+	}
+	return r.write(record)
+}
+
+// RecordDropped writes a record of the notifications of the feed dropped from the recording
+func (r *FeedRecorder) RecordDropped(feed types.FeedType, dropped uint64) error {
+	r.dropped.Add(dropped)
+	record := &FeedRecord{Time: time.Now(), Feed: feed, Dropped: dropped}
+	if r.format == ProtobufRecordingFormat {
+		record.Reply = &pb.FeedRecord{Dropped: dropped}
+	}
+	return r.write(record)
+}
+
+// Dropped returns the number of notifications dropped from the recording, the recording is incomplete if it is not 0
+func (r *FeedRecorder) Dropped() uint64 {
+	return r.dropped.Load()
+}
+
+func (r *FeedRecorder) protobufRecord(clientReq *clientReq, notification types.Notification) (*pb.FeedRecord, error) {
+	switch n := notification.(type) {
+	case *types.NewTransactionNotification:
+		reply := &pb.TxsReply{Tx: []*pb.Tx{r.feedManager.grpcTx(clientReq, n)}}
+		return &pb.FeedRecord{Txs: reply}, nil
+	case *types.EthBlockNotification:
+		reply := NewGrpcHandler(r.feedManager).generateBlockReply(n)
+		reply.Sequence = n.Sequence()
+		return &pb.FeedRecord{Blocks: reply}, nil
+	default:
+		reply, err := generateBeaconBlockReply(notification, clientReq.includes)
+		if err != nil {
+			return nil, err
+		}
+		return &pb.FeedRecord{BeaconBlocks: reply}, nil
+	}
+}
+
+// write appends the record to the recording file
+func (r *FeedRecorder) write(record *FeedRecord) error {
+	var data []byte
+	var err error
+	switch r.format {
+	case ProtobufRecordingFormat:
+		record.Reply.Time = record.Time.UnixNano()
+		record.Reply.Feed = string(record.Feed)
+		data, err = proto.Marshal(record.Reply)
+		if err != nil {
+			return err
+		}
+		data = append(binary.AppendUvarint(nil, uint64(len(data))), data...)
+	default:
+		data, err = json.Marshal(record)
+		if err != nil {
+			return err
+		}
+		data = append(data, '\n')
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	_, err = r.writer.Write(data)
+	return err
+}
+
+func (r *FeedRecorder) close() error {
+	if dropped := r.Dropped(); dropped > 0 {
+		r.log.Warnf("the recording %v is incomplete, %v notifications were dropped", r.file.Filename, dropped)
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if err := r.writer.Flush(); err != nil {
+		return err
+	}
+	return r.file.Close()
+}
+
+// recordedFields returns all the fields of the notifications of the feed
+func recordedFields(feed types.FeedType) []string {
+	switch feed {
+	case types.NewTxsFeed:
+		return validTxParams
+	default:
+		return validParams[feed]
+	}
+}
+
+// RecordingReader reads the records of a recording file
+type RecordingReader struct {
+	file   *os.File
+	reader *bufio.Reader
+	format RecordingFormat
+}
+
+// OpenRecording opens a recording file, its format is given by its extension
+func OpenRecording(path string) (*RecordingReader, error) {
+	format := JSONRecordingFormat
+	if strings.HasSuffix(path, ProtobufRecordingFormat.Extension()) {
+		format = ProtobufRecordingFormat
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordingReader{file: file, reader: bufio.NewReaderSize(file, 1<<20), format: format}, nil
+}
+
+// Format returns the format of the recording
+func (r *RecordingReader) Format() RecordingFormat {
+	return r.format
+}
+
+// Next returns the next record, or io.EOF at the end of the recording
+func (r *RecordingReader) Next() (*FeedRecord, error) {
+	if r.format == ProtobufRecordingFormat {
+		size, err := binary.ReadUvarint(r.reader)
+		if err != nil {
+			return nil, err
+		}
+		data := make([]byte, size)
+		if _, err = io.ReadFull(r.reader, data); err != nil {
+			return nil, fmt.Errorf("truncated record: %w", err)
+		}
+		reply := &pb.FeedRecord{}
+		if err = proto.Unmarshal(data, reply); err != nil {
+			return nil, err
+		}
+		return &FeedRecord{Time: time.Unix(0, reply.Time), Feed: types.FeedType(reply.Feed), Dropped: reply.Dropped, Reply: reply}, nil
+	}
+
+	line, err := r.reader.ReadBytes('\n')
+	if len(line) == 0 && err != nil {
+		return nil, err
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	record := &FeedRecord{}
+	if err = json.Unmarshal(line, record); err != nil {
+		return nil, err
+	}
+	return record, nil
+}
+
+// Close closes the recording file
+func (r *RecordingReader) Close() error {
+	return r.file.Close()
+}
@@ -0,0 +1,154 @@
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/config"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readRecording(t *testing.T, path string) []*FeedRecord {
+	recording, err := OpenRecording(path)
+	require.NoError(t, err)
+	defer recording.Close()
+
+	var records []*FeedRecord
+	for {
+		record, err := recording.Next()
+		if errors.Is(err, io.EOF) {
+			return records
+		}
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+}
+
+func TestFeedRecorder(t *testing.T) {
+	fm := &FeedManager{payloads: newPayloadCache(payloadCacheSize)}
+	tx := newCalldataTxNotification(t, []byte{1})
+	block := newSequencedBlockNotification(t, 10)
+
+	for _, format := range []RecordingFormat{JSONRecordingFormat, ProtobufRecordingFormat} {
+		t.Run(string(format), func(t *testing.T) {
+			dir := t.TempDir()
+			recorder, err := NewFeedRecorder(fm, &config.FeedRecording{
+				Feeds:       []types.FeedType{types.NewTxsFeed, types.NewBlocksFeed},
+				Dir:         dir,
+				Format:      string(format),
+				MaxFileSize: 1,
+			})
+			require.NoError(t, err)
+			require.NoError(t, recorder.Record(types.NewTxsFeed, tx))
+			require.NoError(t, recorder.Record(types.NewBlocksFeed, block))
+			require.NoError(t, recorder.RecordDropped(types.NewTxsFeed, 5))
+			require.NoError(t, recorder.close())
+			assert.Equal(t, uint64(5), recorder.Dropped())
+
+			records := readRecording(t, filepath.Join(dir, recordingFileName+format.Extension()))
+			require.Len(t, records, 3)
+			assert.Equal(t, types.NewTxsFeed, records[0].Feed)
+			assert.Equal(t, types.NewBlocksFeed, records[1].Feed)
+			assert.False(t, records[0].Time.IsZero())
+			assert.False(t, records[1].Time.Before(records[0].Time))
+			assert.Equal(t, types.NewTxsFeed, records[2].Feed)
+			assert.Equal(t, uint64(5), records[2].Dropped)
+			assert.Zero(t, records[0].Dropped)
+
+			if format == ProtobufRecordingFormat {
+				require.Len(t, records[0].Reply.Txs.Tx, 1)
+				assert.Equal(t, tx.RawTx(), records[0].Reply.Txs.Tx[0].RawTx)
+				assert.Equal(t, block.BlockHash.String(), records[1].Reply.Blocks.Hash)
+				assert.Equal(t, "0xa", records[1].Reply.Blocks.Header.Number)
+				return
+			}
+			var txResult map[string]interface{}
+			require.NoError(t, json.Unmarshal(records[0].Result, &txResult))
+			assert.Equal(t, tx.GetHash(), txResult["txHash"])
+			var blockResult map[string]interface{}
+			require.NoError(t, json.Unmarshal(records[1].Result, &blockResult))
+			assert.Equal(t, block.BlockHash.String(), blockResult["hash"])
+		})
+	}
+
+	_, err := NewFeedRecorder(fm, &config.FeedRecording{Feeds: []types.FeedType{types.OnBlockFeed}, Dir: t.TempDir(), Format: string(JSONRecordingFormat)})
+	assert.Error(t, err)
+	_, err = NewFeedRecorder(fm, &config.FeedRecording{Feeds: []types.FeedType{types.NewTxsFeed}, Dir: t.TempDir(), Format: "cbor"})
+	assert.Error(t, err)
+}
+
+func TestFeedRecorder_Dropped(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewFeedRecorder(nil, &config.FeedRecording{Dir: dir, Format: string(JSONRecordingFormat), MaxFileSize: 1})
+	require.NoError(t, err)
+	sub := &ClientSubscriptionHandlingInfo{FeedChan: make(chan types.Notification), StatusChan: make(chan types.SubscriptionStatus)}
+	done := make(chan struct{})
+	go func() {
+		recorder.recordFeed(context.Background(), types.NewTxsFeed, sub)
+		close(done)
+	}()
+
+	// the statuses have the total number of notifications dropped by the subscription
+	for _, dropped := range []uint64{3, 3, 5} {
+		sub.StatusChan <- types.SubscriptionStatus{Backpressure: types.BackpressureDropOldest, Dropped: dropped}
+	}
+	close(sub.FeedChan)
+	<-done
+	require.NoError(t, recorder.close())
+	assert.Equal(t, uint64(5), recorder.Dropped())
+
+	records := readRecording(t, filepath.Join(dir, recordingFileName+JSONRecordingFormat.Extension()))
+	require.Len(t, records, 2)
+	assert.Equal(t, uint64(3), records[0].Dropped)
+	assert.Equal(t, uint64(2), records[1].Dropped)
+	assert.Empty(t, records[1].Result)
+}
+
+func TestReplayServer_Replay(t *testing.T) {
+	dir := t.TempDir()
+	recorder, err := NewFeedRecorder(nil, &config.FeedRecording{Dir: dir, Format: string(JSONRecordingFormat), MaxFileSize: 1})
+	require.NoError(t, err)
+	start := time.Now()
+	for i, feed := range []types.FeedType{types.NewTxsFeed, types.NewBlocksFeed, types.NewTxsFeed, types.NewTxsFeed} {
+		result, err := json.Marshal(i)
+		require.NoError(t, err)
+		require.NoError(t, recorder.write(&FeedRecord{Time: start.Add(time.Duration(i) * 100 * time.Millisecond), Feed: feed, Result: result}))
+	}
+	// the record of dropped notifications is not replayed
+	require.NoError(t, recorder.write(&FeedRecord{Time: start.Add(400 * time.Millisecond), Feed: types.NewTxsFeed, Dropped: 2}))
+	require.NoError(t, recorder.close())
+	path := filepath.Join(dir, recordingFileName+JSONRecordingFormat.Extension())
+
+	replay := func(speed float64) ([]string, time.Duration) {
+		replayServer, err := NewReplayServer(path, speed)
+		require.NoError(t, err)
+		var results []string
+		replayStart := time.Now()
+		err = replayServer.Replay(context.Background(), types.NewTxsFeed, func(record *FeedRecord) error {
+			results = append(results, string(record.Result))
+			return nil
+		})
+		require.NoError(t, err)
+		return results, time.Since(replayStart)
+	}
+
+	// the transactions are 300ms apart in the recording
+	results, elapsed := replay(10)
+	assert.Equal(t, []string{"0", "2", "3"}, results)
+	assert.GreaterOrEqual(t, elapsed, 30*time.Millisecond)
+	assert.Less(t, elapsed, 300*time.Millisecond)
+
+	results, elapsed = replay(0)
+	assert.Equal(t, []string{"0", "2", "3"}, results)
+	assert.Less(t, elapsed, 100*time.Millisecond)
+
+	_, err = NewReplayServer(path, -1)
+	assert.Error(t, err)
+}
@@ -1,135 +1,194 @@
 package servers
 
 import (
-	"errors"
 	"fmt"
-	"regexp"
-	"strconv"
-	"strings"
+	"math/big"
 
-	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/services"
 	"github.com/bloXroute-Labs/gateway/v2/types"
-	"github.com/bloXroute-Labs/gateway/v2/utils"
-	"github.com/zhouzhuojie/conditions"
+	"github.com/bloXroute-Labs/gateway/v2/utils/filter"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
-func createFiltersExpression(filters string) (conditions.Expr, error) {
-	_, expr, err := ParseFilter(filters)
-	if err != nil {
-		return nil, fmt.Errorf("error parsing Filters: %v", err)
-	}
-	if expr == nil {
-		return nil, nil
-	}
+// txFilter is a compiled filter of the transactions feeds
+type txFilter = filter.Filter[*txFilterItem]
 
-	err = EvaluateFilters(expr)
-	if err != nil {
-		return nil, fmt.Errorf("error evaluated Filters: %v", err)
-	}
-
-	log.Infof("GetTxContentAndFilters string - %s, GetTxContentAndFilters args - %s", expr, expr.Args())
-	return expr, nil
+// txFilterItem is a transaction being evaluated by a filter
+type txFilterItem struct {
+	tx   *types.EthTransaction
+	abis *services.ABIRegistry
+	// args are the calldata arguments, decoded on first use
+	args    map[string]interface{}
+	decoded bool
 }
 
-// EvaluateFilters - evaluating if the Filters provided by the user are ok
-func EvaluateFilters(expr conditions.Expr) error {
-	// Evaluate if we should send the tx
-	_, err := conditions.Evaluate(expr, types.EmptyFilteredTransactionMap)
-	return err
+func (item *txFilterItem) abiArgs() map[string]interface{} {
+	if !item.decoded {
+		item.decoded = true
+		if item.abis != nil {
+			item.args = item.abis.Args(item.tx.Input())
+		}
+	}
+	return item.args
 }
 
-// ParseFilter parsing the filter
-func ParseFilter(filters string) (string, conditions.Expr, error) {
-	// if the filters values are go-type filters, for example: {value}, parse the filters
-	// if not go-type, convert it to go-type filters
-	if strings.Contains(filters, "{") {
-		p := conditions.NewParser(strings.NewReader(strings.ToLower(strings.Replace(filters, "'", "\"", -1))))
-		expr, err := p.Parse()
-		if err == nil {
-			isEmptyValue := filtersHasEmptyValue(expr.String())
-			if isEmptyValue != nil {
-				return "", nil, errors.New("filter is empty")
-			}
+// txFilterVars are the transaction fields available in filters. Fee fields are only set for the transactions types
+// which have them
+var txFilterVars = map[string]filter.Var[*txFilterItem]{
+	"gas": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		return new(big.Int).SetUint64(item.tx.Gas()), true
+	}),
+	"gas_price": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		if item.tx.HasFeeCaps() {
+			return nil, false
+		}
+		return item.tx.GasPrice(), true
+	}),
+	"value": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		return item.tx.Value(), true
+	}),
+	"to": filter.StringVar(func(item *txFilterItem) (string, bool) {
+		to := item.tx.To()
+		if to == nil {
+			return "0x0", true
+		}
+		return hexutil.Encode(to[:]), true
+	}),
+	"from": filter.StringVar(func(item *txFilterItem) (string, bool) {
+		if item.tx.From == nil {
+			return "", false
+		}
+		return hexutil.Encode(item.tx.From[:]), true
+	}),
+	"method_id": filter.StringVar(func(item *txFilterItem) (string, bool) {
+		input := item.tx.Input()
+		if len(input) > 4 {
+			input = input[:4]
+		}
+		return hexutil.Encode(input), true
+	}),
+	"type": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		return big.NewInt(int64(item.tx.Type())), true
+	}),
+	"chain_id": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		return item.tx.ChainID, item.tx.ChainID != nil
+	}),
+	"max_fee_per_gas": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		if !item.tx.HasFeeCaps() {
+			return nil, false
+		}
+		return item.tx.GasFeeCap, true
+	}),
+	"max_priority_fee_per_gas": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		if !item.tx.HasFeeCaps() {
+			return nil, false
+		}
+		return item.tx.GasTipCap, true
+	}),
+	"max_fee_per_blob_gas": filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+		blobFeeCap := item.tx.BlobFeeCap()
+		return blobFeeCap, blobFeeCap != nil
+	}),
+	"blob_versioned_hashes": filter.StringListVar(func(item *txFilterItem) ([]string, bool) {
+		blobHashes := item.tx.BlobHashes()
+		if blobHashes == nil {
+			return nil, false
+		}
+		hashes := make([]string, 0, len(blobHashes))
+		for _, blobHash := range blobHashes {
+			hashes = append(hashes, hexutil.Encode(blobHash[:]))
 		}
+		return hashes, true
+	}),
+	"authorities": filter.StringListVar(func(item *txFilterItem) ([]string, bool) {
+		return addressesFilterValue(item.tx.Authorities())
+	}),
+	"delegated_addresses": filter.StringListVar(func(item *txFilterItem) ([]string, bool) {
+		return addressesFilterValue(item.tx.DelegatedAddresses())
+	}),
+}
 
-		return "", expr, err
+// addressesFilterValue returns the addresses of a set-code transaction field, the field is not set for the other types
+func addressesFilterValue(addresses []common.Address) ([]string, bool) {
+	if addresses == nil {
+		return nil, false
 	}
+	values := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		values = append(values, hexutil.Encode(address[:]))
+	}
+	return values, true
+}
 
-	// convert the string and add whitespace to separate elements
-	tempFilters := strings.ReplaceAll(filters, "(", " ( ")
-	tempFilters = strings.ReplaceAll(tempFilters, ")", " ) ")
-	tempFilters = strings.ReplaceAll(tempFilters, "[", " [ ")
-	tempFilters = strings.ReplaceAll(tempFilters, "]", " ] ")
-	tempFilters = strings.ReplaceAll(tempFilters, ",", " , ")
-	tempFilters = strings.ReplaceAll(tempFilters, "=", " = ")
-	tempFilters = strings.ReplaceAll(tempFilters, "<", " < ")
-	tempFilters = strings.ReplaceAll(tempFilters, ">", " > ")
-	tempFilters = strings.ReplaceAll(tempFilters, "!", " ! ")
-	tempFilters = strings.ReplaceAll(tempFilters, ",", " , ")
-	tempFilters = strings.ReplaceAll(tempFilters, "<  =", "<=")
-	tempFilters = strings.ReplaceAll(tempFilters, ">  =", ">=")
-	tempFilters = strings.ReplaceAll(tempFilters, "!  =", "!=")
-	tempFilters = strings.Trim(tempFilters, " ")
-	tempFilters = strings.ToLower(tempFilters)
-	filtersArr := strings.Split(tempFilters, " ")
-
-	var newFilterString strings.Builder
-	for _, elem := range filtersArr {
-		switch {
-		case elem == "":
-		case elem == "(", elem == ")", elem == ",", elem == "]", elem == "[":
-			newFilterString.WriteString(elem)
-		case utils.Exists(elem, operators):
-			newFilterString.WriteString(" ")
-			if elem == "=" {
-				newFilterString.WriteString(elem)
-			}
-			newFilterString.WriteString(elem + " ")
-		case utils.Exists(elem, operands):
-			newFilterString.WriteString(")")
-			newFilterString.WriteString(" " + elem + " ")
-		case utils.Exists(elem, availableFilters):
-			newFilterString.WriteString("({" + elem + "}")
-		default:
-			isString := false
-			if _, err := strconv.Atoi(elem); err != nil {
-				isString = true
-			}
-			switch {
-			case isString && len(elem) >= 2 && elem[0:2] != "0x":
-				newFilterString.WriteString("'0x" + elem + "'")
-			case isString && len(elem) >= 2 && elem[0:2] == "0x":
-				newFilterString.WriteString("'" + elem + "'")
-			default:
-				newFilterString.WriteString(elem)
-			}
+// txFilterEnv resolves the transaction fields, and the arguments of the methods registered in the ABI registry
+func txFilterEnv(abis *services.ABIRegistry) filter.Env[*txFilterItem] {
+	return func(name string) (filter.Var[*txFilterItem], bool) {
+		if v, ok := txFilterVars[name]; ok {
+			return v, true
 		}
+		if abis == nil {
+			return filter.Var[*txFilterItem]{}, false
+		}
+		argType, ok := abis.ArgType(name)
+		if !ok {
+			return filter.Var[*txFilterItem]{}, false
+		}
+		return abiFilterVar(name, argType)
 	}
+}
 
-	newFilterString.WriteString(")")
-
-	p := conditions.NewParser(strings.NewReader(strings.ToLower(strings.Replace(newFilterString.String(), "'", "\"", -1))))
-	expr, err := p.Parse()
-
-	if err == nil {
-		isEmptyValue := filtersHasEmptyValue(expr.String())
-		if isEmptyValue != nil {
-			return "", nil, errors.New("filter is empty")
+func abiFilterVar(name string, argType abi.Type) (filter.Var[*txFilterItem], bool) {
+	switch argType.T {
+	case abi.IntTy, abi.UintTy:
+		return filter.NumberVar(func(item *txFilterItem) (*big.Int, bool) {
+			v, ok := item.abiArgs()[name].(*big.Int)
+			return v, ok
+		}), true
+	case abi.BoolTy:
+		return filter.BoolVar(func(item *txFilterItem) (bool, bool) {
+			v, ok := item.abiArgs()[name].(bool)
+			return v, ok
+		}), true
+	case abi.StringTy, abi.AddressTy, abi.BytesTy, abi.FixedBytesTy, abi.HashTy:
+		return filter.StringVar(func(item *txFilterItem) (string, bool) {
+			v, ok := item.abiArgs()[name].(string)
+			return v, ok
+		}), true
+	case abi.SliceTy, abi.ArrayTy:
+		if argType.Elem.T == abi.IntTy || argType.Elem.T == abi.UintTy {
+			return filter.NumberListVar(func(item *txFilterItem) ([]*big.Int, bool) {
+				v, ok := item.abiArgs()[name].([]*big.Int)
+				return v, ok
+			}), true
 		}
+		return filter.StringListVar(func(item *txFilterItem) ([]string, bool) {
+			v, ok := item.abiArgs()[name].([]string)
+			return v, ok
+		}), true
+	default:
+		return filter.Var[*txFilterItem]{}, false
 	}
+}
 
-	return newFilterString.String(), expr, err
+// compileTxFilter compiles the filters of a transactions feed subscription
+func compileTxFilter(filters string, abis *services.ABIRegistry) (*txFilter, error) {
+	f, err := filter.Compile(filters, txFilterEnv(abis))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Filters: %v", err)
+	}
+	return f, nil
 }
 
-func filtersHasEmptyValue(rawFilters string) error {
-	rex := regexp.MustCompile(`\(([^)]+)\)`)
-	out := rex.FindAllStringSubmatch(rawFilters, -1)
-	for _, i := range out {
-		for _, filter := range availableFilters {
-			if i[1] == filter || filter == rawFilters {
-				return fmt.Errorf("%v", i[1])
-			}
-		}
+// matchTxFilter evaluates the filter against the transaction
+func matchTxFilter(f *txFilter, tx *types.NewTransactionNotification, abis *services.ABIRegistry) bool {
+	if err := tx.MakeBlockchainTransaction(); err != nil {
+		return false
+	}
+	ethTx, ok := tx.BlockchainTransaction.(*types.EthTransaction)
+	if !ok {
+		return false
 	}
-	return nil
+	return f.Match(&txFilterItem{tx: ethTx, abis: abis})
 }
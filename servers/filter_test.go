@@ -1,10 +1,21 @@
 package servers
 
 import (
+	"math/big"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/bloXroute-Labs/gateway/v2/services"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 // pythonFiltersToGoFilters - contains available filters in python format and theirs go format filters
@@ -73,19 +84,204 @@ var invalidPythonFilters = []string{
 }
 
 func TestFilter(t *testing.T) {
-	s := &subscriptionOptions{}
-
-	for pythonFormat, expectedGoFormat := range pythonFiltersToGoFilters {
-		s.Filters = pythonFormat
-		goFormatResult, exp, err := ParseFilter(s.Filters)
-		assert.Equal(t, strings.ToLower(expectedGoFormat), strings.ToLower(goFormatResult))
-		assert.Nil(t, err)
-		assert.Nil(t, EvaluateFilters(exp))
+	to := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	txs := []*types.NewTransactionNotification{
+		newCalldataTxNotification(t, common.FromHex("a9059cbb")),
+		newTxNotification(t, &ethtypes.LegacyTx{
+			Nonce:    1,
+			GasPrice: big.NewInt(183000000001),
+			Gas:      100000,
+			To:       &to,
+			Value:    new(big.Int).Mul(big.NewInt(2), big.NewInt(1e18)),
+			Data:     common.FromHex("a9059cbb"),
+		}),
+		newTxNotification(t, &ethtypes.LegacyTx{Nonce: 2, GasPrice: big.NewInt(5), Gas: 21000, To: &to, Value: big.NewInt(10000)}),
+	}
+
+	// the python format filters match the transactions like their go format
+	for pythonFormat, goFormat := range pythonFiltersToGoFilters {
+		pythonFilter, err := compileTxFilter(pythonFormat, nil)
+		require.NoError(t, err, pythonFormat)
+		goFilter, err := compileTxFilter(goFormat, nil)
+		require.NoError(t, err, goFormat)
+		assert.Equal(t, pythonFilter.Vars(), goFilter.Vars())
+		for _, tx := range txs {
+			assert.Equal(t, matchTxFilter(goFilter, tx, nil), matchTxFilter(pythonFilter, tx, nil), pythonFormat)
+		}
+	}
+
+	matches := map[string][]bool{
+		"value<=10000": {true, false, true},
+		"value!=10000": {true, true, false},
+		"value > 1000000000000000000 and value < 4000000000000000000": {false, true, false},
+		"to = 0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2":             {false, true, true},
+		"gas_price > 183000000000":                                    {false, true, false},
+		"method_id = a9059cbb":                                        {true, true, false},
+		"method_id in [aa, bb,cc, dd]":                                {false, false, false},
+		"from = 0xaa and value > 1000 or value < 500 and (method_id in [aa, bb, cc] and (to = 0xabb or gas_price = 5))":                                                                                                           {false, false, false},
+		"to = 0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2 and ((value > 1000000000000000000 and value < 4000000000000000000) or from in [0x8fdc5df186c58cdc2c22948beee12b1ae1406c6f, 0x77e2b72689fc954c16b37fbcf1b0b1d395a0e288])": {false, true, false},
+	}
+	for filters, expected := range matches {
+		txsFilter, err := compileTxFilter(filters, nil)
+		require.NoError(t, err, filters)
+		for i, tx := range txs {
+			assert.Equal(t, expected[i], matchTxFilter(txsFilter, tx, nil), "%v: transaction %v", filters, i)
+		}
 	}
 
 	for _, invalidFilters := range invalidPythonFilters {
-		s.Filters = invalidFilters
-		_, _, err := ParseFilter(s.Filters)
-		assert.NotNil(t, err)
+		_, err := compileTxFilter(invalidFilters, nil)
+		assert.NotNil(t, err, invalidFilters)
+	}
+}
+
+const testERC20ABI = `[{"name":"transfer","type":"function","stateMutability":"nonpayable","inputs":[
+	{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}]`
+
+func newCalldataTxNotification(t testing.TB, input []byte) *types.NewTransactionNotification {
+	to := common.HexToAddress("0xdAC17F958D2ee523a2206206994597C13D831ec7")
+	return newTxNotification(t, &ethtypes.DynamicFeeTx{
+		ChainID:   bxmock.ChainID,
+		GasTipCap: big.NewInt(20),
+		GasFeeCap: big.NewInt(200),
+		Gas:       100000,
+		To:        &to,
+		Data:      input,
+	})
+}
+
+func newTxNotification(t testing.TB, txData ethtypes.TxData) *types.NewTransactionNotification {
+	tx, err := ethtypes.SignNewTx(crypto.ToECDSAUnsafe(common.FromHex("dae2cb3b03f8a1bbaedae4d43e159360c8d07ffab119d5d7311a81a9d4f53bd1")), ethtypes.NewLondonSigner(bxmock.ChainID), txData)
+	require.NoError(t, err)
+	content, err := rlp.EncodeToBytes(tx)
+	require.NoError(t, err)
+
+	hash, err := types.NewSHA256Hash(tx.Hash().Bytes())
+	require.NoError(t, err)
+	bxTx := types.NewBxTransaction(hash, types.NetworkNum(5), types.TFPaidTx, time.Now())
+	bxTx.SetContent(content)
+	return types.CreateNewTransactionNotification(bxTx)
+}
+
+func TestABIFilter(t *testing.T) {
+	abis := services.NewABIRegistry()
+
+	// filters on arguments are rejected until the ABI is registered
+	_, err := compileTxFilter("{transfer.amount} > 1e18", abis)
+	assert.Error(t, err)
+
+	_, err = abis.Register([]byte(testERC20ABI))
+	require.NoError(t, err)
+	contractABI, err := abi.JSON(strings.NewReader(testERC20ABI))
+	require.NoError(t, err)
+
+	recipient := common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	bigTransfer, err := contractABI.Pack("transfer", recipient, new(big.Int).Mul(big.NewInt(2), big.NewInt(1e18)))
+	require.NoError(t, err)
+	smallTransfer, err := contractABI.Pack("transfer", recipient, big.NewInt(1000))
+	require.NoError(t, err)
+
+	tests := []struct {
+		filters string
+		input   []byte
+		match   bool
+	}{
+		{"{transfer.amount} > 1e18", bigTransfer, true},
+		{"{transfer.amount} > 1e18", smallTransfer, false},
+		{"{transfer.amount} > 1e18", []byte{0x01, 0x02, 0x03, 0x04}, false},
+		{"{transfer.to} == 0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", smallTransfer, true},
+		{"{transfer.amount} > 1e18 or {value} == 0", []byte{}, true},
+		{"method_id = a9059cbb and transfer.amount < 1e18 and max_fee_per_gas >= 200 and gas_price > 0", smallTransfer, false},
+		{"method_id = a9059cbb and transfer.amount < 1e18 and max_fee_per_gas >= 200 and type = 2", smallTransfer, true},
+		{"from = 0x0 or to == 0xdAC17F958D2ee523a2206206994597C13D831ec7", smallTransfer, true},
+	}
+	for _, test := range tests {
+		txsFilter, err := compileTxFilter(test.filters, abis)
+		require.NoError(t, err, test.filters)
+
+		request := &clientReq{includes: []string{"tx_hash", "decoded_input"}, filter: txsFilter, feed: types.NewTxsFeed, abis: abis}
+		result := filterAndInclude(request, newCalldataTxNotification(t, test.input), "", "")
+		assert.Equal(t, test.match, result != nil, test.filters)
+	}
+
+	request := &clientReq{includes: []string{"decoded_input"}, feed: types.NewTxsFeed, abis: abis}
+	result := filterAndInclude(request, newCalldataTxNotification(t, smallTransfer), "", "")
+	require.NotNil(t, result.DecodedInput)
+	assert.Equal(t, "transfer(address,uint256)", result.DecodedInput.Signature)
+	assert.Equal(t, "1000", result.DecodedInput.Args["amount"])
+}
+
+func TestBlobTxFilter(t *testing.T) {
+	blobTx := bxmock.NewSignedBlobTx(1, nil)
+	content, err := blobTx.Content()
+	require.NoError(t, err)
+	hash, err := types.NewSHA256Hash(blobTx.Hash().Bytes())
+	require.NoError(t, err)
+	bxTx := types.NewBxTransaction(hash, types.NetworkNum(5), types.TFPaidTx, time.Now())
+	bxTx.SetContent(content)
+	blobHash := strings.ToLower(blobTx.BlobHashes()[0].Hex())
+
+	tests := []struct {
+		filters string
+		match   bool
+	}{
+		{"type = 3 and max_fee_per_blob_gas >= 10", true},
+		{"max_fee_per_blob_gas > 10", false},
+		{"blob_versioned_hashes contains " + blobHash, true},
+		{"max_fee_per_gas = 100 and max_priority_fee_per_gas = 100", true},
+	}
+	for _, test := range tests {
+		txsFilter, err := compileTxFilter(test.filters, nil)
+		require.NoError(t, err, test.filters)
+
+		request := &clientReq{includes: []string{"tx_hash", "tx_contents.max_fee_per_blob_gas", "tx_contents.blob_versioned_hashes"}, filter: txsFilter, feed: types.NewTxsFeed}
+		result := filterAndInclude(request, types.CreateNewTransactionNotification(bxTx), "", "")
+		assert.Equal(t, test.match, result != nil, test.filters)
+		if result != nil {
+			txContents, ok := result.TxContents.(map[string]interface{})
+			require.True(t, ok)
+			assert.Equal(t, "0xa", txContents["maxFeePerBlobGas"])
+			assert.Equal(t, []string{blobHash}, txContents["blobVersionedHashes"])
+		}
+	}
+}
+
+func TestSetCodeTxFilter(t *testing.T) {
+	codeAddress := common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	setCodeTx := bxmock.NewSignedSetCodeTx(1, nil, nil, codeAddress)
+	content, err := setCodeTx.Content()
+	require.NoError(t, err)
+	hash, err := types.NewSHA256Hash(setCodeTx.Hash().Bytes())
+	require.NoError(t, err)
+	bxTx := types.NewBxTransaction(hash, types.NetworkNum(5), types.TFPaidTx, time.Now())
+	bxTx.SetContent(content)
+	authority := strings.ToLower(setCodeTx.Authorities()[0].Hex())
+	delegatedAddress := strings.ToLower(codeAddress.Hex())
+
+	tests := []struct {
+		filters string
+		match   bool
+	}{
+		{"type = 4 and authorities contains " + authority, true},
+		{"delegated_addresses contains " + delegatedAddress, true},
+		{"delegated_addresses contains " + authority, false},
+		{"max_fee_per_gas = 100 and max_priority_fee_per_gas = 100", true},
+		{"gas_price = 100", false},
+	}
+	for _, test := range tests {
+		txsFilter, err := compileTxFilter(test.filters, nil)
+		require.NoError(t, err, test.filters)
+
+		request := &clientReq{includes: []string{"tx_hash", "tx_contents.authorization_list"}, filter: txsFilter, feed: types.NewTxsFeed}
+		result := filterAndInclude(request, types.CreateNewTransactionNotification(bxTx), "", "")
+		assert.Equal(t, test.match, result != nil, test.filters)
+		if result != nil {
+			txContents, ok := result.TxContents.(map[string]interface{})
+			require.True(t, ok)
+			authList, ok := txContents["authorizationList"].([]map[string]string)
+			require.True(t, ok)
+			require.Len(t, authList, 1)
+			assert.Equal(t, delegatedAddress, authList[0]["address"])
+		}
 	}
 }
@@ -5,29 +5,49 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/bloXroute-Labs/gateway/v2"
 	"github.com/bloXroute-Labs/gateway/v2/connections"
 	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
+	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils"
 	"github.com/sourcegraph/jsonrpc2"
 )
 
 // HTTPServer handler http calls
 type HTTPServer struct {
-	server      *http.Server
-	feedManager *FeedManager
+	server                   *http.Server
+	feedManager              *FeedManager
+	getQuotaUsage            func(accountID string) (*connections.QuotaResponseBody, error)
+	enableBlockchainRPC      bool
+	pendingTxsSourceFromNode *bool
+	authorize                func(accountID types.AccountID, secretHash string, allowAccessToInternalGateway bool) (sdnmessage.Account, error)
 }
 
-// NewHTTPServer creates and returns a new websocket server managed by FeedManager
-func NewHTTPServer(feedManager *FeedManager, port int) *HTTPServer {
+// bundleHTTPMethods are handled without authorization, for the MEV builders submitting bundles to the gateway
+var bundleHTTPMethods = map[jsonrpc.RPCRequestType]bool{
+	jsonrpc.RPCEthSendBundle:     true,
+	jsonrpc.RPCEthSendMegaBundle: true,
+	jsonrpc.RPCBundleSubmission:  true,
+}
+
+// NewHTTPServer creates and returns a new websocket server managed by FeedManager. The JSON-RPC requests, except the
+// bundle submissions, and the feeds streamed as Server-Sent Events are authorized like the websocket connections. The
+// bundle simulations are handled like the websocket requests, only for the account of the gateway
+func NewHTTPServer(feedManager *FeedManager, port int, getQuotaUsage func(accountID string) (*connections.QuotaResponseBody, error), enableBlockchainRPC bool, pendingTxsSourceFromNode *bool, authorize func(accountID types.AccountID, secretHash string, allowAccessToInternalGateway bool) (sdnmessage.Account, error)) *HTTPServer {
 	return &HTTPServer{
 		server: &http.Server{
 			Addr: fmt.Sprintf(":%v", port),
 		},
-		feedManager: feedManager,
+		feedManager:              feedManager,
+		getQuotaUsage:            getQuotaUsage,
+		enableBlockchainRPC:      enableBlockchainRPC,
+		pendingTxsSourceFromNode: pendingTxsSourceFromNode,
+		authorize:                authorize,
 	}
 }
 
@@ -61,18 +81,35 @@ func (s HTTPServer) Stop() {
 func (s *HTTPServer) setupHandlers() http.Handler {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/", s.httpRPCHandler)
+	mux.HandleFunc(sseFeedsPath, s.sseHandler)
 
 	return mux
 }
 
 func (s HTTPServer) httpRPCHandler(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeErrorJSON(w, jsonrpc2.ID{}, http.StatusBadRequest, err)
+		return
+	}
+
+	if isBatchRequest(body) {
+		s.dispatchRPC(w, r, jsonrpc2.ID{}, body, true)
+		return
+	}
+
 	rpcRequest := jsonrpc2.Request{}
-	err := json.NewDecoder(r.Body).Decode(&rpcRequest)
+	err = json.Unmarshal(body, &rpcRequest)
 	if err != nil {
 		writeErrorJSON(w, rpcRequest.ID, http.StatusBadRequest, err)
 		return
 	}
 
+	if !bundleHTTPMethods[jsonrpc.RPCRequestType(rpcRequest.Method)] {
+		s.dispatchRPC(w, r, rpcRequest.ID, body, false)
+		return
+	}
+
 	if rpcRequest.Params == nil {
 		err := errors.New("failed to unmarshal request.Params for mevBundle from mev-builder, error: EOF")
 		writeErrorJSON(w, rpcRequest.ID, http.StatusBadRequest, err)
@@ -193,9 +230,50 @@ func (s HTTPServer) httpRPCHandler(w http.ResponseWriter, r *http.Request) {
 		}
 
 		writeJSON(w, rpcRequest.ID, http.StatusOK, result)
-	default:
-		err := fmt.Errorf("got unsupported method name: %v", rpcRequest.Method)
-		writeErrorJSON(w, rpcRequest.ID, http.StatusNotFound, err)
+	}
+}
+
+// dispatchRPC handles the request, or the batch of requests, like the requests of a websocket connection of the
+// account of the authorization header
+func (s HTTPServer) dispatchRPC(w http.ResponseWriter, r *http.Request, id jsonrpc2.ID, body []byte, batch bool) {
+	connectionAccount, err := authorizeRequest(s.feedManager, r, s.enableBlockchainRPC, s.authorize)
+	if err != nil {
+		writeErrorJSON(w, id, http.StatusUnauthorized, err)
+		return
+	}
+
+	handler := &handlerObj{
+		FeedManager:              s.feedManager,
+		remoteAddress:            r.RemoteAddr,
+		connectionAccount:        connectionAccount,
+		getQuotaUsage:            s.getQuotaUsage,
+		enableBlockchainRPC:      s.enableBlockchainRPC,
+		pendingTxsSourceFromNode: s.pendingTxsSourceFromNode,
+		log: log.WithFields(log.Fields{
+			"component":  "httpHandlerObj",
+			"remoteAddr": r.RemoteAddr,
+		}),
+		ethSubscribeIDToChanMap: make(map[string]chan bool),
+		headers:                 types.SDKMetaFromHeaders(r.Header),
+		stats:                   s.feedManager.stats,
+	}
+
+	var response json.RawMessage
+	if batch {
+		response = handler.handleBatch(r.Context(), body)
+	} else {
+		response = handler.handleRequest(r.Context(), body)
+	}
+	if response == nil {
+		// all the requests were notifications
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(response); err != nil {
+		log.Errorf("failed to write the response of the request from %v: %v", r.RemoteAddr, err)
 	}
 }
 
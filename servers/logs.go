@@ -0,0 +1,243 @@
+package servers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	// maxLogTopics is the number of indexed topics a log can have
+	maxLogTopics = 4
+	// logsReorgDepth is the number of recent blocks whose logs are kept, to be sent again as removed on reorg
+	logsReorgDepth = 64
+)
+
+var validLogParams = []string{"address", "topics", "data", "block_number", "transaction_hash",
+	"transaction_index", "block_hash", "log_index", "removed"}
+
+// logFilter is the address and topics filter of a logs subscription, with the semantics of eth_subscribe("logs"):
+// the log address must be one of the addresses, and each topic one of the alternatives of its position
+type logFilter struct {
+	addresses map[string]struct{}
+	// topics are the alternatives of each topic position, an empty position matches any topic
+	topics [][]string
+}
+
+// newLogFilter parses the address and topics criteria of a logs subscription. The address is either an address or
+// an array of addresses, and each topic either null, a topic or an array of topics
+func newLogFilter(address interface{}, topics []interface{}) (*logFilter, error) {
+	f := &logFilter{addresses: make(map[string]struct{})}
+
+	var addresses []interface{}
+	switch a := address.(type) {
+	case nil:
+	case string:
+		addresses = []interface{}{a}
+	case []interface{}:
+		addresses = a
+	default:
+		return nil, fmt.Errorf("invalid logs address %v", address)
+	}
+	for _, a := range addresses {
+		addressStr, ok := a.(string)
+		if !ok || !ethcommon.IsHexAddress(addressStr) {
+			return nil, fmt.Errorf("invalid logs address %v", a)
+		}
+		f.addresses[strings.ToLower(addressStr)] = struct{}{}
+	}
+
+	if len(topics) > maxLogTopics {
+		return nil, fmt.Errorf("logs topics can have at most %v positions, got %v", maxLogTopics, len(topics))
+	}
+	f.topics = make([][]string, len(topics))
+	for i, topic := range topics {
+		var alternatives []interface{}
+		switch t := topic.(type) {
+		case nil:
+		case string:
+			alternatives = []interface{}{t}
+		case []interface{}:
+			alternatives = t
+		default:
+			return nil, fmt.Errorf("invalid logs topic %v", topic)
+		}
+		for _, alternative := range alternatives {
+			if alternative == nil {
+				// a null alternative matches any topic
+				f.topics[i] = nil
+				break
+			}
+			topicStr, ok := alternative.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid logs topic %v", alternative)
+			}
+			if b, err := hexutil.Decode(topicStr); err != nil || len(b) != ethcommon.HashLength {
+				return nil, fmt.Errorf("invalid logs topic %v", alternative)
+			}
+			f.topics[i] = append(f.topics[i], strings.ToLower(topicStr))
+		}
+	}
+	return f, nil
+}
+
+// newLogFilterFromParams parses the logs criteria of an eth_subscribe request, {"address": ..., "topics": [...]}
+func newLogFilterFromParams(params interface{}) (*logFilter, error) {
+	if params == nil {
+		return newLogFilter(nil, nil)
+	}
+	criteria, ok := params.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("invalid logs criteria %v", params)
+	}
+	var topics []interface{}
+	if t, exist := criteria["topics"]; exist && t != nil {
+		if topics, ok = t.([]interface{}); !ok {
+			return nil, fmt.Errorf("invalid logs topics %v", t)
+		}
+	}
+	return newLogFilter(criteria["address"], topics)
+}
+
+func (f *logFilter) match(l *types.LogNotification) bool {
+	if len(f.addresses) > 0 {
+		if _, ok := f.addresses[strings.ToLower(l.Log.Address)]; !ok {
+			return false
+		}
+	}
+	if len(f.topics) > len(l.Log.Topics) {
+		return false
+	}
+	for i, alternatives := range f.topics {
+		if len(alternatives) == 0 {
+			continue
+		}
+		topic := strings.ToLower(l.Log.Topics[i])
+		found := false
+		for _, alternative := range alternatives {
+			if topic == alternative {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+type logsBlock struct {
+	number uint64
+	hash   ethcommon.Hash
+	logs   []*types.LogNotification
+}
+
+// logsSubscription is the state of a logs subscription: its filter, and the logs sent for the recent blocks, which
+// are sent again as removed when the blocks are reorganized out of the chain
+type logsSubscription struct {
+	filter *logFilter
+	blocks []logsBlock
+}
+
+func newLogsSubscription(filter *logFilter) *logsSubscription {
+	return &logsSubscription{filter: filter}
+}
+
+// update tracks the logs sent for the block, and returns the logs sent for the blocks it replaces, marked as removed
+// and in reverse order. It returns false if the block was already seen
+func (s *logsSubscription) update(number uint64, hash, parentHash ethcommon.Hash, logs []*types.LogNotification) ([]*types.LogNotification, bool) {
+	for _, b := range s.blocks {
+		if b.hash == hash {
+			return nil, false
+		}
+	}
+
+	var removed []*types.LogNotification
+	for len(s.blocks) > 0 {
+		last := s.blocks[len(s.blocks)-1]
+		if last.number+1 < number || (last.number+1 == number && last.hash == parentHash) {
+			break
+		}
+		// the block is at the height of the new block or above, or it is not its parent
+		for i := len(last.logs) - 1; i >= 0; i-- {
+			removed = append(removed, last.logs[i].Removed())
+		}
+		s.blocks = s.blocks[:len(s.blocks)-1]
+	}
+
+	s.blocks = append(s.blocks, logsBlock{number: number, hash: hash, logs: logs})
+	if len(s.blocks) > logsReorgDepth {
+		s.blocks = s.blocks[len(s.blocks)-logsReorgDepth:]
+	}
+	return removed, true
+}
+
+// handleLogs fetches the receipts of the block transactions and sends the logs matching the subscription filter in
+// the order of the block. The logs sent for the blocks replaced by a reorg are sent first, marked as removed. Only the
+// errors of sending the logs are returned, the block whose logs cannot be fetched is skipped
+func handleLogs(feedManager *FeedManager, block *types.EthBlockNotification, subscription *logsSubscription, sendNotification func(notification *types.LogNotification) error) error {
+	nodeWS, ok := feedManager.getSyncedWSProvider(block.Source())
+	if !ok {
+		log.Errorf("failed to fetch logs of block %v, %v: node ws connection is not available", block.BlockHash, block.Header.Number)
+		return sendLogs(block, subscription, nil, sendNotification)
+	}
+	return sendBlockLogs(nodeWS, block, subscription, sendNotification)
+}
+
+// sendBlockLogs sends the logs of the block fetched from the node. If the logs cannot be fetched, the error is logged
+// and the block is tracked without logs, so the logs of the blocks it replaces are still sent as removed
+func sendBlockLogs(nodeWS blockchain.WSProvider, block *types.EthBlockNotification, subscription *logsSubscription, sendNotification func(notification *types.LogNotification) error) error {
+	var logs []*types.LogNotification
+	fetchErr := fetchTxReceipts(nodeWS, block, func(receipt map[string]interface{}) error {
+		receiptLogs, _ := receipt["logs"].([]interface{})
+		for _, receiptLog := range receiptLogs {
+			receiptLogMap, ok := receiptLog.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			logNotification, err := types.NewLogNotification(receiptLogMap)
+			if err != nil {
+				log.Debugf("failed to parse log of transaction %v in block %v: %v", receipt["transactionHash"], block.BlockHash, err)
+				continue
+			}
+			if subscription.filter.match(logNotification) {
+				logNotification.SetSequence(block.Sequence())
+				logs = append(logs, logNotification)
+			}
+		}
+		return nil
+	})
+	if fetchErr != nil {
+		log.Errorf("failed to fetch logs of block %v, %v: %v", block.BlockHash, block.Header.Number, fetchErr)
+		// none of the logs of the block is sent, so there are none to remove on reorg
+		logs = nil
+	}
+	return sendLogs(block, subscription, logs, sendNotification)
+}
+
+// sendLogs tracks the logs of the block and sends them, after the logs of the blocks it replaces marked as removed
+func sendLogs(block *types.EthBlockNotification, subscription *logsSubscription, logs []*types.LogNotification, sendNotification func(notification *types.LogNotification) error) error {
+	sort.Slice(logs, func(i, j int) bool {
+		return hexutil.MustDecodeUint64(logs[i].Log.LogIndex) < hexutil.MustDecodeUint64(logs[j].Log.LogIndex)
+	})
+
+	removed, isNew := subscription.update(block.Header.GetNumber(), *block.BlockHash, block.Header.ParentHash, logs)
+	if !isNew {
+		return nil
+	}
+	for _, logNotification := range append(removed, logs...) {
+		if err := sendNotification(logNotification); err != nil {
+			log.Errorf("failed to send log %v of transaction %v err %v", logNotification.Log.LogIndex, logNotification.Log.TransactionHash, err)
+			return err
+		}
+	}
+	log.Debugf("finished sending logs for block %v, %v", block.BlockHash, block.Header.Number)
+	return nil
+}
@@ -0,0 +1,200 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
+	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	ethcommon "github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testLogAddress = "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2"
+	transferTopic  = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+	approvalTopic  = "0x8c5be1e5ebec7d5bd14f71427d1e84f3dd0314c0f7b2291e5b200ac8c7c3b925"
+	senderTopic    = "0x000000000000000000000000a0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"
+)
+
+func newTestLog(t *testing.T, blockHash ethcommon.Hash, logIndex int, address string, topics ...string) *types.LogNotification {
+	logTopics := make([]interface{}, 0, len(topics))
+	for _, topic := range topics {
+		logTopics = append(logTopics, topic)
+	}
+	logNotification, err := types.NewLogNotification(map[string]interface{}{
+		"address":          address,
+		"topics":           logTopics,
+		"data":             "0x",
+		"blockNumber":      "0x10",
+		"transactionHash":  "0x6d1b3f3ae0e2b8b2c2f7a3e37d0f6c8b6f3d45e8b9e0c6b8b7c1f1e1a2b3c4d5",
+		"transactionIndex": "0x0",
+		"blockHash":        blockHash.Hex(),
+		"logIndex":         fmt.Sprintf("0x%x", logIndex),
+		"removed":          false,
+	})
+	require.NoError(t, err)
+	return logNotification
+}
+
+func TestLogFilter(t *testing.T) {
+	transfer := newTestLog(t, ethcommon.Hash{}, 0, testLogAddress, transferTopic, senderTopic)
+	approval := newTestLog(t, ethcommon.Hash{}, 1, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", approvalTopic)
+
+	tests := []struct {
+		name     string
+		params   interface{}
+		transfer bool
+		approval bool
+	}{
+		{"no criteria", nil, true, true},
+		{"address", map[string]interface{}{"address": testLogAddress}, true, false},
+		{"lowercase address", map[string]interface{}{"address": []interface{}{"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"}}, true, false},
+		{"topic", map[string]interface{}{"topics": []interface{}{approvalTopic}}, false, true},
+		{"topic alternatives", map[string]interface{}{"topics": []interface{}{[]interface{}{transferTopic, approvalTopic}}}, true, true},
+		{"any first topic", map[string]interface{}{"topics": []interface{}{nil, senderTopic}}, true, false},
+		{"null alternative", map[string]interface{}{"topics": []interface{}{[]interface{}{approvalTopic, nil}}}, true, true},
+		{"more topics than the log", map[string]interface{}{"topics": []interface{}{nil, nil, nil}}, false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			f, err := newLogFilterFromParams(test.params)
+			require.NoError(t, err)
+			assert.Equal(t, test.transfer, f.match(transfer))
+			assert.Equal(t, test.approval, f.match(approval))
+		})
+	}
+
+	invalid := []interface{}{
+		"logs",
+		map[string]interface{}{"address": "0x1234"},
+		map[string]interface{}{"address": 5},
+		map[string]interface{}{"topics": "0x1234"},
+		map[string]interface{}{"topics": []interface{}{"0x1234"}},
+		map[string]interface{}{"topics": []interface{}{nil, nil, nil, nil, nil}},
+	}
+	for _, params := range invalid {
+		_, err := newLogFilterFromParams(params)
+		assert.Error(t, err, params)
+	}
+}
+
+func TestLogsSubscriptionReorg(t *testing.T) {
+	hash := func(b byte) ethcommon.Hash { return ethcommon.Hash{b} }
+	s := newLogsSubscription(nil)
+
+	logs1 := []*types.LogNotification{newTestLog(t, hash(1), 0, testLogAddress), newTestLog(t, hash(1), 1, testLogAddress)}
+	removed, ok := s.update(1, hash(1), hash(0), logs1)
+	require.True(t, ok)
+	assert.Empty(t, removed)
+
+	logs2 := []*types.LogNotification{newTestLog(t, hash(2), 0, testLogAddress)}
+	removed, ok = s.update(2, hash(2), hash(1), logs2)
+	require.True(t, ok)
+	assert.Empty(t, removed)
+
+	// the same block again is ignored
+	_, ok = s.update(2, hash(2), hash(1), logs2)
+	assert.False(t, ok)
+
+	// a sibling of block 1 replaces blocks 1 and 2, whose logs are removed in reverse order
+	removed, ok = s.update(1, hash(11), hash(0), nil)
+	require.True(t, ok)
+	require.Len(t, removed, 3)
+	assert.Equal(t, []string{"0x0", "0x1", "0x0"}, []string{removed[0].Log.LogIndex, removed[1].Log.LogIndex, removed[2].Log.LogIndex})
+	assert.Equal(t, hash(2).Hex(), removed[0].Log.BlockHash)
+	assert.Equal(t, hash(1).Hex(), removed[2].Log.BlockHash)
+	for _, l := range removed {
+		assert.True(t, l.IsRemoved())
+		assert.Equal(t, true, l.EthFormat()["removed"])
+	}
+	assert.False(t, logs1[0].IsRemoved())
+
+	// a block which is not the child of the last block replaces it
+	logs12 := []*types.LogNotification{newTestLog(t, hash(12), 0, testLogAddress)}
+	_, ok = s.update(2, hash(12), hash(11), logs12)
+	require.True(t, ok)
+	removed, ok = s.update(3, hash(13), hash(22), nil)
+	require.True(t, ok)
+	require.Len(t, removed, 1)
+	assert.Equal(t, hash(12).Hex(), removed[0].Log.BlockHash)
+}
+
+type logsWSProvider struct {
+	blockchain.WSProvider
+	logs map[string][]interface{}
+}
+
+func (p logsWSProvider) FetchTransactionReceipt(payload []interface{}, _ blockchain.RPCOptions) (interface{}, error) {
+	logs, ok := p.logs[payload[0].(string)]
+	if !ok {
+		return nil, errors.New("receipt not found")
+	}
+	return map[string]interface{}{"transactionHash": payload[0], "logs": logs}, nil
+}
+
+func newTestLogsBlock(number int64, hash, parentHash ethcommon.Hash, txHashes ...string) *types.EthBlockNotification {
+	block := &types.EthBlockNotification{
+		BlockHash: &hash,
+		Header:    types.ConvertEthHeaderToBlockNotificationHeader(&ethtypes.Header{Number: big.NewInt(number), ParentHash: parentHash, Difficulty: big.NewInt(0)}),
+	}
+	for _, txHash := range txHashes {
+		block.Transactions = append(block.Transactions, map[string]interface{}{"hash": txHash})
+	}
+	return block
+}
+
+func TestSendBlockLogs_FetchError(t *testing.T) {
+	hash := func(b byte) ethcommon.Hash { return ethcommon.Hash{b} }
+	logEntry := func(blockHash ethcommon.Hash) interface{} {
+		return map[string]interface{}{
+			"address":          testLogAddress,
+			"topics":           []interface{}{transferTopic},
+			"data":             "0x",
+			"blockNumber":      "0x1",
+			"transactionHash":  "0x01",
+			"transactionIndex": "0x0",
+			"blockHash":        blockHash.Hex(),
+			"logIndex":         "0x0",
+			"removed":          false,
+		}
+	}
+	nodeWS := logsWSProvider{logs: map[string][]interface{}{"0x01": {logEntry(hash(1))}}}
+	filter, err := newLogFilter(nil, nil)
+	require.NoError(t, err)
+	s := newLogsSubscription(filter)
+
+	var sent []*types.LogNotification
+	send := func(l *types.LogNotification) error {
+		sent = append(sent, l)
+		return nil
+	}
+
+	require.NoError(t, sendBlockLogs(nodeWS, newTestLogsBlock(1, hash(1), hash(0), "0x01"), s, send))
+	require.Len(t, sent, 1)
+
+	// the logs of the sibling cannot be fetched, but the logs of the block it replaces are still removed and the
+	// subscription goes on
+	sent = nil
+	require.NoError(t, sendBlockLogs(nodeWS, newTestLogsBlock(1, hash(11), hash(0), "0x02"), s, send))
+	require.Len(t, sent, 1)
+	assert.True(t, sent[0].IsRemoved())
+	assert.Equal(t, hash(1).Hex(), sent[0].Log.BlockHash)
+
+	// the sibling is tracked, so its child is not a reorg
+	sent = nil
+	require.NoError(t, sendBlockLogs(nodeWS, newTestLogsBlock(2, hash(12), hash(11)), s, send))
+	assert.Empty(t, sent)
+}
+
+func TestGrpcLogs_Includes(t *testing.T) {
+	handler := NewGrpcHandler(newRPCDispatchFeedManager(t))
+	err := handler.Logs(&pb.LogsRequest{Includes: []string{"address", "tx_contents"}}, nil, sdnmessage.Account{})
+	require.EqualError(t, err, "got unsupported param tx_contents")
+}
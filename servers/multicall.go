@@ -0,0 +1,208 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/bloXroute-Labs/gateway/v2"
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const multicall3ABIJSON = `[{"inputs":[{"components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}],"name":"calls","type":"tuple[]"}],"name":"aggregate3","outputs":[{"components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}],"name":"returnData","type":"tuple[]"}],"stateMutability":"payable","type":"function"}]`
+
+var multicall3ABI = func() abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(multicall3ABIJSON))
+	if err != nil {
+		panic(err)
+	}
+	return parsed
+}()
+
+// errMulticallReverted is the error of an aggregated call which reverted
+var errMulticallReverted = errors.New("execution reverted")
+
+type multicall3Call struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+type multicall3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// onBlockCall is an ethOnBlock call of a block, and its response
+type onBlockCall struct {
+	call     *RPCCall
+	tag      string
+	payload  []interface{}
+	response interface{}
+	err      error
+}
+
+// aggregatable returns true if the call can be executed by the Multicall3 contract, which is the sender of the
+// aggregated calls and does not forward gas or value
+func (c *onBlockCall) aggregatable() bool {
+	if c.call.commandMethod != "eth_call" {
+		return false
+	}
+	if _, ok := c.call.callPayload["to"]; !ok {
+		return false
+	}
+	for _, field := range []string{"from", "gas", "gasPrice", "value"} {
+		if _, ok := c.call.callPayload[field]; ok {
+			return false
+		}
+	}
+	return true
+}
+
+// executeOnBlockCalls sends the calls to the node in one batch request. If multicall is set, the eth_call calls of
+// the same tag are aggregated in one call of the Multicall3 contract. The calls failing in the batch are sent again
+// one by one
+func executeOnBlockCalls(nodeWS blockchain.WSProvider, calls []*onBlockCall, multicall bool) error {
+	var batch []*blockchain.RPCBatchCall
+	// the calls answered by each call of the batch
+	var batchCalls [][]*onBlockCall
+
+	aggregated := make(map[string][]*onBlockCall)
+	var tags []string
+	for _, call := range calls {
+		if multicall && call.aggregatable() {
+			if _, ok := aggregated[call.tag]; !ok {
+				tags = append(tags, call.tag)
+			}
+			aggregated[call.tag] = append(aggregated[call.tag], call)
+			continue
+		}
+		batch = append(batch, &blockchain.RPCBatchCall{Method: call.call.commandMethod, Payload: call.payload})
+		batchCalls = append(batchCalls, []*onBlockCall{call})
+	}
+	for _, tag := range tags {
+		group := aggregated[tag]
+		if len(group) == 1 {
+			batch = append(batch, &blockchain.RPCBatchCall{Method: group[0].call.commandMethod, Payload: group[0].payload})
+			batchCalls = append(batchCalls, group)
+			continue
+		}
+		payload, err := multicallPayload(group, tag)
+		if err != nil {
+			return err
+		}
+		batch = append(batch, &blockchain.RPCBatchCall{Method: "eth_call", Payload: payload})
+		batchCalls = append(batchCalls, group)
+	}
+	if len(batch) == 0 {
+		return nil
+	}
+
+	if err := nodeWS.BatchCallRPC(batch, blockchain.RPCOptions{RetryAttempts: bxgateway.MaxEthOnBlockCallRetries, RetryInterval: bxgateway.EthOnBlockCallRetrySleepInterval}); err != nil {
+		log.Debugf("failed to send the batch of %v onBlock calls, sending them one by one: %v", len(batch), err)
+		for _, call := range calls {
+			call.err = err
+		}
+	} else {
+		for i, batchCall := range batch {
+			group := batchCalls[i]
+			if len(group) == 1 {
+				group[0].response, group[0].err = batchCall.Result, batchCall.Error
+				if group[0].err == nil && group[0].response == nil {
+					group[0].err = errors.New("empty response")
+				}
+				continue
+			}
+			setMulticallResponses(group, batchCall)
+		}
+	}
+
+	callFailedIndividually(nodeWS, calls)
+	return nil
+}
+
+// callFailedIndividually sends the failed calls one by one, since a call can fail because of the batch request or
+// the aggregate3 call it is part of, and only a call failing on its own is disabled
+func callFailedIndividually(nodeWS blockchain.WSProvider, calls []*onBlockCall) {
+	var wg sync.WaitGroup
+	for _, c := range calls {
+		if c.err == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(call *onBlockCall) {
+			defer wg.Done()
+			call.response, call.err = nodeWS.CallRPC(call.call.commandMethod, call.payload, blockchain.RPCOptions{RetryAttempts: bxgateway.MaxEthOnBlockCallRetries, RetryInterval: bxgateway.EthOnBlockCallRetrySleepInterval})
+			if call.err == nil && call.response == nil {
+				call.err = errors.New("empty response")
+			}
+		}(c)
+	}
+	wg.Wait()
+}
+
+// multicallPayload returns the eth_call payload of the aggregate3 call of the calls
+func multicallPayload(calls []*onBlockCall, tag string) ([]interface{}, error) {
+	aggregatedCalls := make([]multicall3Call, len(calls))
+	for i, call := range calls {
+		callData, err := hexutil.Decode(call.call.callPayload["data"])
+		if err != nil {
+			return nil, fmt.Errorf("invalid data of call %v: %v", call.call.callName, err)
+		}
+		aggregatedCalls[i] = multicall3Call{
+			Target:       common.HexToAddress(call.call.callPayload["to"]),
+			AllowFailure: true,
+			CallData:     callData,
+		}
+	}
+	data, err := multicall3ABI.Pack("aggregate3", aggregatedCalls)
+	if err != nil {
+		return nil, err
+	}
+	return []interface{}{map[string]string{"to": bxgateway.Multicall3Address, "data": hexutil.Encode(data)}, tag}, nil
+}
+
+// setMulticallResponses sets the responses of the aggregated calls from the response of the aggregate3 call
+func setMulticallResponses(calls []*onBlockCall, batchCall *blockchain.RPCBatchCall) {
+	setError := func(err error) {
+		for _, call := range calls {
+			call.err = err
+		}
+	}
+	if batchCall.Error != nil {
+		setError(batchCall.Error)
+		return
+	}
+	encoded, ok := batchCall.Result.(string)
+	if !ok {
+		setError(fmt.Errorf("unexpected Multicall3 response %v", batchCall.Result))
+		return
+	}
+	data, err := hexutil.Decode(encoded)
+	if err != nil {
+		setError(fmt.Errorf("invalid Multicall3 response: %v", err))
+		return
+	}
+	outputs, err := multicall3ABI.Unpack("aggregate3", data)
+	if err != nil || len(outputs) != 1 {
+		setError(fmt.Errorf("failed to decode Multicall3 response: %v", err))
+		return
+	}
+	results := *abi.ConvertType(outputs[0], new([]multicall3Result)).(*[]multicall3Result)
+	if len(results) != len(calls) {
+		setError(fmt.Errorf("expected %v Multicall3 results, got %v", len(calls), len(results)))
+		return
+	}
+	for i, call := range calls {
+		if !results[i].Success {
+			call.err = errMulticallReverted
+			continue
+		}
+		call.response = hexutil.Encode(results[i].ReturnData)
+	}
+}
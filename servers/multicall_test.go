@@ -0,0 +1,127 @@
+package servers
+
+import (
+	"errors"
+	"sync"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2"
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// testMulticallProvider answers the aggregate3 calls, the aggregated calls with an empty data revert. If batchErr is
+// set, the batch requests fail
+type testMulticallProvider struct {
+	blockchain.WSProvider
+	batchErr error
+	batches  [][]*blockchain.RPCBatchCall
+	lock     sync.Mutex
+	calls    []string
+}
+
+func (p *testMulticallProvider) CallRPC(method string, payload []interface{}, _ blockchain.RPCOptions) (interface{}, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	callObject, _ := payload[0].(map[string]string)
+	p.calls = append(p.calls, callObject["to"]+callObject["address"])
+	if method == "eth_call" && callObject["data"] == "0x" {
+		return nil, errors.New("execution reverted")
+	}
+	return "0x01", nil
+}
+
+func (p *testMulticallProvider) BatchCallRPC(calls []*blockchain.RPCBatchCall, _ blockchain.RPCOptions) error {
+	p.batches = append(p.batches, calls)
+	if p.batchErr != nil {
+		return p.batchErr
+	}
+	for _, call := range calls {
+		callObject, ok := call.Payload[0].(map[string]string)
+		if !ok || callObject["to"] != bxgateway.Multicall3Address {
+			call.Result = "0x01"
+			continue
+		}
+
+		data, err := hexutil.Decode(callObject["data"])
+		if err != nil {
+			return err
+		}
+		inputs, err := multicall3ABI.Methods["aggregate3"].Inputs.Unpack(data[4:])
+		if err != nil {
+			return err
+		}
+		aggregatedCalls := *abi.ConvertType(inputs[0], new([]multicall3Call)).(*[]multicall3Call)
+		results := make([]multicall3Result, len(aggregatedCalls))
+		for i, aggregatedCall := range aggregatedCalls {
+			results[i] = multicall3Result{Success: len(aggregatedCall.CallData) > 0, ReturnData: aggregatedCall.CallData}
+		}
+		output, err := multicall3ABI.Methods["aggregate3"].Outputs.Pack(results)
+		if err != nil {
+			return err
+		}
+		call.Result = hexutil.Encode(output)
+	}
+	return nil
+}
+
+func newTestOnBlockCall(method string, payload map[string]string) *onBlockCall {
+	call := newCall(method)
+	call.commandMethod = method
+	call.callPayload = payload
+	return &onBlockCall{call: call, tag: "0xa", payload: []interface{}{payload, "0xa"}}
+}
+
+func TestExecuteOnBlockCalls(t *testing.T) {
+	newCalls := func() []*onBlockCall {
+		return []*onBlockCall{
+			newTestOnBlockCall("eth_call", map[string]string{"to": "0x0000000000000000000000000000000000000001", "data": "0x1234"}),
+			newTestOnBlockCall("eth_call", map[string]string{"to": "0x0000000000000000000000000000000000000002", "data": "0x"}),
+			newTestOnBlockCall("eth_call", map[string]string{"to": "0x0000000000000000000000000000000000000003", "data": "0xabcd"}),
+			newTestOnBlockCall("eth_call", map[string]string{"to": "0x0000000000000000000000000000000000000004", "data": "0x12", "from": "0x0000000000000000000000000000000000000005"}),
+			newTestOnBlockCall("eth_getBalance", map[string]string{"address": "0x0000000000000000000000000000000000000001"}),
+		}
+	}
+
+	provider := &testMulticallProvider{}
+	calls := newCalls()
+	require.NoError(t, executeOnBlockCalls(provider, calls, true))
+	// the first three calls are aggregated, the call with a sender and the eth_getBalance call are not
+	require.Len(t, provider.batches, 1)
+	assert.Len(t, provider.batches[0], 3)
+	assert.Equal(t, "0x1234", calls[0].response)
+	assert.Equal(t, "0xabcd", calls[2].response)
+	assert.Equal(t, "0x01", calls[3].response)
+	assert.Equal(t, "0x01", calls[4].response)
+	// the reverted aggregated call is sent again on its own before it fails
+	assert.Equal(t, []string{"0x0000000000000000000000000000000000000002"}, provider.calls)
+	assert.EqualError(t, calls[1].err, "execution reverted")
+
+	provider = &testMulticallProvider{}
+	calls = newCalls()
+	require.NoError(t, executeOnBlockCalls(provider, calls, false))
+	require.Len(t, provider.batches, 1)
+	assert.Len(t, provider.batches[0], 5)
+	for _, call := range calls {
+		assert.NoError(t, call.err)
+		assert.Equal(t, "0x01", call.response)
+	}
+
+	// the calls of a failed batch request are sent one by one
+	provider = &testMulticallProvider{batchErr: errors.New("batch failed")}
+	calls = newCalls()
+	require.NoError(t, executeOnBlockCalls(provider, calls, true))
+	require.Len(t, provider.batches, 1)
+	assert.Len(t, provider.calls, len(calls))
+	for i, call := range calls {
+		if i == 1 {
+			assert.Error(t, call.err)
+			continue
+		}
+		assert.NoError(t, call.err)
+		assert.Equal(t, "0x01", call.response)
+	}
+}
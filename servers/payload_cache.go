@@ -0,0 +1,182 @@
+package servers
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bloXroute-Labs/gateway/v2"
+	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// payloadCacheSize is the number of recent notifications whose payloads are kept, it covers the notifications waiting
+// in the feed channel of a subscription
+const payloadCacheSize = 2 * bxgateway.BxNotificationChannelSize
+
+// payloadFormat is the format of an encoded notification payload
+type payloadFormat uint8
+
+// payloadFormat enumeration
+const (
+	// bxPayloadFormat - the JSON result of the subscribe notifications
+	bxPayloadFormat payloadFormat = iota
+	// ethPayloadFormat - the JSON result of the eth_subscribe notifications
+	ethPayloadFormat
+	// grpcPayloadFormat - the gRPC reply message
+	grpcPayloadFormat
+	// protobufPayloadFormat - the encoded WsNotification of the websocket binary frames
+	protobufPayloadFormat
+)
+
+// payloadKey identifies a payload of a notification, it is the same for all the subscriptions of the feed with the same
+// includes
+type payloadKey struct {
+	feed     types.FeedType
+	includes string
+	format   payloadFormat
+}
+
+type payloadEntry struct {
+	once    sync.Once
+	payload interface{}
+	err     error
+}
+
+// payloadCache keeps the encoded payloads of the recent notifications. The feed manager sends the same notification to
+// all the subscriptions of a feed, so the payload is encoded once per include-set and format instead of once per subscription
+type payloadCache struct {
+	lock     sync.Mutex
+	payloads map[types.Notification]map[payloadKey]*payloadEntry
+	// recent is a ring of the notifications with cached payloads, the oldest one is evicted by a new one
+	recent []types.Notification
+	next   int
+}
+
+func newPayloadCache(size int) *payloadCache {
+	return &payloadCache{
+		payloads: make(map[types.Notification]map[payloadKey]*payloadEntry, size),
+		recent:   make([]types.Notification, size),
+	}
+}
+
+// get returns the payload of the notification for the key. The payload is encoded once, the concurrent callers wait for it
+func (c *payloadCache) get(notification types.Notification, key payloadKey, encode func() (interface{}, error)) (interface{}, error) {
+	c.lock.Lock()
+	entries, ok := c.payloads[notification]
+	if !ok {
+		if evicted := c.recent[c.next]; evicted != nil {
+			delete(c.payloads, evicted)
+		}
+		c.recent[c.next] = notification
+		c.next = (c.next + 1) % len(c.recent)
+		entries = make(map[payloadKey]*payloadEntry)
+		c.payloads[notification] = entries
+	}
+	entry, ok := entries[key]
+	if !ok {
+		entry = &payloadEntry{}
+		entries[key] = entry
+	}
+	c.lock.Unlock()
+
+	entry.once.Do(func() {
+		entry.payload, entry.err = encode()
+	})
+	return entry.payload, entry.err
+}
+
+// payloadIncludes returns the includes of the request as a payload key, it is the same for any order of the includes
+func (c *clientReq) payloadIncludes() string {
+	if c.sortedIncludes == "" && len(c.includes) > 0 {
+		includes := append([]string(nil), c.includes...)
+		sort.Strings(includes)
+		c.sortedIncludes = strings.Join(includes, ",")
+	}
+	return c.sortedIncludes
+}
+
+// txPayload returns the JSON result of the transaction with the fields included by the request, or nil if the
+// transaction does not match the filter of the request. The time field is the time of the first encoding
+func (f *FeedManager) txPayload(clientReq *clientReq, tx *types.NewTransactionNotification) (json.RawMessage, error) {
+	if clientReq.filter != nil && !matchTxFilter(clientReq.filter, tx, clientReq.abis) {
+		return nil, nil
+	}
+	key := payloadKey{feed: clientReq.feed, includes: clientReq.payloadIncludes(), format: bxPayloadFormat}
+	payload, err := f.payloads.get(tx, key, func() (interface{}, error) {
+		result := includeTxFields(clientReq, tx)
+		if result == nil {
+			return json.RawMessage(nil), nil
+		}
+		b, err := json.Marshal(result)
+		return json.RawMessage(b), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payload.(json.RawMessage), nil
+}
+
+// notificationPayload returns the JSON result of the notification, built by the result function from the notification
+// with the fields included by the request
+func (f *FeedManager) notificationPayload(clientReq *clientReq, notification types.Notification, format payloadFormat, result func() interface{}) (json.RawMessage, error) {
+	key := payloadKey{feed: clientReq.feed, includes: clientReq.payloadIncludes(), format: format}
+	payload, err := f.payloads.get(notification, key, func() (interface{}, error) {
+		b, err := json.Marshal(result())
+		return json.RawMessage(b), err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payload.(json.RawMessage), nil
+}
+
+// grpcTx returns the gRPC transaction for the request, or nil if the transaction does not match the filter of the
+// request. The transaction is shared by the streams, so it must not be modified
+func (f *FeedManager) grpcTx(clientReq *clientReq, tx *types.NewTransactionNotification) *pb.Tx {
+	if clientReq.filter != nil && !matchTxFilter(clientReq.filter, tx, clientReq.abis) {
+		return nil
+	}
+	key := payloadKey{feed: clientReq.feed, includes: clientReq.payloadIncludes(), format: grpcPayloadFormat}
+	payload, _ := f.payloads.get(tx, key, func() (interface{}, error) {
+		result := includeTxFields(clientReq, tx)
+		if result == nil {
+			return (*pb.Tx)(nil), nil
+		}
+		return makeTransaction(*tx, result.DecodedInput), nil
+	})
+	return payload.(*pb.Tx)
+}
+
+// protobufTxPayload returns the encoded WsNotification of the transaction for the request, or nil if the transaction
+// does not match the filter of the request
+func (f *FeedManager) protobufTxPayload(clientReq *clientReq, tx *types.NewTransactionNotification) ([]byte, error) {
+	grpcTx := f.grpcTx(clientReq, tx)
+	if grpcTx == nil {
+		return nil, nil
+	}
+	key := payloadKey{feed: clientReq.feed, includes: clientReq.payloadIncludes(), format: protobufPayloadFormat}
+	payload, err := f.payloads.get(tx, key, func() (interface{}, error) {
+		return proto.Marshal(&pb.WsNotification{Txs: &pb.TxsReply{Tx: []*pb.Tx{grpcTx}}})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payload.([]byte), nil
+}
+
+// protobufBlockPayload returns the encoded WsNotification of the block with the fields included by the request
+func (f *FeedManager) protobufBlockPayload(clientReq *clientReq, block *types.EthBlockNotification) ([]byte, error) {
+	key := payloadKey{feed: clientReq.feed, includes: clientReq.payloadIncludes(), format: protobufPayloadFormat}
+	payload, err := f.payloads.get(block, key, func() (interface{}, error) {
+		reply := NewGrpcHandler(f).generateBlockReply(block.WithFields(clientReq.includes).(*types.EthBlockNotification))
+		reply.Sequence = types.NotificationSequence(block)
+		return proto.Marshal(&pb.WsNotification{Blocks: reply})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return payload.([]byte), nil
+}
@@ -0,0 +1,145 @@
+package servers
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadCache(t *testing.T) {
+	cache := newPayloadCache(2)
+	block1 := newSequencedBlockNotification(t, 1)
+	block2 := newSequencedBlockNotification(t, 2)
+	block3 := newSequencedBlockNotification(t, 3)
+
+	encodings := 0
+	get := func(notification types.Notification, key payloadKey) interface{} {
+		payload, err := cache.get(notification, key, func() (interface{}, error) {
+			encodings++
+			return encodings, nil
+		})
+		require.NoError(t, err)
+		return payload
+	}
+
+	hashKey := payloadKey{feed: types.NewBlocksFeed, includes: "hash", format: bxPayloadFormat}
+	headerKey := payloadKey{feed: types.NewBlocksFeed, includes: "header", format: bxPayloadFormat}
+	ethKey := payloadKey{feed: types.NewBlocksFeed, includes: "hash", format: ethPayloadFormat}
+
+	assert.Equal(t, 1, get(block1, hashKey))
+	assert.Equal(t, 1, get(block1, hashKey))
+	assert.Equal(t, 2, get(block1, headerKey))
+	assert.Equal(t, 3, get(block1, ethKey))
+	assert.Equal(t, 4, get(block2, hashKey))
+
+	// the oldest notification is evicted
+	assert.Equal(t, 5, get(block3, hashKey))
+	assert.Equal(t, 4, get(block2, hashKey))
+	assert.Equal(t, 6, get(block1, hashKey))
+}
+
+func TestTxPayload(t *testing.T) {
+	fm := &FeedManager{payloads: newPayloadCache(payloadCacheSize)}
+	tx := newCalldataTxNotification(t, nil)
+
+	req := &clientReq{feed: types.NewTxsFeed, includes: []string{"tx_hash", "raw_tx"}}
+	payload, err := fm.txPayload(req, tx)
+	require.NoError(t, err)
+	expected, err := json.Marshal(filterAndInclude(req, tx, "", ""))
+	require.NoError(t, err)
+	assert.JSONEq(t, string(expected), string(payload))
+
+	// the same includes in another order share the payload
+	reordered := &clientReq{feed: types.NewTxsFeed, includes: []string{"raw_tx", "tx_hash"}}
+	shared, err := fm.txPayload(reordered, tx)
+	require.NoError(t, err)
+	assert.Same(t, &payload[0], &shared[0])
+
+	hashOnly, err := fm.txPayload(&clientReq{feed: types.NewTxsFeed, includes: []string{"tx_hash"}}, tx)
+	require.NoError(t, err)
+	assert.JSONEq(t, fmt.Sprintf(`{"txHash":"%v"}`, tx.GetHash()), string(hashOnly))
+
+	filtered := &clientReq{feed: types.NewTxsFeed, includes: []string{"tx_hash"}}
+	filtered.filter, err = compileTxFilter("{gas} < 1000", nil)
+	require.NoError(t, err)
+	payload, err = fm.txPayload(filtered, tx)
+	require.NoError(t, err)
+	assert.Nil(t, payload)
+
+	grpcTx := fm.grpcTx(req, tx)
+	require.NotNil(t, grpcTx)
+	assert.Same(t, grpcTx, fm.grpcTx(reordered, tx))
+	assert.Equal(t, tx.RawTx(), grpcTx.RawTx)
+}
+
+// benchmarkTxSubscribers is the number of newTxs subscriptions receiving each transaction, half of them with the
+// default includes and half with the hash only
+const benchmarkTxSubscribers = 200
+
+func newBenchmarkTxSubscriptions() []*clientReq {
+	requests := make([]*clientReq, 0, benchmarkTxSubscribers)
+	for i := 0; i < benchmarkTxSubscribers; i++ {
+		includes := []string{"tx_hash"}
+		if i%2 == 0 {
+			includes = append(append(includes, txContentFields...), "tx_contents")
+		}
+		requests = append(requests, &clientReq{feed: types.NewTxsFeed, includes: includes})
+	}
+	return requests
+}
+
+func newBenchmarkTxs(b *testing.B) []*types.NewTransactionNotification {
+	// more transactions than the cache size, so each transaction is encoded again once per benchmark iteration
+	txs := make([]*types.NewTransactionNotification, 0, payloadCacheSize+1)
+	for i := 0; i < payloadCacheSize+1; i++ {
+		txs = append(txs, newCalldataTxNotification(b, []byte{byte(i), byte(i >> 8)}))
+	}
+	return txs
+}
+
+// BenchmarkTxNotification_PerSubscription encodes each transaction for every subscription, as before the payload cache
+func BenchmarkTxNotification_PerSubscription(b *testing.B) {
+	requests := newBenchmarkTxSubscriptions()
+	txs := newBenchmarkTxs(b)
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tx := txs[i%len(txs)]
+		for _, req := range requests {
+			result := filterAndInclude(req, tx, "", "")
+			if _, err := json.Marshal(struct {
+				Subscription string   `json:"subscription"`
+				Result       TxResult `json:"result"`
+			}{"subscription", *result}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+// BenchmarkTxNotification_SharedPayload encodes each transaction once per include-set
+func BenchmarkTxNotification_SharedPayload(b *testing.B) {
+	requests := newBenchmarkTxSubscriptions()
+	txs := newBenchmarkTxs(b)
+	fm := &FeedManager{payloads: newPayloadCache(payloadCacheSize)}
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	for i := 0; i < b.N; i++ {
+		tx := txs[i%len(txs)]
+		for _, req := range requests {
+			result, err := fm.txPayload(req, tx)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if _, err = json.Marshal(TxResponse{Subscription: "subscription", Result: result}); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,87 @@
+package servers
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils/filter"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// receiptFilter is a compiled filter of the txReceipts feed, with the same syntax as the filters of the transactions
+// feeds
+type receiptFilter = filter.Filter[*types.TxReceiptNotification]
+
+func receiptAddressVar(get func(n *types.TxReceiptNotification) interface{}) filter.Var[*types.TxReceiptNotification] {
+	return filter.StringVar(func(n *types.TxReceiptNotification) (string, bool) {
+		address, ok := get(n).(string)
+		return strings.ToLower(address), ok && address != ""
+	})
+}
+
+func receiptNumberVar(get func(n *types.TxReceiptNotification) string) filter.Var[*types.TxReceiptNotification] {
+	return filter.NumberVar(func(n *types.TxReceiptNotification) (*big.Int, bool) {
+		number, err := hexutil.DecodeBig(get(n))
+		return number, err == nil
+	})
+}
+
+// receiptLogs returns the fields of the logs of the receipt
+func receiptLogs(n *types.TxReceiptNotification, field string) []string {
+	var values []string
+	for _, l := range n.Receipt.Logs {
+		receiptLog, ok := l.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		switch value := receiptLog[field].(type) {
+		case string:
+			values = append(values, strings.ToLower(value))
+		case []interface{}:
+			for _, v := range value {
+				if s, ok := v.(string); ok {
+					values = append(values, strings.ToLower(s))
+				}
+			}
+		}
+	}
+	return values
+}
+
+// receiptFilterVars are the receipt fields available in the filters of the txReceipts feed
+var receiptFilterVars = map[string]filter.Var[*types.TxReceiptNotification]{
+	"from": receiptAddressVar(func(n *types.TxReceiptNotification) interface{} { return n.Receipt.From }),
+	"to":   receiptAddressVar(func(n *types.TxReceiptNotification) interface{} { return n.Receipt.To }),
+	"contract_address": receiptAddressVar(func(n *types.TxReceiptNotification) interface{} {
+		return n.Receipt.ContractAddress
+	}),
+	"status":   receiptNumberVar(func(n *types.TxReceiptNotification) string { return n.Receipt.Status }),
+	"gas_used": receiptNumberVar(func(n *types.TxReceiptNotification) string { return n.Receipt.GasUsed }),
+	"cumulative_gas_used": receiptNumberVar(func(n *types.TxReceiptNotification) string {
+		return n.Receipt.CumulativeGasUsed
+	}),
+	"effective_gas_price": receiptNumberVar(func(n *types.TxReceiptNotification) string {
+		return n.Receipt.EffectiveGasPrice
+	}),
+	"type": receiptNumberVar(func(n *types.TxReceiptNotification) string { return n.Receipt.TxType }),
+	"log_addresses": filter.StringListVar(func(n *types.TxReceiptNotification) ([]string, bool) {
+		return receiptLogs(n, "address"), true
+	}),
+	"log_topics": filter.StringListVar(func(n *types.TxReceiptNotification) ([]string, bool) {
+		return receiptLogs(n, "topics"), true
+	}),
+}
+
+// compileReceiptFilter compiles the filters of a txReceipts feed subscription
+func compileReceiptFilter(filters string) (*receiptFilter, error) {
+	f, err := filter.Compile(filters, func(name string) (filter.Var[*types.TxReceiptNotification], bool) {
+		v, ok := receiptFilterVars[name]
+		return v, ok
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing Filters: %v", err)
+	}
+	return f, nil
+}
@@ -0,0 +1,62 @@
+package servers
+
+import (
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testTransferTopic = "0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef"
+
+func newTestReceiptNotification(status string, logAddress string) *types.TxReceiptNotification {
+	return types.NewTxReceiptNotification(map[string]interface{}{
+		"from":              "0xAB5801a7D398351b8bE11C439e05C5B3259aeC9B",
+		"to":                "0x00000000000000000000000000000000000000aa",
+		"contractAddress":   nil,
+		"status":            status,
+		"gasUsed":           "0x5208",
+		"cumulativeGasUsed": "0x10000",
+		"effectiveGasPrice": "0x3b9aca00",
+		"type":              "0x2",
+		"logs": []interface{}{
+			map[string]interface{}{
+				"address": logAddress,
+				"topics":  []interface{}{testTransferTopic, "0x0000000000000000000000000000000000000000000000000000000000000001"},
+			},
+		},
+	}, "0x1")
+}
+
+func TestReceiptFilter(t *testing.T) {
+	succeeded := newTestReceiptNotification("0x1", "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	reverted := newTestReceiptNotification("0x0", "0x00000000000000000000000000000000000000bb")
+
+	tests := []struct {
+		filters   string
+		succeeded bool
+		reverted  bool
+	}{
+		{"status = 1", true, false},
+		{"status = 0", false, true},
+		{"from = 0xab5801a7d398351b8be11c439e05c5b3259aec9b", true, true},
+		{"to in [0x00000000000000000000000000000000000000aa]", true, true},
+		{"gas_used > 21000", false, false},
+		{"gas_used >= 21000 and type = 2", true, true},
+		{"log_addresses contains 0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", true, false},
+		{"status = 1 and log_topics contains " + testTransferTopic, true, false},
+		{"log_topics contains 0x0000000000000000000000000000000000000000000000000000000000000002", false, false},
+	}
+	for _, test := range tests {
+		t.Run(test.filters, func(t *testing.T) {
+			f, err := compileReceiptFilter(test.filters)
+			require.NoError(t, err)
+			assert.Equal(t, test.succeeded, f.Match(succeeded))
+			assert.Equal(t, test.reverted, f.Match(reverted))
+		})
+	}
+
+	_, err := compileReceiptFilter("value > 1")
+	assert.Error(t, err)
+}
@@ -0,0 +1,327 @@
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/sourcegraph/jsonrpc2"
+	websocketjsonrpc2 "github.com/sourcegraph/jsonrpc2/websocket"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ReplayServer serves a feed recording as a gateway websocket and gRPC endpoint, for deterministic backtests. Each
+// subscription gets the notifications of its feed from the start of the recording, at the recorded pace multiplied
+// by the speed
+type ReplayServer struct {
+	path  string
+	speed float64
+	log   *log.Entry
+}
+
+// NewReplayServer creates a new ReplayServer of the recording file. A zero speed replays the recording without waiting
+// between the notifications
+func NewReplayServer(path string, speed float64) (*ReplayServer, error) {
+	if speed < 0 {
+		return nil, fmt.Errorf("invalid replay speed %v, the speed must not be negative", speed)
+	}
+	recording, err := OpenRecording(path)
+	if err != nil {
+		return nil, err
+	}
+	_ = recording.Close()
+
+	return &ReplayServer{
+		path:  path,
+		speed: speed,
+		log:   log.WithField("component", "replayServer"),
+	}, nil
+}
+
+// Replay calls send with the records of the feed in order, waiting between them the recorded interval divided by the
+// speed. The records of dropped notifications are skipped
+func (s *ReplayServer) Replay(ctx context.Context, feed types.FeedType, send func(*FeedRecord) error) error {
+	recording, err := OpenRecording(s.path)
+	if err != nil {
+		return err
+	}
+	defer recording.Close()
+
+	var first time.Time
+	start := time.Now()
+	for {
+		record, err := recording.Next()
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if record.Feed != feed {
+			continue
+		}
+		if record.Dropped > 0 {
+			s.log.Warnf("the recording of the %v feed is incomplete, %v notifications were dropped", feed, record.Dropped)
+			continue
+		}
+
+		if first.IsZero() {
+			first = record.Time
+		}
+		if s.speed > 0 {
+			due := start.Add(time.Duration(float64(record.Time.Sub(first)) / s.speed))
+			if wait := time.Until(due); wait > 0 {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(wait):
+				}
+			}
+		}
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		if err = send(record); err != nil {
+			return err
+		}
+	}
+}
+
+// Run serves the websocket endpoint and the gRPC endpoint until the context is done, a zero port disables an endpoint
+func (s *ReplayServer) Run(ctx context.Context, wsPort int, grpcPort int) error {
+	errs := make(chan error, 2)
+	if wsPort != 0 {
+		server := &http.Server{Addr: fmt.Sprintf(":%v", wsPort), Handler: s.WSHandler()}
+		go func() {
+			<-ctx.Done()
+			_ = server.Close()
+		}()
+		go func() {
+			s.log.Infof("replaying %v on websocket port %v", s.path, wsPort)
+			errs <- server.ListenAndServe()
+		}()
+	}
+	if grpcPort != 0 {
+		listener, err := net.Listen("tcp", fmt.Sprintf(":%v", grpcPort))
+		if err != nil {
+			return err
+		}
+		server := grpc.NewServer()
+		s.RegisterGRPC(server)
+		go func() {
+			<-ctx.Done()
+			server.Stop()
+		}()
+		go func() {
+			s.log.Infof("replaying %v on gRPC port %v", s.path, grpcPort)
+			errs <- server.Serve(listener)
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errs:
+		return err
+	}
+}
+
+// WSHandler returns the handler of the websocket connections, which can subscribe and unsubscribe to the feeds
+func (s *ReplayServer) WSHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		connection, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			s.log.Errorf("error upgrading HTTP server connection to the WebSocket protocol - %v", err)
+			return
+		}
+		handler := &replayWSHandler{replay: s, subscriptions: make(map[string]context.CancelFunc)}
+		_ = jsonrpc2.NewConn(r.Context(), websocketjsonrpc2.NewObjectStream(connection), jsonrpc2.AsyncHandler(handler))
+	})
+}
+
+type replayWSHandler struct {
+	replay        *ReplayServer
+	lock          sync.Mutex
+	subscriptions map[string]context.CancelFunc
+}
+
+// Handle handles the subscribe and unsubscribe requests of a websocket connection
+func (h *replayWSHandler) Handle(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) {
+	var params []json.RawMessage
+	if req.Params == nil || json.Unmarshal(*req.Params, &params) != nil || len(params) == 0 {
+		SendErrorMsg(ctx, jsonrpc.InvalidParams, "params is missing in the request", conn, req.ID)
+		return
+	}
+
+	switch jsonrpc.RPCRequestType(req.Method) {
+	case jsonrpc.RPCSubscribe:
+		var feed types.FeedType
+		if err := json.Unmarshal(params[0], &feed); err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		subscriptionID := utils.GenerateUUID()
+		subscriptionCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		h.lock.Lock()
+		h.subscriptions[subscriptionID] = cancel
+		h.lock.Unlock()
+		defer func() {
+			h.lock.Lock()
+			delete(h.subscriptions, subscriptionID)
+			h.lock.Unlock()
+		}()
+
+		if err := conn.Reply(ctx, req.ID, subscriptionID); err != nil {
+			return
+		}
+		go func() {
+			select {
+			case <-conn.DisconnectNotify():
+				cancel()
+			case <-subscriptionCtx.Done():
+			}
+		}()
+
+		err := h.replay.Replay(subscriptionCtx, feed, func(record *FeedRecord) error {
+			result, err := record.result()
+			if err != nil {
+				return err
+			}
+			return conn.Notify(subscriptionCtx, "subscribe", BlockResponse{Subscription: subscriptionID, Result: result})
+		})
+		if err != nil && !errors.Is(err, context.Canceled) {
+			h.replay.log.Errorf("failed to replay %v feed: %v", feed, err)
+			SendErrorMsg(ctx, jsonrpc.InternalError, err.Error(), conn, req.ID)
+		}
+	case jsonrpc.RPCUnsubscribe:
+		var subscriptionID string
+		if err := json.Unmarshal(params[0], &subscriptionID); err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, err.Error(), conn, req.ID)
+			return
+		}
+		h.lock.Lock()
+		cancel, ok := h.subscriptions[subscriptionID]
+		h.lock.Unlock()
+		if !ok {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, fmt.Sprintf("subscription %v was not found", subscriptionID), conn, req.ID)
+			return
+		}
+		cancel()
+		_ = conn.Reply(ctx, req.ID, true)
+	default:
+		SendErrorMsg(ctx, jsonrpc.MethodNotFound, req.Method, conn, req.ID)
+	}
+}
+
+// result returns the result of the websocket notification of the record, the replies of the protobuf records are
+// encoded as JSON
+func (r *FeedRecord) result() (json.RawMessage, error) {
+	if r.Reply == nil {
+		return r.Result, nil
+	}
+	reply := r.reply()
+	if reply == nil {
+		return nil, fmt.Errorf("%v record has no reply", r.Feed)
+	}
+	return protojson.Marshal(reply)
+}
+
+// reply returns the gRPC reply of a protobuf record
+func (r *FeedRecord) reply() proto.Message {
+	switch {
+	case r.Reply == nil:
+		return nil
+	case r.Reply.Txs != nil:
+		return r.Reply.Txs
+	case r.Reply.Blocks != nil:
+		return r.Reply.Blocks
+	case r.Reply.TxReceipts != nil:
+		return r.Reply.TxReceipts
+	case r.Reply.BeaconBlocks != nil:
+		return r.Reply.BeaconBlocks
+	}
+	return nil
+}
+
+// RegisterGRPC registers the streams of the recorded feeds on the gRPC server
+func (s *ReplayServer) RegisterGRPC(server *grpc.Server) {
+	pb.RegisterGatewayServer(server, &replayGatewayServer{replay: s})
+}
+
+// replayGatewayServer serves the feeds of a protobuf recording as the gRPC streams of the gateway
+type replayGatewayServer struct {
+	pb.UnimplementedGatewayServer
+	replay *ReplayServer
+}
+
+func (g *replayGatewayServer) stream(ctx context.Context, feed types.FeedType, send func(message proto.Message) error) error {
+	recording, err := OpenRecording(g.replay.path)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	format := recording.Format()
+	_ = recording.Close()
+	if format != ProtobufRecordingFormat {
+		return status.Errorf(codes.FailedPrecondition, "%v recordings can only be replayed on the websocket endpoint", format)
+	}
+
+	err = g.replay.Replay(ctx, feed, func(record *FeedRecord) error {
+		return send(record.reply())
+	})
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
+
+func (g *replayGatewayServer) NewTxs(_ *pb.TxsRequest, stream pb.Gateway_NewTxsServer) error {
+	return g.stream(stream.Context(), types.NewTxsFeed, func(message proto.Message) error {
+		return stream.Send(message.(*pb.TxsReply))
+	})
+}
+
+func (g *replayGatewayServer) NewBlocks(_ *pb.BlocksRequest, stream pb.Gateway_NewBlocksServer) error {
+	return g.stream(stream.Context(), types.NewBlocksFeed, func(message proto.Message) error {
+		return stream.Send(message.(*pb.BlocksReply))
+	})
+}
+
+func (g *replayGatewayServer) BdnBlocks(_ *pb.BlocksRequest, stream pb.Gateway_BdnBlocksServer) error {
+	return g.stream(stream.Context(), types.BDNBlocksFeed, func(message proto.Message) error {
+		return stream.Send(message.(*pb.BlocksReply))
+	})
+}
+
+func (g *replayGatewayServer) TxReceipts(_ *pb.TxReceiptsRequest, stream pb.Gateway_TxReceiptsServer) error {
+	return g.stream(stream.Context(), types.TxReceiptsFeed, func(message proto.Message) error {
+		return stream.Send(message.(*pb.TxReceiptsReply))
+	})
+}
+
+func (g *replayGatewayServer) NewBeaconBlocks(_ *pb.BeaconBlocksRequest, stream pb.Gateway_NewBeaconBlocksServer) error {
+	return g.stream(stream.Context(), types.NewBeaconBlocksFeed, func(message proto.Message) error {
+		return stream.Send(message.(*pb.BeaconBlocksReply))
+	})
+}
+
+func (g *replayGatewayServer) BdnBeaconBlocks(_ *pb.BeaconBlocksRequest, stream pb.Gateway_BdnBeaconBlocksServer) error {
+	return g.stream(stream.Context(), types.BDNBeaconBlocksFeed, func(message proto.Message) error {
+		return stream.Send(message.(*pb.BeaconBlocksReply))
+	})
+}
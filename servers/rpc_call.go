@@ -3,8 +3,9 @@ package servers
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
-	"sync"
+	"time"
 
 	"github.com/bloXroute-Labs/gateway/v2"
 	"github.com/bloXroute-Labs/gateway/v2/blockchain"
@@ -69,6 +70,10 @@ func (c *RPCCall) constructCall(callParams map[string]string, nodeWSManager bloc
 	if err != nil {
 		return err
 	}
+	// the values of the payload fields are validated by constructing the payload once
+	if _, err = nodeWSManager.ConstructRPCCallPayload(c.commandMethod, c.callPayload, "latest"); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -127,39 +132,58 @@ func handleEthOnBlock(feedManager *FeedManager, block *types.EthBlockNotificatio
 		}
 		blockHeightStr := block.Header.Number
 		hashStr := block.BlockHash.String()
+		provider := nodeWS.BlockchainPeerEndpoint().IPPort()
 
-		var wg sync.WaitGroup
-		for _, c := range calls {
-			wg.Add(1)
-			go func(call *RPCCall) {
-				defer wg.Done()
-				if !call.active {
-					return
-				}
-				tag := hexutil.EncodeUint64(block.Header.GetNumber() + uint64(call.blockOffset))
-				payload, err := feedManager.nodeWSManager.ConstructRPCCallPayload(call.commandMethod, call.callPayload, tag)
-				if err != nil {
-					return
-				}
-				response, err := nodeWS.CallRPC(call.commandMethod, payload, blockchain.RPCOptions{RetryAttempts: bxgateway.MaxEthOnBlockCallRetries, RetryInterval: bxgateway.EthOnBlockCallRetrySleepInterval})
-				if err != nil {
-					log.Debugf("disabling failed onBlock call %v: %v", call.callName, err)
-					call.active = false
-					taskDisabledNotification := types.NewOnBlockNotification(bxgateway.TaskDisabledEvent, call.string(), blockHeightStr, tag, hashStr)
-					err = sendNotification(taskDisabledNotification)
-					if err != nil {
-						log.Errorf("failed to send TaskDisabledNotification for %v", call.callName)
-					}
-					return
-				}
-				onBlockNotification := types.NewOnBlockNotification(call.callName, response.(string), blockHeightStr, tag, hashStr)
-				err = sendNotification(onBlockNotification)
-				if err != nil {
-					return
+		names := make([]string, 0, len(calls))
+		for name := range calls {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		blockCalls := make([]*onBlockCall, 0, len(calls))
+		for _, name := range names {
+			call := calls[name]
+			if !call.active {
+				continue
+			}
+			tag := hexutil.EncodeUint64(block.Header.GetNumber() + uint64(call.blockOffset))
+			payload, err := feedManager.nodeWSManager.ConstructRPCCallPayload(call.commandMethod, call.callPayload, tag)
+			if err != nil {
+				continue
+			}
+			blockCalls = append(blockCalls, &onBlockCall{call: call, tag: tag, payload: payload})
+		}
+
+		// all the calls of the block are sent in one request, so they have the same latency
+		start := time.Now()
+		if err := executeOnBlockCalls(nodeWS, blockCalls, feedManager.cfg.EthOnBlockMulticall); err != nil {
+			for _, blockCall := range blockCalls {
+				blockCall.err = err
+			}
+		}
+		latency := float64(time.Since(start).Microseconds()) / 1000
+
+		for _, blockCall := range blockCalls {
+			call := blockCall.call
+			if blockCall.err != nil {
+				log.Debugf("disabling failed onBlock call %v: %v", call.callName, blockCall.err)
+				call.active = false
+				taskDisabledNotification := types.NewOnBlockNotification(bxgateway.TaskDisabledEvent, call.string(), blockHeightStr, blockCall.tag, hashStr)
+				if err := sendNotification(taskDisabledNotification); err != nil {
+					log.Errorf("failed to send TaskDisabledNotification for %v", call.callName)
 				}
-			}(c)
+				continue
+			}
+			onBlockNotification := types.NewOnBlockNotification(call.callName, "", blockHeightStr, blockCall.tag, hashStr)
+			if err := onBlockNotification.SetResponse(blockCall.response); err != nil {
+				log.Errorf("failed to encode the response of onBlock call %v: %v", call.callName, err)
+				continue
+			}
+			onBlockNotification.LatencyMs = latency
+			onBlockNotification.Provider = provider
+			if err := sendNotification(onBlockNotification); err != nil {
+				log.Errorf("failed to send onBlock notification for %v", call.callName)
+			}
 		}
-		wg.Wait()
 		taskCompletedNotification := types.NewOnBlockNotification(bxgateway.TaskCompletedEvent, "", blockHeightStr, blockHeightStr, hashStr)
 		err := sendNotification(taskCompletedNotification)
 		if err != nil {
@@ -171,44 +195,87 @@ func handleEthOnBlock(feedManager *FeedManager, block *types.EthBlockNotificatio
 	return nil
 }
 
-func handleTxReceipts(feedManager *FeedManager, block *types.EthBlockNotification, sendNotification func(notification *types.TxReceiptNotification) error) error {
+// handleTxReceipts sends the receipts of the block transactions matching the filter, all of them if it is nil
+func handleTxReceipts(feedManager *FeedManager, block *types.EthBlockNotification, receiptsFilter *receiptFilter, sendNotification func(notification *types.TxReceiptNotification) error) error {
 	nodeWS, ok := feedManager.getSyncedWSProvider(block.Source())
 	if !ok {
 		log.Errorf("node ws connection is not available")
 		return fmt.Errorf("node ws connection is not available")
 	}
 
+	err := fetchTxReceipts(nodeWS, block, func(receipt map[string]interface{}) error {
+		txReceiptNotification := types.NewTxReceiptNotification(receipt, "")
+		if receiptsFilter != nil && !receiptsFilter.Match(txReceiptNotification) {
+			return nil
+		}
+
+		responseBlock, err := nodeWS.FetchBlock([]interface{}{receipt["blockNumber"], false}, blockchain.RPCOptions{RetryAttempts: bxgateway.MaxEthOnBlockCallRetries, RetryInterval: bxgateway.EthOnBlockCallRetrySleepInterval})
+		var txsCount int
+		if err == nil && responseBlock != nil {
+			transactions, exist := responseBlock.(map[string]interface{})["transactions"]
+			txsCount = len(transactions.([]interface{}))
+			if !exist {
+				return fmt.Errorf("transactions field doesn't exist when query previous epoch block")
+			}
+		}
+
+		txReceiptNotification.Receipt.TxsCount = fmt.Sprintf("0x%x", txsCount)
+		txReceiptNotification.SetSequence(txReceiptSequence(block.Sequence(), txIndex(receipt)))
+		if err = sendNotification(txReceiptNotification); err != nil {
+			log.Errorf("failed to send tx receipt for %v err %v", receipt["transactionHash"], err)
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		return nil
+	}
+	log.Debugf("finished fetching transaction receipts for block %v, %v", block.BlockHash, block.Header.Number)
+	return nil
+}
+
+// fetchTxReceipts fetches the receipts of the block transactions from the node concurrently, and calls handleReceipt
+// with each of them in the order of the block transactions
+func fetchTxReceipts(nodeWS blockchain.WSProvider, block *types.EthBlockNotification, handleReceipt func(receipt map[string]interface{}) error) error {
+	receipts := make([]chan map[string]interface{}, len(block.Transactions))
 	g := new(errgroup.Group)
-	for _, t := range block.Transactions {
+	for i, t := range block.Transactions {
 		tx := t
+		receipt := make(chan map[string]interface{}, 1)
+		receipts[i] = receipt
 		g.Go(func() error {
+			defer close(receipt)
 			hash := tx["hash"]
 			responseTxReceipt, err := nodeWS.FetchTransactionReceipt([]interface{}{hash}, blockchain.RPCOptions{RetryAttempts: bxgateway.MaxEthTxReceiptCallRetries, RetryInterval: bxgateway.EthTxReceiptCallRetrySleepInterval})
 			if err != nil || responseTxReceipt == nil {
 				log.Debugf("failed to fetch transaction receipt for %v in block %v: %v", hash, block.BlockHash, err)
 				return err
 			}
-			responseBlock, err := nodeWS.FetchBlock([]interface{}{responseTxReceipt.(map[string]interface{})["blockNumber"], false}, blockchain.RPCOptions{RetryAttempts: bxgateway.MaxEthOnBlockCallRetries, RetryInterval: bxgateway.EthOnBlockCallRetrySleepInterval})
-			var txsCount int
-			if err == nil && responseBlock != nil {
-				transactions, exist := responseBlock.(map[string]interface{})["transactions"]
-				txsCount = len(transactions.([]interface{}))
-				if !exist {
-					return fmt.Errorf("transactions field doesn't exist when query previous epoch block")
-				}
-			}
-
-			txReceiptNotification := types.NewTxReceiptNotification(responseTxReceipt.(map[string]interface{}), fmt.Sprintf("0x%x", txsCount))
-			if err = sendNotification(txReceiptNotification); err != nil {
-				log.Errorf("failed to send tx receipt for %v err %v", hash, err)
-				return err
-			}
-			return err
+			receipt <- responseTxReceipt.(map[string]interface{})
+			return nil
 		})
 	}
+
+	var handleErr error
+	for _, receipt := range receipts {
+		r, ok := <-receipt
+		if !ok || handleErr != nil {
+			continue
+		}
+		handleErr = handleReceipt(r)
+	}
 	if err := g.Wait(); err != nil {
-		return nil
+		return err
 	}
-	log.Debugf("finished fetching transaction receipts for block %v, %v", block.BlockHash, block.Header.Number)
-	return nil
+	return handleErr
+}
+
+// txIndex returns the index of the transaction of the receipt in its block
+func txIndex(receipt map[string]interface{}) uint64 {
+	index, _ := receipt["transactionIndex"].(string)
+	i, err := hexutil.DecodeUint64(index)
+	if err != nil {
+		log.Debugf("invalid transaction index %v of receipt %v: %v", receipt["transactionIndex"], receipt["transactionHash"], err)
+	}
+	return i
 }
@@ -0,0 +1,146 @@
+package servers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// rpcBatchMaxSize is the max number of requests of a batch request
+const rpcBatchMaxSize = 100
+
+// connectionMethods are the methods which need a persistent connection, they are not handled without one
+var connectionMethods = []jsonrpc.RPCRequestType{jsonrpc.RPCSubscribe, jsonrpc.RPCEthSubscribe}
+
+// rpcResponseStream is a jsonrpc2.ObjectStream without a peer, it keeps the responses written by the handler of a
+// request, so the requests of HTTP and of the batch requests are handled like the websocket ones
+type rpcResponseStream struct {
+	lock      sync.Mutex
+	responses []json.RawMessage
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newRPCResponseStream() *rpcResponseStream {
+	return &rpcResponseStream{closed: make(chan struct{})}
+}
+
+// WriteObject implements jsonrpc2.ObjectStream, the notifications are discarded
+func (s *rpcResponseStream) WriteObject(obj interface{}) error {
+	message, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	var header struct {
+		Method *string `json:"method"`
+	}
+	if err = json.Unmarshal(message, &header); err != nil {
+		return err
+	}
+	if header.Method != nil {
+		return nil
+	}
+	s.lock.Lock()
+	s.responses = append(s.responses, message)
+	s.lock.Unlock()
+	return nil
+}
+
+// ReadObject implements jsonrpc2.ObjectStream, there is nothing to read until the stream is closed
+func (s *rpcResponseStream) ReadObject(interface{}) error {
+	<-s.closed
+	return io.EOF
+}
+
+// Close implements jsonrpc2.ObjectStream
+func (s *rpcResponseStream) Close() error {
+	s.closeOnce.Do(func() { close(s.closed) })
+	return nil
+}
+
+// response returns the first response written by the handler
+func (s *rpcResponseStream) response() json.RawMessage {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if len(s.responses) == 0 {
+		return nil
+	}
+	return s.responses[0]
+}
+
+// isBatchRequest returns true if the message is a JSON-RPC batch, an array of requests
+func isBatchRequest(message []byte) bool {
+	trimmed := bytes.TrimLeft(message, " \t\r\n")
+	return len(trimmed) > 0 && trimmed[0] == '['
+}
+
+// rpcErrorResponse returns the encoded error response of the request
+func rpcErrorResponse(id jsonrpc2.ID, code jsonrpc.RPCErrorCode, data string) json.RawMessage {
+	rpcError := &jsonrpc2.Error{
+		Code:    int64(code),
+		Message: jsonrpc.ErrorMsg[code],
+	}
+	rpcError.SetError(data)
+	response, _ := json.Marshal(&jsonrpc2.Response{ID: id, Error: rpcError})
+	return response
+}
+
+// handleRequest handles an encoded request without a connection, and returns its encoded response. The response is
+// nil for a notification
+func (h *handlerObj) handleRequest(ctx context.Context, message json.RawMessage) json.RawMessage {
+	req := &jsonrpc2.Request{}
+	if err := json.Unmarshal(message, req); err != nil {
+		return rpcErrorResponse(jsonrpc2.ID{}, jsonrpc.InvalidRequest, err.Error())
+	}
+	for _, method := range connectionMethods {
+		if jsonrpc.RPCRequestType(req.Method) == method {
+			return rpcErrorResponse(req.ID, jsonrpc.InvalidRequest, fmt.Sprintf("%v requires a websocket connection", req.Method))
+		}
+	}
+
+	stream := newRPCResponseStream()
+	conn := jsonrpc2.NewConn(ctx, stream, nil)
+	defer conn.Close()
+	h.Handle(ctx, conn, req)
+
+	if req.Notif {
+		return nil
+	}
+	if response := stream.response(); response != nil {
+		return response
+	}
+	return rpcErrorResponse(req.ID, jsonrpc.InternalError, fmt.Sprintf("%v was not answered", req.Method))
+}
+
+// handleBatch handles the requests of a batch in order, and returns the encoded array of their responses. The
+// response is nil if all the requests are notifications
+func (h *handlerObj) handleBatch(ctx context.Context, message json.RawMessage) json.RawMessage {
+	var requests []json.RawMessage
+	if err := json.Unmarshal(message, &requests); err != nil {
+		return rpcErrorResponse(jsonrpc2.ID{}, jsonrpc.ParseError, err.Error())
+	}
+	if len(requests) == 0 {
+		return rpcErrorResponse(jsonrpc2.ID{}, jsonrpc.InvalidRequest, "empty batch")
+	}
+	if len(requests) > rpcBatchMaxSize {
+		return rpcErrorResponse(jsonrpc2.ID{}, jsonrpc.InvalidRequest, fmt.Sprintf("batch of %v requests exceeds the limit of %v requests", len(requests), rpcBatchMaxSize))
+	}
+
+	responses := make([]json.RawMessage, 0, len(requests))
+	for _, request := range requests {
+		if response := h.handleRequest(ctx, request); response != nil {
+			responses = append(responses, response)
+		}
+	}
+	if len(responses) == 0 {
+		return nil
+	}
+	response, _ := json.Marshal(responses)
+	return response
+}
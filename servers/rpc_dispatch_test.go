@@ -0,0 +1,172 @@
+package servers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/config"
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	"github.com/bloXroute-Labs/gateway/v2/services"
+	"github.com/bloXroute-Labs/gateway/v2/services/statistics"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/gorilla/websocket"
+	"github.com/sourcegraph/jsonrpc2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const rpcDispatchBatch = `[
+	{"jsonrpc":"2.0","id":1,"method":"ping"},
+	{"jsonrpc":"2.0","id":2,"method":"quota_usage"},
+	{"jsonrpc":"2.0","method":"ping"},
+	{"jsonrpc":"2.0","id":3,"method":"unknown_method"},
+	{"jsonrpc":"2.0","id":4,"method":"subscribe","params":["newTxs",{"include":["tx_hash"]}]}
+]`
+
+func newRPCDispatchFeedManager(t *testing.T) *FeedManager {
+	gwAccount, _ := getMockCustomerAccountModel("gw")
+	fm := NewFeedManager(context.Background(), bxmock.MockBxListener{}, make(chan types.Notification), services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 1, types.NodeID("nodeID"), nil, gwAccount, getMockCustomerAccountModel, "", "", config.Bx{}, statistics.NoStats{}, nil, nil)
+	require.NoError(t, fm.Start())
+	return fm
+}
+
+func assertRPCDispatchBatch(t *testing.T, responses []jsonrpc2.Response) {
+	require.Len(t, responses, 4)
+	for i, response := range responses {
+		assert.Equal(t, jsonrpc2.ID{Num: uint64(i + 1)}, response.ID)
+	}
+
+	require.Nil(t, responses[0].Error)
+	var pong rpcPingResponse
+	require.NoError(t, json.Unmarshal(*responses[0].Result, &pong))
+	assert.NotEmpty(t, pong.Pong)
+
+	require.Nil(t, responses[1].Error)
+	assert.Contains(t, string(*responses[1].Result), `"quota_filled":1`)
+
+	require.NotNil(t, responses[2].Error)
+	assert.Equal(t, int64(jsonrpc.MethodNotFound), responses[2].Error.Code)
+
+	require.NotNil(t, responses[3].Error)
+	assert.Equal(t, int64(jsonrpc.InvalidRequest), responses[3].Error.Code)
+}
+
+func TestHTTPServer_RPCDispatch(t *testing.T) {
+	fm := newRPCDispatchFeedManager(t)
+	server := httptest.NewServer(NewHTTPServer(fm, 0, getMockQuotaUsage, false, nil, mockAuthorize).setupHandlers())
+	defer server.Close()
+
+	post := func(body string, authorization string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(body))
+		require.NoError(t, err)
+		if authorization != "" {
+			req.Header.Set("Authorization", authorization)
+		}
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return resp
+	}
+
+	t.Run("single", func(t *testing.T) {
+		resp := post(`{"jsonrpc":"2.0","id":7,"method":"ping"}`, "Z3c6c2VjcmV0")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var response jsonrpc2.Response
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		assert.Equal(t, jsonrpc2.ID{Num: 7}, response.ID)
+		assert.Nil(t, response.Error)
+	})
+
+	t.Run("batch", func(t *testing.T) {
+		resp := post(rpcDispatchBatch, "Z3c6c2VjcmV0")
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var responses []jsonrpc2.Response
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&responses))
+		assertRPCDispatchBatch(t, responses)
+	})
+
+	t.Run("notification", func(t *testing.T) {
+		resp := post(`{"jsonrpc":"2.0","method":"ping"}`, "Z3c6c2VjcmV0")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+	})
+
+	t.Run("empty batch", func(t *testing.T) {
+		resp := post(`[]`, "Z3c6c2VjcmV0")
+		defer resp.Body.Close()
+		var response jsonrpc2.Response
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+		require.NotNil(t, response.Error)
+		assert.Equal(t, int64(jsonrpc.InvalidRequest), response.Error.Code)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		resp := post(`{"jsonrpc":"2.0","id":1,"method":"ping"}`, "")
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+
+	for _, method := range []jsonrpc.RPCRequestType{jsonrpc.RPCBundleSimulation, jsonrpc.RPCEthCallBundle} {
+		t.Run(string(method), func(t *testing.T) {
+			request := `{"jsonrpc":"2.0","id":1,"method":"` + string(method) + `","params":[{"txs":[],"blockNumber":"0x1"}]}`
+
+			resp := post(request, "")
+			defer resp.Body.Close()
+			assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+
+			// the account of the request is not the account of the gateway
+			resp = post(request, "YTpzZWNyZXQ=")
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			var response jsonrpc2.Response
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+			require.NotNil(t, response.Error)
+			assert.Equal(t, int64(jsonrpc.AccountIDError), response.Error.Code)
+
+			resp = post(request, "Z3c6c2VjcmV0")
+			defer resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+			response = jsonrpc2.Response{}
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&response))
+			if response.Error != nil {
+				assert.NotEqual(t, int64(jsonrpc.AccountIDError), response.Error.Code)
+			}
+		})
+	}
+}
+
+func TestWSServer_RPCBatch(t *testing.T) {
+	fm := newRPCDispatchFeedManager(t)
+	server := httptest.NewServer(NewWSServer(fm, getMockQuotaUsage, false, nil, mockAuthorize).Handler)
+	defer server.Close()
+
+	headers := make(http.Header)
+	headers.Set("Authorization", "Z3c6c2VjcmV0")
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), headers)
+	require.NoError(t, err)
+	defer ws.Close()
+
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte(rpcDispatchBatch)))
+	_, message, err := ws.ReadMessage()
+	require.NoError(t, err)
+	require.True(t, bytes.HasPrefix(message, []byte("[")))
+	var responses []jsonrpc2.Response
+	require.NoError(t, json.Unmarshal(message, &responses))
+	assertRPCDispatchBatch(t, responses)
+
+	// the single requests are still handled by the connection
+	require.NoError(t, ws.WriteMessage(websocket.TextMessage, []byte(`{"jsonrpc":"2.0","id":5,"method":"ping"}`)))
+	_, message, err = ws.ReadMessage()
+	require.NoError(t, err)
+	var response jsonrpc2.Response
+	require.NoError(t, json.Unmarshal(message, &response))
+	assert.Equal(t, jsonrpc2.ID{Num: 5}, response.ID)
+	assert.Nil(t, response.Error)
+}
@@ -0,0 +1,246 @@
+package servers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	// rpcProxyCacheSize is the max number of results cached for a head
+	rpcProxyCacheSize = 10000
+	// rpcProxyPendingTag is the block tag of the calls which results can change without a new head
+	rpcProxyPendingTag = "pending"
+	// rpcProxyHeadInterval is how often the heads of the nodes are polled
+	rpcProxyHeadInterval = time.Second
+)
+
+// rpcProxyOptions are the options of the calls of the proxy, the failover to the next node replaces the retries
+var rpcProxyOptions = blockchain.RPCOptions{RetryAttempts: 1}
+
+// errNoSyncedNode is returned if no node can answer a call of the proxy
+var errNoSyncedNode = errors.New("no synced blockchain node is connected to the gateway")
+
+// rpcProxyHead is the latest block of a node
+type rpcProxyHead struct {
+	number uint64
+	hash   string
+}
+
+// rpcProxy forwards the read only blockchain RPC methods of its allowlist to the synced nodes. The nodes which are not
+// synced are skipped, and a call failing on a node is sent to the next one. The heads of the nodes are polled, and the
+// results are cached until the highest head changes
+type rpcProxy struct {
+	wsManager blockchain.WSManager
+	methods   map[string]bool
+	log       *log.Entry
+
+	lock  sync.Mutex
+	heads map[string]rpcProxyHead
+	head  string
+	cache map[string]interface{}
+}
+
+func newRPCProxy(wsManager blockchain.WSManager, methods []string) *rpcProxy {
+	p := &rpcProxy{
+		wsManager: wsManager,
+		methods:   make(map[string]bool, len(methods)),
+		log:       log.WithField("component", "rpcProxy"),
+		heads:     make(map[string]rpcProxyHead),
+		cache:     make(map[string]interface{}),
+	}
+	for _, method := range methods {
+		p.methods[method] = true
+	}
+	return p
+}
+
+// allowed returns true if the method is forwarded by the proxy
+func (p *rpcProxy) allowed(method string) bool {
+	return p != nil && p.methods[method]
+}
+
+// run polls the heads of the nodes until the context is done
+func (p *rpcProxy) run(ctx context.Context) {
+	ticker := time.NewTicker(rpcProxyHeadInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			p.pollHeads()
+		}
+	}
+}
+
+// pollHeads fetches the latest block of each synced node. The cached results are discarded when the highest head
+// changes, including a reorg to a block of the same height
+func (p *rpcProxy) pollHeads() {
+	heads := make(map[string]rpcProxyHead)
+	var highest rpcProxyHead
+	for address, provider := range p.wsManager.Providers() {
+		if !provider.IsOpen() || provider.SyncStatus() != blockchain.Synced {
+			continue
+		}
+		head, err := fetchRPCProxyHead(provider)
+		if err != nil {
+			p.log.Debugf("failed to fetch the head of %v: %v", address, err)
+			continue
+		}
+		heads[address] = head
+		if head.number > highest.number || (head.number == highest.number && head.hash > highest.hash) {
+			highest = head
+		}
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.heads = heads
+	if highest.hash == "" || highest.hash == p.head {
+		return
+	}
+	p.head = highest.hash
+	p.cache = make(map[string]interface{})
+}
+
+func fetchRPCProxyHead(provider blockchain.WSProvider) (rpcProxyHead, error) {
+	result, err := provider.CallRPC("eth_getBlockByNumber", []interface{}{"latest", false}, rpcProxyOptions)
+	if err != nil {
+		return rpcProxyHead{}, err
+	}
+	block, ok := result.(map[string]interface{})
+	if !ok {
+		return rpcProxyHead{}, fmt.Errorf("unexpected latest block %v", result)
+	}
+	number, _ := block["number"].(string)
+	hash, _ := block["hash"].(string)
+	blockNumber, err := hexutil.DecodeUint64(number)
+	if err != nil || hash == "" {
+		return rpcProxyHead{}, fmt.Errorf("invalid latest block number %v hash %v", number, hash)
+	}
+	return rpcProxyHead{number: blockNumber, hash: hash}, nil
+}
+
+// call returns the result of the method from the cache, or from the first synced node answering it
+func (p *rpcProxy) call(method string, params []interface{}) (interface{}, error) {
+	key, cacheable := rpcProxyCacheKey(method, params)
+
+	p.lock.Lock()
+	head := p.head
+	if cacheable {
+		if result, ok := p.cache[key]; ok {
+			p.lock.Unlock()
+			return result, nil
+		}
+	}
+	p.lock.Unlock()
+
+	result, err := p.callNodes(method, params)
+	if err != nil {
+		return nil, err
+	}
+
+	// the empty results, e.g. a receipt of a transaction not mined yet, are not cached
+	if cacheable && result != nil {
+		p.lock.Lock()
+		if p.head == head && len(p.cache) < rpcProxyCacheSize {
+			p.cache[key] = result
+		}
+		p.lock.Unlock()
+	}
+	return result, nil
+}
+
+// callNodes sends the call to the synced nodes until one of them answers. The nodes with the highest heads are called
+// first, so the results are of the head the cache is keyed by, and the nodes with the same head are ordered by address.
+// An error returned by a node is the answer of the call, the other nodes are not called
+func (p *rpcProxy) callNodes(method string, params []interface{}) (interface{}, error) {
+	providers := p.wsManager.Providers()
+	addresses := make([]string, 0, len(providers))
+	for address := range providers {
+		addresses = append(addresses, address)
+	}
+
+	p.lock.Lock()
+	heads := p.heads
+	p.lock.Unlock()
+	sort.Slice(addresses, func(i, j int) bool {
+		if heads[addresses[i]].number != heads[addresses[j]].number {
+			return heads[addresses[i]].number > heads[addresses[j]].number
+		}
+		return addresses[i] < addresses[j]
+	})
+
+	for _, address := range addresses {
+		provider := providers[address]
+		if !provider.IsOpen() || provider.SyncStatus() != blockchain.Synced {
+			continue
+		}
+
+		result, err := provider.CallRPC(method, params, rpcProxyOptions)
+		var nodeErr rpc.Error
+		if err == nil || errors.As(err, &nodeErr) {
+			return result, err
+		}
+		p.log.Debugf("failed to call %v on %v, trying the next node: %v", method, address, err)
+	}
+	return nil, errNoSyncedNode
+}
+
+// rpcProxyCacheKey returns the cache key of the call, and false if its result must not be cached
+func rpcProxyCacheKey(method string, params []interface{}) (string, bool) {
+	for _, param := range params {
+		if tag, ok := param.(string); ok && tag == rpcProxyPendingTag {
+			return "", false
+		}
+	}
+	encodedParams, err := json.Marshal(params)
+	if err != nil {
+		return "", false
+	}
+	return fmt.Sprintf("%v%s", method, encodedParams), true
+}
+
+// handleRPCProxy replies to the request with the result of the proxy, the errors of the nodes are forwarded as is
+func (h *handlerObj) handleRPCProxy(ctx context.Context, conn *jsonrpc2.Conn, req *jsonrpc2.Request) {
+	var params []interface{}
+	if req.Params != nil {
+		if err := json.Unmarshal(*req.Params, &params); err != nil {
+			SendErrorMsg(ctx, jsonrpc.InvalidParams, fmt.Sprintf("failed to unmarshal params of %v: %v", req.Method, err), conn, req.ID)
+			return
+		}
+	}
+
+	result, err := h.FeedManager.rpcProxy.call(req.Method, params)
+	var nodeErr rpc.Error
+	switch {
+	case errors.As(err, &nodeErr):
+		rpcErr := &jsonrpc2.Error{Code: int64(nodeErr.ErrorCode()), Message: nodeErr.Error()}
+		var dataErr rpc.DataError
+		if errors.As(err, &dataErr) && dataErr.ErrorData() != nil {
+			rpcErr.SetError(dataErr.ErrorData())
+		}
+		err = conn.ReplyWithError(ctx, req.ID, rpcErr)
+	case err != nil:
+		SendErrorMsg(ctx, jsonrpc.InternalError, err.Error(), conn, req.ID)
+		return
+	default:
+		err = reply(ctx, conn, req.ID, result)
+	}
+	if err != nil {
+		h.log.Errorf("%v reply error - %v", req.Method, err)
+	}
+}
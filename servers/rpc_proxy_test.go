@@ -0,0 +1,154 @@
+package servers
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/blockchain"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testProxyProvider struct {
+	blockchain.WSProvider
+	synced bool
+	err    error
+	head   uint64
+	result string
+	calls  int
+}
+
+func (p *testProxyProvider) IsOpen() bool { return true }
+
+func (p *testProxyProvider) SyncStatus() blockchain.NodeSyncStatus {
+	if p.synced {
+		return blockchain.Synced
+	}
+	return blockchain.Unsynced
+}
+
+func (p *testProxyProvider) CallRPC(method string, _ []interface{}, _ blockchain.RPCOptions) (interface{}, error) {
+	if method == "eth_getBlockByNumber" {
+		if p.head == 0 {
+			return nil, errors.New("no head")
+		}
+		return map[string]interface{}{"number": fmt.Sprintf("0x%x", p.head), "hash": fmt.Sprintf("0x%064x", p.head)}, nil
+	}
+	p.calls++
+	if p.err != nil {
+		return nil, p.err
+	}
+	if p.result != "" {
+		return p.result, nil
+	}
+	return "0x1", nil
+}
+
+type testProxyWSManager struct {
+	blockchain.WSManager
+	providers map[string]blockchain.WSProvider
+}
+
+func (m *testProxyWSManager) Providers() map[string]blockchain.WSProvider { return m.providers }
+
+type testNodeError struct{}
+
+func (testNodeError) Error() string  { return "execution reverted" }
+func (testNodeError) ErrorCode() int { return 3 }
+
+func TestRPCProxy(t *testing.T) {
+	unsynced := &testProxyProvider{}
+	failing := &testProxyProvider{synced: true, err: errors.New("connection reset")}
+	synced := &testProxyProvider{synced: true}
+	proxy := newRPCProxy(&testProxyWSManager{providers: map[string]blockchain.WSProvider{
+		"a": unsynced,
+		"b": failing,
+		"c": synced,
+	}}, []string{"eth_getBalance", "eth_call"})
+
+	assert.True(t, proxy.allowed("eth_getBalance"))
+	assert.False(t, proxy.allowed("eth_sendRawTransaction"))
+	var disabled *rpcProxy
+	assert.False(t, disabled.allowed("eth_getBalance"))
+
+	// the unsynced node is skipped and the failing node is failed over
+	params := []interface{}{"0x0000000000000000000000000000000000000001", "latest"}
+	result, err := proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, "0x1", result)
+	assert.Equal(t, 0, unsynced.calls)
+	assert.Equal(t, 1, failing.calls)
+	assert.Equal(t, 1, synced.calls)
+
+	// the result is cached until the next head
+	_, err = proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, 1, synced.calls)
+	synced.head = 1
+	proxy.pollHeads()
+	_, err = proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, 2, synced.calls)
+
+	// the pending calls are not cached
+	pendingParams := []interface{}{"0x0000000000000000000000000000000000000001", "pending"}
+	for i := 0; i < 2; i++ {
+		_, err = proxy.call("eth_getBalance", pendingParams)
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 4, synced.calls)
+
+	// the errors of the nodes are the answer of the call
+	failing.err = testNodeError{}
+	failing.head = 1
+	proxy.pollHeads()
+	_, err = proxy.call("eth_call", nil)
+	var nodeErr rpc.Error
+	require.True(t, errors.As(err, &nodeErr))
+	assert.Equal(t, 3, nodeErr.ErrorCode())
+	assert.Equal(t, 4, synced.calls)
+
+	synced.synced = false
+	failing.synced = false
+	_, err = proxy.call("eth_call", []interface{}{"0x01"})
+	assert.Equal(t, errNoSyncedNode, err)
+}
+
+func TestRPCProxy_Heads(t *testing.T) {
+	behind := &testProxyProvider{synced: true, head: 10, result: "0xa"}
+	ahead := &testProxyProvider{synced: true, head: 11, result: "0xb"}
+	proxy := newRPCProxy(&testProxyWSManager{providers: map[string]blockchain.WSProvider{
+		"a": behind,
+		"b": ahead,
+	}}, []string{"eth_getBalance"})
+
+	// the nodes are ordered by address until their heads are known
+	params := []interface{}{"0x0000000000000000000000000000000000000001", "latest"}
+	result, err := proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, "0xa", result)
+
+	// the node with the highest head is called first, and its head discards the cache
+	proxy.pollHeads()
+	result, err = proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, "0xb", result)
+	assert.Equal(t, 1, ahead.calls)
+
+	// the cache is kept while the highest head is the same, even if the other nodes have new heads
+	behind.head = 11
+	proxy.pollHeads()
+	result, err = proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, "0xb", result)
+	assert.Equal(t, 1, ahead.calls)
+
+	behind.head = 12
+	proxy.pollHeads()
+	result, err = proxy.call("eth_getBalance", params)
+	require.NoError(t, err)
+	assert.Equal(t, "0xa", result)
+	assert.Equal(t, 2, behind.calls)
+}
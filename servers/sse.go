@@ -0,0 +1,285 @@
+package servers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+const (
+	// sseFeedsPath is the path of the feeds streamed as Server-Sent Events, followed by the feed name
+	sseFeedsPath = "/feeds/"
+	// sseHeartbeatInterval is the interval of the comments sent to keep idle connections open through proxies
+	sseHeartbeatInterval = 15 * time.Second
+)
+
+// sseStatusEvent and sseErrorEvent are the types of the events which are not feed notifications
+const (
+	sseStatusEvent = "status"
+	sseErrorEvent  = "error"
+)
+
+// sseStream writes the events of a subscription streamed as Server-Sent Events
+type sseStream struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+	// lock serializes the writes to the response, so the events written from several goroutines are not interleaved
+	lock sync.Mutex
+}
+
+// writeEvent writes an event with the JSON data. The id is the sequence of the notification, used by the client to
+// resume the feed with the Last-Event-ID header, it is omitted if zero
+func (s *sseStream) writeEvent(event string, id uint64, data []byte) error {
+	var b strings.Builder
+	if id != 0 {
+		b.WriteString("id: ")
+		b.WriteString(strconv.FormatUint(id, 10))
+		b.WriteString("\n")
+	}
+	if event != "" {
+		b.WriteString("event: ")
+		b.WriteString(event)
+		b.WriteString("\n")
+	}
+	b.WriteString("data: ")
+	b.Write(data)
+	b.WriteString("\n\n")
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.w.Write([]byte(b.String())); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// writeJSONEvent writes an event with the data encoded as JSON
+func (s *sseStream) writeJSONEvent(event string, id uint64, data interface{}) error {
+	b, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	return s.writeEvent(event, id, b)
+}
+
+// writeHeartbeat writes a comment, which is ignored by the clients
+func (s *sseStream) writeHeartbeat() error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if _, err := s.w.Write([]byte(": heartbeat\n\n")); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
+
+// sseOptions returns the subscribe params of the feed with the options of the query: include, filters, backpressure,
+// call_params and resume_from. The Last-Event-ID header of a reconnecting client takes precedence over resume_from
+func sseOptions(feed types.FeedType, r *http.Request) (json.RawMessage, error) {
+	query := r.URL.Query()
+	options := subscriptionOptions{
+		Include:      []string{},
+		Filters:      query.Get("filters"),
+		Backpressure: query.Get("backpressure"),
+	}
+	if include := query.Get("include"); include != "" {
+		options.Include = strings.Split(include, ",")
+	}
+	if callParams := query.Get("call_params"); callParams != "" {
+		if err := json.Unmarshal([]byte(callParams), &options.CallParams); err != nil {
+			return nil, fmt.Errorf("invalid call_params %v: %v", callParams, err)
+		}
+	}
+
+	resumeFrom := query.Get("resume_from")
+	if lastEventID := r.Header.Get("Last-Event-ID"); lastEventID != "" {
+		resumeFrom = lastEventID
+	}
+	if resumeFrom != "" {
+		sequence, err := strconv.ParseUint(resumeFrom, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid resume sequence %v: %v", resumeFrom, err)
+		}
+		options.ResumeFrom = sequence
+	}
+
+	return json.Marshal([]interface{}{feed, options})
+}
+
+// sseHandler streams a feed as Server-Sent Events: GET /feeds/{feed}?include=...&filters=...
+func (s *HTTPServer) sseHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, fmt.Sprintf("method %v is not allowed, feeds are streamed with %v", r.Method, http.MethodGet), http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming is not supported", http.StatusInternalServerError)
+		return
+	}
+
+	accountModel, err := authorizeRequest(s.feedManager, r, s.enableBlockchainRPC, s.authorize)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	handler := &handlerObj{
+		FeedManager:       s.feedManager,
+		remoteAddress:     r.RemoteAddr,
+		connectionAccount: accountModel,
+		log: log.WithFields(log.Fields{
+			"component":  "sseHandler",
+			"remoteAddr": r.RemoteAddr,
+		}),
+		headers: types.SDKMetaFromHeaders(r.Header),
+		stats:   s.feedManager.stats,
+	}
+
+	feedName := types.FeedType(strings.TrimPrefix(r.URL.Path, sseFeedsPath))
+	params, err := sseOptions(feedName, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	rawParams := json.RawMessage(params)
+	request, err := handler.createClientReq(&jsonrpc2.Request{Method: string(jsonrpc.RPCSubscribe), Params: &rawParams})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if request.encoding != jsonWSEncoding || request.MultiTxs {
+		http.Error(w, "feeds are streamed as JSON events, one notification per event", http.StatusBadRequest)
+		return
+	}
+
+	filters := request.filters()
+	ci := types.ClientInfo{
+		RemoteAddress: r.RemoteAddr,
+		AccountID:     accountModel.AccountID,
+		Tier:          string(accountModel.TierName),
+		MetaInfo:      handler.headers,
+	}
+	ro := types.ReqOptions{
+		Filters:      filters,
+		Includes:     strings.Join(request.includes, ","),
+		ResumeFrom:   request.resumeFrom,
+		Backpressure: request.backpressure,
+	}
+	sub, err := s.feedManager.Subscribe(request.feed, types.SSEFeed, nil, ci, ro, false)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	subscriptionID := sub.SubscriptionID
+	defer s.feedManager.Unsubscribe(subscriptionID, false, "")
+
+	s.feedManager.stats.LogSubscribeStats(subscriptionID,
+		accountModel.AccountID,
+		request.feed,
+		accountModel.TierName,
+		r.RemoteAddr,
+		s.feedManager.networkNum,
+		request.includes,
+		filters,
+		"")
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Subscription-Id", subscriptionID)
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	stream := &sseStream{w: w, flusher: flusher}
+	heartbeat := time.NewTicker(sseHeartbeatInterval)
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if err = stream.writeHeartbeat(); err != nil {
+				return
+			}
+		case errMsg := <-sub.ErrMsgChan:
+			_ = stream.writeJSONEvent(sseErrorEvent, 0, errMsg)
+			return
+		case status := <-sub.StatusChan:
+			if err = stream.writeJSONEvent(sseStatusEvent, 0, status); err != nil {
+				return
+			}
+		case notification, ok := <-sub.FeedChan:
+			if !ok {
+				if s.feedManager.SubscriptionExists(subscriptionID) {
+					_ = stream.writeJSONEvent(sseErrorEvent, 0, "subscription was closed")
+				}
+				return
+			}
+			if err = s.sendSSENotification(handler, stream, request, notification); err != nil {
+				handler.log.Debugf("closing %v subscription %v: %v", request.feed, subscriptionID, err)
+				return
+			}
+		}
+	}
+}
+
+// sendSSENotification writes the events of a notification. The payloads of the notifications shared by the
+// subscriptions of the feed are encoded once, like the websocket ones
+func (s *HTTPServer) sendSSENotification(h *handlerObj, stream *sseStream, request *clientReq, notification types.Notification) error {
+	event := string(request.feed)
+	switch request.feed {
+	case types.NewTxsFeed, types.PendingTxsFeed:
+		tx, ok := notification.(*types.NewTransactionNotification)
+		if !ok {
+			tx = &notification.(*types.PendingTransactionNotification).NewTransactionNotification
+		}
+		payload, err := s.feedManager.txPayload(request, tx)
+		if err != nil {
+			h.log.Errorf("failed to encode transaction %v: %v", tx.GetHash(), err)
+			return nil
+		}
+		if payload == nil {
+			return nil
+		}
+		return stream.writeEvent(event, tx.Sequence(), payload)
+	case types.BDNBlocksFeed, types.NewBlocksFeed, types.NewBeaconBlocksFeed, types.BDNBeaconBlocksFeed, types.BlobSidecarsFeed, types.TransactionStatusFeed, types.MempoolEventsFeed:
+		withFields := func() interface{} {
+			return notification.WithFields(request.includes)
+		}
+		payload, err := s.feedManager.notificationPayload(request, notification, bxPayloadFormat, withFields)
+		if err != nil {
+			h.log.Errorf("failed to encode %v notification %v: %v", notification.NotificationType(), notification.GetHash(), err)
+			return nil
+		}
+		return stream.writeEvent(event, types.NotificationSequence(notification), payload)
+	case types.TxReceiptsFeed:
+		block := notification.(*types.EthBlockNotification)
+		return handleTxReceipts(s.feedManager, block, request.receiptFilter, func(receipt *types.TxReceiptNotification) error {
+			return stream.writeJSONEvent(event, receipt.Sequence(), receipt.WithFields(request.includes))
+		})
+	case types.LogsFeed:
+		block := notification.(*types.EthBlockNotification)
+		return handleLogs(s.feedManager, block, request.logs, func(logNotification *types.LogNotification) error {
+			return stream.writeJSONEvent(event, 0, logNotification.WithFields(request.includes))
+		})
+	case types.OnBlockFeed:
+		block := notification.(*types.EthBlockNotification)
+		return handleEthOnBlock(s.feedManager, block, *request.calls, func(onBlock *types.OnBlockNotification) error {
+			return stream.writeJSONEvent(event, 0, onBlock.WithFields(request.includes))
+		})
+	}
+	return errors.New("unsupported feed")
+}
@@ -0,0 +1,145 @@
+package servers
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/config"
+	"github.com/bloXroute-Labs/gateway/v2/services"
+	"github.com/bloXroute-Labs/gateway/v2/services/statistics"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type sseEvent struct {
+	id    string
+	event string
+	data  string
+}
+
+func readSSEEvent(t *testing.T, scanner *bufio.Scanner) sseEvent {
+	var event sseEvent
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			if event.data != "" {
+				return event
+			}
+		case strings.HasPrefix(line, "id: "):
+			event.id = strings.TrimPrefix(line, "id: ")
+		case strings.HasPrefix(line, "event: "):
+			event.event = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			event.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+	require.NoError(t, scanner.Err())
+	require.Fail(t, "stream ended")
+	return event
+}
+
+func TestHTTPServer_SSE(t *testing.T) {
+	feedChan := make(chan types.Notification)
+	gwAccount, _ := getMockCustomerAccountModel("gw")
+	fm := NewFeedManager(context.Background(), bxmock.MockBxListener{}, feedChan, services.NewNoOpSubscriptionServices(), types.NetworkNum(1), 1, types.NodeID("nodeID"), nil, gwAccount, getMockCustomerAccountModel, "", "", config.Bx{}, statistics.NoStats{}, nil, nil)
+	fm.history[types.NewTxsFeed] = newFeedHistory(3)
+	require.NoError(t, fm.Start())
+
+	txs := make(map[string]bool)
+	sendTx := func(input byte) {
+		tx := newCalldataTxNotification(t, []byte{input})
+		txs[tx.GetHash()] = true
+		feedChan <- tx
+	}
+	for input := byte(1); input <= 3; input++ {
+		sendTx(input)
+	}
+
+	server := httptest.NewServer(NewHTTPServer(fm, 0, nil, true, nil, nil).setupHandlers())
+	defer server.Close()
+
+	t.Run("resume", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, server.URL+"/feeds/newTxs?include=tx_hash", nil)
+		require.NoError(t, err)
+		req.Header.Set("Last-Event-ID", "1")
+		resp, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "text/event-stream", resp.Header.Get("Content-Type"))
+
+		scanner := bufio.NewScanner(resp.Body)
+		expectTx := func(sequence string) {
+			event := readSSEEvent(t, scanner)
+			assert.Equal(t, sequence, event.id)
+			assert.Equal(t, string(types.NewTxsFeed), event.event)
+			var result map[string]interface{}
+			require.NoError(t, json.Unmarshal([]byte(event.data), &result))
+			assert.True(t, txs[result["txHash"].(string)])
+		}
+
+		// the missed transactions are replayed, then the new ones follow
+		expectTx("2")
+		expectTx("3")
+		sendTx(4)
+		expectTx("4")
+	})
+
+	t.Run("invalid feed", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/feeds/unknown")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("unauthorized", func(t *testing.T) {
+		authServer := httptest.NewServer(NewHTTPServer(fm, 0, nil, false, nil, nil).setupHandlers())
+		defer authServer.Close()
+		resp, err := http.Get(authServer.URL + "/feeds/newTxs")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+	})
+}
+
+func TestSSEStream_ConcurrentWrites(t *testing.T) {
+	w := httptest.NewRecorder()
+	stream := &sseStream{w: w, flusher: w}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			assert.NoError(t, stream.writeJSONEvent("txReceipts", uint64(i+1), map[string]int{"index": i}))
+		}(i)
+		go func() {
+			defer wg.Done()
+			assert.NoError(t, stream.writeHeartbeat())
+		}()
+	}
+	wg.Wait()
+
+	// each event is written whole
+	events := strings.Split(strings.TrimSuffix(w.Body.String(), "\n\n"), "\n\n")
+	require.Len(t, events, 20)
+	for _, event := range events {
+		if event == ": heartbeat" {
+			continue
+		}
+		lines := strings.Split(event, "\n")
+		require.Len(t, lines, 3, event)
+		assert.True(t, strings.HasPrefix(lines[0], "id: "), event)
+		assert.Equal(t, "event: txReceipts", lines[1])
+		assert.True(t, strings.HasPrefix(lines[2], "data: {\"index\":"), event)
+	}
+}
@@ -0,0 +1,79 @@
+package servers
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"sync"
+
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	"github.com/gorilla/websocket"
+	"github.com/sourcegraph/jsonrpc2"
+)
+
+// wsObjectStream is a jsonrpc2.ObjectStream over a websocket connection, which also writes the binary frames of the
+// subscriptions with the protobuf encoding. The connection supports one concurrent writer only, so all writes are serialized
+type wsObjectStream struct {
+	conn      *websocket.Conn
+	writeLock sync.Mutex
+	// handleBatch returns the response of a batch of requests, which the jsonrpc2 connection does not support
+	handleBatch func(message json.RawMessage) json.RawMessage
+}
+
+func newWSObjectStream(conn *websocket.Conn) *wsObjectStream {
+	return &wsObjectStream{conn: conn}
+}
+
+// WriteObject implements jsonrpc2.ObjectStream
+func (s *wsObjectStream) WriteObject(obj interface{}) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	return s.conn.WriteJSON(obj)
+}
+
+// ReadObject implements jsonrpc2.ObjectStream, the batches of requests are handled in the background and the
+// malformed messages are answered with a parse error, neither is returned
+func (s *wsObjectStream) ReadObject(v interface{}) error {
+	for {
+		_, message, err := s.conn.ReadMessage()
+		if e, ok := err.(*websocket.CloseError); ok {
+			if e.Code == websocket.CloseAbnormalClosure && e.Text == io.ErrUnexpectedEOF.Error() {
+				// the connection was closed without a close message
+				err = io.ErrUnexpectedEOF
+			}
+		}
+		if err != nil {
+			return err
+		}
+
+		if s.handleBatch == nil || !isBatchRequest(message) {
+			// the message is decoded like by the websocket ReadJSON, the bytes after the first JSON value are ignored
+			err = json.NewDecoder(bytes.NewReader(message)).Decode(v)
+			if err == nil {
+				return nil
+			}
+			// the malformed message is answered, the connection is kept open
+			if err = s.WriteObject(rpcErrorResponse(jsonrpc2.ID{}, jsonrpc.ParseError, err.Error())); err != nil {
+				return err
+			}
+			continue
+		}
+		go func() {
+			if response := s.handleBatch(message); response != nil {
+				_ = s.WriteObject(response)
+			}
+		}()
+	}
+}
+
+// Close implements jsonrpc2.ObjectStream
+func (s *wsObjectStream) Close() error {
+	return s.conn.Close()
+}
+
+// WriteBinary writes a binary frame
+func (s *wsObjectStream) WriteBinary(data []byte) error {
+	s.writeLock.Lock()
+	defer s.writeLock.Unlock()
+	return s.conn.WriteMessage(websocket.BinaryMessage, data)
+}
@@ -0,0 +1,88 @@
+package servers
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/jsonrpc"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	pb "github.com/bloXroute-Labs/gateway/v2/protobuf"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/gorilla/websocket"
+	"github.com/sourcegraph/jsonrpc2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestWSObjectStream_BinaryFrames(t *testing.T) {
+	fm := &FeedManager{payloads: newPayloadCache(payloadCacheSize)}
+	req := &clientReq{feed: types.NewTxsFeed, includes: []string{"tx_hash"}, encoding: protobufWSEncoding}
+	tx1 := newCalldataTxNotification(t, []byte{1})
+	tx2 := newCalldataTxNotification(t, []byte{2})
+	payload1, err := fm.protobufTxPayload(req, tx1)
+	require.NoError(t, err)
+	payload2, err := fm.protobufTxPayload(req, tx2)
+	require.NoError(t, err)
+
+	upgrader := &websocket.Upgrader{EnableCompression: true}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		h := &handlerObj{stream: newWSObjectStream(conn), log: log.WithField("test", t.Name())}
+		assert.NoError(t, h.sendBinary("subscription", payload1, payload2))
+	}))
+	defer server.Close()
+
+	dialer := websocket.Dialer{EnableCompression: true}
+	conn, resp, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+	assert.Contains(t, resp.Header.Get("Sec-Websocket-Extensions"), "permessage-deflate")
+
+	messageType, frame, err := conn.ReadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, websocket.BinaryMessage, messageType)
+
+	// the concatenated payloads are merged into one notification
+	var notification pb.WsNotification
+	require.NoError(t, proto.Unmarshal(frame, &notification))
+	assert.Equal(t, "subscription", notification.SubscriptionId)
+	require.Len(t, notification.Txs.Tx, 2)
+	assert.Equal(t, tx1.RawTx(), notification.Txs.Tx[0].RawTx)
+	assert.Equal(t, tx2.RawTx(), notification.Txs.Tx[1].RawTx)
+}
+
+func TestWSObjectStream_ReadObject(t *testing.T) {
+	requests := make(chan *jsonrpc2.Request, 1)
+	upgrader := &websocket.Upgrader{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		stream := newWSObjectStream(conn)
+		req := &jsonrpc2.Request{}
+		if assert.NoError(t, stream.ReadObject(req)) {
+			requests <- req
+		}
+	}))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// the malformed message is answered with a parse error and the connection is kept open
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"id": 1, "method":`)))
+	var response jsonrpc2.Response
+	require.NoError(t, conn.ReadJSON(&response))
+	require.NotNil(t, response.Error)
+	assert.Equal(t, int64(jsonrpc.ParseError), response.Error.Code)
+
+	// the bytes after the request are ignored
+	require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(`{"id": 2, "method": "ping"}}`)))
+	req := <-requests
+	assert.Equal(t, "ping", req.Method)
+	assert.Equal(t, jsonrpc2.ID{Num: 2}, req.ID)
+}
@@ -0,0 +1,273 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// DecodedInput is the calldata of a transaction decoded with a registered ABI
+type DecodedInput struct {
+	Method    string                 `json:"method"`
+	Signature string                 `json:"signature"`
+	Args      map[string]interface{} `json:"args"`
+}
+
+// ABIRegistry keeps the methods of the registered contract ABIs by selector, so transactions calldata can be
+// decoded and filtered by its arguments. Each argument is exposed as the filter `method_name.arg_name`, lowercased
+type ABIRegistry struct {
+	lock    sync.RWMutex
+	methods map[[4]byte]abi.Method
+	filters map[string]abi.Type
+}
+
+// NewABIRegistry creates an empty ABIRegistry
+func NewABIRegistry() *ABIRegistry {
+	return &ABIRegistry{
+		methods: make(map[[4]byte]abi.Method),
+		filters: make(map[string]abi.Type),
+	}
+}
+
+// LoadDir registers every .json ABI file of the directory
+func (r *ABIRegistry) LoadDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return fmt.Errorf("failed to read ABI file %v: %v", file, err)
+		}
+		methods, err := r.Register(content)
+		if err != nil {
+			return fmt.Errorf("failed to register ABI file %v: %v", file, err)
+		}
+		log.Infof("registered %v methods from ABI file %v", len(methods), file)
+	}
+	return nil
+}
+
+// Register registers the methods of a JSON ABI, either a plain ABI array or a compiler artifact with an `abi` field,
+// and returns their signatures
+func (r *ABIRegistry) Register(abiJSON []byte) ([]string, error) {
+	var artifact struct {
+		ABI json.RawMessage `json:"abi"`
+	}
+	if err := json.Unmarshal(abiJSON, &artifact); err == nil && len(artifact.ABI) != 0 {
+		abiJSON = artifact.ABI
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(abiJSON)))
+	if err != nil {
+		return nil, err
+	}
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	signatures := make([]string, 0, len(contractABI.Methods))
+	for _, method := range contractABI.Methods {
+		var selector [4]byte
+		copy(selector[:], method.ID)
+		r.methods[selector] = method
+		for i, input := range method.Inputs {
+			r.filters[filterName(method, i)] = input.Type
+		}
+		signatures = append(signatures, method.Sig)
+	}
+	return signatures, nil
+}
+
+// ArgType returns the type of the argument of a registered method, by its filter name
+func (r *ABIRegistry) ArgType(name string) (abi.Type, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	argType, ok := r.filters[name]
+	return argType, ok
+}
+
+// Args returns the arguments of the method called by the calldata by their filter name. Numbers are *big.Int,
+// addresses and bytes lowercase hex strings, and arrays slices of either. Arguments of other types are omitted
+func (r *ABIRegistry) Args(input []byte) map[string]interface{} {
+	r.lock.RLock()
+	method, values, ok := r.unpack(input)
+	r.lock.RUnlock()
+	if !ok {
+		return nil
+	}
+
+	args := make(map[string]interface{}, len(values))
+	for i, value := range values {
+		if v, ok := filterValue(method.Inputs[i].Type, value); ok {
+			args[filterName(method, i)] = v
+		}
+	}
+	return args
+}
+
+// Decode decodes the calldata with the registered method matching its selector
+func (r *ABIRegistry) Decode(input []byte) (*DecodedInput, bool) {
+	r.lock.RLock()
+	method, values, ok := r.unpack(input)
+	r.lock.RUnlock()
+	if !ok {
+		return nil, false
+	}
+
+	decoded := &DecodedInput{
+		Method:    method.RawName,
+		Signature: method.Sig,
+		Args:      make(map[string]interface{}, len(values)),
+	}
+	for i, value := range values {
+		decoded.Args[argName(method, i)] = jsonValue(value)
+	}
+	return decoded, true
+}
+
+func (r *ABIRegistry) unpack(input []byte) (abi.Method, []interface{}, bool) {
+	if len(input) < 4 {
+		return abi.Method{}, nil, false
+	}
+	var selector [4]byte
+	copy(selector[:], input[:4])
+	method, ok := r.methods[selector]
+	if !ok {
+		return abi.Method{}, nil, false
+	}
+	values, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return abi.Method{}, nil, false
+	}
+	return method, values, true
+}
+
+func argName(method abi.Method, i int) string {
+	if method.Inputs[i].Name == "" {
+		return fmt.Sprintf("arg%v", i)
+	}
+	return method.Inputs[i].Name
+}
+
+// filterName is lowercased since the filters provided by the clients are lowercased
+func filterName(method abi.Method, i int) string {
+	return strings.ToLower(method.RawName + "." + argName(method, i))
+}
+
+func isNumber(t abi.Type) bool {
+	return t.T == abi.IntTy || t.T == abi.UintTy
+}
+
+// filterValue converts an unpacked argument to a type supported by the filters
+func filterValue(t abi.Type, value interface{}) (interface{}, bool) {
+	switch t.T {
+	case abi.IntTy, abi.UintTy:
+		return toBigInt(reflect.ValueOf(value))
+	case abi.BoolTy:
+		return value, true
+	case abi.StringTy:
+		return strings.ToLower(value.(string)), true
+	case abi.AddressTy, abi.BytesTy, abi.FixedBytesTy, abi.HashTy:
+		return toHex(reflect.ValueOf(value))
+	case abi.SliceTy, abi.ArrayTy:
+		elems := reflect.ValueOf(value)
+		if isNumber(*t.Elem) {
+			numbers := make([]*big.Int, 0, elems.Len())
+			for i := 0; i < elems.Len(); i++ {
+				number, ok := toBigInt(elems.Index(i))
+				if !ok {
+					return nil, false
+				}
+				numbers = append(numbers, number.(*big.Int))
+			}
+			return numbers, true
+		}
+		strs := make([]string, 0, elems.Len())
+		for i := 0; i < elems.Len(); i++ {
+			str, ok := filterValue(*t.Elem, elems.Index(i).Interface())
+			if !ok {
+				return nil, false
+			}
+			s, ok := str.(string)
+			if !ok {
+				return nil, false
+			}
+			strs = append(strs, s)
+		}
+		return strs, true
+	default:
+		return nil, false
+	}
+}
+
+func toBigInt(v reflect.Value) (interface{}, bool) {
+	if number, ok := v.Interface().(*big.Int); ok {
+		return number, true
+	}
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(v.Uint()), true
+	default:
+		return nil, false
+	}
+}
+
+func toHex(v reflect.Value) (interface{}, bool) {
+	switch v.Kind() {
+	case reflect.Slice:
+		return hexutil.Encode(v.Bytes()), true
+	case reflect.Array:
+		b := make([]byte, v.Len())
+		reflect.Copy(reflect.ValueOf(b), v)
+		return hexutil.Encode(b), true
+	default:
+		return nil, false
+	}
+}
+
+// jsonValue converts an unpacked argument to its JSON representation: big numbers are decimal strings, and
+// addresses and bytes hex strings
+func jsonValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case *big.Int:
+		return v.String()
+	case common.Address:
+		return v.Hex()
+	case common.Hash:
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	}
+
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Array:
+		if rv.Type().Elem().Kind() == reflect.Uint8 {
+			hex, _ := toHex(rv)
+			return hex
+		}
+		fallthrough
+	case reflect.Slice:
+		elems := make([]interface{}, 0, rv.Len())
+		for i := 0; i < rv.Len(); i++ {
+			elems = append(elems, jsonValue(rv.Index(i).Interface()))
+		}
+		return elems
+	default:
+		return value
+	}
+}
@@ -0,0 +1,86 @@
+package services
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testRouterABI = `[
+	{"name":"swapExactTokensForTokens","type":"function","stateMutability":"nonpayable","inputs":[
+		{"name":"amountIn","type":"uint256"},{"name":"amountOutMin","type":"uint256"},{"name":"path","type":"address[]"},
+		{"name":"to","type":"address"},{"name":"deadline","type":"uint256"}],"outputs":[]},
+	{"name":"transfer","type":"function","stateMutability":"nonpayable","inputs":[
+		{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+var (
+	testWETH = common.HexToAddress("0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2")
+	testUSDC = common.HexToAddress("0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48")
+)
+
+func packTestCall(t *testing.T, method string, args ...interface{}) []byte {
+	contractABI, err := abi.JSON(strings.NewReader(testRouterABI))
+	require.NoError(t, err)
+	input, err := contractABI.Pack(method, args...)
+	require.NoError(t, err)
+	return input
+}
+
+func TestABIRegistry(t *testing.T) {
+	registry := NewABIRegistry()
+	methods, err := registry.Register([]byte(testRouterABI))
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []string{"swapExactTokensForTokens(uint256,uint256,address[],address,uint256)", "transfer(address,uint256)"}, methods)
+
+	argType, ok := registry.ArgType("swapexacttokensfortokens.path")
+	require.True(t, ok)
+	assert.Equal(t, abi.SliceTy, argType.T)
+	_, ok = registry.ArgType("transfer.amount")
+	assert.True(t, ok)
+	_, ok = registry.ArgType("transfer.from")
+	assert.False(t, ok)
+
+	amount, _ := new(big.Int).SetString("2000000000000000000", 10)
+	swap := packTestCall(t, "swapExactTokensForTokens", amount, big.NewInt(1), []common.Address{testWETH, testUSDC}, testUSDC, big.NewInt(100))
+
+	args := registry.Args(swap)
+	require.Len(t, args, 5)
+	assert.Equal(t, []string{"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2", "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"}, args["swapexacttokensfortokens.path"])
+	assert.Equal(t, amount, args["swapexacttokensfortokens.amountin"])
+	assert.Equal(t, "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48", args["swapexacttokensfortokens.to"])
+	assert.Nil(t, registry.Args([]byte{0x01, 0x02, 0x03, 0x04}))
+
+	decoded, ok := registry.Decode(swap)
+	require.True(t, ok)
+	assert.Equal(t, "swapExactTokensForTokens", decoded.Method)
+	assert.Equal(t, "2000000000000000000", decoded.Args["amountIn"])
+	assert.Equal(t, []interface{}{testWETH.Hex(), testUSDC.Hex()}, decoded.Args["path"])
+	assert.Equal(t, testUSDC.Hex(), decoded.Args["to"])
+
+	_, ok = registry.Decode([]byte{0x01, 0x02, 0x03, 0x04})
+	assert.False(t, ok)
+	_, ok = registry.Decode(nil)
+	assert.False(t, ok)
+}
+
+func TestABIRegistry_LoadDir(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "router.json"), []byte(`{"contractName":"Router","abi":`+testRouterABI+`}`), 0644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "README.md"), []byte("not an ABI"), 0644))
+
+	registry := NewABIRegistry()
+	require.NoError(t, registry.LoadDir(dir))
+	_, ok := registry.ArgType("transfer.to")
+	assert.True(t, ok)
+
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "invalid.json"), []byte(`{"abi":"invalid"}`), 0644))
+	assert.Error(t, registry.LoadDir(dir))
+}
@@ -57,6 +57,14 @@ type bxBlockSSZ struct {
 	Number uint64
 }
 
+// bxBlockSSZDeneb is the encoding of the blocks starting from Deneb, which carry the blob sidecars of the block
+type bxBlockSSZDeneb struct {
+	Block        []byte                     `ssz-max:"367832"`
+	Txs          []*bxCompressedTransaction `ssz-max:"1048576,1073741825" ssz-size:"?,?"`
+	Number       uint64
+	BlobSidecars [][]byte `ssz-max:"4096" ssz-size:"?,131928"`
+}
+
 type bxBlockRLP struct {
 	Header          rlp.RawValue
 	Txs             []bxCompressedTransaction
@@ -71,7 +79,7 @@ func (bp *blockProcessor) BxBlockToBroadcast(block *types.BxBlock, networkNum ty
 		if !bp.ShouldProcess(block.Hash()) {
 			return nil, nil, ErrAlreadyProcessed
 		}
-	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
+	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella, types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
 		if !bp.ShouldProcess(block.BeaconHash()) {
 			return nil, nil, ErrAlreadyProcessed
 		}
@@ -83,7 +91,7 @@ func (bp *blockProcessor) BxBlockToBroadcast(block *types.BxBlock, networkNum ty
 	switch block.Type {
 	case types.BxBlockTypeEth:
 		broadcastMessage, usedShortIDs, err = bp.newRLPBlockBroadcast(block, networkNum, minTxAge)
-	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
+	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella, types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
 		broadcastMessage, usedShortIDs, err = bp.newSSZBlockBroadcast(block, networkNum, minTxAge)
 	case types.BxBlockTypeUnknown:
 		return nil, nil, ErrUnknownBlockType
@@ -96,7 +104,7 @@ func (bp *blockProcessor) BxBlockToBroadcast(block *types.BxBlock, networkNum ty
 	switch block.Type {
 	case types.BxBlockTypeEth:
 		bp.markProcessed(block.Hash())
-	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
+	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella, types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
 		bp.markProcessed(block.BeaconHash())
 	}
 
@@ -110,7 +118,7 @@ func (bp *blockProcessor) BxBlockFromBroadcast(broadcast *bxmessage.Broadcast) (
 		if !bp.ShouldProcess(broadcast.Hash()) {
 			return nil, nil, ErrAlreadyProcessed
 		}
-	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
+	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella, types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
 		if broadcast.BeaconHash().Empty() {
 			return nil, nil, ErrNotCompitableBeaconBlock
 		}
@@ -149,7 +157,7 @@ func (bp *blockProcessor) BxBlockFromBroadcast(broadcast *bxmessage.Broadcast) (
 		if err == nil {
 			bp.markProcessed(broadcast.Hash())
 		}
-	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella:
+	case types.BxBlockTypeBeaconPhase0, types.BxBlockTypeBeaconAltair, types.BxBlockTypeBeaconBellatrix, types.BxBlockTypeBeaconCapella, types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
 		block, err = bp.newBxBlockFromSSZBroadcast(broadcast, bxTransactions)
 
 		if err == nil {
@@ -197,8 +205,28 @@ func (bp *blockProcessor) newBxBlockFromRLPBroadcast(broadcast *bxmessage.Broadc
 
 func (bp *blockProcessor) newBxBlockFromSSZBroadcast(broadcast *bxmessage.Broadcast, bxTransactions []*types.BxTransaction) (*types.BxBlock, error) {
 	var sszBlock bxBlockSSZ
-	if err := sszBlock.UnmarshalSSZ(broadcast.Block()); err != nil {
-		return nil, err
+	var blobSidecars []*types.BlobSidecar
+	switch broadcast.BlockType() {
+	case types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
+		var sszBlockDeneb bxBlockSSZDeneb
+		if err := sszBlockDeneb.UnmarshalSSZ(broadcast.Block()); err != nil {
+			return nil, err
+		}
+
+		blobSidecars = make([]*types.BlobSidecar, 0, len(sszBlockDeneb.BlobSidecars))
+		for _, rawSidecar := range sszBlockDeneb.BlobSidecars {
+			sidecar := new(types.BlobSidecar)
+			if err := sidecar.UnmarshalSSZ(rawSidecar); err != nil {
+				return nil, fmt.Errorf("could not decode blob sidecar: %v", err)
+			}
+			blobSidecars = append(blobSidecars, sidecar)
+		}
+
+		sszBlock = bxBlockSSZ{Block: sszBlockDeneb.Block, Txs: sszBlockDeneb.Txs, Number: sszBlockDeneb.Number}
+	default:
+		if err := sszBlock.UnmarshalSSZ(broadcast.Block()); err != nil {
+			return nil, err
+		}
 	}
 
 	compressedTransactionCount := 0
@@ -221,7 +249,10 @@ func (bp *blockProcessor) newBxBlockFromSSZBroadcast(broadcast *bxmessage.Broadc
 
 	blockSize := len(sszBlock.Block) + txsBytes
 
-	return types.NewRawBxBlock(broadcast.Hash(), broadcast.BeaconHash(), broadcast.BlockType(), nil, txs, sszBlock.Block, nil, big.NewInt(int64(sszBlock.Number)), int(blockSize)), nil
+	block := types.NewRawBxBlock(broadcast.Hash(), broadcast.BeaconHash(), broadcast.BlockType(), nil, txs, sszBlock.Block, nil, big.NewInt(int64(sszBlock.Number)), int(blockSize))
+	block.BlobSidecars = blobSidecars
+
+	return block, nil
 }
 
 func calcBeaconTransactionLength(rawTx []byte) int {
@@ -236,6 +267,15 @@ func calcBeaconTransactionLength(rawTx []byte) int {
 		return 0
 	}
 
+	// The blob transactions from the tx store are in their network form, while the blocks include the canonical form
+	if types.IsBlobTxContent(rawTx) {
+		if blobTx, err := types.BlobTxFromContent(rawTx); err == nil {
+			if txBytes, err := blobTx.MarshalBinary(); err == nil {
+				return len(txBytes) + 4
+			}
+		}
+	}
+
 	// Anyway beside said above SSZ encodes 4 bytes for length of transaction
 	txLen := len(rawTx) + 4
 
@@ -331,13 +371,34 @@ func (bp *blockProcessor) newSSZBlockBroadcast(block *types.BxBlock, networkNum
 		})
 	}
 
-	sszBlock := bxBlockSSZ{
-		Block:  block.Trailer,
-		Txs:    txs,
-		Number: block.Number.Uint64(),
-	}
+	var encodedBlock []byte
+	var err error
+	switch block.Type {
+	case types.BxBlockTypeBeaconDeneb, types.BxBlockTypeBeaconElectra:
+		sszBlock := bxBlockSSZDeneb{
+			Block:        block.Trailer,
+			Txs:          txs,
+			Number:       block.Number.Uint64(),
+			BlobSidecars: make([][]byte, 0, len(block.BlobSidecars)),
+		}
+		for _, sidecar := range block.BlobSidecars {
+			rawSidecar, err := sidecar.MarshalSSZ()
+			if err != nil {
+				return nil, usedShortIDs, fmt.Errorf("could not encode blob sidecar: %v", err)
+			}
+			sszBlock.BlobSidecars = append(sszBlock.BlobSidecars, rawSidecar)
+		}
 
-	encodedBlock, err := sszBlock.MarshalSSZ()
+		encodedBlock, err = sszBlock.MarshalSSZ()
+	default:
+		sszBlock := bxBlockSSZ{
+			Block:  block.Trailer,
+			Txs:    txs,
+			Number: block.Number.Uint64(),
+		}
+
+		encodedBlock, err = sszBlock.MarshalSSZ()
+	}
 	if err != nil {
 		return nil, usedShortIDs, err
 	}
@@ -1,5 +1,5 @@
 // Code generated by fastssz. DO NOT EDIT.
-// Hash: fdc7d541c3ff5d95bba223e838544d6afd5816ba13b1cd9810d4abb2200096b1
+// Hash: 3e8c817813258331968613797a9c110c1a43ebafb843614095f9c025a44dc4bd
 package services
 
 import (
@@ -303,3 +303,255 @@ func (b *bxBlockSSZ) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	return
 }
+
+// MarshalSSZ ssz marshals the bxBlockSSZDeneb object
+func (b *bxBlockSSZDeneb) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the bxBlockSSZDeneb object to a target array
+func (b *bxBlockSSZDeneb) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(20)
+
+	// Offset (0) 'Block'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.Block)
+
+	// Offset (1) 'Txs'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(b.Txs); ii++ {
+		offset += 4
+		offset += b.Txs[ii].SizeSSZ()
+	}
+
+	// Field (2) 'Number'
+	dst = ssz.MarshalUint64(dst, b.Number)
+
+	// Offset (3) 'BlobSidecars'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(b.BlobSidecars) * 131928
+
+	// Field (0) 'Block'
+	if size := len(b.Block); size > 367832 {
+		err = ssz.ErrBytesLengthFn("--.Block", size, 367832)
+		return
+	}
+	dst = append(dst, b.Block...)
+
+	// Field (1) 'Txs'
+	if size := len(b.Txs); size > 1073741825 {
+		err = ssz.ErrListTooBigFn("--.Txs", size, 1073741825)
+		return
+	}
+	{
+		offset = 4 * len(b.Txs)
+		for ii := 0; ii < len(b.Txs); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += b.Txs[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(b.Txs); ii++ {
+		if dst, err = b.Txs[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (3) 'BlobSidecars'
+	if size := len(b.BlobSidecars); size > 4096 {
+		err = ssz.ErrListTooBigFn("--.BlobSidecars", size, 4096)
+		return
+	}
+	for ii := 0; ii < len(b.BlobSidecars); ii++ {
+		if size := len(b.BlobSidecars[ii]); size != 131928 {
+			err = ssz.ErrBytesLengthFn("--.BlobSidecars[ii]", size, 131928)
+			return
+		}
+		dst = append(dst, b.BlobSidecars[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the bxBlockSSZDeneb object
+func (b *bxBlockSSZDeneb) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 20 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o3 uint64
+
+	// Offset (0) 'Block'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 20 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'Txs'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Field (2) 'Number'
+	b.Number = ssz.UnmarshallUint64(buf[8:16])
+
+	// Offset (3) 'BlobSidecars'
+	if o3 = ssz.ReadOffset(buf[16:20]); o3 > size || o1 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'Block'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 367832 {
+			return ssz.ErrBytesLength
+		}
+		if cap(b.Block) == 0 {
+			b.Block = make([]byte, 0, len(buf))
+		}
+		b.Block = append(b.Block, buf...)
+	}
+
+	// Field (1) 'Txs'
+	{
+		buf = tail[o1:o3]
+		num, err := ssz.DecodeDynamicLength(buf, 1073741825)
+		if err != nil {
+			return err
+		}
+		b.Txs = make([]*bxCompressedTransaction, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if b.Txs[indx] == nil {
+				b.Txs[indx] = new(bxCompressedTransaction)
+			}
+			if err = b.Txs[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (3) 'BlobSidecars'
+	{
+		buf = tail[o3:]
+		num, err := ssz.DivideInt2(len(buf), 131928, 4096)
+		if err != nil {
+			return err
+		}
+		b.BlobSidecars = make([][]byte, num)
+		for ii := 0; ii < num; ii++ {
+			if cap(b.BlobSidecars[ii]) == 0 {
+				b.BlobSidecars[ii] = make([]byte, 0, len(buf[ii*131928:(ii+1)*131928]))
+			}
+			b.BlobSidecars[ii] = append(b.BlobSidecars[ii], buf[ii*131928:(ii+1)*131928]...)
+		}
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the bxBlockSSZDeneb object
+func (b *bxBlockSSZDeneb) SizeSSZ() (size int) {
+	size = 20
+
+	// Field (0) 'Block'
+	size += len(b.Block)
+
+	// Field (1) 'Txs'
+	for ii := 0; ii < len(b.Txs); ii++ {
+		size += 4
+		size += b.Txs[ii].SizeSSZ()
+	}
+
+	// Field (3) 'BlobSidecars'
+	size += len(b.BlobSidecars) * 131928
+
+	return
+}
+
+// HashTreeRoot ssz hashes the bxBlockSSZDeneb object
+func (b *bxBlockSSZDeneb) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the bxBlockSSZDeneb object with a hasher
+func (b *bxBlockSSZDeneb) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Block'
+	{
+		elemIndx := hh.Index()
+		byteLen := uint64(len(b.Block))
+		if byteLen > 367832 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		hh.PutBytes(b.Block)
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(elemIndx, byteLen, (367832+31)/32)
+		} else {
+			hh.MerkleizeWithMixin(elemIndx, byteLen, (367832+31)/32)
+		}
+	}
+
+	// Field (1) 'Txs'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(b.Txs))
+		if num > 1073741825 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for _, elem := range b.Txs {
+			if err = elem.HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, num, 1073741825)
+		} else {
+			hh.MerkleizeWithMixin(subIndx, num, 1073741825)
+		}
+	}
+
+	// Field (2) 'Number'
+	hh.PutUint64(b.Number)
+
+	// Field (3) 'BlobSidecars'
+	{
+		if size := len(b.BlobSidecars); size > 4096 {
+			err = ssz.ErrListTooBigFn("--.BlobSidecars", size, 4096)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.BlobSidecars {
+			if len(i) != 131928 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.PutBytes(i)
+		}
+
+		numItems := uint64(len(b.BlobSidecars))
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeWithMixinVectorizedHTR(subIndx, numItems, ssz.CalculateLimit(4096, numItems, 32))
+		} else {
+			hh.MerkleizeWithMixin(subIndx, numItems, ssz.CalculateLimit(4096, numItems, 32))
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/bloXroute-Labs/gateway/v2/bxmessage"
 	"github.com/bloXroute-Labs/gateway/v2/test"
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
 	"github.com/bloXroute-Labs/gateway/v2/test/fixtures"
 	"github.com/bloXroute-Labs/gateway/v2/types"
 	"github.com/bloXroute-Labs/gateway/v2/utils"
@@ -214,3 +215,34 @@ func TestRLPBlockProcessor_ProcessBroadcast(t *testing.T) {
 	_, _, err = bp.BxBlockFromBroadcast(broadcast)
 	assert.Equal(t, ErrAlreadyProcessed, err)
 }
+
+func TestSSZBlockProcessor_BlobSidecars(t *testing.T) {
+	store := newTestBxTxStore()
+	bp := NewBlockProcessor(&store)
+
+	block := bxmock.NewDenebBeaconBlock(t, 10, bxmock.NewEthBlock(10, common.Hash{}), bxmock.NewSignedBlobTx(1, nil), bxmock.NewSignedBlobTx(2, nil))
+	sidecars := bxmock.NewBlobSidecars(t, block)
+
+	rawBlock, err := block.MarshalSSZ()
+	assert.Nil(t, err)
+
+	txs := []*types.BxBlockTransaction{
+		types.NewBxBlockTransaction(types.GenerateSHA256Hash(), test.GenerateBytes(250)),
+	}
+
+	bxBlock, err := types.NewBxBlock(types.GenerateSHA256Hash(), types.GenerateSHA256Hash(), types.BxBlockTypeBeaconDeneb, nil, txs, rawBlock, nil, big.NewInt(10), len(rawBlock))
+	assert.Nil(t, err)
+	bxBlock.BlobSidecars = sidecars
+
+	broadcastMessage, _, err := bp.BxBlockToBroadcast(bxBlock, testNetworkNum, time.Second*2)
+	assert.Nil(t, err)
+
+	bp.(*blockProcessor).processedBlocks = NewHashHistory("processedBlocks", 30*time.Minute)
+	decodedBxBlock, missingShortIDs, err := bp.BxBlockFromBroadcast(broadcastMessage)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(missingShortIDs))
+
+	assert.Equal(t, rawBlock, decodedBxBlock.Trailer)
+	assert.Equal(t, txs[0].Content(), decodedBxBlock.Txs[0].Content())
+	assert.Equal(t, sidecars, decodedBxBlock.BlobSidecars)
+}
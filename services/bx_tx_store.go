@@ -33,6 +33,8 @@ type BxTxStore struct {
 	assigner               ShortIDAssigner
 	cleanedShortIDsChannel chan types.ShortIDsByNetwork
 	bloom                  BloomFilter
+	// evictionHandler is called with each transaction removed by the cleanup
+	evictionHandler func(*types.BxTransaction)
 }
 
 // NewBxTxStore creates a new BxTxStore to store and processes all relevant transactions
@@ -80,6 +82,12 @@ func (t *BxTxStore) Clear() {
 	log.Debugf("Cleared tx service.")
 }
 
+// SetEvictionHandler sets the function called with each transaction removed by the cleanup. It should be set before
+// the BxTxStore is started
+func (t *BxTxStore) SetEvictionHandler(handler func(*types.BxTransaction)) {
+	t.evictionHandler = handler
+}
+
 // Count indicates the number of stored transaction in BxTxStore
 func (t *BxTxStore) Count() int {
 	return t.hashToContent.Size()
@@ -251,6 +259,35 @@ type networkData struct {
 	ages       []int
 	cleanAge   int
 	cleanNoSID int
+	// blobs are the blob transactions, which are much larger than the others and are cleaned by size
+	blobs        []blobTxAge
+	blobsSize    int
+	blobsMaxAge  time.Duration
+	cleanBlobAge int
+}
+
+type blobTxAge struct {
+	age  int
+	size int
+}
+
+// blobsCleanAge returns the age of the blob transactions removed to bring back their size to 90% of TxStoreMaxBlobsSize
+func (netData *networkData) blobsCleanAge(maxTxAge time.Duration) time.Duration {
+	if netData.blobsSize <= bxgateway.TxStoreMaxBlobsSize {
+		return maxTxAge
+	}
+	sort.Slice(netData.blobs, func(i, j int) bool { return netData.blobs[i].age < netData.blobs[j].age })
+	size := 0
+	for _, blob := range netData.blobs {
+		size += blob.size
+		if size > bxgateway.TxStoreMaxBlobsSize*9/10 {
+			if maxAge := time.Duration(blob.age) * time.Second; maxAge < maxTxAge {
+				return maxAge
+			}
+			break
+		}
+	}
+	return maxTxAge
 }
 
 func (t *BxTxStore) clean() (cleaned int, cleanedShortIDs types.ShortIDsByNetwork) {
@@ -267,11 +304,21 @@ func (t *BxTxStore) clean() (cleaned int, cleanedShortIDs types.ShortIDsByNetwor
 		}
 		txAge := int(currTime.Sub(bxTransaction.AddTime()) / time.Second)
 		networks[bxTransaction.NetworkNum()].ages = append(networks[bxTransaction.NetworkNum()].ages, txAge)
+		if content := bxTransaction.Content(); types.IsBlobTxContent(content) {
+			netData.blobs = append(netData.blobs, blobTxAge{age: txAge, size: len(content)})
+			netData.blobsSize += len(content)
+		}
 
 		return true
 	})
 
 	for net, netData := range networks {
+		netData.blobsMaxAge = netData.blobsCleanAge(t.maxTxAge)
+		if netData.blobsMaxAge < t.maxTxAge {
+			log.Debugf("TxStore blobs size for network %v is %v. Cleaning blob transactions older than %v",
+				net, netData.blobsSize, netData.blobsMaxAge)
+		}
+
 		// if we are below the number of allowed Txs, no need to do anything
 		if len(netData.ages) <= bxgateway.TxStoreMaxSize {
 			networks[net].maxAge = t.maxTxAge
@@ -298,6 +345,9 @@ func (t *BxTxStore) clean() (cleaned int, cleanedShortIDs types.ShortIDsByNetwor
 		if netDataExists && txAge > netData.maxAge {
 			removeReason = fmt.Sprintf("transation age %v is greater than  %v", txAge, netData.maxAge)
 			netData.cleanAge++
+		} else if netDataExists && txAge > netData.blobsMaxAge && types.IsBlobTxContent(bxTransaction.Content()) {
+			removeReason = fmt.Sprintf("blob transation age %v is greater than  %v", txAge, netData.blobsMaxAge)
+			netData.cleanBlobAge++
 		} else {
 			if txAge > t.noSIDAge && len(bxTransaction.ShortIDs()) == 0 {
 				removeReason = fmt.Sprintf("transation age %v but no short ID", txAge)
@@ -311,15 +361,18 @@ func (t *BxTxStore) clean() (cleaned int, cleanedShortIDs types.ShortIDsByNetwor
 			// dec-5-2021: add to hash history to prevent a lot of reentry (BSC, Polygon)
 			t.remove(key, FullReEntryProtection, removeReason)
 			cleanedShortIDs[networkNum] = append(cleanedShortIDs[networkNum], bxTransaction.ShortIDs()...)
+			if t.evictionHandler != nil {
+				t.evictionHandler(bxTransaction)
+			}
 		}
 
 		return true
 	})
 
 	for net, netData := range networks {
-		log.Debugf("TxStore network %v #txs before cleanup %v cleaned %v missing SID entries and %v aged entries",
-			net, len(netData.ages), netData.cleanNoSID, netData.cleanAge)
-		cleaned += netData.cleanNoSID + netData.cleanAge
+		log.Debugf("TxStore network %v #txs before cleanup %v cleaned %v missing SID entries, %v aged entries and %v aged blob entries",
+			net, len(netData.ages), netData.cleanNoSID, netData.cleanAge, netData.cleanBlobAge)
+		cleaned += netData.cleanNoSID + netData.cleanAge + netData.cleanBlobAge
 	}
 
 	return cleaned, cleanedShortIDs
@@ -378,6 +431,11 @@ func (t *BxTxStore) HasContent(hash types.SHA256Hash) bool {
 	return tx.Content() != nil
 }
 
+// ValidateFeeBump is not supported since BxTxStore does not track transactions by sender and nonce
+func (t *BxTxStore) ValidateFeeBump(tx *types.EthTransaction, network types.NetworkNum) (types.SHA256Hash, error) {
+	return types.EmptyHash, fmt.Errorf("fee bump is not supported for network %v", network)
+}
+
 // Summarize returns some info about the tx service
 func (t *BxTxStore) Summarize() *pbbase.TxStoreReply {
 	networks := make(map[types.NetworkNum]*pbbase.TxStoreNetworkData)
@@ -387,6 +445,12 @@ func (t *BxTxStore) Summarize() *pbbase.TxStoreReply {
 	}
 
 	t.hashToContent.Range(func(key string, bxTransaction *types.BxTransaction) bool {
+		var blobTxCount, blobsSize uint64
+		if content := bxTransaction.Content(); types.IsBlobTxContent(content) {
+			blobTxCount, blobsSize = 1, uint64(len(content))
+			res.BlobTxCount++
+			res.BlobsSize += blobsSize
+		}
 
 		networkData, exists := networks[bxTransaction.NetworkNum()]
 		if !exists {
@@ -395,6 +459,8 @@ func (t *BxTxStore) Summarize() *pbbase.TxStoreReply {
 			networkData.TxCount++
 			networkData.Network = uint64(bxTransaction.NetworkNum())
 			networkData.ShortIdCount += uint64(len(bxTransaction.ShortIDs()))
+			networkData.BlobTxCount += blobTxCount
+			networkData.BlobsSize += blobsSize
 			networks[bxTransaction.NetworkNum()] = networkData
 
 			// continue iteration
@@ -407,6 +473,8 @@ func (t *BxTxStore) Summarize() *pbbase.TxStoreReply {
 		}
 		networkData.TxCount++
 		networkData.ShortIdCount += uint64(len(bxTransaction.ShortIDs()))
+		networkData.BlobTxCount += blobTxCount
+		networkData.BlobsSize += blobsSize
 
 		return true
 	})
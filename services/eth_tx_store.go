@@ -20,6 +20,11 @@ import (
 const (
 	cleanNonceInterval = 10 * time.Second
 	timeToAvoidReEntry = 24 * time.Hour
+
+	// feeBumpTrackingDuration is the minimal time a tx is kept by the nonce tracker so it can be replaced by a fee bump
+	feeBumpTrackingDuration = 10 * time.Minute
+	// MinFeeBumpPercent is the minimal increase of both gas fee cap and gas tip cap required from a replacement tx
+	MinFeeBumpPercent = 10
 )
 
 // EthTxStore represents transaction storage and validation for Ethereum transactions
@@ -34,7 +39,7 @@ func NewEthTxStore(clock utils.Clock, cleanupInterval time.Duration, maxTxAge ti
 	networkConfig sdnmessage.BlockchainNetworks, bloom BloomFilter) *EthTxStore {
 	return &EthTxStore{
 		BxTxStore:    newBxTxStore(clock, cleanupInterval, maxTxAge, noSIDAge, assigner, hashHistory, cleanedShortIDsChannel, timeToAvoidReEntry, bloom),
-		nonceTracker: newNonceTracker(clock, networkConfig, cleanNonceInterval),
+		nonceTracker: newNonceTracker(clock, networkConfig, cleanNonceInterval, feeBumpTrackingDuration),
 	}
 }
 
@@ -112,15 +117,64 @@ func (t *EthTxStore) add(hash types.SHA256Hash, content types.TxContent, shortID
 
 	seenNonce, otherTx := t.track(ethTx, network)
 	if !seenNonce {
+		if otherTx != nil {
+			result.ReplacedHash = *otherTx
+		}
 		return result
 	}
 
 	// mark tx as reuse nonce
 	result.Transaction.AddFlags(types.TFReusedNonce)
+	result.ReplacedBy = *otherTx
 	result.DebugData = fmt.Sprintf("reuse nonce detected. New transaction %v from %v with nonce %v is reusing nonce with existing tx %v on network %v", result.Transaction.Hash(), ethTx.From.String(), ethTx.Nonce, otherTx, networkChainID)
 	return result
 }
 
+// ValidateFeeBump checks that tx replaces a transaction already tracked for the same sender and nonce, and that
+// both its gas fee cap and gas tip cap are at least MinFeeBumpPercent higher. It returns the hash of the replaced transaction
+func (t *EthTxStore) ValidateFeeBump(tx *types.EthTransaction, network types.NetworkNum) (types.SHA256Hash, error) {
+	if !t.isReuseNonceActive(network) {
+		return types.EmptyHash, fmt.Errorf("sender nonce tracking is disabled for network %v", network)
+	}
+
+	tracked, ok := t.getTransaction(tx.From, tx.Nonce)
+	if !ok {
+		return types.EmptyHash, fmt.Errorf("no transaction from %v with nonce %v was found to replace", types.AddressAsString(tx.From), tx.Nonce)
+	}
+
+	replacedHash := tracked.tx.Hash()
+	if replacedHash == tx.Hash() {
+		return types.EmptyHash, fmt.Errorf("transaction %v is already known", replacedHash)
+	}
+
+	minGasFeeCap := feeBump(tracked.tx.EffectiveGasFeeCap())
+	minGasTipCap := feeBump(tracked.tx.EffectiveGasTipCap())
+	// replacements below the reuse nonce threshold are not propagated
+	if t.nonceTracker.clock.Now().Before(tracked.expireTime) {
+		if tracked.gasFeeCap.Cmp(minGasFeeCap) > 0 {
+			minGasFeeCap = tracked.gasFeeCap
+		}
+		if tracked.gasTipCap.Cmp(minGasTipCap) > 0 {
+			minGasTipCap = tracked.gasTipCap
+		}
+	}
+
+	if tx.EffectiveGasFeeCap().Cmp(minGasFeeCap) < 0 {
+		return types.EmptyHash, fmt.Errorf("replacement transaction underpriced: gas fee cap %v is lower than the required %v to replace %v", tx.EffectiveGasFeeCap(), minGasFeeCap, replacedHash)
+	}
+	if tx.EffectiveGasTipCap().Cmp(minGasTipCap) < 0 {
+		return types.EmptyHash, fmt.Errorf("replacement transaction underpriced: gas tip cap %v is lower than the required %v to replace %v", tx.EffectiveGasTipCap(), minGasTipCap, replacedHash)
+	}
+
+	return replacedHash, nil
+}
+
+// feeBump returns the fee increased by MinFeeBumpPercent
+func feeBump(fee *big.Int) *big.Int {
+	bumped := new(big.Int).Mul(fee, big.NewInt(100+MinFeeBumpPercent))
+	return bumped.Div(bumped, big.NewInt(100))
+}
+
 // Stop halts the nonce tracker in addition to regular tx service cleanup
 func (t *EthTxStore) Stop() {
 	t.BxTxStore.Stop()
@@ -136,12 +190,14 @@ type trackedTx struct {
 	gasTipCap *big.Int
 
 	expireTime time.Time // after this time, txs with same key are not considered duplicates
+	removeTime time.Time // after this time, the tx is no longer tracked
 }
 
 type nonceTracker struct {
 	clock            utils.Clock
 	addressNonceToTx *syncmap.SyncMap[string, trackedTx]
 	cleanInterval    time.Duration
+	minTrackDuration time.Duration
 	networkConfig    sdnmessage.BlockchainNetworks
 	quit             chan bool
 }
@@ -154,12 +210,13 @@ func fromNonceKey(from *common.Address, nonce uint64) string {
 	return b.String()
 }
 
-func newNonceTracker(clock utils.Clock, networkConfig sdnmessage.BlockchainNetworks, cleanInterval time.Duration) nonceTracker {
+func newNonceTracker(clock utils.Clock, networkConfig sdnmessage.BlockchainNetworks, cleanInterval time.Duration, minTrackDuration time.Duration) nonceTracker {
 	nt := nonceTracker{
 		clock:            clock,
 		networkConfig:    networkConfig,
 		addressNonceToTx: syncmap.NewStringMapOf[trackedTx](),
 		cleanInterval:    cleanInterval,
+		minTrackDuration: minTrackDuration,
 		quit:             make(chan bool),
 	}
 	go nt.cleanLoop()
@@ -188,9 +245,16 @@ func (nt *nonceTracker) setTransaction(tx *types.EthTransaction, network types.N
 	gasTipCap := new(big.Float).SetInt(tx.EffectiveGasTipCap())
 	gasTipCap.Mul(gasTipCap, reuseNonceGasChange).Int(intGasTipCap)
 
+	trackDuration := reuseNonceDelay
+	if nt.minTrackDuration > trackDuration {
+		trackDuration = nt.minTrackDuration
+	}
+
+	now := nt.clock.Now()
 	tracked := trackedTx{
 		tx:         tx,
-		expireTime: nt.clock.Now().Add(reuseNonceDelay),
+		expireTime: now.Add(reuseNonceDelay),
+		removeTime: now.Add(trackDuration),
 		gasFeeCap:  intGasFeeCap,
 		gasTipCap:  intGasTipCap,
 	}
@@ -203,7 +267,8 @@ func (nt nonceTracker) isReuseNonceActive(networkNum types.NetworkNum) bool {
 	return config != nil && config.EnableCheckSenderNonce
 }
 
-// track returns whether the tx is the newest from its address, and if it should be considered a duplicate
+// track returns whether the tx should be considered a duplicate of the tracked tx with the same sender and nonce, and
+// the hash of that tx. If the tx is not a duplicate it replaces the tracked tx
 func (nt *nonceTracker) track(tx *types.EthTransaction, network types.NetworkNum) (bool, *types.SHA256Hash) {
 	oldTx, ok := nt.getTransaction(tx.From, tx.Nonce)
 	if !ok {
@@ -211,11 +276,14 @@ func (nt *nonceTracker) track(tx *types.EthTransaction, network types.NetworkNum
 		return false, nil
 	}
 
+	hash := oldTx.tx.Hash()
 	if (tx.EffectiveGasFeeCap().Cmp(oldTx.gasFeeCap) >= 0 && tx.EffectiveGasTipCap().Cmp(oldTx.gasTipCap) >= 0) || nt.clock.Now().After(oldTx.expireTime) {
 		nt.setTransaction(tx, network)
-		return false, nil
+		if hash == tx.Hash() {
+			return false, nil
+		}
+		return false, &hash
 	}
-	hash := oldTx.tx.Hash()
 	return true, &hash
 }
 
@@ -238,7 +306,7 @@ func (nt *nonceTracker) clean() {
 	removed := 0
 
 	nt.addressNonceToTx.Range(func(key string, tracked trackedTx) bool {
-		if currentTime.After(tracked.expireTime) {
+		if currentTime.After(tracked.removeTime) {
 			nt.addressNonceToTx.Delete(key)
 			removed++
 		}
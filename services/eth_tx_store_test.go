@@ -202,12 +202,80 @@ func TestEthTxStore_AddInvalidTx(t *testing.T) {
 	assert.Equal(t, 0, store.Count())
 }
 
+func newSignedDynamicFeeTx(t *testing.T, nonce uint64, gasFeeCap, gasTipCap int64) *ethtypes.Transaction {
+	tx, err := ethtypes.SignNewTx(privateKey, ethtypes.NewLondonSigner(bxmock.ChainID), &ethtypes.DynamicFeeTx{
+		ChainID:   bxmock.ChainID,
+		Nonce:     nonce,
+		GasFeeCap: big.NewInt(gasFeeCap),
+		GasTipCap: big.NewInt(gasTipCap),
+		Gas:       21000,
+	})
+	require.NoError(t, err)
+	return tx
+}
+
+func TestEthTxStore_ValidateFeeBump(t *testing.T) {
+	mc := utils.MockClock{}
+	nc := blockchainNetwork
+	nc.AllowTimeReuseSenderNonce = 10
+	store := NewEthTxStore(&mc, 30*time.Second, 30*time.Second, 20*time.Second, NewEmptyShortIDAssigner(), NewHashHistory("seenTxs", 30*time.Minute), nil, sdnmessage.BlockchainNetworks{testNetworkNum: &nc}, newTestBloomFilter(t))
+
+	toEthTx := func(tx *ethtypes.Transaction) *types.EthTransaction {
+		hash, err := types.NewSHA256Hash(tx.Hash().Bytes())
+		require.NoError(t, err)
+		ethTx, err := types.NewEthTransaction(hash, tx, types.EmptySender)
+		require.NoError(t, err)
+		return ethTx
+	}
+
+	original := newSignedDynamicFeeTx(t, 1, 1000, 100)
+	content, _ := rlp.EncodeToBytes(original)
+	result := store.Add(toEthTx(original).Hash(), content, types.ShortIDEmpty, testNetworkNum, true, types.TFPaidTx, time.Now(), bxmock.ChainID.Int64(), types.EmptySender)
+	require.True(t, result.NewContent)
+
+	// no tracked tx for this nonce
+	_, err := store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 2, 2000, 200)), testNetworkNum)
+	assert.Error(t, err)
+
+	// same tx
+	_, err = store.ValidateFeeBump(toEthTx(original), testNetworkNum)
+	assert.Error(t, err)
+
+	// tip cap is not bumped enough
+	_, err = store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 1, 1100, 109)), testNetworkNum)
+	assert.Error(t, err)
+
+	// fee cap is not bumped enough
+	_, err = store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 1, 1099, 110)), testNetworkNum)
+	assert.Error(t, err)
+
+	replacedHash, err := store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 1, 1100, 110)), testNetworkNum)
+	require.NoError(t, err)
+	assert.Equal(t, toEthTx(original).Hash(), replacedHash)
+
+	// original is still tracked for fee bumps after the reuse nonce delay
+	mc.IncTime(time.Minute)
+	store.nonceTracker.clean()
+	replacedHash, err = store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 1, 1100, 110)), testNetworkNum)
+	require.NoError(t, err)
+	assert.Equal(t, toEthTx(original).Hash(), replacedHash)
+
+	mc.IncTime(feeBumpTrackingDuration)
+	store.nonceTracker.clean()
+	_, err = store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 1, 1100, 110)), testNetworkNum)
+	assert.Error(t, err)
+
+	// sender nonce tracking disabled
+	_, err = store.ValidateFeeBump(toEthTx(newSignedDynamicFeeTx(t, 1, 1100, 110)), testNetworkNum+1)
+	assert.Error(t, err)
+}
+
 func TestNonceTracker_track(t *testing.T) {
 	c := utils.MockClock{}
 	nc := blockchainNetwork
 	nc.AllowTimeReuseSenderNonce = 1
 	nc.NetworkNum = testNetworkNum
-	n := newNonceTracker(&c, sdnmessage.BlockchainNetworks{nc.NetworkNum: &nc}, 10)
+	n := newNonceTracker(&c, sdnmessage.BlockchainNetworks{nc.NetworkNum: &nc}, 10, 0)
 	var fromBytes common.Address
 	rand.Read(fromBytes[:])
 	address := &fromBytes
@@ -263,7 +331,7 @@ func TestNonceTracker_clean(t *testing.T) {
 	nc := blockchainNetwork
 	nc.AllowTimeReuseSenderNonce = 1
 	nc.NetworkNum = testNetworkNum
-	n := newNonceTracker(&c, sdnmessage.BlockchainNetworks{nc.NetworkNum: &nc}, 10)
+	n := newNonceTracker(&c, sdnmessage.BlockchainNetworks{nc.NetworkNum: &nc}, 10, 0)
 	var fromBytes common.Address
 	rand.Read(fromBytes[:])
 	address := &fromBytes
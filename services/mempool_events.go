@@ -0,0 +1,98 @@
+package services
+
+import (
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+)
+
+const mempoolEventsCleanupFreq = 10 * time.Minute
+
+// MempoolEvents produces the mempoolEvents feed notifications, each time a pending transaction of the TxStore is
+// replaced by a transaction with the same sender and nonce, included in a block, or evicted from the TxStore
+// without being included
+type MempoolEvents struct {
+	txStore   TxStore
+	notify    func(types.Notification)
+	retention time.Duration
+	confirmed HashHistory
+	replaced  HashHistory
+}
+
+// NewMempoolEvents creates a new MempoolEvents. The included and replaced transactions are remembered for the
+// retention, which should be at least the longest time the TxStore keeps a transaction, so they are not reported as
+// dropped when they are evicted. Notifications are delivered using the notify function
+func NewMempoolEvents(clock utils.Clock, txStore TxStore, retention time.Duration, notify func(types.Notification)) *MempoolEvents {
+	return &MempoolEvents{
+		txStore:   txStore,
+		notify:    notify,
+		retention: retention,
+		confirmed: newHashHistory("mempoolEventsConfirmed", clock, mempoolEventsCleanupFreq),
+		replaced:  newHashHistory("mempoolEventsReplaced", clock, mempoolEventsCleanupFreq),
+	}
+}
+
+// OnTransaction reports the transaction replaced by a new transaction of the TxStore, if any. A new transaction which
+// does not pay enough to replace the pending transaction with the same sender and nonce is reported as replaced by it
+func (e *MempoolEvents) OnTransaction(result TransactionResult) {
+	if result.Transaction == nil {
+		return
+	}
+	switch {
+	case result.ReplacedHash != types.EmptyHash:
+		e.onReplaced(result.ReplacedHash, result.Transaction.Hash())
+	case result.ReplacedBy != types.EmptyHash:
+		e.onReplaced(result.Transaction.Hash(), result.ReplacedBy)
+	}
+}
+
+func (e *MempoolEvents) onReplaced(hash, replacedBy types.SHA256Hash) {
+	key := string(hash[:])
+	if e.confirmed.Exists(key) || !e.replaced.SetIfAbsent(key, e.retention) {
+		return
+	}
+	e.notify(&types.MempoolEventNotification{
+		Event:           types.MempoolEventReplaced,
+		TransactionHash: hash.String(),
+		ReplacedBy:      replacedBy.String(),
+	})
+}
+
+// OnBlock reports the pending transactions of the TxStore included in the block
+func (e *MempoolEvents) OnBlock(block *types.EthBlockNotification) {
+	blockHeight := block.Header.GetNumber()
+	blockHash := block.BlockHash.String()
+	for _, fields := range block.Transactions {
+		hashStr, _ := fields["hash"].(string)
+		hash, err := types.NewSHA256HashFromString(hashStr)
+		if err != nil || !e.txStore.HasContent(hash) {
+			continue
+		}
+		if !e.confirmed.SetIfAbsent(string(hash[:]), e.retention) {
+			continue
+		}
+		e.notify(&types.MempoolEventNotification{
+			Event:           types.MempoolEventConfirmed,
+			TransactionHash: hash.String(),
+			BlockHeight:     blockHeight,
+			BlockHash:       blockHash,
+		})
+	}
+}
+
+// OnEvicted reports the transaction evicted from the TxStore as dropped, unless it was included or replaced
+func (e *MempoolEvents) OnEvicted(tx *types.BxTransaction) {
+	if !tx.HasContent() {
+		return
+	}
+	hash := tx.Hash()
+	key := string(hash[:])
+	if e.confirmed.Exists(key) || e.replaced.Exists(key) {
+		return
+	}
+	e.notify(&types.MempoolEventNotification{
+		Event:           types.MempoolEventDropped,
+		TransactionHash: hash.String(),
+	})
+}
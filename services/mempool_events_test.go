@@ -0,0 +1,83 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/sdnmessage"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMempoolEvents(t *testing.T) {
+	mc := utils.MockClock{}
+	nc := blockchainNetwork
+	nc.AllowTimeReuseSenderNonce = 10
+	store := NewEthTxStore(&mc, 30*time.Second, 30*time.Second, 20*time.Second, NewEmptyShortIDAssigner(), NewHashHistory("seenTxs", 30*time.Minute), nil, sdnmessage.BlockchainNetworks{testNetworkNum: &nc}, NoOpBloomFilter{})
+
+	var notifications []*types.MempoolEventNotification
+	events := NewMempoolEvents(&mc, store, 50*time.Second, func(n types.Notification) {
+		notifications = append(notifications, n.(*types.MempoolEventNotification))
+	})
+	store.SetEvictionHandler(events.OnEvicted)
+
+	add := func(tx *ethtypes.Transaction) TransactionResult {
+		hash, err := types.NewSHA256Hash(tx.Hash().Bytes())
+		require.NoError(t, err)
+		content, err := rlp.EncodeToBytes(tx)
+		require.NoError(t, err)
+		result := store.Add(hash, content, types.ShortIDEmpty, testNetworkNum, true, types.TFPaidTx, mc.Now(), tx.ChainId().Int64(), types.EmptySender)
+		require.True(t, result.NewContent)
+		events.OnTransaction(result)
+		return result
+	}
+
+	original := newSignedDynamicFeeTx(t, 1, 1000, 100)
+	add(original)
+	assert.Empty(t, notifications)
+
+	// a transaction with the same nonce and higher fees replaces the original
+	replacement := newSignedDynamicFeeTx(t, 1, 1200, 120)
+	result := add(replacement)
+	assert.Equal(t, original.Hash().Bytes(), result.ReplacedHash.Bytes())
+	require.Len(t, notifications, 1)
+	assert.Equal(t, types.MempoolEventReplaced, notifications[0].Event)
+	assert.Equal(t, result.ReplacedHash.String(), notifications[0].TransactionHash)
+	assert.Equal(t, result.Transaction.Hash().String(), notifications[0].ReplacedBy)
+
+	// an underpriced transaction with the same nonce is a duplicate, replaced by the transaction which keeps the nonce
+	underpriced := newSignedDynamicFeeTx(t, 1, 1201, 100)
+	result = add(underpriced)
+	assert.True(t, result.Transaction.Flags().IsReuseSenderNonce())
+	assert.Equal(t, types.EmptyHash, result.ReplacedHash)
+	require.Len(t, notifications, 2)
+	assert.Equal(t, types.MempoolEventReplaced, notifications[1].Event)
+	assert.Equal(t, underpriced.Hash().Hex()[2:], notifications[1].TransactionHash)
+	assert.Equal(t, replacement.Hash().Hex()[2:], notifications[1].ReplacedBy)
+
+	next := newSignedDynamicFeeTx(t, 2, 1000, 100)
+	add(next)
+	block := newTestBlockNotification(t, 10, next, newSignedDynamicFeeTx(t, 3, 1000, 100))
+	events.OnBlock(block)
+	require.Len(t, notifications, 3)
+	assert.Equal(t, types.MempoolEventConfirmed, notifications[2].Event)
+	assert.Equal(t, uint64(10), notifications[2].BlockHeight)
+	assert.Equal(t, block.BlockHash.String(), notifications[2].BlockHash)
+
+	// the same block from another source
+	events.OnBlock(block)
+	assert.Len(t, notifications, 3)
+
+	// only the transaction which was neither replaced nor included is reported as dropped, once it is older than
+	// the maximum age of the TxStore
+	mc.IncTime(40 * time.Second)
+	store.CleanNow()
+	assert.Equal(t, 0, store.Count())
+	require.Len(t, notifications, 4)
+	assert.Equal(t, types.MempoolEventDropped, notifications[3].Event)
+	assert.Equal(t, replacement.Hash().Hex()[2:], notifications[3].TransactionHash)
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/connections"
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+)
+
+const (
+	// PrivateTxMaxBlocks is the number of blocks a private transaction is re-broadcast for before it expires
+	PrivateTxMaxBlocks = 25
+	privateTxRetention = time.Hour
+)
+
+// private transaction statuses
+const (
+	PrivateTxPending = "pending"
+	PrivateTxMined   = "mined"
+	PrivateTxExpired = "expired"
+)
+
+// PrivateTx is a transaction which should not reach the public mempool
+type PrivateTx struct {
+	Hash          types.SHA256Hash
+	RawTx         string
+	Content       types.TxContent
+	AccountID     types.AccountID
+	NextValidator bool
+	Source        connections.Conn
+
+	status      string
+	attempts    int
+	submitTime  time.Time
+	updateTime  time.Time
+	blockHeight uint64
+}
+
+// PrivateTxStatus is the status of a private transaction as reported to its account
+type PrivateTxStatus struct {
+	TxHash        string `json:"tx_hash"`
+	Status        string `json:"status"`
+	NextValidator bool   `json:"next_validator"`
+	Attempts      int    `json:"attempts"`
+	SubmittedAt   string `json:"submitted_at"`
+	BlockHeight   uint64 `json:"block_height,omitempty"`
+}
+
+// PrivateTxBalance summarizes the private transactions of an account
+type PrivateTxBalance struct {
+	AccountID    types.AccountID   `json:"account_id"`
+	Pending      int               `json:"pending"`
+	Transactions []PrivateTxStatus `json:"transactions"`
+}
+
+// PrivateTxService keeps private transactions and re-broadcasts them on each new block until they are mined or expire
+type PrivateTxService struct {
+	clock      utils.Clock
+	send       func(tx *PrivateTx, rebroadcast bool) error
+	lock       sync.Mutex
+	txs        map[types.SHA256Hash]*PrivateTx
+	lastHeight uint64
+}
+
+// NewPrivateTxService creates a new PrivateTxService. send is called on submission and on each re-broadcast
+func NewPrivateTxService(clock utils.Clock, send func(tx *PrivateTx, rebroadcast bool) error) *PrivateTxService {
+	return &PrivateTxService{
+		clock: clock,
+		send:  send,
+		txs:   make(map[types.SHA256Hash]*PrivateTx),
+	}
+}
+
+// Submit sends the private transaction and keeps it for re-broadcast. The transaction is kept before it is sent,
+// so a concurrent submission of the same transaction is rejected
+func (s *PrivateTxService) Submit(tx *PrivateTx) error {
+	s.lock.Lock()
+	if existing, ok := s.txs[tx.Hash]; ok && existing.status == PrivateTxPending {
+		s.lock.Unlock()
+		return fmt.Errorf("private transaction %v was already submitted", tx.Hash)
+	}
+	now := s.clock.Now()
+	tx.status = PrivateTxPending
+	tx.attempts = 1
+	tx.submitTime = now
+	tx.updateTime = now
+	s.txs[tx.Hash] = tx
+	s.lock.Unlock()
+
+	if err := s.send(tx, false); err != nil {
+		s.lock.Lock()
+		if s.txs[tx.Hash] == tx {
+			delete(s.txs, tx.Hash)
+		}
+		s.lock.Unlock()
+		return err
+	}
+
+	return nil
+}
+
+// OnBlock marks the private transactions included in the block as mined. The first time a block height is seen, the
+// pending transactions are re-broadcast, and the ones re-broadcast for PrivateTxMaxBlocks blocks expire
+func (s *PrivateTxService) OnBlock(block *types.EthBlockNotification) {
+	var rebroadcast []*PrivateTx
+
+	s.lock.Lock()
+	now := s.clock.Now()
+	blockHeight := block.Header.GetNumber()
+	if len(s.txs) != 0 {
+		for _, fields := range block.Transactions {
+			hashStr, _ := fields["hash"].(string)
+			hash, err := types.NewSHA256HashFromString(hashStr)
+			if err != nil {
+				continue
+			}
+			if tx, ok := s.txs[hash]; ok && tx.status != PrivateTxMined {
+				tx.status = PrivateTxMined
+				tx.blockHeight = blockHeight
+				tx.updateTime = now
+				log.Debugf("private transaction %v was mined in block %v, stopping re-broadcast", hash, blockHeight)
+			}
+		}
+	}
+
+	if blockHeight > s.lastHeight {
+		s.lastHeight = blockHeight
+		for hash, tx := range s.txs {
+			switch {
+			case tx.status != PrivateTxPending:
+				if now.Sub(tx.updateTime) > privateTxRetention {
+					delete(s.txs, hash)
+				}
+			case tx.attempts > PrivateTxMaxBlocks:
+				tx.status = PrivateTxExpired
+				tx.updateTime = now
+			default:
+				tx.attempts++
+				rebroadcast = append(rebroadcast, tx)
+			}
+		}
+	}
+	s.lock.Unlock()
+
+	for _, tx := range rebroadcast {
+		if err := s.send(tx, true); err != nil {
+			log.Errorf("failed to re-broadcast private transaction %v: %v", tx.Hash, err)
+		}
+	}
+}
+
+// Balance returns the private transactions of the account
+func (s *PrivateTxService) Balance(accountID types.AccountID) PrivateTxBalance {
+	balance := PrivateTxBalance{
+		AccountID:    accountID,
+		Transactions: []PrivateTxStatus{},
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	var txs []*PrivateTx
+	for _, tx := range s.txs {
+		if tx.AccountID == accountID {
+			txs = append(txs, tx)
+		}
+	}
+	sort.Slice(txs, func(i, j int) bool { return txs[i].submitTime.Before(txs[j].submitTime) })
+
+	for _, tx := range txs {
+		if tx.status == PrivateTxPending {
+			balance.Pending++
+		}
+		balance.Transactions = append(balance.Transactions, PrivateTxStatus{
+			TxHash:        tx.Hash.String(),
+			Status:        tx.status,
+			NextValidator: tx.NextValidator,
+			Attempts:      tx.attempts,
+			SubmittedAt:   tx.submitTime.UTC().Format(time.RFC3339),
+			BlockHeight:   tx.blockHeight,
+		})
+	}
+	return balance
+}
@@ -0,0 +1,120 @@
+package services
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestPrivateTx(t *testing.T, nonce uint64, accountID types.AccountID) (*PrivateTx, *ethtypes.Transaction) {
+	ethTx := bxmock.NewSignedEthTx(ethtypes.LegacyTxType, nonce, nil)
+	hash, err := types.NewSHA256Hash(ethTx.Hash().Bytes())
+	require.NoError(t, err)
+	return &PrivateTx{Hash: hash, AccountID: accountID}, ethTx
+}
+
+func TestPrivateTxService(t *testing.T) {
+	clock := utils.MockClock{}
+	sent := make(map[types.SHA256Hash]int)
+	s := NewPrivateTxService(&clock, func(tx *PrivateTx, rebroadcast bool) error {
+		sent[tx.Hash]++
+		return nil
+	})
+
+	tx1, ethTx1 := newTestPrivateTx(t, 1, "account1")
+	tx2, _ := newTestPrivateTx(t, 2, "account1")
+	tx3, _ := newTestPrivateTx(t, 3, "account2")
+	require.NoError(t, s.Submit(tx1))
+	clock.IncTime(time.Second)
+	require.NoError(t, s.Submit(tx2))
+	require.NoError(t, s.Submit(tx3))
+	assert.Error(t, s.Submit(tx1))
+
+	balance := s.Balance("account1")
+	assert.Equal(t, 2, balance.Pending)
+	assert.Len(t, balance.Transactions, 2)
+
+	// each new height re-broadcasts pending transactions once
+	s.OnBlock(newTestBlockNotification(t, 10))
+	s.OnBlock(newTestBlockNotification(t, 10))
+	assert.Equal(t, 2, sent[tx1.Hash])
+
+	s.OnBlock(newTestBlockNotification(t, 11, ethTx1))
+	assert.Equal(t, 2, sent[tx1.Hash])
+	assert.Equal(t, 3, sent[tx2.Hash])
+
+	balance = s.Balance("account1")
+	assert.Equal(t, 1, balance.Pending)
+	require.Len(t, balance.Transactions, 2)
+	assert.Equal(t, PrivateTxMined, balance.Transactions[0].Status)
+	assert.Equal(t, uint64(11), balance.Transactions[0].BlockHeight)
+
+	for height := uint64(12); height < 12+PrivateTxMaxBlocks; height++ {
+		s.OnBlock(newTestBlockNotification(t, height))
+	}
+	assert.Equal(t, PrivateTxMaxBlocks+1, sent[tx2.Hash])
+	balance = s.Balance("account1")
+	assert.Equal(t, 0, balance.Pending)
+	assert.Equal(t, PrivateTxExpired, balance.Transactions[1].Status)
+
+	// finished transactions are removed after the retention period
+	clock.IncTime(2 * time.Hour)
+	s.OnBlock(newTestBlockNotification(t, 100))
+	assert.Empty(t, s.Balance("account1").Transactions)
+	assert.Empty(t, s.Balance("account2").Transactions)
+}
+
+func TestPrivateTxService_ConcurrentSubmit(t *testing.T) {
+	var sent atomic.Int32
+	s := NewPrivateTxService(&utils.MockClock{}, func(tx *PrivateTx, rebroadcast bool) error {
+		sent.Add(1)
+		return nil
+	})
+
+	tx, _ := newTestPrivateTx(t, 1, "account1")
+	var accepted atomic.Int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			privateTx := *tx
+			if s.Submit(&privateTx) == nil {
+				accepted.Add(1)
+			}
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(1), accepted.Load())
+	assert.Equal(t, int32(1), sent.Load())
+	assert.Equal(t, 1, s.Balance("account1").Pending)
+}
+
+func TestPrivateTxService_SubmitFailure(t *testing.T) {
+	fail := true
+	s := NewPrivateTxService(&utils.MockClock{}, func(tx *PrivateTx, rebroadcast bool) error {
+		if fail {
+			return errors.New("send failed")
+		}
+		return nil
+	})
+
+	// a transaction which failed to be sent is not kept and can be submitted again
+	tx, _ := newTestPrivateTx(t, 1, "account1")
+	assert.Error(t, s.Submit(tx))
+	assert.Empty(t, s.Balance("account1").Transactions)
+
+	fail = false
+	assert.NoError(t, s.Submit(tx))
+	assert.Equal(t, 1, s.Balance("account1").Pending)
+}
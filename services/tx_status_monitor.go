@@ -0,0 +1,342 @@
+package services
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+const (
+	txStatusCheckInterval = 30 * time.Second
+	maxMonitoredTxAge     = 24 * time.Hour
+)
+
+// TxStatusMonitor tracks transactions registered by transactionStatus subscribers and produces
+// a notification each time one of them changes its status
+type TxStatusMonitor struct {
+	clock   utils.Clock
+	txStore TxStore
+	notify  func(types.Notification)
+
+	lock                 sync.Mutex
+	hashToTx             map[types.SHA256Hash]*monitoredTx
+	senderNonceToHash    map[string]types.SHA256Hash
+	subscriptionToHashes map[string]map[types.SHA256Hash]struct{}
+
+	checkInterval time.Duration
+	maxAge        time.Duration
+	quit          chan bool
+}
+
+type monitoredTx struct {
+	hash            types.SHA256Hash
+	subscriptionIDs map[string]struct{}
+	status          types.Status
+	senderNonceKey  string
+	blockHeight     uint64
+	blockHash       string
+	replacedBy      string
+	lastUpdate      time.Time
+}
+
+// NewTxStatusMonitor creates a new TxStatusMonitor. Notifications are delivered using the notify function
+func NewTxStatusMonitor(clock utils.Clock, txStore TxStore, notify func(types.Notification)) *TxStatusMonitor {
+	return newTxStatusMonitor(clock, txStore, notify, txStatusCheckInterval, maxMonitoredTxAge)
+}
+
+func newTxStatusMonitor(clock utils.Clock, txStore TxStore, notify func(types.Notification), checkInterval time.Duration, maxAge time.Duration) *TxStatusMonitor {
+	return &TxStatusMonitor{
+		clock:                clock,
+		txStore:              txStore,
+		notify:               notify,
+		hashToTx:             make(map[types.SHA256Hash]*monitoredTx),
+		senderNonceToHash:    make(map[string]types.SHA256Hash),
+		subscriptionToHashes: make(map[string]map[types.SHA256Hash]struct{}),
+		checkInterval:        checkInterval,
+		maxAge:               maxAge,
+		quit:                 make(chan bool),
+	}
+}
+
+// Start runs the periodic check for dropped and expired transactions
+func (m *TxStatusMonitor) Start() {
+	ticker := m.clock.Ticker(m.checkInterval)
+	for {
+		select {
+		case <-ticker.Alert():
+			m.check()
+		case <-m.quit:
+			ticker.Stop()
+			return
+		}
+	}
+}
+
+// Stop halts the periodic check
+func (m *TxStatusMonitor) Stop() {
+	m.quit <- true
+}
+
+// Monitor registers the transaction hashes for the subscription and reports their current status
+func (m *TxStatusMonitor) Monitor(subscriptionID string, txHashes []string) error {
+	hashes, err := parseTxHashes(txHashes)
+	if err != nil {
+		return err
+	}
+
+	var notifications []types.Notification
+
+	m.lock.Lock()
+	subscriptionHashes, ok := m.subscriptionToHashes[subscriptionID]
+	if !ok {
+		subscriptionHashes = make(map[types.SHA256Hash]struct{})
+		m.subscriptionToHashes[subscriptionID] = subscriptionHashes
+	}
+	for _, hash := range hashes {
+		subscriptionHashes[hash] = struct{}{}
+
+		tx, exists := m.hashToTx[hash]
+		if !exists {
+			tx = &monitoredTx{
+				hash:            hash,
+				subscriptionIDs: make(map[string]struct{}),
+				status:          types.Unknown,
+				lastUpdate:      m.clock.Now(),
+			}
+			m.hashToTx[hash] = tx
+			if bxTx, ok := m.txStore.Get(hash); ok && bxTx.HasContent() {
+				tx.status = types.TxPool
+				m.trackSenderNonce(tx, bxTx)
+			}
+		}
+		tx.subscriptionIDs[subscriptionID] = struct{}{}
+
+		notification := tx.notification()
+		notification.SubscriptionIDs = []string{subscriptionID}
+		notifications = append(notifications, notification)
+	}
+	m.lock.Unlock()
+
+	for _, notification := range notifications {
+		m.notify(notification)
+	}
+	return nil
+}
+
+// StopMonitoring removes the transaction hashes from the subscription. If no hashes are provided all the
+// transactions of the subscription are removed
+func (m *TxStatusMonitor) StopMonitoring(subscriptionID string, txHashes []string) error {
+	hashes, err := parseTxHashes(txHashes)
+	if err != nil {
+		return err
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	subscriptionHashes, ok := m.subscriptionToHashes[subscriptionID]
+	if !ok {
+		return nil
+	}
+	if len(hashes) == 0 {
+		for hash := range subscriptionHashes {
+			hashes = append(hashes, hash)
+		}
+	}
+	for _, hash := range hashes {
+		delete(subscriptionHashes, hash)
+		tx, exists := m.hashToTx[hash]
+		if !exists {
+			continue
+		}
+		delete(tx.subscriptionIDs, subscriptionID)
+		if len(tx.subscriptionIDs) == 0 {
+			m.remove(tx)
+		}
+	}
+	if len(subscriptionHashes) == 0 {
+		delete(m.subscriptionToHashes, subscriptionID)
+	}
+	return nil
+}
+
+// OnTransaction updates the status of a monitored transaction when its content reaches the gateway
+func (m *TxStatusMonitor) OnTransaction(bxTx *types.BxTransaction) {
+	var notification *types.TransactionStatusNotification
+
+	m.lock.Lock()
+	tx, exists := m.hashToTx[bxTx.Hash()]
+	if exists && (tx.status == types.Unknown || tx.status == types.Dropped) {
+		m.trackSenderNonce(tx, bxTx)
+		notification = m.update(tx, types.TxPool)
+	}
+	m.lock.Unlock()
+
+	if notification != nil {
+		m.notify(notification)
+	}
+}
+
+// OnBlock updates the status of the monitored transactions included in the block, and of the monitored
+// transactions whose sender and nonce were used by a different transaction of the block
+func (m *TxStatusMonitor) OnBlock(block *types.EthBlockNotification, isBlockchainBlock bool) {
+	var notifications []types.Notification
+
+	m.lock.Lock()
+	if len(m.hashToTx) == 0 {
+		m.lock.Unlock()
+		return
+	}
+
+	blockHeight := block.Header.GetNumber()
+	blockHash := block.BlockHash.String()
+	for _, fields := range block.Transactions {
+		hashStr, _ := fields["hash"].(string)
+		hash, err := types.NewSHA256HashFromString(hashStr)
+		if err != nil {
+			continue
+		}
+
+		if tx, ok := m.hashToTx[hash]; ok {
+			switch {
+			case isBlockchainBlock && (tx.status != types.Mined || tx.blockHash != blockHash):
+				tx.blockHeight = blockHeight
+				tx.blockHash = blockHash
+				notifications = append(notifications, m.update(tx, types.Mined))
+			case !isBlockchainBlock && tx.status != types.Mined && tx.status != types.BDNBlock:
+				tx.blockHeight = blockHeight
+				tx.blockHash = blockHash
+				notifications = append(notifications, m.update(tx, types.BDNBlock))
+			}
+			continue
+		}
+
+		from, _ := fields["from"].(string)
+		nonceStr, _ := fields["nonce"].(string)
+		nonce, err := hexutil.DecodeUint64(nonceStr)
+		if err != nil {
+			continue
+		}
+		replacedHash, ok := m.senderNonceToHash[senderNonceKey(from, nonce)]
+		if !ok {
+			continue
+		}
+		tx := m.hashToTx[replacedHash]
+		if tx.status == types.Mined || tx.status == types.Replaced {
+			continue
+		}
+		tx.blockHeight = blockHeight
+		tx.blockHash = blockHash
+		tx.replacedBy = strings.TrimPrefix(hashStr, "0x")
+		notifications = append(notifications, m.update(tx, types.Replaced))
+	}
+	m.lock.Unlock()
+
+	for _, notification := range notifications {
+		m.notify(notification)
+	}
+}
+
+// check marks pending transactions which were removed from the TxStore as dropped and removes expired entries
+func (m *TxStatusMonitor) check() {
+	var notifications []types.Notification
+
+	m.lock.Lock()
+	now := m.clock.Now()
+	for _, tx := range m.hashToTx {
+		if now.Sub(tx.lastUpdate) > m.maxAge {
+			for subscriptionID := range tx.subscriptionIDs {
+				delete(m.subscriptionToHashes[subscriptionID], tx.hash)
+			}
+			m.remove(tx)
+			continue
+		}
+		if tx.status == types.TxPool && !m.txStore.HasContent(tx.hash) {
+			notifications = append(notifications, m.update(tx, types.Dropped))
+		}
+	}
+	size := len(m.hashToTx)
+	m.lock.Unlock()
+
+	for _, notification := range notifications {
+		m.notify(notification)
+	}
+	log.Tracef("TxStatusMonitor check done. Monitoring %v transactions, dropped %v", size, len(notifications))
+}
+
+// Count returns the number of monitored transactions
+func (m *TxStatusMonitor) Count() int {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return len(m.hashToTx)
+}
+
+// update should be called while holding the lock
+func (m *TxStatusMonitor) update(tx *monitoredTx, status types.Status) *types.TransactionStatusNotification {
+	tx.status = status
+	tx.lastUpdate = m.clock.Now()
+
+	notification := tx.notification()
+	for subscriptionID := range tx.subscriptionIDs {
+		notification.SubscriptionIDs = append(notification.SubscriptionIDs, subscriptionID)
+	}
+	return notification
+}
+
+// trackSenderNonce should be called while holding the lock
+func (m *TxStatusMonitor) trackSenderNonce(tx *monitoredTx, bxTx *types.BxTransaction) {
+	if tx.senderNonceKey != "" {
+		return
+	}
+	blockchainTx, err := bxTx.BlockchainTransaction(bxTx.Sender())
+	if err != nil {
+		log.Debugf("failed to parse monitored transaction %v: %v", tx.hash, err)
+		return
+	}
+	ethTx, ok := blockchainTx.(*types.EthTransaction)
+	if !ok || ethTx.From == nil {
+		return
+	}
+	tx.senderNonceKey = senderNonceKey(types.AddressAsString(ethTx.From), ethTx.Nonce)
+	m.senderNonceToHash[tx.senderNonceKey] = tx.hash
+}
+
+// remove should be called while holding the lock
+func (m *TxStatusMonitor) remove(tx *monitoredTx) {
+	delete(m.hashToTx, tx.hash)
+	if tx.senderNonceKey != "" && m.senderNonceToHash[tx.senderNonceKey] == tx.hash {
+		delete(m.senderNonceToHash, tx.senderNonceKey)
+	}
+}
+
+func (tx *monitoredTx) notification() *types.TransactionStatusNotification {
+	return &types.TransactionStatusNotification{
+		TransactionHash: tx.hash.String(),
+		Status:          tx.status,
+		BlockHeight:     tx.blockHeight,
+		BlockHash:       tx.blockHash,
+		ReplacedBy:      tx.replacedBy,
+	}
+}
+
+func senderNonceKey(from string, nonce uint64) string {
+	return fmt.Sprintf("%v:%v", strings.ToLower(from), nonce)
+}
+
+func parseTxHashes(txHashes []string) ([]types.SHA256Hash, error) {
+	hashes := make([]types.SHA256Hash, 0, len(txHashes))
+	for _, txHash := range txHashes {
+		hash, err := types.NewSHA256HashFromString(txHash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction hash %v", txHash)
+		}
+		hashes = append(hashes, hash)
+	}
+	return hashes, nil
+}
@@ -0,0 +1,123 @@
+package services
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/bloXroute-Labs/gateway/v2/utils"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestTxStatusMonitor() (*TxStatusMonitor, *BxTxStore, *[]*types.TransactionStatusNotification) {
+	txStore := NewBxTxStore(time.Minute, time.Minute, time.Minute, NewEmptyShortIDAssigner(), NewHashHistory("seenTxs", time.Minute), nil, 30*time.Minute, NoOpBloomFilter{})
+	notifications := make([]*types.TransactionStatusNotification, 0)
+	monitor := newTxStatusMonitor(&utils.MockClock{}, &txStore, func(n types.Notification) {
+		notifications = append(notifications, n.(*types.TransactionStatusNotification))
+	}, time.Minute, time.Hour)
+	return monitor, &txStore, &notifications
+}
+
+func addTestTx(t *testing.T, txStore *BxTxStore, tx *ethtypes.Transaction) *types.BxTransaction {
+	hash, err := types.NewSHA256Hash(tx.Hash().Bytes())
+	require.NoError(t, err)
+	content, err := rlp.EncodeToBytes(tx)
+	require.NoError(t, err)
+	result := txStore.Add(hash, content, types.ShortIDEmpty, testNetworkNum, false, 0, time.Now(), 0, types.EmptySender)
+	require.True(t, result.NewContent)
+	return result.Transaction
+}
+
+func newTestBlockNotification(t *testing.T, height uint64, txs ...*ethtypes.Transaction) *types.EthBlockNotification {
+	header := bxmock.NewEthBlockHeader(height, common.Hash{})
+	block := ethtypes.NewBlock(header, txs, nil, nil, bxmock.NewTestHasher())
+	notification, err := types.NewEthBlockNotification(block.Hash(), block, nil)
+	require.NoError(t, err)
+	return notification
+}
+
+func TestTxStatusMonitor_Mined(t *testing.T) {
+	monitor, txStore, notifications := newTestTxStatusMonitor()
+	tx := bxmock.NewSignedEthTx(ethtypes.LegacyTxType, 1, nil)
+	hash := tx.Hash().String()
+
+	require.NoError(t, monitor.Monitor("sub1", []string{hash}))
+	require.Len(t, *notifications, 1)
+	assert.Equal(t, types.Unknown, (*notifications)[0].Status)
+	assert.Equal(t, []string{"sub1"}, (*notifications)[0].SubscriptionIDs)
+
+	monitor.OnTransaction(addTestTx(t, txStore, tx))
+	require.Len(t, *notifications, 2)
+	assert.Equal(t, types.TxPool, (*notifications)[1].Status)
+
+	// a second subscription gets the current status only
+	require.NoError(t, monitor.Monitor("sub2", []string{hash}))
+	require.Len(t, *notifications, 3)
+	assert.Equal(t, types.TxPool, (*notifications)[2].Status)
+	assert.Equal(t, []string{"sub2"}, (*notifications)[2].SubscriptionIDs)
+
+	monitor.OnBlock(newTestBlockNotification(t, 10, tx), false)
+	require.Len(t, *notifications, 4)
+	assert.Equal(t, types.BDNBlock, (*notifications)[3].Status)
+	assert.Equal(t, uint64(10), (*notifications)[3].BlockHeight)
+	assert.ElementsMatch(t, []string{"sub1", "sub2"}, (*notifications)[3].SubscriptionIDs)
+
+	// same block from the node
+	monitor.OnBlock(newTestBlockNotification(t, 10, tx), true)
+	require.Len(t, *notifications, 5)
+	assert.Equal(t, types.Mined, (*notifications)[4].Status)
+
+	// BDN blocks do not override mined status
+	monitor.OnBlock(newTestBlockNotification(t, 10, tx), false)
+	assert.Len(t, *notifications, 5)
+
+	require.NoError(t, monitor.StopMonitoring("sub1", nil))
+	assert.Equal(t, 1, monitor.Count())
+	require.NoError(t, monitor.StopMonitoring("sub2", []string{hash}))
+	assert.Equal(t, 0, monitor.Count())
+}
+
+func TestTxStatusMonitor_Replaced(t *testing.T) {
+	monitor, txStore, notifications := newTestTxStatusMonitor()
+	tx := bxmock.NewSignedEthTx(ethtypes.LegacyTxType, 5, nil)
+	replacement := bxmock.NewSignedEthTx(ethtypes.DynamicFeeTxType, 5, nil)
+	require.NotEqual(t, tx.Hash(), replacement.Hash())
+
+	addTestTx(t, txStore, tx)
+	require.NoError(t, monitor.Monitor("sub1", []string{tx.Hash().String()}))
+	require.Len(t, *notifications, 1)
+	assert.Equal(t, types.TxPool, (*notifications)[0].Status)
+
+	monitor.OnBlock(newTestBlockNotification(t, 11, replacement), true)
+	require.Len(t, *notifications, 2)
+	assert.Equal(t, types.Replaced, (*notifications)[1].Status)
+	assert.Equal(t, replacement.Hash().String()[2:], (*notifications)[1].ReplacedBy)
+	assert.Equal(t, uint64(11), (*notifications)[1].BlockHeight)
+}
+
+func TestTxStatusMonitor_Dropped(t *testing.T) {
+	monitor, txStore, notifications := newTestTxStatusMonitor()
+	tx := bxmock.NewSignedEthTx(ethtypes.LegacyTxType, 1, nil)
+	bxTx := addTestTx(t, txStore, tx)
+
+	require.Error(t, monitor.Monitor("sub1", []string{"0x1234"}))
+	require.NoError(t, monitor.Monitor("sub1", []string{tx.Hash().String()}))
+
+	monitor.check()
+	require.Len(t, *notifications, 1)
+
+	txStore.RemoveHashes(&types.SHA256HashList{bxTx.Hash()}, NoReEntryProtection, "test")
+	monitor.check()
+	require.Len(t, *notifications, 2)
+	assert.Equal(t, types.Dropped, (*notifications)[1].Status)
+
+	// expired entries are removed
+	monitor.clock.(*utils.MockClock).IncTime(2 * time.Hour)
+	monitor.check()
+	assert.Equal(t, 0, monitor.Count())
+}
@@ -30,6 +30,7 @@ type TxStore interface {
 	Get(hash types.SHA256Hash) (*types.BxTransaction, bool)
 	Known(hash types.SHA256Hash) bool
 	HasContent(hash types.SHA256Hash) bool
+	ValidateFeeBump(tx *types.EthTransaction, network types.NetworkNum) (types.SHA256Hash, error)
 
 	RemoveShortIDs(*types.ShortIDList, ReEntryProtectionFlags, string)
 	RemoveHashes(*types.SHA256HashList, ReEntryProtectionFlags, string)
@@ -55,4 +56,9 @@ type TransactionResult struct {
 	DebugData        interface{}
 	AlreadySeen      bool
 	Nonce            uint64
+	// ReplacedHash is the hash of the pending transaction with the same sender and nonce replaced by the transaction
+	ReplacedHash types.SHA256Hash
+	// ReplacedBy is the hash of the pending transaction with the same sender and nonce kept instead of the
+	// transaction, when the transaction reuses the nonce without paying enough to replace it
+	ReplacedBy types.SHA256Hash
 }
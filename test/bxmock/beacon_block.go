@@ -7,10 +7,12 @@ import (
 
 	"github.com/prysmaticlabs/go-bitfield"
 
+	"github.com/bloXroute-Labs/gateway/v2/types"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/prysmaticlabs/prysm/v4/config/params"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/blocks"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/stretchr/testify/assert"
@@ -373,3 +375,123 @@ func NewCapellaBeaconBlock(t *testing.T, slot int, prevBlock interfaces.ReadOnly
 
 	return blk
 }
+
+// NewDenebBeaconBlock creates Deneb beacon block using execution layer block, the blob transactions are appended
+// to the transactions of the execution layer block
+func NewDenebBeaconBlock(t *testing.T, slot int, ethBlock *ethtypes.Block, blobTxs ...*types.BlobTx) *types.SignedBeaconBlockDeneb {
+	capella, err := NewCapellaBeaconBlock(t, slot, nil, ethBlock).PbCapellaBlock()
+	assert.NoError(t, err)
+
+	body := capella.GetBlock().GetBody()
+	payload, commitments := newExecutionPayloadDeneb(t, body.GetExecutionPayload(), blobTxs)
+
+	return &types.SignedBeaconBlockDeneb{
+		Block: &types.BeaconBlockDeneb{
+			Slot:          capella.GetBlock().GetSlot(),
+			ProposerIndex: capella.GetBlock().GetProposerIndex(),
+			ParentRoot:    capella.GetBlock().GetParentRoot(),
+			StateRoot:     capella.GetBlock().GetStateRoot(),
+			Body: &types.BeaconBlockBodyDeneb{
+				RandaoReveal:          body.GetRandaoReveal(),
+				Eth1Data:              body.GetEth1Data(),
+				Graffiti:              body.GetGraffiti(),
+				ProposerSlashings:     body.GetProposerSlashings(),
+				AttesterSlashings:     body.GetAttesterSlashings(),
+				Attestations:          body.GetAttestations(),
+				Deposits:              body.GetDeposits(),
+				VoluntaryExits:        body.GetVoluntaryExits(),
+				SyncAggregate:         body.GetSyncAggregate(),
+				ExecutionPayload:      payload,
+				BlsToExecutionChanges: body.GetBlsToExecutionChanges(),
+				BlobKzgCommitments:    commitments,
+			},
+		},
+		Signature: capella.GetSignature(),
+	}
+}
+
+// NewElectraBeaconBlock creates Electra beacon block using execution layer block, the blob transactions are appended
+// to the transactions of the execution layer block
+func NewElectraBeaconBlock(t *testing.T, slot int, ethBlock *ethtypes.Block, blobTxs ...*types.BlobTx) *types.SignedBeaconBlockElectra {
+	capella, err := NewCapellaBeaconBlock(t, slot, nil, ethBlock).PbCapellaBlock()
+	assert.NoError(t, err)
+
+	body := capella.GetBlock().GetBody()
+	payload, commitments := newExecutionPayloadDeneb(t, body.GetExecutionPayload(), blobTxs)
+
+	attestations := make([]*types.AttestationElectra, 0, len(body.GetAttestations()))
+	for _, attestation := range body.GetAttestations() {
+		committeeBits := bitfield.NewBitvector64()
+		committeeBits.SetBitAt(uint64(attestation.GetData().GetCommitteeIndex()), true)
+		attestations = append(attestations, &types.AttestationElectra{
+			AggregationBits: attestation.GetAggregationBits(),
+			Data:            attestation.GetData(),
+			Signature:       attestation.GetSignature(),
+			CommitteeBits:   committeeBits,
+		})
+	}
+
+	return &types.SignedBeaconBlockElectra{
+		Block: &types.BeaconBlockElectra{
+			Slot:          capella.GetBlock().GetSlot(),
+			ProposerIndex: capella.GetBlock().GetProposerIndex(),
+			ParentRoot:    capella.GetBlock().GetParentRoot(),
+			StateRoot:     capella.GetBlock().GetStateRoot(),
+			Body: &types.BeaconBlockBodyElectra{
+				RandaoReveal:          body.GetRandaoReveal(),
+				Eth1Data:              body.GetEth1Data(),
+				Graffiti:              body.GetGraffiti(),
+				ProposerSlashings:     body.GetProposerSlashings(),
+				Attestations:          attestations,
+				Deposits:              body.GetDeposits(),
+				VoluntaryExits:        body.GetVoluntaryExits(),
+				SyncAggregate:         body.GetSyncAggregate(),
+				ExecutionPayload:      payload,
+				BlsToExecutionChanges: body.GetBlsToExecutionChanges(),
+				BlobKzgCommitments:    commitments,
+				ExecutionRequests: &types.ExecutionRequests{
+					Withdrawals: []*types.WithdrawalRequest{
+						{
+							SourceAddress:   make([]byte, 20),
+							ValidatorPubkey: make([]byte, 48),
+							Amount:          1,
+						},
+					},
+				},
+			},
+		},
+		Signature: capella.GetSignature(),
+	}
+}
+
+func newExecutionPayloadDeneb(t *testing.T, capella *enginev1.ExecutionPayloadCapella, blobTxs []*types.BlobTx) (*types.ExecutionPayloadDeneb, [][]byte) {
+	txs := capella.GetTransactions()
+	var commitments [][]byte
+	for _, blobTx := range blobTxs {
+		txBytes, err := blobTx.MarshalBinary()
+		assert.NoError(t, err)
+
+		txs = append(txs, txBytes)
+		commitments = append(commitments, blobTx.Sidecar().Commitments...)
+	}
+
+	return &types.ExecutionPayloadDeneb{
+		ParentHash:    capella.GetParentHash(),
+		FeeRecipient:  capella.GetFeeRecipient(),
+		StateRoot:     capella.GetStateRoot(),
+		ReceiptsRoot:  capella.GetReceiptsRoot(),
+		LogsBloom:     capella.GetLogsBloom(),
+		PrevRandao:    capella.GetPrevRandao(),
+		BlockNumber:   capella.GetBlockNumber(),
+		GasLimit:      capella.GetGasLimit(),
+		GasUsed:       capella.GetGasUsed(),
+		Timestamp:     capella.GetTimestamp(),
+		ExtraData:     capella.GetExtraData(),
+		BaseFeePerGas: bytesutil.PadTo(bytesutil.ReverseByteOrder(capella.GetBaseFeePerGas()), 32),
+		BlockHash:     capella.GetBlockHash(),
+		Transactions:  txs,
+		Withdrawals:   capella.GetWithdrawals(),
+		BlobGasUsed:   uint64(len(commitments)) * 131072,
+		ExcessBlobGas: 0,
+	}, commitments
+}
@@ -0,0 +1,125 @@
+package bxmock
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/bloXroute-Labs/gateway/v2/test"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	ssz "github.com/prysmaticlabs/fastssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/stretchr/testify/require"
+)
+
+// NewBlobSidecars creates the blob sidecars of the KZG commitments of the Deneb block, with valid inclusion proofs.
+// The blobs and the KZG proofs are random
+func NewBlobSidecars(t *testing.T, block *types.SignedBeaconBlockDeneb) []*types.BlobSidecar {
+	body := block.GetBlock().GetBody()
+	bodyRoot, err := body.HashTreeRoot()
+	require.NoError(t, err)
+
+	header := &ethpb.SignedBeaconBlockHeader{
+		Header: &ethpb.BeaconBlockHeader{
+			Slot:          block.GetBlock().GetSlot(),
+			ProposerIndex: block.GetBlock().GetProposerIndex(),
+			ParentRoot:    block.GetBlock().GetParentRoot(),
+			StateRoot:     block.GetBlock().GetStateRoot(),
+			BodyRoot:      bodyRoot[:],
+		},
+		Signature: block.Signature,
+	}
+
+	// The roots of the body fields, padded to the power of two
+	fieldRoots := make([][32]byte, 16)
+	fieldRoots[0] = hashRoot(t, func(hh *ssz.Hasher) error { hh.PutBytes(body.RandaoReveal); return nil })
+	fieldRoots[1] = hashRoot(t, body.Eth1Data.HashTreeRootWith)
+	fieldRoots[2] = hashRoot(t, func(hh *ssz.Hasher) error { hh.PutBytes(body.Graffiti); return nil })
+	fieldRoots[3] = listRoot(t, body.ProposerSlashings, 16)
+	fieldRoots[4] = listRoot(t, body.AttesterSlashings, 2)
+	fieldRoots[5] = listRoot(t, body.Attestations, 128)
+	fieldRoots[6] = listRoot(t, body.Deposits, 16)
+	fieldRoots[7] = listRoot(t, body.VoluntaryExits, 16)
+	fieldRoots[8] = hashRoot(t, body.SyncAggregate.HashTreeRootWith)
+	fieldRoots[9] = hashRoot(t, body.ExecutionPayload.HashTreeRootWith)
+	fieldRoots[10] = listRoot(t, body.BlsToExecutionChanges, 16)
+
+	commitmentRoots := make([][32]byte, 4096)
+	for i, commitment := range body.BlobKzgCommitments {
+		var chunks [64]byte
+		copy(chunks[:], commitment)
+		commitmentRoots[i] = sha256.Sum256(chunks[:])
+	}
+	commitmentLayers := merkleLayers(commitmentRoots)
+
+	var lengthChunk [32]byte
+	binary.LittleEndian.PutUint64(lengthChunk[:], uint64(len(body.BlobKzgCommitments)))
+	fieldRoots[11] = sha256.Sum256(append(commitmentLayers[len(commitmentLayers)-1][0][:], lengthChunk[:]...))
+
+	bodyLayers := merkleLayers(fieldRoots)
+	require.Equal(t, bodyRoot, bodyLayers[len(bodyLayers)-1][0])
+
+	sidecars := make([]*types.BlobSidecar, 0, len(body.BlobKzgCommitments))
+	for i, commitment := range body.BlobKzgCommitments {
+		proof := merkleBranch(commitmentLayers, i)
+		proof = append(proof, lengthChunk[:])
+		proof = append(proof, merkleBranch(bodyLayers, 11)...)
+
+		sidecars = append(sidecars, &types.BlobSidecar{
+			Index:                    uint64(i),
+			Blob:                     test.GenerateBytes(131072),
+			KzgCommitment:            commitment,
+			KzgProof:                 test.GenerateBytes(48),
+			SignedBlockHeader:        header,
+			CommitmentInclusionProof: proof,
+		})
+	}
+
+	return sidecars
+}
+
+func hashRoot(t *testing.T, hashTreeRootWith func(hh *ssz.Hasher) error) [32]byte {
+	hh := ssz.NewHasher()
+	require.NoError(t, hashTreeRootWith(hh))
+	root, err := hh.HashRoot()
+	require.NoError(t, err)
+	return root
+}
+
+func listRoot[T ssz.HashRoot](t *testing.T, items []T, limit uint64) [32]byte {
+	return hashRoot(t, func(hh *ssz.Hasher) error {
+		indx := hh.Index()
+		for _, item := range items {
+			if err := item.HashTreeRootWith(hh); err != nil {
+				return err
+			}
+		}
+		hh.MerkleizeWithMixin(indx, uint64(len(items)), limit)
+		return nil
+	})
+}
+
+// merkleLayers returns the layers of the merkle tree of the leaves, from the leaves to the root
+func merkleLayers(leaves [][32]byte) [][][32]byte {
+	layers := [][][32]byte{leaves}
+	for layer := leaves; len(layer) > 1; {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sha256.Sum256(append(layer[2*i][:], layer[2*i+1][:]...))
+		}
+		layers = append(layers, next)
+		layer = next
+	}
+	return layers
+}
+
+// merkleBranch returns the siblings of the leaf from the leaves to the root
+func merkleBranch(layers [][][32]byte, index int) [][]byte {
+	branch := make([][]byte, 0, len(layers)-1)
+	for _, layer := range layers[:len(layers)-1] {
+		sibling := layer[index^1]
+		branch = append(branch, sibling[:])
+		index /= 2
+	}
+	return branch
+}
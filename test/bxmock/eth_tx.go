@@ -6,6 +6,7 @@ import (
 
 	"github.com/bloXroute-Labs/gateway/v2/bxmessage"
 	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -60,6 +61,72 @@ func NewSignedEthTxMessage(txType uint8, nonce uint64, privateKey *ecdsa.Private
 	return ethTx, bxmessage.NewTx(hash, ethTxBytes, networkNum, flags, "")
 }
 
+// NewSignedBlobTx generates a valid signed blob transaction with one blob from a provided private key. nil can be specified to use a hardcoded key.
+func NewSignedBlobTx(nonce uint64, privateKey *ecdsa.PrivateKey) *types.BlobTx {
+	if privateKey == nil {
+		privateKey = pKey
+	}
+
+	commitment := make([]byte, types.KZGCommitmentSize)
+	commitment[0] = 0xc0
+	sidecar := &types.BlobTxSidecar{
+		Blobs:       [][]byte{make([]byte, types.BlobSize)},
+		Commitments: [][]byte{commitment},
+		Proofs:      [][]byte{make([]byte, types.KZGCommitmentSize)},
+	}
+	blobTx, err := types.SignBlobTx(&types.BlobTxData{
+		ChainID:    ChainID,
+		Nonce:      nonce,
+		GasTipCap:  big.NewInt(100),
+		GasFeeCap:  big.NewInt(100),
+		Gas:        21000,
+		To:         crypto.PubkeyToAddress(privateKey.PublicKey),
+		Value:      big.NewInt(1),
+		Data:       []byte{},
+		BlobFeeCap: big.NewInt(10),
+		BlobHashes: []common.Hash{types.KZGToVersionedHash(commitment)},
+	}, sidecar, privateKey)
+	if err != nil {
+		panic(err)
+	}
+	return blobTx
+}
+
+// NewSignedSetCodeTx generates a valid signed set-code transaction from a provided private key, with an authorization
+// of the authority key delegating to the code address. nil can be specified to use a hardcoded key for both.
+func NewSignedSetCodeTx(nonce uint64, privateKey *ecdsa.PrivateKey, authorityKey *ecdsa.PrivateKey, codeAddress common.Address) *types.SetCodeTx {
+	if privateKey == nil {
+		privateKey = pKey
+	}
+	if authorityKey == nil {
+		authorityKey = pKey
+	}
+
+	auth, err := types.SignSetCodeAuthorization(types.SetCodeAuthorization{
+		ChainID: ChainID,
+		Address: codeAddress,
+		Nonce:   nonce + 1,
+	}, authorityKey)
+	if err != nil {
+		panic(err)
+	}
+	setCodeTx, err := types.SignSetCodeTx(&types.SetCodeTxData{
+		ChainID:   ChainID,
+		Nonce:     nonce,
+		GasTipCap: big.NewInt(100),
+		GasFeeCap: big.NewInt(100),
+		Gas:       50000,
+		To:        crypto.PubkeyToAddress(privateKey.PublicKey),
+		Value:     big.NewInt(1),
+		Data:      []byte{},
+		AuthList:  []types.SetCodeAuthorization{auth},
+	}, privateKey)
+	if err != nil {
+		panic(err)
+	}
+	return setCodeTx
+}
+
 // newEthLegacyTx generates a valid signed Ethereum transaction from a provided private key. nil can be specified to use a hardcoded private key.
 func newEthLegacyTx(nonce uint64, privateKey *ecdsa.PrivateKey) *ethtypes.Transaction {
 	address := crypto.PubkeyToAddress(privateKey.PublicKey)
@@ -0,0 +1,42 @@
+package types
+
+import "fmt"
+
+// BackpressurePolicy is what a subscription does with the notifications of its feed when the client can not keep up
+type BackpressurePolicy string
+
+// BackpressurePolicy enumeration
+const (
+	// BackpressureDisconnect - the subscription is closed when its feed channel is full
+	BackpressureDisconnect BackpressurePolicy = "disconnect"
+	// BackpressureDropOldest - the oldest notification waiting in the feed channel is dropped to make room for the new one
+	BackpressureDropOldest BackpressurePolicy = "drop_oldest"
+	// BackpressureDropNewest - the new notification is dropped when the feed channel is full
+	BackpressureDropNewest BackpressurePolicy = "drop_newest"
+	// BackpressureCoalesce - only the latest block is kept, the blocks not read yet by the client are dropped
+	BackpressureCoalesce BackpressurePolicy = "coalesce"
+)
+
+// CoalescableFeeds are the feeds of block headers, which can be coalesced to the latest block. The receipts, logs
+// and ethOnBlock feeds are not, since each of their blocks carries data the later blocks do not
+var CoalescableFeeds = []FeedType{NewBlocksFeed, BDNBlocksFeed, NewBeaconBlocksFeed, BDNBeaconBlocksFeed}
+
+// NewBackpressurePolicy returns the policy by its name, the default policy is BackpressureDisconnect
+func NewBackpressurePolicy(name string) (BackpressurePolicy, error) {
+	switch policy := BackpressurePolicy(name); policy {
+	case "":
+		return BackpressureDisconnect, nil
+	case BackpressureDisconnect, BackpressureDropOldest, BackpressureDropNewest, BackpressureCoalesce:
+		return policy, nil
+	default:
+		return "", fmt.Errorf("invalid backpressure policy %v, valid policies are %v, %v, %v and %v", name,
+			BackpressureDisconnect, BackpressureDropOldest, BackpressureDropNewest, BackpressureCoalesce)
+	}
+}
+
+// SubscriptionStatus is reported periodically to the client of a subscription whose notifications were dropped
+type SubscriptionStatus struct {
+	Backpressure BackpressurePolicy `json:"backpressure"`
+	// Dropped is the total number of notifications dropped since the subscription was opened
+	Dropped uint64 `json:"dropped"`
+}
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	ssz "github.com/prysmaticlabs/fastssz"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	"github.com/prysmaticlabs/prysm/v4/runtime/version"
+)
+
+// Versions of the beacon blocks of the forks which are not supported by prysm, they follow the prysm versions
+const (
+	VersionDeneb = version.Capella + 1 + iota
+	VersionElectra
+)
+
+// BeaconBlock is a signed beacon block of the Deneb fork or later. These blocks are encoded natively
+// since prysm does not support them
+type BeaconBlock interface {
+	ssz.Marshaler
+	ssz.Unmarshaler
+
+	Version() int
+	Slot() primitives.Slot
+	BlockRoot() ([32]byte, error)
+	ExecutionPayload() *ExecutionPayloadDeneb
+	BlobKzgCommitments() [][]byte
+}
+
+// NewBeaconBlock returns an empty beacon block of the version, to be decoded
+func NewBeaconBlock(v int) (BeaconBlock, error) {
+	switch v {
+	case VersionDeneb:
+		return &SignedBeaconBlockDeneb{}, nil
+	case VersionElectra:
+		return &SignedBeaconBlockElectra{}, nil
+	default:
+		return nil, fmt.Errorf("unsupported beacon block version %v", BeaconVersionString(v))
+	}
+}
+
+// BeaconVersionString returns the name of the beacon block version, including the versions not supported by prysm
+func BeaconVersionString(v int) string {
+	switch v {
+	case VersionDeneb:
+		return "deneb"
+	case VersionElectra:
+		return "electra"
+	default:
+		return version.String(v)
+	}
+}
@@ -0,0 +1,156 @@
+package types
+
+import (
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// SignedBeaconBlockDeneb is a signed beacon block of the Deneb fork
+type SignedBeaconBlockDeneb struct {
+	Block     *BeaconBlockDeneb `json:"block,omitempty"`
+	Signature []byte            `json:"signature,omitempty" ssz-size:"96"`
+}
+
+// BeaconBlockDeneb is a beacon block of the Deneb fork
+type BeaconBlockDeneb struct {
+	Slot          primitives.Slot           `json:"slot,omitempty"`
+	ProposerIndex primitives.ValidatorIndex `json:"proposer_index,omitempty"`
+	ParentRoot    []byte                    `json:"parent_root,omitempty" ssz-size:"32"`
+	StateRoot     []byte                    `json:"state_root,omitempty" ssz-size:"32"`
+	Body          *BeaconBlockBodyDeneb     `json:"body,omitempty"`
+}
+
+// BeaconBlockBodyDeneb is the body of a beacon block of the Deneb fork, which adds the KZG commitments of the blobs
+type BeaconBlockBodyDeneb struct {
+	RandaoReveal          []byte                              `json:"randao_reveal,omitempty" ssz-size:"96"`
+	Eth1Data              *ethpb.Eth1Data                     `json:"eth1_data,omitempty"`
+	Graffiti              []byte                              `json:"graffiti,omitempty" ssz-size:"32"`
+	ProposerSlashings     []*ethpb.ProposerSlashing           `json:"proposer_slashings,omitempty" ssz-max:"16"`
+	AttesterSlashings     []*ethpb.AttesterSlashing           `json:"attester_slashings,omitempty" ssz-max:"2"`
+	Attestations          []*ethpb.Attestation                `json:"attestations,omitempty" ssz-max:"128"`
+	Deposits              []*ethpb.Deposit                    `json:"deposits,omitempty" ssz-max:"16"`
+	VoluntaryExits        []*ethpb.SignedVoluntaryExit        `json:"voluntary_exits,omitempty" ssz-max:"16"`
+	SyncAggregate         *ethpb.SyncAggregate                `json:"sync_aggregate,omitempty"`
+	ExecutionPayload      *ExecutionPayloadDeneb              `json:"execution_payload,omitempty"`
+	BlsToExecutionChanges []*ethpb.SignedBLSToExecutionChange `json:"bls_to_execution_changes,omitempty" ssz-max:"16"`
+	BlobKzgCommitments    [][]byte                            `json:"blob_kzg_commitments,omitempty" ssz-max:"4096" ssz-size:"?,48"`
+}
+
+// ExecutionPayloadDeneb is the execution payload of the Deneb fork, which adds the blob gas fields.
+// Electra blocks use the same payload
+type ExecutionPayloadDeneb struct {
+	ParentHash    []byte                 `json:"parent_hash,omitempty" ssz-size:"32"`
+	FeeRecipient  []byte                 `json:"fee_recipient,omitempty" ssz-size:"20"`
+	StateRoot     []byte                 `json:"state_root,omitempty" ssz-size:"32"`
+	ReceiptsRoot  []byte                 `json:"receipts_root,omitempty" ssz-size:"32"`
+	LogsBloom     []byte                 `json:"logs_bloom,omitempty" ssz-size:"256"`
+	PrevRandao    []byte                 `json:"prev_randao,omitempty" ssz-size:"32"`
+	BlockNumber   uint64                 `json:"block_number,omitempty"`
+	GasLimit      uint64                 `json:"gas_limit,omitempty"`
+	GasUsed       uint64                 `json:"gas_used,omitempty"`
+	Timestamp     uint64                 `json:"timestamp,omitempty"`
+	ExtraData     []byte                 `json:"extra_data,omitempty" ssz-max:"32"`
+	BaseFeePerGas []byte                 `json:"base_fee_per_gas,omitempty" ssz-size:"32"`
+	BlockHash     []byte                 `json:"block_hash,omitempty" ssz-size:"32"`
+	Transactions  [][]byte               `json:"transactions,omitempty" ssz-max:"1048576,1073741824" ssz-size:"?,?"`
+	Withdrawals   []*enginev1.Withdrawal `json:"withdrawals,omitempty" ssz-max:"16"`
+	BlobGasUsed   uint64                 `json:"blob_gas_used,omitempty"`
+	ExcessBlobGas uint64                 `json:"excess_blob_gas,omitempty"`
+}
+
+// SignedBlockContentsDeneb is the signed block with its blobs, as published to the beacon API
+type SignedBlockContentsDeneb struct {
+	SignedBlock *SignedBeaconBlockDeneb `json:"signed_block"`
+	KzgProofs   [][]byte                `json:"kzg_proofs" ssz-max:"4096" ssz-size:"?,48"`
+	Blobs       [][]byte                `json:"blobs" ssz-max:"4096" ssz-size:"?,131072"`
+}
+
+// Version returns the Deneb block version
+func (s *SignedBeaconBlockDeneb) Version() int {
+	return VersionDeneb
+}
+
+// Slot returns the slot of the block
+func (s *SignedBeaconBlockDeneb) Slot() primitives.Slot {
+	return s.GetBlock().GetSlot()
+}
+
+// BlockRoot returns the hash tree root of the block message, which is the beacon block hash
+func (s *SignedBeaconBlockDeneb) BlockRoot() ([32]byte, error) {
+	return s.GetBlock().HashTreeRoot()
+}
+
+// ExecutionPayload returns the execution payload of the block
+func (s *SignedBeaconBlockDeneb) ExecutionPayload() *ExecutionPayloadDeneb {
+	return s.GetBlock().GetBody().GetExecutionPayload()
+}
+
+// BlobKzgCommitments returns the KZG commitments of the blobs of the block
+func (s *SignedBeaconBlockDeneb) BlobKzgCommitments() [][]byte {
+	return s.GetBlock().GetBody().GetBlobKzgCommitments()
+}
+
+// GetBlock returns the block message
+func (s *SignedBeaconBlockDeneb) GetBlock() *BeaconBlockDeneb {
+	if s != nil {
+		return s.Block
+	}
+	return nil
+}
+
+// GetSlot returns the slot of the block
+func (b *BeaconBlockDeneb) GetSlot() primitives.Slot {
+	if b != nil {
+		return b.Slot
+	}
+	return 0
+}
+
+// GetProposerIndex returns the index of the proposer of the block
+func (b *BeaconBlockDeneb) GetProposerIndex() primitives.ValidatorIndex {
+	if b != nil {
+		return b.ProposerIndex
+	}
+	return 0
+}
+
+// GetParentRoot returns the root of the parent block
+func (b *BeaconBlockDeneb) GetParentRoot() []byte {
+	if b != nil {
+		return b.ParentRoot
+	}
+	return nil
+}
+
+// GetStateRoot returns the root of the beacon state after the block
+func (b *BeaconBlockDeneb) GetStateRoot() []byte {
+	if b != nil {
+		return b.StateRoot
+	}
+	return nil
+}
+
+// GetBody returns the body of the block
+func (b *BeaconBlockDeneb) GetBody() *BeaconBlockBodyDeneb {
+	if b != nil {
+		return b.Body
+	}
+	return nil
+}
+
+// GetExecutionPayload returns the execution payload of the body
+func (b *BeaconBlockBodyDeneb) GetExecutionPayload() *ExecutionPayloadDeneb {
+	if b != nil {
+		return b.ExecutionPayload
+	}
+	return nil
+}
+
+// GetBlobKzgCommitments returns the KZG commitments of the blobs of the body
+func (b *BeaconBlockBodyDeneb) GetBlobKzgCommitments() [][]byte {
+	if b != nil {
+		return b.BlobKzgCommitments
+	}
+	return nil
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"github.com/prysmaticlabs/go-bitfield"
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// SignedBeaconBlockElectra is a signed beacon block of the Electra fork
+type SignedBeaconBlockElectra struct {
+	Block     *BeaconBlockElectra `json:"block,omitempty"`
+	Signature []byte              `json:"signature,omitempty" ssz-size:"96"`
+}
+
+// BeaconBlockElectra is a beacon block of the Electra fork
+type BeaconBlockElectra struct {
+	Slot          primitives.Slot           `json:"slot,omitempty"`
+	ProposerIndex primitives.ValidatorIndex `json:"proposer_index,omitempty"`
+	ParentRoot    []byte                    `json:"parent_root,omitempty" ssz-size:"32"`
+	StateRoot     []byte                    `json:"state_root,omitempty" ssz-size:"32"`
+	Body          *BeaconBlockBodyElectra   `json:"body,omitempty"`
+}
+
+// BeaconBlockBodyElectra is the body of a beacon block of the Electra fork, which changes the attestations
+// to cover several committees and adds the execution layer requests
+type BeaconBlockBodyElectra struct {
+	RandaoReveal          []byte                              `json:"randao_reveal,omitempty" ssz-size:"96"`
+	Eth1Data              *ethpb.Eth1Data                     `json:"eth1_data,omitempty"`
+	Graffiti              []byte                              `json:"graffiti,omitempty" ssz-size:"32"`
+	ProposerSlashings     []*ethpb.ProposerSlashing           `json:"proposer_slashings,omitempty" ssz-max:"16"`
+	AttesterSlashings     []*AttesterSlashingElectra          `json:"attester_slashings,omitempty" ssz-max:"1"`
+	Attestations          []*AttestationElectra               `json:"attestations,omitempty" ssz-max:"8"`
+	Deposits              []*ethpb.Deposit                    `json:"deposits,omitempty" ssz-max:"16"`
+	VoluntaryExits        []*ethpb.SignedVoluntaryExit        `json:"voluntary_exits,omitempty" ssz-max:"16"`
+	SyncAggregate         *ethpb.SyncAggregate                `json:"sync_aggregate,omitempty"`
+	ExecutionPayload      *ExecutionPayloadDeneb              `json:"execution_payload,omitempty"`
+	BlsToExecutionChanges []*ethpb.SignedBLSToExecutionChange `json:"bls_to_execution_changes,omitempty" ssz-max:"16"`
+	BlobKzgCommitments    [][]byte                            `json:"blob_kzg_commitments,omitempty" ssz-max:"4096" ssz-size:"?,48"`
+	ExecutionRequests     *ExecutionRequests                  `json:"execution_requests,omitempty"`
+}
+
+// AttestationElectra is an attestation of the Electra fork, aggregated over the committees of the committee bits
+type AttestationElectra struct {
+	AggregationBits bitfield.Bitlist       `json:"aggregation_bits,omitempty" ssz-max:"131072"`
+	Data            *ethpb.AttestationData `json:"data,omitempty"`
+	Signature       []byte                 `json:"signature,omitempty" ssz-size:"96"`
+	CommitteeBits   bitfield.Bitvector64   `json:"committee_bits,omitempty" ssz-size:"8"`
+}
+
+// IndexedAttestationElectra is an indexed attestation of the Electra fork
+type IndexedAttestationElectra struct {
+	AttestingIndices []uint64               `json:"attesting_indices,omitempty" ssz-max:"131072"`
+	Data             *ethpb.AttestationData `json:"data,omitempty"`
+	Signature        []byte                 `json:"signature,omitempty" ssz-size:"96"`
+}
+
+// AttesterSlashingElectra is an attester slashing of the Electra fork
+type AttesterSlashingElectra struct {
+	Attestation_1 *IndexedAttestationElectra `json:"attestation_1,omitempty"`
+	Attestation_2 *IndexedAttestationElectra `json:"attestation_2,omitempty"`
+}
+
+// ExecutionRequests are the requests of the execution layer contracts which are processed by the beacon chain
+type ExecutionRequests struct {
+	Deposits       []*DepositRequest       `json:"deposits,omitempty" ssz-max:"8192"`
+	Withdrawals    []*WithdrawalRequest    `json:"withdrawals,omitempty" ssz-max:"16"`
+	Consolidations []*ConsolidationRequest `json:"consolidations,omitempty" ssz-max:"2"`
+}
+
+// DepositRequest is a deposit made to the deposit contract
+type DepositRequest struct {
+	Pubkey                []byte `json:"pubkey,omitempty" ssz-size:"48"`
+	WithdrawalCredentials []byte `json:"withdrawal_credentials,omitempty" ssz-size:"32"`
+	Amount                uint64 `json:"amount,omitempty"`
+	Signature             []byte `json:"signature,omitempty" ssz-size:"96"`
+	Index                 uint64 `json:"index,omitempty"`
+}
+
+// WithdrawalRequest is a withdrawal triggered by the withdrawal credentials of a validator
+type WithdrawalRequest struct {
+	SourceAddress   []byte `json:"source_address,omitempty" ssz-size:"20"`
+	ValidatorPubkey []byte `json:"validator_pubkey,omitempty" ssz-size:"48"`
+	Amount          uint64 `json:"amount,omitempty"`
+}
+
+// ConsolidationRequest is a request to consolidate the balance of a validator into another validator
+type ConsolidationRequest struct {
+	SourceAddress []byte `json:"source_address,omitempty" ssz-size:"20"`
+	SourcePubkey  []byte `json:"source_pubkey,omitempty" ssz-size:"48"`
+	TargetPubkey  []byte `json:"target_pubkey,omitempty" ssz-size:"48"`
+}
+
+// SignedBlockContentsElectra is the signed block with its blobs, as published to the beacon API
+type SignedBlockContentsElectra struct {
+	SignedBlock *SignedBeaconBlockElectra `json:"signed_block"`
+	KzgProofs   [][]byte                  `json:"kzg_proofs" ssz-max:"4096" ssz-size:"?,48"`
+	Blobs       [][]byte                  `json:"blobs" ssz-max:"4096" ssz-size:"?,131072"`
+}
+
+// Version returns the Electra block version
+func (s *SignedBeaconBlockElectra) Version() int {
+	return VersionElectra
+}
+
+// Slot returns the slot of the block
+func (s *SignedBeaconBlockElectra) Slot() primitives.Slot {
+	return s.GetBlock().GetSlot()
+}
+
+// BlockRoot returns the hash tree root of the block message, which is the beacon block hash
+func (s *SignedBeaconBlockElectra) BlockRoot() ([32]byte, error) {
+	return s.GetBlock().HashTreeRoot()
+}
+
+// ExecutionPayload returns the execution payload of the block
+func (s *SignedBeaconBlockElectra) ExecutionPayload() *ExecutionPayloadDeneb {
+	return s.GetBlock().GetBody().GetExecutionPayload()
+}
+
+// BlobKzgCommitments returns the KZG commitments of the blobs of the block
+func (s *SignedBeaconBlockElectra) BlobKzgCommitments() [][]byte {
+	return s.GetBlock().GetBody().GetBlobKzgCommitments()
+}
+
+// GetBlock returns the block message
+func (s *SignedBeaconBlockElectra) GetBlock() *BeaconBlockElectra {
+	if s != nil {
+		return s.Block
+	}
+	return nil
+}
+
+// GetSlot returns the slot of the block
+func (b *BeaconBlockElectra) GetSlot() primitives.Slot {
+	if b != nil {
+		return b.Slot
+	}
+	return 0
+}
+
+// GetProposerIndex returns the index of the proposer of the block
+func (b *BeaconBlockElectra) GetProposerIndex() primitives.ValidatorIndex {
+	if b != nil {
+		return b.ProposerIndex
+	}
+	return 0
+}
+
+// GetParentRoot returns the root of the parent block
+func (b *BeaconBlockElectra) GetParentRoot() []byte {
+	if b != nil {
+		return b.ParentRoot
+	}
+	return nil
+}
+
+// GetStateRoot returns the root of the beacon state after the block
+func (b *BeaconBlockElectra) GetStateRoot() []byte {
+	if b != nil {
+		return b.StateRoot
+	}
+	return nil
+}
+
+// GetBody returns the body of the block
+func (b *BeaconBlockElectra) GetBody() *BeaconBlockBodyElectra {
+	if b != nil {
+		return b.Body
+	}
+	return nil
+}
+
+// GetExecutionPayload returns the execution payload of the body
+func (b *BeaconBlockBodyElectra) GetExecutionPayload() *ExecutionPayloadDeneb {
+	if b != nil {
+		return b.ExecutionPayload
+	}
+	return nil
+}
+
+// GetBlobKzgCommitments returns the KZG commitments of the blobs of the body
+func (b *BeaconBlockBodyElectra) GetBlobKzgCommitments() [][]byte {
+	if b != nil {
+		return b.BlobKzgCommitments
+	}
+	return nil
+}
+
+// GetExecutionRequests returns the execution layer requests of the body
+func (b *BeaconBlockBodyElectra) GetExecutionRequests() *ExecutionRequests {
+	if b != nil {
+		return b.ExecutionRequests
+	}
+	return nil
+}
+
+// GetDeposits returns the deposit requests
+func (r *ExecutionRequests) GetDeposits() []*DepositRequest {
+	if r != nil {
+		return r.Deposits
+	}
+	return nil
+}
+
+// GetWithdrawals returns the withdrawal requests
+func (r *ExecutionRequests) GetWithdrawals() []*WithdrawalRequest {
+	if r != nil {
+		return r.Withdrawals
+	}
+	return nil
+}
+
+// GetConsolidations returns the consolidation requests
+func (r *ExecutionRequests) GetConsolidations() []*ConsolidationRequest {
+	if r != nil {
+		return r.Consolidations
+	}
+	return nil
+}
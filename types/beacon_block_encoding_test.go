@@ -0,0 +1,239 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/prysmaticlabs/go-bitfield"
+	enginev1 "github.com/prysmaticlabs/prysm/v4/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// the reference SSZ merkleization of the consensus specs, used to check the hash tree roots of the lists of byte
+// vectors independently of the encoding of the containers
+
+func sszHash(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+func sszZeroHash(depth int) [32]byte {
+	var h [32]byte
+	for i := 0; i < depth; i++ {
+		h = sszHash(h, h)
+	}
+	return h
+}
+
+// sszMerkleize returns the root of the chunks padded with zero chunks to the limit
+func sszMerkleize(chunks [][32]byte, limit int) [32]byte {
+	depth := 0
+	for 1<<depth < limit {
+		depth++
+	}
+	if len(chunks) == 0 {
+		return sszZeroHash(depth)
+	}
+	layer := chunks
+	for d := 0; d < depth; d++ {
+		if len(layer)%2 == 1 {
+			layer = append(layer, sszZeroHash(d))
+		}
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = sszHash(layer[2*i], layer[2*i+1])
+		}
+		layer = next
+	}
+	return layer[0]
+}
+
+func sszMixInLength(root [32]byte, length int) [32]byte {
+	var l [32]byte
+	binary.LittleEndian.PutUint64(l[:], uint64(length))
+	return sszHash(root, l)
+}
+
+// sszBytesRoot returns the root of a byte vector
+func sszBytesRoot(b []byte) [32]byte {
+	chunks := make([][32]byte, (len(b)+31)/32)
+	for i := range chunks {
+		copy(chunks[i][:], b[32*i:])
+	}
+	return sszMerkleize(chunks, len(chunks))
+}
+
+// sszBytesListRoot returns the root of a list of byte vectors with the maximum length
+func sszBytesListRoot(items [][]byte, limit int) [32]byte {
+	roots := make([][32]byte, len(items))
+	for i, item := range items {
+		roots[i] = sszBytesRoot(item)
+	}
+	return sszMixInLength(sszMerkleize(roots, limit), len(items))
+}
+
+// sszContainerRoot returns the root of a container with the roots of its fields
+func sszContainerRoot(t *testing.T, fields ...interface{}) [32]byte {
+	roots := make([][32]byte, len(fields))
+	for i, field := range fields {
+		switch f := field.(type) {
+		case [32]byte:
+			roots[i] = f
+		case interface{ HashTreeRoot() ([32]byte, error) }:
+			root, err := f.HashTreeRoot()
+			require.NoError(t, err)
+			roots[i] = root
+		default:
+			t.Fatalf("unsupported field %T", field)
+		}
+	}
+	return sszMerkleize(roots, len(roots))
+}
+
+func testBytes(size int, b byte) []byte {
+	bytes := make([]byte, size)
+	for i := range bytes {
+		bytes[i] = b + byte(i)
+	}
+	return bytes
+}
+
+func newTestExecutionPayloadDeneb() *ExecutionPayloadDeneb {
+	return &ExecutionPayloadDeneb{
+		ParentHash:    testBytes(32, 1),
+		FeeRecipient:  testBytes(20, 2),
+		StateRoot:     testBytes(32, 3),
+		ReceiptsRoot:  testBytes(32, 4),
+		LogsBloom:     testBytes(256, 5),
+		PrevRandao:    testBytes(32, 6),
+		BlockNumber:   10,
+		GasLimit:      30000000,
+		GasUsed:       21000,
+		Timestamp:     1710338135,
+		BaseFeePerGas: testBytes(32, 7),
+		BlockHash:     testBytes(32, 8),
+		Transactions:  [][]byte{testBytes(100, 9)},
+		Withdrawals:   []*enginev1.Withdrawal{{Index: 1, ValidatorIndex: 2, Address: testBytes(20, 10), Amount: 3}},
+		BlobGasUsed:   131072,
+		ExcessBlobGas: 0,
+	}
+}
+
+// emptyListRoot returns the root of an empty list of containers with the maximum length
+func emptyListRoot(limit int) [32]byte {
+	return sszMixInLength(sszMerkleize(nil, limit), 0)
+}
+
+func TestBeaconBlockBodyDeneb_HashTreeRoot(t *testing.T) {
+	commitments := [][]byte{testBytes(48, 11), testBytes(48, 12), testBytes(48, 13)}
+	body := &BeaconBlockBodyDeneb{
+		RandaoReveal:       testBytes(96, 14),
+		Eth1Data:           &ethpb.Eth1Data{DepositRoot: testBytes(32, 15), DepositCount: 16, BlockHash: testBytes(32, 17)},
+		Graffiti:           testBytes(32, 18),
+		SyncAggregate:      &ethpb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: testBytes(96, 19)},
+		ExecutionPayload:   newTestExecutionPayloadDeneb(),
+		BlobKzgCommitments: commitments,
+	}
+
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, sszContainerRoot(t,
+		sszBytesRoot(body.RandaoReveal),
+		body.Eth1Data,
+		sszBytesRoot(body.Graffiti),
+		emptyListRoot(16),
+		emptyListRoot(2),
+		emptyListRoot(128),
+		emptyListRoot(16),
+		emptyListRoot(16),
+		body.SyncAggregate,
+		body.ExecutionPayload,
+		emptyListRoot(16),
+		sszBytesListRoot(commitments, 4096),
+	), root)
+}
+
+func TestBeaconBlockBodyElectra_HashTreeRoot(t *testing.T) {
+	commitments := [][]byte{testBytes(48, 11), testBytes(48, 12)}
+	body := &BeaconBlockBodyElectra{
+		RandaoReveal:       testBytes(96, 14),
+		Eth1Data:           &ethpb.Eth1Data{DepositRoot: testBytes(32, 15), DepositCount: 16, BlockHash: testBytes(32, 17)},
+		Graffiti:           testBytes(32, 18),
+		SyncAggregate:      &ethpb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: testBytes(96, 19)},
+		ExecutionPayload:   newTestExecutionPayloadDeneb(),
+		BlobKzgCommitments: commitments,
+		ExecutionRequests:  &ExecutionRequests{},
+	}
+
+	root, err := body.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, sszContainerRoot(t,
+		sszBytesRoot(body.RandaoReveal),
+		body.Eth1Data,
+		sszBytesRoot(body.Graffiti),
+		emptyListRoot(16),
+		emptyListRoot(1),
+		emptyListRoot(8),
+		emptyListRoot(16),
+		emptyListRoot(16),
+		body.SyncAggregate,
+		body.ExecutionPayload,
+		emptyListRoot(16),
+		sszBytesListRoot(commitments, 4096),
+		sszContainerRoot(t, emptyListRoot(8192), emptyListRoot(16), emptyListRoot(2)),
+	), root)
+}
+
+func TestSignedBlockContents_HashTreeRoot(t *testing.T) {
+	proofs := [][]byte{testBytes(48, 20), testBytes(48, 21)}
+	blobs := [][]byte{testBytes(131072, 22), testBytes(131072, 23)}
+
+	deneb := &SignedBlockContentsDeneb{
+		SignedBlock: &SignedBeaconBlockDeneb{
+			Block: &BeaconBlockDeneb{
+				Slot:       1,
+				ParentRoot: testBytes(32, 24),
+				StateRoot:  testBytes(32, 25),
+				Body: &BeaconBlockBodyDeneb{
+					RandaoReveal:     testBytes(96, 14),
+					Eth1Data:         &ethpb.Eth1Data{DepositRoot: testBytes(32, 15), BlockHash: testBytes(32, 17)},
+					Graffiti:         testBytes(32, 18),
+					SyncAggregate:    &ethpb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: testBytes(96, 19)},
+					ExecutionPayload: newTestExecutionPayloadDeneb(),
+				},
+			},
+			Signature: testBytes(96, 26),
+		},
+		KzgProofs: proofs,
+		Blobs:     blobs,
+	}
+	root, err := deneb.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, sszContainerRoot(t, deneb.SignedBlock, sszBytesListRoot(proofs, 4096), sszBytesListRoot(blobs, 4096)), root)
+
+	electra := &SignedBlockContentsElectra{
+		SignedBlock: &SignedBeaconBlockElectra{
+			Block: &BeaconBlockElectra{
+				Slot:       1,
+				ParentRoot: testBytes(32, 24),
+				StateRoot:  testBytes(32, 25),
+				Body: &BeaconBlockBodyElectra{
+					RandaoReveal:      testBytes(96, 14),
+					Eth1Data:          &ethpb.Eth1Data{DepositRoot: testBytes(32, 15), BlockHash: testBytes(32, 17)},
+					Graffiti:          testBytes(32, 18),
+					SyncAggregate:     &ethpb.SyncAggregate{SyncCommitteeBits: bitfield.NewBitvector512(), SyncCommitteeSignature: testBytes(96, 19)},
+					ExecutionPayload:  newTestExecutionPayloadDeneb(),
+					ExecutionRequests: &ExecutionRequests{},
+				},
+			},
+			Signature: testBytes(96, 26),
+		},
+		KzgProofs: proofs,
+		Blobs:     blobs,
+	}
+	root, err = electra.HashTreeRoot()
+	require.NoError(t, err)
+	assert.Equal(t, sszContainerRoot(t, electra.SignedBlock, sszBytesListRoot(proofs, 4096), sszBytesListRoot(blobs, 4096)), root)
+}
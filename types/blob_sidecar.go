@@ -0,0 +1,94 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/prysmaticlabs/prysm/v4/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// BlobSidecarSize is the size of the SSZ encoded blob sidecar
+const BlobSidecarSize = 131928
+
+const (
+	// kzgCommitmentInclusionProofDepth is the depth of the proof of a KZG commitment in the block body,
+	// 4 levels of the body fields, 1 level of the length mix in and 12 levels of the commitments list
+	kzgCommitmentInclusionProofDepth = 17
+	// blobKzgCommitmentsBodyIndex is the index of the KZG commitments in the block body, the same in Deneb and Electra
+	blobKzgCommitmentsBodyIndex = 11
+	// maxBlobCommitmentsPerBlock is the limit of the KZG commitments list of the block body
+	maxBlobCommitmentsPerBlock = 4096
+)
+
+// BlobSidecar is a blob of a block with its KZG commitment and proof. Starting from Deneb the blobs are gossiped
+// separately from the block, with the header of the block and the proof of the commitment in the block body
+type BlobSidecar struct {
+	Index                    uint64                         `json:"index,omitempty"`
+	Blob                     []byte                         `json:"blob,omitempty" ssz-size:"131072"`
+	KzgCommitment            []byte                         `json:"kzg_commitment,omitempty" ssz-size:"48"`
+	KzgProof                 []byte                         `json:"kzg_proof,omitempty" ssz-size:"48"`
+	SignedBlockHeader        *ethpb.SignedBeaconBlockHeader `json:"signed_block_header,omitempty"`
+	CommitmentInclusionProof [][]byte                       `json:"kzg_commitment_inclusion_proof,omitempty" ssz-size:"17,32"`
+}
+
+// Slot returns the slot of the block of the sidecar
+func (s *BlobSidecar) Slot() primitives.Slot {
+	return s.GetSignedBlockHeader().GetHeader().GetSlot()
+}
+
+// BlockRoot returns the hash tree root of the block header, which is the beacon block hash
+func (s *BlobSidecar) BlockRoot() ([32]byte, error) {
+	header := s.GetSignedBlockHeader().GetHeader()
+	if header == nil {
+		return [32]byte{}, fmt.Errorf("blob sidecar %v has no block header", s.Index)
+	}
+	return header.HashTreeRoot()
+}
+
+// VerifyInclusionProof verifies the KZG commitment is included in the body of the block of the sidecar.
+// The KZG proof of the blob is not verified
+func (s *BlobSidecar) VerifyInclusionProof() error {
+	if s.Index >= maxBlobCommitmentsPerBlock {
+		return fmt.Errorf("blob sidecar index %v exceeds the limit of %v", s.Index, maxBlobCommitmentsPerBlock)
+	}
+	if len(s.CommitmentInclusionProof) != kzgCommitmentInclusionProofDepth {
+		return fmt.Errorf("invalid inclusion proof depth %v", len(s.CommitmentInclusionProof))
+	}
+	bodyRoot := s.GetSignedBlockHeader().GetHeader().GetBodyRoot()
+	if len(bodyRoot) != 32 {
+		return fmt.Errorf("blob sidecar %v has no block body root", s.Index)
+	}
+
+	// The hash tree root of the commitment is the root of its two chunks
+	var chunks [64]byte
+	copy(chunks[:], s.KzgCommitment)
+	node := sha256.Sum256(chunks[:])
+
+	var pair [64]byte
+	index := uint64(blobKzgCommitmentsBodyIndex)<<(kzgCommitmentInclusionProofDepth-4) | s.Index
+	for i, sibling := range s.CommitmentInclusionProof {
+		if index>>i&1 == 1 {
+			copy(pair[:32], sibling)
+			copy(pair[32:], node[:])
+		} else {
+			copy(pair[:32], node[:])
+			copy(pair[32:], sibling)
+		}
+		node = sha256.Sum256(pair[:])
+	}
+
+	if !bytes.Equal(node[:], bodyRoot) {
+		return fmt.Errorf("invalid inclusion proof of blob sidecar %v", s.Index)
+	}
+	return nil
+}
+
+// GetSignedBlockHeader returns the signed header of the block of the sidecar
+func (s *BlobSidecar) GetSignedBlockHeader() *ethpb.SignedBeaconBlockHeader {
+	if s != nil {
+		return s.SignedBlockHeader
+	}
+	return nil
+}
@@ -0,0 +1,188 @@
+// Code generated by fastssz. DO NOT EDIT.
+// Hash: 2c6b373bebd000f12f424d909304d43ae2eeffbfc89c330718b5cd1884691810
+package types
+
+import (
+	ssz "github.com/prysmaticlabs/fastssz"
+	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
+)
+
+// MarshalSSZ ssz marshals the BlobSidecar object
+func (b *BlobSidecar) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(b)
+}
+
+// MarshalSSZTo ssz marshals the BlobSidecar object to a target array
+func (b *BlobSidecar) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+
+	// Field (0) 'Index'
+	dst = ssz.MarshalUint64(dst, b.Index)
+
+	// Field (1) 'Blob'
+	if size := len(b.Blob); size != 131072 {
+		err = ssz.ErrBytesLengthFn("--.Blob", size, 131072)
+		return
+	}
+	dst = append(dst, b.Blob...)
+
+	// Field (2) 'KzgCommitment'
+	if size := len(b.KzgCommitment); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgCommitment", size, 48)
+		return
+	}
+	dst = append(dst, b.KzgCommitment...)
+
+	// Field (3) 'KzgProof'
+	if size := len(b.KzgProof); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgProof", size, 48)
+		return
+	}
+	dst = append(dst, b.KzgProof...)
+
+	// Field (4) 'SignedBlockHeader'
+	if b.SignedBlockHeader == nil {
+		b.SignedBlockHeader = new(ethpb.SignedBeaconBlockHeader)
+	}
+	if dst, err = b.SignedBlockHeader.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (5) 'CommitmentInclusionProof'
+	if size := len(b.CommitmentInclusionProof); size != 17 {
+		err = ssz.ErrVectorLengthFn("--.CommitmentInclusionProof", size, 17)
+		return
+	}
+	for ii := 0; ii < 17; ii++ {
+		if size := len(b.CommitmentInclusionProof[ii]); size != 32 {
+			err = ssz.ErrBytesLengthFn("--.CommitmentInclusionProof[ii]", size, 32)
+			return
+		}
+		dst = append(dst, b.CommitmentInclusionProof[ii]...)
+	}
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the BlobSidecar object
+func (b *BlobSidecar) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size != 131928 {
+		return ssz.ErrSize
+	}
+
+	// Field (0) 'Index'
+	b.Index = ssz.UnmarshallUint64(buf[0:8])
+
+	// Field (1) 'Blob'
+	if cap(b.Blob) == 0 {
+		b.Blob = make([]byte, 0, len(buf[8:131080]))
+	}
+	b.Blob = append(b.Blob, buf[8:131080]...)
+
+	// Field (2) 'KzgCommitment'
+	if cap(b.KzgCommitment) == 0 {
+		b.KzgCommitment = make([]byte, 0, len(buf[131080:131128]))
+	}
+	b.KzgCommitment = append(b.KzgCommitment, buf[131080:131128]...)
+
+	// Field (3) 'KzgProof'
+	if cap(b.KzgProof) == 0 {
+		b.KzgProof = make([]byte, 0, len(buf[131128:131176]))
+	}
+	b.KzgProof = append(b.KzgProof, buf[131128:131176]...)
+
+	// Field (4) 'SignedBlockHeader'
+	if b.SignedBlockHeader == nil {
+		b.SignedBlockHeader = new(ethpb.SignedBeaconBlockHeader)
+	}
+	if err = b.SignedBlockHeader.UnmarshalSSZ(buf[131176:131384]); err != nil {
+		return err
+	}
+
+	// Field (5) 'CommitmentInclusionProof'
+	b.CommitmentInclusionProof = make([][]byte, 17)
+	for ii := 0; ii < 17; ii++ {
+		if cap(b.CommitmentInclusionProof[ii]) == 0 {
+			b.CommitmentInclusionProof[ii] = make([]byte, 0, len(buf[131384:131928][ii*32:(ii+1)*32]))
+		}
+		b.CommitmentInclusionProof[ii] = append(b.CommitmentInclusionProof[ii], buf[131384:131928][ii*32:(ii+1)*32]...)
+	}
+
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the BlobSidecar object
+func (b *BlobSidecar) SizeSSZ() (size int) {
+	size = 131928
+	return
+}
+
+// HashTreeRoot ssz hashes the BlobSidecar object
+func (b *BlobSidecar) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(b)
+}
+
+// HashTreeRootWith ssz hashes the BlobSidecar object with a hasher
+func (b *BlobSidecar) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Index'
+	hh.PutUint64(b.Index)
+
+	// Field (1) 'Blob'
+	if size := len(b.Blob); size != 131072 {
+		err = ssz.ErrBytesLengthFn("--.Blob", size, 131072)
+		return
+	}
+	hh.PutBytes(b.Blob)
+
+	// Field (2) 'KzgCommitment'
+	if size := len(b.KzgCommitment); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgCommitment", size, 48)
+		return
+	}
+	hh.PutBytes(b.KzgCommitment)
+
+	// Field (3) 'KzgProof'
+	if size := len(b.KzgProof); size != 48 {
+		err = ssz.ErrBytesLengthFn("--.KzgProof", size, 48)
+		return
+	}
+	hh.PutBytes(b.KzgProof)
+
+	// Field (4) 'SignedBlockHeader'
+	if err = b.SignedBlockHeader.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'CommitmentInclusionProof'
+	{
+		if size := len(b.CommitmentInclusionProof); size != 17 {
+			err = ssz.ErrVectorLengthFn("--.CommitmentInclusionProof", size, 17)
+			return
+		}
+		subIndx := hh.Index()
+		for _, i := range b.CommitmentInclusionProof {
+			if len(i) != 32 {
+				err = ssz.ErrBytesLength
+				return
+			}
+			hh.Append(i)
+		}
+
+		if ssz.EnableVectorizedHTR {
+			hh.MerkleizeVectorizedHTR(subIndx)
+		} else {
+			hh.Merkleize(subIndx)
+		}
+	}
+
+	if ssz.EnableVectorizedHTR {
+		hh.MerkleizeVectorizedHTR(indx)
+	} else {
+		hh.Merkleize(indx)
+	}
+	return
+}
@@ -0,0 +1,93 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// BlobSidecarNotification - represents a blobSidecars feed entry, the sidecars are keyed by the block root and the index
+type BlobSidecarNotification struct {
+	BlockRoot     string `json:"block_root,omitempty"`
+	Index         string `json:"index,omitempty"`
+	Slot          string `json:"slot,omitempty"`
+	ProposerIndex string `json:"proposer_index,omitempty"`
+	KzgCommitment string `json:"kzg_commitment,omitempty"`
+	KzgProof      string `json:"kzg_proof,omitempty"`
+	VersionedHash string `json:"versioned_hash,omitempty"`
+	Blob          string `json:"blob,omitempty"`
+
+	sidecar *BlobSidecar
+}
+
+// NewBlobSidecarNotification creates the notification of the blob sidecar, the blob is encoded only when it is included
+func NewBlobSidecarNotification(sidecar *BlobSidecar) (*BlobSidecarNotification, error) {
+	blockRoot, err := sidecar.BlockRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	header := sidecar.GetSignedBlockHeader().GetHeader()
+	return &BlobSidecarNotification{
+		BlockRoot:     hexutil.Encode(blockRoot[:]),
+		Index:         strconv.FormatUint(sidecar.Index, 10),
+		Slot:          strconv.FormatUint(uint64(header.GetSlot()), 10),
+		ProposerIndex: strconv.FormatUint(uint64(header.GetProposerIndex()), 10),
+		KzgCommitment: hexutil.Encode(sidecar.KzgCommitment),
+		KzgProof:      hexutil.Encode(sidecar.KzgProof),
+		VersionedHash: KZGToVersionedHash(sidecar.KzgCommitment).String(),
+		sidecar:       sidecar,
+	}, nil
+}
+
+// Sidecar returns the blob sidecar of the notification
+func (n *BlobSidecarNotification) Sidecar() *BlobSidecar {
+	return n.sidecar
+}
+
+// WithFields -
+func (n *BlobSidecarNotification) WithFields(fields []string) Notification {
+	blobSidecarNotification := BlobSidecarNotification{sidecar: n.sidecar}
+	for _, param := range fields {
+		switch param {
+		case "block_root":
+			blobSidecarNotification.BlockRoot = n.BlockRoot
+		case "index":
+			blobSidecarNotification.Index = n.Index
+		case "slot":
+			blobSidecarNotification.Slot = n.Slot
+		case "proposer_index":
+			blobSidecarNotification.ProposerIndex = n.ProposerIndex
+		case "kzg_commitment":
+			blobSidecarNotification.KzgCommitment = n.KzgCommitment
+		case "kzg_proof":
+			blobSidecarNotification.KzgProof = n.KzgProof
+		case "versioned_hash":
+			blobSidecarNotification.VersionedHash = n.VersionedHash
+		case "blob":
+			blobSidecarNotification.Blob = hexutil.Encode(n.sidecar.Blob)
+		}
+	}
+	return &blobSidecarNotification
+}
+
+// NotificationType - returns the feed name notification
+func (n *BlobSidecarNotification) NotificationType() FeedType {
+	return BlobSidecarsFeed
+}
+
+// Filters -
+func (n *BlobSidecarNotification) Filters(filters []string) map[string]interface{} {
+	return nil
+}
+
+// LocalRegion -
+func (n *BlobSidecarNotification) LocalRegion() bool {
+	return false
+}
+
+// GetHash - returns the block root and the index of the sidecar
+func (n *BlobSidecarNotification) GetHash() string {
+	return fmt.Sprintf("%v:%v", n.BlockRoot, n.Index)
+}
@@ -1,15 +1,19 @@
 package types
 
 import (
+	"bytes"
 	"encoding/hex"
 	"errors"
 	"fmt"
+	"math/big"
 
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
 	ethcommon "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/trie"
 	"github.com/prysmaticlabs/prysm/v4/consensus-types/interfaces"
+	"github.com/prysmaticlabs/prysm/v4/encoding/bytesutil"
 	ethpb "github.com/prysmaticlabs/prysm/v4/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v4/runtime/version"
 )
@@ -52,6 +56,233 @@ func NewBeaconBlockNotification(block interfaces.ReadOnlySignedBeaconBlock) (Blo
 	}
 }
 
+// NewNativeBeaconBlockNotification creates beacon block notification of the natively encoded beacon blocks
+func NewNativeBeaconBlockNotification(block BeaconBlock) (BlockNotification, error) {
+	hash, err := block.BlockRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	switch blk := block.(type) {
+	case *SignedBeaconBlockDeneb:
+		return &DenebBlockNotification{
+			Hash:                   hex.EncodeToString(hash[:]),
+			SignedBeaconBlockDeneb: blk,
+		}, nil
+	case *SignedBeaconBlockElectra:
+		return &ElectraBlockNotification{
+			Hash:                     hex.EncodeToString(hash[:]),
+			SignedBeaconBlockElectra: blk,
+		}, nil
+	default:
+		return nil, fmt.Errorf("not supported %s", BeaconVersionString(block.Version()))
+	}
+}
+
+// ElectraBlockNotification represents electra beacon block notification
+type ElectraBlockNotification struct {
+	*SignedBeaconBlockElectra
+
+	Hash string `json:"hash"`
+
+	notificationType FeedType      `json:"-"`
+	source           *NodeEndpoint `json:"-"`
+}
+
+// WithFields returns notification with specified fields
+func (beaconBlockNotification *ElectraBlockNotification) WithFields(fields []string) Notification {
+	block := ElectraBlockNotification{}
+	for _, param := range fields {
+		switch param {
+		case "hash":
+			block.Hash = beaconBlockNotification.Hash
+		case "header":
+			if block.SignedBeaconBlockElectra == nil {
+				block.SignedBeaconBlockElectra = &SignedBeaconBlockElectra{}
+			}
+
+			if block.Block == nil {
+				block.Block = &BeaconBlockElectra{}
+			}
+
+			block.Block.Slot = beaconBlockNotification.GetBlock().GetSlot()
+			block.Block.ProposerIndex = beaconBlockNotification.GetBlock().GetProposerIndex()
+			block.Block.ParentRoot = beaconBlockNotification.GetBlock().GetParentRoot()
+			block.Block.StateRoot = beaconBlockNotification.GetBlock().GetStateRoot()
+		case "slot":
+			if block.SignedBeaconBlockElectra == nil {
+				block.SignedBeaconBlockElectra = &SignedBeaconBlockElectra{}
+			}
+
+			if block.SignedBeaconBlockElectra.Block == nil {
+				block.Block = &BeaconBlockElectra{}
+			}
+
+			block.Block.Slot = beaconBlockNotification.GetBlock().GetSlot()
+		case "body":
+			if block.SignedBeaconBlockElectra == nil {
+				block.SignedBeaconBlockElectra = &SignedBeaconBlockElectra{}
+			}
+
+			if block.SignedBeaconBlockElectra.Block == nil {
+				block.Block = &BeaconBlockElectra{}
+			}
+
+			block.Block.Body = beaconBlockNotification.GetBlock().GetBody()
+		}
+	}
+
+	return &block
+}
+
+// Filters converts filters as field value map
+func (beaconBlockNotification *ElectraBlockNotification) Filters(filters []string) map[string]interface{} {
+	return nil
+}
+
+// LocalRegion -
+func (beaconBlockNotification *ElectraBlockNotification) LocalRegion() bool {
+	return false
+}
+
+// GetHash returns block hash
+func (beaconBlockNotification *ElectraBlockNotification) GetHash() string {
+	return beaconBlockNotification.Hash
+}
+
+// SetNotificationType - set feed name
+func (beaconBlockNotification *ElectraBlockNotification) SetNotificationType(feedName FeedType) {
+	beaconBlockNotification.notificationType = feedName
+}
+
+// NotificationType - feed name
+func (beaconBlockNotification *ElectraBlockNotification) NotificationType() FeedType {
+	return beaconBlockNotification.notificationType
+}
+
+// SetSource - source blockchain node endpoint
+func (beaconBlockNotification *ElectraBlockNotification) SetSource(source *NodeEndpoint) {
+	beaconBlockNotification.source = source
+}
+
+// Source - source blockchain node endpoint
+func (beaconBlockNotification *ElectraBlockNotification) Source() *NodeEndpoint {
+	return beaconBlockNotification.source
+}
+
+// IsNil returns true if nil
+func (beaconBlockNotification *ElectraBlockNotification) IsNil() bool {
+	return beaconBlockNotification == nil
+}
+
+// Clone clones notification
+func (beaconBlockNotification *ElectraBlockNotification) Clone() BlockNotification {
+	n := *beaconBlockNotification
+	return &n
+}
+
+// DenebBlockNotification represents deneb beacon block notification
+type DenebBlockNotification struct {
+	*SignedBeaconBlockDeneb
+
+	Hash string `json:"hash"`
+
+	notificationType FeedType      `json:"-"`
+	source           *NodeEndpoint `json:"-"`
+}
+
+// WithFields returns notification with specified fields
+func (beaconBlockNotification *DenebBlockNotification) WithFields(fields []string) Notification {
+	block := DenebBlockNotification{}
+	for _, param := range fields {
+		switch param {
+		case "hash":
+			block.Hash = beaconBlockNotification.Hash
+		case "header":
+			if block.SignedBeaconBlockDeneb == nil {
+				block.SignedBeaconBlockDeneb = &SignedBeaconBlockDeneb{}
+			}
+
+			if block.Block == nil {
+				block.Block = &BeaconBlockDeneb{}
+			}
+
+			block.Block.Slot = beaconBlockNotification.GetBlock().GetSlot()
+			block.Block.ProposerIndex = beaconBlockNotification.GetBlock().GetProposerIndex()
+			block.Block.ParentRoot = beaconBlockNotification.GetBlock().GetParentRoot()
+			block.Block.StateRoot = beaconBlockNotification.GetBlock().GetStateRoot()
+		case "slot":
+			if block.SignedBeaconBlockDeneb == nil {
+				block.SignedBeaconBlockDeneb = &SignedBeaconBlockDeneb{}
+			}
+
+			if block.SignedBeaconBlockDeneb.Block == nil {
+				block.Block = &BeaconBlockDeneb{}
+			}
+
+			block.Block.Slot = beaconBlockNotification.GetBlock().GetSlot()
+		case "body":
+			if block.SignedBeaconBlockDeneb == nil {
+				block.SignedBeaconBlockDeneb = &SignedBeaconBlockDeneb{}
+			}
+
+			if block.SignedBeaconBlockDeneb.Block == nil {
+				block.Block = &BeaconBlockDeneb{}
+			}
+
+			block.Block.Body = beaconBlockNotification.GetBlock().GetBody()
+		}
+	}
+
+	return &block
+}
+
+// Filters converts filters as field value map
+func (beaconBlockNotification *DenebBlockNotification) Filters(filters []string) map[string]interface{} {
+	return nil
+}
+
+// LocalRegion -
+func (beaconBlockNotification *DenebBlockNotification) LocalRegion() bool {
+	return false
+}
+
+// GetHash returns block hash
+func (beaconBlockNotification *DenebBlockNotification) GetHash() string {
+	return beaconBlockNotification.Hash
+}
+
+// SetNotificationType - set feed name
+func (beaconBlockNotification *DenebBlockNotification) SetNotificationType(feedName FeedType) {
+	beaconBlockNotification.notificationType = feedName
+}
+
+// NotificationType - feed name
+func (beaconBlockNotification *DenebBlockNotification) NotificationType() FeedType {
+	return beaconBlockNotification.notificationType
+}
+
+// SetSource - source blockchain node endpoint
+func (beaconBlockNotification *DenebBlockNotification) SetSource(source *NodeEndpoint) {
+	beaconBlockNotification.source = source
+}
+
+// Source - source blockchain node endpoint
+func (beaconBlockNotification *DenebBlockNotification) Source() *NodeEndpoint {
+	return beaconBlockNotification.source
+}
+
+// IsNil returns true if nil
+func (beaconBlockNotification *DenebBlockNotification) IsNil() bool {
+	return beaconBlockNotification == nil
+}
+
+// Clone clones notification
+func (beaconBlockNotification *DenebBlockNotification) Clone() BlockNotification {
+	n := *beaconBlockNotification
+	return &n
+}
+
 // CapellaBlockNotification represents capella beacon block notification
 type CapellaBlockNotification struct {
 	*ethpb.SignedBeaconBlockCapella
@@ -266,6 +497,7 @@ type EthBlockNotification struct {
 	rawTransactions  [][]byte
 	notificationType FeedType
 	source           *NodeEndpoint
+	sequence         uint64
 }
 
 // NewEthBlockNotification creates ETH block notification
@@ -320,6 +552,129 @@ func NewEthBlockNotification(hash ethcommon.Hash, block *ethtypes.Block, info []
 	}, nil
 }
 
+// NewEthBlockNotificationFromPayload creates ETH block notification of the execution payload of a beacon block of
+// the Deneb fork or later. The payload may include blob transactions which can not be decoded to an Ethereum block
+func NewEthBlockNotificationFromPayload(hash ethcommon.Hash, payload *ExecutionPayloadDeneb, info []*FutureValidatorInfo) (*EthBlockNotification, error) {
+	if hash == (ethcommon.Hash{}) {
+		return nil, errors.New("empty block hash")
+	}
+
+	if payload == nil {
+		return nil, errors.New("payload is empty")
+	}
+
+	rawTransactions := make([][]byte, 0, len(payload.Transactions))
+	ethTxs := make([]map[string]interface{}, 0, len(payload.Transactions))
+
+	for i, rawTx := range payload.Transactions {
+		ethTx, err := newEthTransactionFromBinary(rawTx)
+		if err != nil {
+			return nil, fmt.Errorf("invalid transaction %d: %v", i, err)
+		}
+
+		ethTxs = append(ethTxs, ethTx.Fields(AllFields))
+		rawTransactions = append(rawTransactions, rawTx)
+	}
+
+	withdrawals := make(ethtypes.Withdrawals, len(payload.Withdrawals))
+	for i, withdrawal := range payload.Withdrawals {
+		withdrawals[i] = &ethtypes.Withdrawal{
+			Index:     withdrawal.Index,
+			Validator: uint64(withdrawal.ValidatorIndex),
+			Address:   ethcommon.BytesToAddress(withdrawal.Address),
+			Amount:    withdrawal.Amount,
+		}
+	}
+	withdrawalsHash := ethtypes.DeriveSha(withdrawals, trie.NewStackTrie(nil))
+
+	header := ConvertEthHeaderToBlockNotificationHeader(&ethtypes.Header{
+		ParentHash:      ethcommon.BytesToHash(payload.ParentHash),
+		UncleHash:       ethtypes.EmptyUncleHash,
+		Coinbase:        ethcommon.BytesToAddress(payload.FeeRecipient),
+		Root:            ethcommon.BytesToHash(payload.StateRoot),
+		TxHash:          ethtypes.DeriveSha(binaryTransactions(payload.Transactions), trie.NewStackTrie(nil)),
+		ReceiptHash:     ethcommon.BytesToHash(payload.ReceiptsRoot),
+		Bloom:           ethtypes.BytesToBloom(payload.LogsBloom),
+		Difficulty:      ethcommon.Big0,
+		Number:          new(big.Int).SetUint64(payload.BlockNumber),
+		GasLimit:        payload.GasLimit,
+		GasUsed:         payload.GasUsed,
+		Time:            payload.Timestamp,
+		BaseFee:         new(big.Int).SetBytes(bytesutil.ReverseByteOrder(payload.BaseFeePerGas)),
+		Extra:           payload.ExtraData,
+		MixDigest:       ethcommon.BytesToHash(payload.PrevRandao),
+		WithdrawalsHash: &withdrawalsHash,
+	})
+	header.BlobGasUsed = hexutil.EncodeUint64(payload.BlobGasUsed)
+	header.ExcessBlobGas = hexutil.EncodeUint64(payload.ExcessBlobGas)
+
+	return &EthBlockNotification{
+		BlockHash:       &hash,
+		Header:          header,
+		Transactions:    ethTxs,
+		Uncles:          []Header{},
+		ValidatorInfo:   info,
+		rawTransactions: rawTransactions,
+	}, nil
+}
+
+// newEthTransactionFromBinary decodes the binary encoding of a transaction of an execution payload
+func newEthTransactionFromBinary(b []byte) (*EthTransaction, error) {
+	if IsBlobTx(b) {
+		blobTx, err := DecodeBlobTx(b)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash, err := NewSHA256Hash(blobTx.Hash().Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		// send EmptySender to cause extraction of real sender
+		return NewEthBlobTransaction(txHash, blobTx, EmptySender)
+	}
+	if IsSetCodeTx(b) {
+		setCodeTx, err := DecodeSetCodeTx(b)
+		if err != nil {
+			return nil, err
+		}
+
+		txHash, err := NewSHA256Hash(setCodeTx.Hash().Bytes())
+		if err != nil {
+			return nil, err
+		}
+
+		// send EmptySender to cause extraction of real sender
+		return NewEthSetCodeTransaction(txHash, setCodeTx, EmptySender)
+	}
+
+	tx := new(ethtypes.Transaction)
+	if err := tx.UnmarshalBinary(b); err != nil {
+		return nil, err
+	}
+
+	txHash, err := NewSHA256Hash(tx.Hash().Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	// send EmptySender to cause extraction of real sender
+	return NewEthTransaction(txHash, tx, EmptySender)
+}
+
+// binaryTransactions are the binary encoded transactions of an execution payload, which are the values
+// of the transactions trie
+type binaryTransactions [][]byte
+
+// Len returns the number of transactions
+func (txs binaryTransactions) Len() int { return len(txs) }
+
+// EncodeIndex writes the binary encoding of the transaction
+func (txs binaryTransactions) EncodeIndex(i int, w *bytes.Buffer) {
+	w.Write(txs[i])
+}
+
 // FutureValidatorInfo - represents information about the validator information of the second block after the current block
 type FutureValidatorInfo struct {
 	BlockHeight uint64 `json:"block_height"`
@@ -346,6 +701,8 @@ type Header struct {
 	Nonce            string             `json:"nonce"`
 	BaseFee          *int               `json:"baseFeePerGas,omitempty"`
 	WithdrawalsHash  *ethcommon.Hash    `json:"withdrawalsRoot,omitempty"`
+	BlobGasUsed      string             `json:"blobGasUsed,omitempty"`
+	ExcessBlobGas    string             `json:"excessBlobGas,omitempty"`
 	hexNumber        uint64
 }
 
@@ -384,7 +741,7 @@ func ConvertEthHeaderToBlockNotificationHeader(ethHeader *ethtypes.Header) *Head
 
 // WithFields returns notification with specified fields
 func (ethBlockNotification *EthBlockNotification) WithFields(fields []string) Notification {
-	block := EthBlockNotification{}
+	block := EthBlockNotification{sequence: ethBlockNotification.sequence}
 
 	for _, param := range fields {
 		switch param {
@@ -450,6 +807,16 @@ func (ethBlockNotification *EthBlockNotification) Clone() BlockNotification {
 	return &n
 }
 
+// SetSequence - set the position of the notification in the feed
+func (ethBlockNotification *EthBlockNotification) SetSequence(sequence uint64) {
+	ethBlockNotification.sequence = sequence
+}
+
+// Sequence - position of the notification in the feed
+func (ethBlockNotification *EthBlockNotification) Sequence() uint64 {
+	return ethBlockNotification.sequence
+}
+
 // GetRawTxByIndex return rawTransaction data by given index
 func (ethBlockNotification *EthBlockNotification) GetRawTxByIndex(index int) []byte {
 	if index > len(ethBlockNotification.rawTransactions) {
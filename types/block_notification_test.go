@@ -28,3 +28,25 @@ func TestCheckNonce(t *testing.T) {
 	nonce = fmt.Sprintf("0x%016s", hexutil.EncodeUint64(0)[2:])
 	assert.Equal(t, "0x0000000000000000", nonce)
 }
+
+func TestDenebBlockNotification_WithFields(t *testing.T) {
+	n := &DenebBlockNotification{
+		Hash: "hash",
+		SignedBeaconBlockDeneb: &SignedBeaconBlockDeneb{
+			Block: &BeaconBlockDeneb{
+				Slot:          10,
+				ProposerIndex: 2,
+				Body:          &BeaconBlockBodyDeneb{BlobKzgCommitments: [][]byte{make([]byte, 48)}},
+			},
+		},
+	}
+
+	slotOnly := n.WithFields([]string{"slot"}).(*DenebBlockNotification)
+	assert.Equal(t, "", slotOnly.Hash)
+	assert.Equal(t, n.GetBlock().GetSlot(), slotOnly.GetBlock().GetSlot())
+	assert.Nil(t, slotOnly.GetBlock().GetBody())
+
+	withBody := n.WithFields([]string{"hash", "body"}).(*DenebBlockNotification)
+	assert.Equal(t, "hash", withBody.Hash)
+	assert.Equal(t, n.BlobKzgCommitments(), withBody.BlobKzgCommitments())
+}
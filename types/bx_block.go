@@ -21,6 +21,8 @@ const (
 	BxBlockTypeBeaconAltair
 	BxBlockTypeBeaconBellatrix
 	BxBlockTypeBeaconCapella
+	BxBlockTypeBeaconDeneb
+	BxBlockTypeBeaconElectra
 )
 
 // String implements Stringer interface
@@ -36,6 +38,10 @@ func (t BxBlockType) String() string {
 		return "bellatrix"
 	case BxBlockTypeBeaconCapella:
 		return "capella"
+	case BxBlockTypeBeaconDeneb:
+		return "deneb"
+	case BxBlockTypeBeaconElectra:
+		return "electra"
 	default:
 		return ""
 	}
@@ -84,6 +90,9 @@ type BxBlock struct {
 	Number          *big.Int
 	timestamp       time.Time
 	size            int
+
+	// BlobSidecars are the blob sidecars of a beacon block, which are carried over the BDN with the block
+	BlobSidecars []*BlobSidecar
 }
 
 // NewBxBlock creates a new BxBlock that's ready for compression. This means that all transaction hashes must be included.
@@ -125,7 +134,7 @@ func (b BxBlock) String() string {
 // IsBeaconBlock returns true if block is beacon
 func (b *BxBlock) IsBeaconBlock() bool {
 	switch b.Type {
-	case BxBlockTypeBeaconPhase0, BxBlockTypeBeaconAltair, BxBlockTypeBeaconBellatrix, BxBlockTypeBeaconCapella:
+	case BxBlockTypeBeaconPhase0, BxBlockTypeBeaconAltair, BxBlockTypeBeaconBellatrix, BxBlockTypeBeaconCapella, BxBlockTypeBeaconDeneb, BxBlockTypeBeaconElectra:
 		return true
 	default:
 		return false
@@ -0,0 +1,371 @@
+package types
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// BlobTxType is the EIP-4844 blob transaction type, which is not supported by the go-ethereum transactions
+const BlobTxType = 0x03
+
+// blob transactions limits of EIP-4844
+const (
+	// BlobSize is the size of a blob
+	BlobSize = 131072
+	// KZGCommitmentSize is the size of the KZG commitment and the KZG proof of a blob
+	KZGCommitmentSize = 48
+	// MaxBlobsPerTx is the max number of blobs of a transaction
+	MaxBlobsPerTx = 6
+	// blobCommitmentVersionKZG is the version of the blob versioned hashes
+	blobCommitmentVersionKZG = 0x01
+)
+
+// ErrBlobTxSidecarMissing is returned if a blob transaction is not in its network form
+var ErrBlobTxSidecarMissing = errors.New("blob transaction without blobs")
+
+// BlobTxData is the payload of a blob transaction
+type BlobTxData struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	BlobFeeCap *big.Int
+	BlobHashes []common.Hash
+
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// BlobTxSidecar holds the blobs of a blob transaction, and their KZG commitments and proofs
+type BlobTxSidecar struct {
+	Blobs       [][]byte
+	Commitments [][]byte
+	Proofs      [][]byte
+}
+
+// blobTxWithSidecar is the network form of a blob transaction, which is gossiped with its sidecar
+type blobTxWithSidecar struct {
+	Tx          *BlobTxData
+	Blobs       [][]byte
+	Commitments [][]byte
+	Proofs      [][]byte
+}
+
+// blobTxSigningPayload is the payload signed by the sender of a blob transaction
+type blobTxSigningPayload struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	BlobFeeCap *big.Int
+	BlobHashes []common.Hash
+}
+
+// BlobTx is an EIP-4844 blob transaction with its sidecar, if it was received in its network form
+type BlobTx struct {
+	inner   *BlobTxData
+	sidecar *BlobTxSidecar
+	hash    common.Hash
+	size    int
+}
+
+// NewBlobTx returns the blob transaction of the payload and the sidecar, which can be nil
+func NewBlobTx(inner *BlobTxData, sidecar *BlobTxSidecar) (*BlobTx, error) {
+	canonical, err := encodeTyped(BlobTxType, inner)
+	if err != nil {
+		return nil, err
+	}
+	return &BlobTx{inner: inner, sidecar: sidecar, hash: crypto.Keccak256Hash(canonical)}, nil
+}
+
+// SignBlobTx signs the blob transaction payload with the private key
+func SignBlobTx(inner *BlobTxData, sidecar *BlobTxSidecar, privateKey *ecdsa.PrivateKey) (*BlobTx, error) {
+	signature, err := crypto.Sign(blobTxSigningHash(inner).Bytes(), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	inner.R = new(big.Int).SetBytes(signature[:32])
+	inner.S = new(big.Int).SetBytes(signature[32:64])
+	inner.V = new(big.Int).SetBytes([]byte{signature[64]})
+	return NewBlobTx(inner, sidecar)
+}
+
+// IsBlobTx returns true if the binary encoding is a blob transaction
+func IsBlobTx(b []byte) bool {
+	return len(b) > 0 && b[0] == BlobTxType
+}
+
+// IsBlobTxContent returns true if the BDN content of the transaction is a blob transaction
+func IsBlobTxContent(content TxContent) bool {
+	b, err := typedTxBytes(content, BlobTxType)
+	return err == nil && b != nil
+}
+
+// typedTxBytes returns the binary encoding of the typed transaction of the RLP content, nil if it is another type
+func typedTxBytes(content []byte, txType byte) ([]byte, error) {
+	kind, b, _, err := rlp.Split(content)
+	if err != nil {
+		return nil, err
+	}
+	if kind != rlp.String || len(b) == 0 || b[0] != txType {
+		return nil, nil
+	}
+	return b, nil
+}
+
+// DecodeBlobTx decodes the binary encoding of a blob transaction, in its canonical form or in its network form
+func DecodeBlobTx(b []byte) (*BlobTx, error) {
+	if !IsBlobTx(b) {
+		return nil, fmt.Errorf("not a blob transaction")
+	}
+
+	_, payload, _, err := rlp.Split(b[1:])
+	if err != nil {
+		return nil, fmt.Errorf("could not decode blob transaction: %v", err)
+	}
+	kind, _, _, err := rlp.Split(payload)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode blob transaction: %v", err)
+	}
+
+	// the first item of the network form is the transaction payload, the first item of the canonical form is the chain ID
+	if kind != rlp.List {
+		var inner BlobTxData
+		if err = rlp.DecodeBytes(b[1:], &inner); err != nil {
+			return nil, fmt.Errorf("could not decode blob transaction: %v", err)
+		}
+		tx, err := NewBlobTx(&inner, nil)
+		if err != nil {
+			return nil, err
+		}
+		tx.size = len(b)
+		return tx, nil
+	}
+
+	var networkTx blobTxWithSidecar
+	if err = rlp.DecodeBytes(b[1:], &networkTx); err != nil {
+		return nil, fmt.Errorf("could not decode blob transaction: %v", err)
+	}
+	sidecar := &BlobTxSidecar{Blobs: networkTx.Blobs, Commitments: networkTx.Commitments, Proofs: networkTx.Proofs}
+	if err = sidecar.Validate(networkTx.Tx.BlobHashes); err != nil {
+		return nil, err
+	}
+	tx, err := NewBlobTx(networkTx.Tx, sidecar)
+	if err != nil {
+		return nil, err
+	}
+	tx.size = len(b)
+	return tx, nil
+}
+
+// BlobTxFromContent decodes the blob transaction of the BDN content
+func BlobTxFromContent(content TxContent) (*BlobTx, error) {
+	b, err := typedTxBytes(content, BlobTxType)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, fmt.Errorf("not a blob transaction")
+	}
+	return DecodeBlobTx(b)
+}
+
+// Validate checks the sizes of the blobs, commitments and proofs, and that the commitments match the versioned hashes
+// of the transaction. The KZG proofs are verified by the nodes
+func (s *BlobTxSidecar) Validate(blobHashes []common.Hash) error {
+	if len(blobHashes) == 0 || len(blobHashes) > MaxBlobsPerTx {
+		return fmt.Errorf("invalid number of blobs %v", len(blobHashes))
+	}
+	if len(s.Blobs) != len(blobHashes) || len(s.Commitments) != len(blobHashes) || len(s.Proofs) != len(blobHashes) {
+		return fmt.Errorf("expected %v blobs, commitments and proofs, got %v, %v and %v", len(blobHashes), len(s.Blobs), len(s.Commitments), len(s.Proofs))
+	}
+	for i, blobHash := range blobHashes {
+		if len(s.Blobs[i]) != BlobSize {
+			return fmt.Errorf("invalid size %v of blob %v", len(s.Blobs[i]), i)
+		}
+		if len(s.Commitments[i]) != KZGCommitmentSize || len(s.Proofs[i]) != KZGCommitmentSize {
+			return fmt.Errorf("invalid size of commitment or proof %v", i)
+		}
+		if KZGToVersionedHash(s.Commitments[i]) != blobHash {
+			return fmt.Errorf("commitment %v does not match the versioned hash %v", i, blobHash)
+		}
+	}
+	return nil
+}
+
+// KZGToVersionedHash returns the versioned hash of the KZG commitment of a blob
+func KZGToVersionedHash(commitment []byte) common.Hash {
+	h := sha256.Sum256(commitment)
+	h[0] = blobCommitmentVersionKZG
+	return h
+}
+
+// Type returns the blob transaction type
+func (tx *BlobTx) Type() uint8 { return BlobTxType }
+
+// Hash returns the hash of the canonical form of the transaction
+func (tx *BlobTx) Hash() common.Hash { return tx.hash }
+
+// Nonce returns the nonce of the transaction
+func (tx *BlobTx) Nonce() uint64 { return tx.inner.Nonce }
+
+// Data returns the calldata of the transaction
+func (tx *BlobTx) Data() []byte { return tx.inner.Data }
+
+// Gas returns the gas limit of the transaction
+func (tx *BlobTx) Gas() uint64 { return tx.inner.Gas }
+
+// GasPrice returns the gas fee cap, as the go-ethereum dynamic fee transactions
+func (tx *BlobTx) GasPrice() *big.Int { return tx.inner.GasFeeCap }
+
+// GasFeeCap returns the max fee per gas of the transaction
+func (tx *BlobTx) GasFeeCap() *big.Int { return tx.inner.GasFeeCap }
+
+// GasTipCap returns the max priority fee per gas of the transaction
+func (tx *BlobTx) GasTipCap() *big.Int { return tx.inner.GasTipCap }
+
+// Value returns the amount of wei transferred by the transaction
+func (tx *BlobTx) Value() *big.Int { return tx.inner.Value }
+
+// To returns the recipient of the transaction, blob transactions cannot create contracts
+func (tx *BlobTx) To() *common.Address {
+	to := tx.inner.To
+	return &to
+}
+
+// AccessList returns the access list of the transaction
+func (tx *BlobTx) AccessList() ethtypes.AccessList { return tx.inner.AccessList }
+
+// ChainId returns the chain ID of the transaction
+func (tx *BlobTx) ChainId() *big.Int { return tx.inner.ChainID }
+
+// RawSignatureValues returns the signature values of the transaction
+func (tx *BlobTx) RawSignatureValues() (v, r, s *big.Int) {
+	return tx.inner.V, tx.inner.R, tx.inner.S
+}
+
+// BlobFeeCap returns the max fee per blob gas of the transaction
+func (tx *BlobTx) BlobFeeCap() *big.Int { return tx.inner.BlobFeeCap }
+
+// BlobHashes returns the versioned hashes of the blobs of the transaction
+func (tx *BlobTx) BlobHashes() []common.Hash { return tx.inner.BlobHashes }
+
+// Sidecar returns the blobs of the transaction, nil if it was received in its canonical form
+func (tx *BlobTx) Sidecar() *BlobTxSidecar { return tx.sidecar }
+
+// Size returns the size of the network form of the transaction, or of its canonical form if it has no sidecar
+func (tx *BlobTx) Size() int {
+	if tx.size == 0 {
+		var b []byte
+		if tx.sidecar != nil {
+			b, _ = tx.MarshalNetwork()
+		} else {
+			b, _ = tx.MarshalBinary()
+		}
+		tx.size = len(b)
+	}
+	return tx.size
+}
+
+// MarshalBinary returns the canonical form of the transaction, the form included in the blocks
+func (tx *BlobTx) MarshalBinary() ([]byte, error) {
+	return encodeTyped(BlobTxType, tx.inner)
+}
+
+// MarshalNetwork returns the network form of the transaction, with its sidecar
+func (tx *BlobTx) MarshalNetwork() ([]byte, error) {
+	if tx.sidecar == nil {
+		return nil, ErrBlobTxSidecarMissing
+	}
+	return encodeTyped(BlobTxType, &blobTxWithSidecar{
+		Tx:          tx.inner,
+		Blobs:       tx.sidecar.Blobs,
+		Commitments: tx.sidecar.Commitments,
+		Proofs:      tx.sidecar.Proofs,
+	})
+}
+
+// Content returns the BDN content of the transaction, the RLP string of its network form
+func (tx *BlobTx) Content() (TxContent, error) {
+	b, err := tx.MarshalNetwork()
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(b)
+}
+
+// Sender recovers the sender of the transaction from its signature
+func (tx *BlobTx) Sender() (common.Address, error) {
+	v, r, s := tx.RawSignatureValues()
+	return recoverSigner(blobTxSigningHash(tx.inner), v, r, s)
+}
+
+// recoverSigner recovers the address which signed the hash, v is the recovery ID of the typed transactions
+func recoverSigner(sigHash common.Hash, v, r, s *big.Int) (common.Address, error) {
+	if v == nil || r == nil || s == nil || v.BitLen() > 8 {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+	recovery := byte(v.Uint64())
+	if !crypto.ValidateSignatureValues(recovery, r, s, true) {
+		return common.Address{}, ethtypes.ErrInvalidSig
+	}
+
+	signature := make([]byte, crypto.SignatureLength)
+	r.FillBytes(signature[:32])
+	s.FillBytes(signature[32:64])
+	signature[64] = recovery
+	pubKey, err := crypto.Ecrecover(sigHash.Bytes(), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var sender common.Address
+	copy(sender[:], crypto.Keccak256(pubKey[1:])[12:])
+	return sender, nil
+}
+
+func blobTxSigningHash(inner *BlobTxData) common.Hash {
+	b, _ := encodeTyped(BlobTxType, &blobTxSigningPayload{
+		ChainID:    inner.ChainID,
+		Nonce:      inner.Nonce,
+		GasTipCap:  inner.GasTipCap,
+		GasFeeCap:  inner.GasFeeCap,
+		Gas:        inner.Gas,
+		To:         inner.To,
+		Value:      inner.Value,
+		Data:       inner.Data,
+		AccessList: inner.AccessList,
+		BlobFeeCap: inner.BlobFeeCap,
+		BlobHashes: inner.BlobHashes,
+	})
+	return crypto.Keccak256Hash(b)
+}
+
+// encodeTyped returns the EIP-2718 encoding of the typed transaction payload
+func encodeTyped(txType byte, payload interface{}) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte(txType)
+	if err := rlp.Encode(&buf, payload); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
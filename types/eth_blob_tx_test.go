@@ -0,0 +1,130 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestBlobTx(t *testing.T) *BlobTx {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	commitment := make([]byte, KZGCommitmentSize)
+	commitment[0] = 0xc0
+	blobTx, err := SignBlobTx(&BlobTxData{
+		ChainID:    big.NewInt(1),
+		Nonce:      7,
+		GasTipCap:  big.NewInt(2),
+		GasFeeCap:  big.NewInt(30),
+		Gas:        21000,
+		To:         common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		Value:      big.NewInt(1),
+		Data:       []byte{0x12, 0x34, 0x56, 0x78, 0x9a},
+		BlobFeeCap: big.NewInt(5),
+		BlobHashes: []common.Hash{KZGToVersionedHash(commitment)},
+	}, &BlobTxSidecar{
+		Blobs:       [][]byte{make([]byte, BlobSize)},
+		Commitments: [][]byte{commitment},
+		Proofs:      [][]byte{make([]byte, KZGCommitmentSize)},
+	}, privateKey)
+	require.NoError(t, err)
+
+	sender, err := blobTx.Sender()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), sender)
+	return blobTx
+}
+
+func TestBlobTx_Encoding(t *testing.T) {
+	blobTx := newTestBlobTx(t)
+
+	canonical, err := blobTx.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(canonical), blobTx.Hash())
+	network, err := blobTx.MarshalNetwork()
+	require.NoError(t, err)
+	assert.Equal(t, len(network), blobTx.Size())
+
+	// both forms have the same hash, only the network form has the blobs
+	decoded, err := DecodeBlobTx(canonical)
+	require.NoError(t, err)
+	assert.Equal(t, blobTx.Hash(), decoded.Hash())
+	assert.Nil(t, decoded.Sidecar())
+	_, err = decoded.MarshalNetwork()
+	assert.Equal(t, ErrBlobTxSidecarMissing, err)
+
+	decoded, err = DecodeBlobTx(network)
+	require.NoError(t, err)
+	assert.Equal(t, blobTx.Hash(), decoded.Hash())
+	assert.Equal(t, blobTx.Sidecar(), decoded.Sidecar())
+
+	content, err := blobTx.Content()
+	require.NoError(t, err)
+	assert.True(t, IsBlobTxContent(content))
+	decoded, err = BlobTxFromContent(content)
+	require.NoError(t, err)
+	assert.Equal(t, blobTx.Hash(), decoded.Hash())
+
+	legacyContent, err := rlp.EncodeToBytes([]interface{}{uint64(1)})
+	require.NoError(t, err)
+	assert.False(t, IsBlobTxContent(legacyContent))
+}
+
+func TestBlobTxSidecar_Validate(t *testing.T) {
+	blobTx := newTestBlobTx(t)
+	sidecar := blobTx.Sidecar()
+	assert.NoError(t, sidecar.Validate(blobTx.BlobHashes()))
+
+	assert.Error(t, sidecar.Validate([]common.Hash{{0x01}}))
+	assert.Error(t, sidecar.Validate(nil))
+	assert.Error(t, (&BlobTxSidecar{Blobs: sidecar.Blobs, Commitments: sidecar.Commitments}).Validate(blobTx.BlobHashes()))
+	assert.Error(t, (&BlobTxSidecar{Blobs: [][]byte{{0x01}}, Commitments: sidecar.Commitments, Proofs: sidecar.Proofs}).Validate(blobTx.BlobHashes()))
+}
+
+func TestBlobTx_EthTransaction(t *testing.T) {
+	blobTx := newTestBlobTx(t)
+	content, err := blobTx.Content()
+	require.NoError(t, err)
+
+	var hash SHA256Hash
+	copy(hash[:], blobTx.Hash().Bytes())
+	tx := NewBxTransaction(hash, testNetworkNum, TFPaidTx, time.Now())
+	tx.SetContent(content)
+	blockchainTx, err := tx.BlockchainTransaction(EmptySender)
+	require.NoError(t, err)
+	ethTx := blockchainTx.(*EthTransaction)
+
+	assert.Equal(t, uint8(BlobTxType), ethTx.Type())
+	assert.Equal(t, uint64(7), ethTx.Nonce)
+	assert.Equal(t, big.NewInt(30), ethTx.EffectiveGasFeeCap())
+	assert.Equal(t, big.NewInt(2), ethTx.EffectiveGasTipCap())
+	assert.Equal(t, big.NewInt(5), ethTx.BlobFeeCap())
+	assert.Equal(t, blobTx.BlobHashes(), ethTx.BlobHashes())
+
+	blobHash := strings.ToLower(blobTx.BlobHashes()[0].Hex())
+	fields := ethTx.Fields([]string{"tx_contents.max_fee_per_blob_gas", "tx_contents.blob_versioned_hashes", "tx_contents.max_fee_per_gas", "tx_contents.type"})
+	assert.Equal(t, "0x5", fields["maxFeePerBlobGas"])
+	assert.Equal(t, []string{blobHash}, fields["blobVersionedHashes"])
+	assert.Equal(t, "0x1e", fields["maxFeePerGas"])
+	assert.Equal(t, "0x3", fields["type"])
+
+	filters := ethTx.Filters(nil)
+	assert.Equal(t, float64(5), filters["max_fee_per_blob_gas"])
+	assert.Equal(t, []string{blobHash}, filters["blob_versioned_hashes"])
+
+	// the raw transaction of the feeds is the network form, the form accepted by eth_sendRawTransaction
+	network, err := blobTx.MarshalNetwork()
+	require.NoError(t, err)
+	rawTx, err := ethTx.rawTx()
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(network), hexutil.Encode(rawTx))
+}
@@ -0,0 +1,274 @@
+package types
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// SetCodeTxType is the EIP-7702 set-code transaction type, which is not supported by the go-ethereum transactions
+const SetCodeTxType = 0x04
+
+// setCodeAuthorizationMagic prefixes the signing payload of the authorizations of EIP-7702
+const setCodeAuthorizationMagic = 0x05
+
+// ErrEmptyAuthorizationList is returned if a set-code transaction has no authorizations
+var ErrEmptyAuthorizationList = errors.New("set-code transaction with empty authorization list")
+
+// SetCodeAuthorization is an authorization of a set-code transaction, the authority signing it delegates its code
+// to the address. The zero address clears the delegation of the authority
+type SetCodeAuthorization struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+	V       uint8
+	R       *big.Int
+	S       *big.Int
+}
+
+// setCodeAuthorizationPayload is the payload signed by the authority of an authorization
+type setCodeAuthorizationPayload struct {
+	ChainID *big.Int
+	Address common.Address
+	Nonce   uint64
+}
+
+// SetCodeTxData is the payload of a set-code transaction
+type SetCodeTxData struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []SetCodeAuthorization
+
+	V *big.Int
+	R *big.Int
+	S *big.Int
+}
+
+// setCodeTxSigningPayload is the payload signed by the sender of a set-code transaction
+type setCodeTxSigningPayload struct {
+	ChainID    *big.Int
+	Nonce      uint64
+	GasTipCap  *big.Int
+	GasFeeCap  *big.Int
+	Gas        uint64
+	To         common.Address
+	Value      *big.Int
+	Data       []byte
+	AccessList ethtypes.AccessList
+	AuthList   []SetCodeAuthorization
+}
+
+// SetCodeTx is an EIP-7702 set-code transaction
+type SetCodeTx struct {
+	inner *SetCodeTxData
+	hash  common.Hash
+	size  int
+}
+
+// NewSetCodeTx returns the set-code transaction of the payload
+func NewSetCodeTx(inner *SetCodeTxData) (*SetCodeTx, error) {
+	if len(inner.AuthList) == 0 {
+		return nil, ErrEmptyAuthorizationList
+	}
+	b, err := encodeTyped(SetCodeTxType, inner)
+	if err != nil {
+		return nil, err
+	}
+	return &SetCodeTx{inner: inner, hash: crypto.Keccak256Hash(b), size: len(b)}, nil
+}
+
+// SignSetCodeTx signs the set-code transaction payload with the private key
+func SignSetCodeTx(inner *SetCodeTxData, privateKey *ecdsa.PrivateKey) (*SetCodeTx, error) {
+	signature, err := crypto.Sign(setCodeTxSigningHash(inner).Bytes(), privateKey)
+	if err != nil {
+		return nil, err
+	}
+	inner.R = new(big.Int).SetBytes(signature[:32])
+	inner.S = new(big.Int).SetBytes(signature[32:64])
+	inner.V = new(big.Int).SetBytes([]byte{signature[64]})
+	return NewSetCodeTx(inner)
+}
+
+// SignSetCodeAuthorization signs the authorization with the private key of the authority
+func SignSetCodeAuthorization(auth SetCodeAuthorization, privateKey *ecdsa.PrivateKey) (SetCodeAuthorization, error) {
+	signature, err := crypto.Sign(auth.sigHash().Bytes(), privateKey)
+	if err != nil {
+		return auth, err
+	}
+	auth.R = new(big.Int).SetBytes(signature[:32])
+	auth.S = new(big.Int).SetBytes(signature[32:64])
+	auth.V = signature[64]
+	return auth, nil
+}
+
+// IsSetCodeTx returns true if the binary encoding is a set-code transaction
+func IsSetCodeTx(b []byte) bool {
+	return len(b) > 0 && b[0] == SetCodeTxType
+}
+
+// IsSetCodeTxContent returns true if the BDN content of the transaction is a set-code transaction
+func IsSetCodeTxContent(content TxContent) bool {
+	b, err := typedTxBytes(content, SetCodeTxType)
+	return err == nil && b != nil
+}
+
+// DecodeSetCodeTx decodes the binary encoding of a set-code transaction
+func DecodeSetCodeTx(b []byte) (*SetCodeTx, error) {
+	if !IsSetCodeTx(b) {
+		return nil, fmt.Errorf("not a set-code transaction")
+	}
+
+	var inner SetCodeTxData
+	if err := rlp.DecodeBytes(b[1:], &inner); err != nil {
+		return nil, fmt.Errorf("could not decode set-code transaction: %v", err)
+	}
+	return NewSetCodeTx(&inner)
+}
+
+// SetCodeTxFromContent decodes the set-code transaction of the BDN content
+func SetCodeTxFromContent(content TxContent) (*SetCodeTx, error) {
+	b, err := typedTxBytes(content, SetCodeTxType)
+	if err != nil {
+		return nil, err
+	}
+	if b == nil {
+		return nil, fmt.Errorf("not a set-code transaction")
+	}
+	return DecodeSetCodeTx(b)
+}
+
+// Authority recovers the account which signed the authorization
+func (a *SetCodeAuthorization) Authority() (common.Address, error) {
+	return recoverSigner(a.sigHash(), new(big.Int).SetUint64(uint64(a.V)), a.R, a.S)
+}
+
+func (a *SetCodeAuthorization) sigHash() common.Hash {
+	b, _ := encodeTyped(setCodeAuthorizationMagic, &setCodeAuthorizationPayload{
+		ChainID: a.ChainID,
+		Address: a.Address,
+		Nonce:   a.Nonce,
+	})
+	return crypto.Keccak256Hash(b)
+}
+
+// Type returns the set-code transaction type
+func (tx *SetCodeTx) Type() uint8 { return SetCodeTxType }
+
+// Hash returns the hash of the transaction
+func (tx *SetCodeTx) Hash() common.Hash { return tx.hash }
+
+// Nonce returns the nonce of the transaction
+func (tx *SetCodeTx) Nonce() uint64 { return tx.inner.Nonce }
+
+// Data returns the calldata of the transaction
+func (tx *SetCodeTx) Data() []byte { return tx.inner.Data }
+
+// Gas returns the gas limit of the transaction
+func (tx *SetCodeTx) Gas() uint64 { return tx.inner.Gas }
+
+// GasPrice returns the gas fee cap, as the go-ethereum dynamic fee transactions
+func (tx *SetCodeTx) GasPrice() *big.Int { return tx.inner.GasFeeCap }
+
+// GasFeeCap returns the max fee per gas of the transaction
+func (tx *SetCodeTx) GasFeeCap() *big.Int { return tx.inner.GasFeeCap }
+
+// GasTipCap returns the max priority fee per gas of the transaction
+func (tx *SetCodeTx) GasTipCap() *big.Int { return tx.inner.GasTipCap }
+
+// Value returns the amount of wei transferred by the transaction
+func (tx *SetCodeTx) Value() *big.Int { return tx.inner.Value }
+
+// To returns the recipient of the transaction, set-code transactions cannot create contracts
+func (tx *SetCodeTx) To() *common.Address {
+	to := tx.inner.To
+	return &to
+}
+
+// AccessList returns the access list of the transaction
+func (tx *SetCodeTx) AccessList() ethtypes.AccessList { return tx.inner.AccessList }
+
+// ChainId returns the chain ID of the transaction
+func (tx *SetCodeTx) ChainId() *big.Int { return tx.inner.ChainID }
+
+// RawSignatureValues returns the signature values of the transaction
+func (tx *SetCodeTx) RawSignatureValues() (v, r, s *big.Int) {
+	return tx.inner.V, tx.inner.R, tx.inner.S
+}
+
+// AuthList returns the authorizations of the transaction
+func (tx *SetCodeTx) AuthList() []SetCodeAuthorization { return tx.inner.AuthList }
+
+// Authorities returns the accounts which signed the authorizations of the transaction. The authorizations with an
+// invalid signature are skipped, as they are skipped by the execution
+func (tx *SetCodeTx) Authorities() []common.Address {
+	authorities := make([]common.Address, 0, len(tx.inner.AuthList))
+	for i := range tx.inner.AuthList {
+		authority, err := tx.inner.AuthList[i].Authority()
+		if err != nil {
+			continue
+		}
+		authorities = append(authorities, authority)
+	}
+	return authorities
+}
+
+// DelegatedAddresses returns the code addresses delegated to by the authorizations of the transaction
+func (tx *SetCodeTx) DelegatedAddresses() []common.Address {
+	addresses := make([]common.Address, 0, len(tx.inner.AuthList))
+	for _, auth := range tx.inner.AuthList {
+		addresses = append(addresses, auth.Address)
+	}
+	return addresses
+}
+
+// Size returns the size of the binary encoding of the transaction
+func (tx *SetCodeTx) Size() int { return tx.size }
+
+// MarshalBinary returns the binary encoding of the transaction
+func (tx *SetCodeTx) MarshalBinary() ([]byte, error) {
+	return encodeTyped(SetCodeTxType, tx.inner)
+}
+
+// Content returns the BDN content of the transaction, the RLP string of its binary encoding
+func (tx *SetCodeTx) Content() (TxContent, error) {
+	b, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return rlp.EncodeToBytes(b)
+}
+
+// Sender recovers the sender of the transaction from its signature
+func (tx *SetCodeTx) Sender() (common.Address, error) {
+	v, r, s := tx.RawSignatureValues()
+	return recoverSigner(setCodeTxSigningHash(tx.inner), v, r, s)
+}
+
+func setCodeTxSigningHash(inner *SetCodeTxData) common.Hash {
+	b, _ := encodeTyped(SetCodeTxType, &setCodeTxSigningPayload{
+		ChainID:    inner.ChainID,
+		Nonce:      inner.Nonce,
+		GasTipCap:  inner.GasTipCap,
+		GasFeeCap:  inner.GasFeeCap,
+		Gas:        inner.Gas,
+		To:         inner.To,
+		Value:      inner.Value,
+		Data:       inner.Data,
+		AccessList: inner.AccessList,
+		AuthList:   inner.AuthList,
+	})
+	return crypto.Keccak256Hash(b)
+}
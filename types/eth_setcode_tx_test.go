@@ -0,0 +1,145 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testCodeAddress = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+
+func newTestSetCodeTx(t *testing.T) (*SetCodeTx, common.Address) {
+	privateKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	authorityKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	auth, err := SignSetCodeAuthorization(SetCodeAuthorization{
+		ChainID: big.NewInt(1),
+		Address: testCodeAddress,
+		Nonce:   3,
+	}, authorityKey)
+	require.NoError(t, err)
+
+	setCodeTx, err := SignSetCodeTx(&SetCodeTxData{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(2),
+		GasFeeCap: big.NewInt(30),
+		Gas:       50000,
+		To:        common.HexToAddress("0x00000000000000000000000000000000000000aa"),
+		Value:     big.NewInt(1),
+		Data:      []byte{0x12, 0x34, 0x56, 0x78, 0x9a},
+		AuthList:  []SetCodeAuthorization{auth},
+	}, privateKey)
+	require.NoError(t, err)
+
+	sender, err := setCodeTx.Sender()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.PubkeyToAddress(privateKey.PublicKey), sender)
+	return setCodeTx, crypto.PubkeyToAddress(authorityKey.PublicKey)
+}
+
+func TestSetCodeTx_Encoding(t *testing.T) {
+	setCodeTx, authority := newTestSetCodeTx(t)
+	assert.Equal(t, []common.Address{authority}, setCodeTx.Authorities())
+	assert.Equal(t, []common.Address{testCodeAddress}, setCodeTx.DelegatedAddresses())
+
+	b, err := setCodeTx.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, crypto.Keccak256Hash(b), setCodeTx.Hash())
+	assert.Equal(t, len(b), setCodeTx.Size())
+
+	decoded, err := DecodeSetCodeTx(b)
+	require.NoError(t, err)
+	assert.Equal(t, setCodeTx.Hash(), decoded.Hash())
+	assert.Equal(t, setCodeTx.AuthList(), decoded.AuthList())
+
+	content, err := setCodeTx.Content()
+	require.NoError(t, err)
+	assert.True(t, IsSetCodeTxContent(content))
+	assert.False(t, IsBlobTxContent(content))
+	decoded, err = SetCodeTxFromContent(content)
+	require.NoError(t, err)
+	assert.Equal(t, setCodeTx.Hash(), decoded.Hash())
+
+	// the authorization list cannot be empty
+	_, err = NewSetCodeTx(&SetCodeTxData{ChainID: big.NewInt(1)})
+	assert.Equal(t, ErrEmptyAuthorizationList, err)
+
+	legacyContent, err := rlp.EncodeToBytes([]interface{}{uint64(1)})
+	require.NoError(t, err)
+	assert.False(t, IsSetCodeTxContent(legacyContent))
+}
+
+func TestSetCodeTx_InvalidAuthorization(t *testing.T) {
+	setCodeTx, _ := newTestSetCodeTx(t)
+	auth := setCodeTx.AuthList()[0]
+	auth.S = new(big.Int).Set(crypto.S256().Params().N)
+	_, err := auth.Authority()
+	assert.Error(t, err)
+
+	// the authorizations with an invalid signature have no authority
+	setCodeTx, err = NewSetCodeTx(&SetCodeTxData{
+		ChainID:   big.NewInt(1),
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(1),
+		Value:     big.NewInt(0),
+		AuthList:  []SetCodeAuthorization{auth},
+	})
+	require.NoError(t, err)
+	assert.Empty(t, setCodeTx.Authorities())
+	assert.Equal(t, []common.Address{testCodeAddress}, setCodeTx.DelegatedAddresses())
+}
+
+func TestSetCodeTx_EthTransaction(t *testing.T) {
+	setCodeTx, authority := newTestSetCodeTx(t)
+	content, err := setCodeTx.Content()
+	require.NoError(t, err)
+
+	var hash SHA256Hash
+	copy(hash[:], setCodeTx.Hash().Bytes())
+	tx := NewBxTransaction(hash, testNetworkNum, TFPaidTx, time.Now())
+	tx.SetContent(content)
+	blockchainTx, err := tx.BlockchainTransaction(EmptySender)
+	require.NoError(t, err)
+	ethTx := blockchainTx.(*EthTransaction)
+
+	assert.Equal(t, uint8(SetCodeTxType), ethTx.Type())
+	assert.True(t, ethTx.HasFeeCaps())
+	assert.Equal(t, big.NewInt(30), ethTx.EffectiveGasFeeCap())
+	assert.Equal(t, big.NewInt(2), ethTx.EffectiveGasTipCap())
+
+	auth := setCodeTx.AuthList()[0]
+	fields := ethTx.Fields([]string{"tx_contents.authorization_list", "tx_contents.type", "tx_contents.max_fee_per_gas"})
+	assert.Equal(t, []map[string]string{{
+		"chainId": "0x1",
+		"address": strings.ToLower(testCodeAddress.Hex()),
+		"nonce":   "0x3",
+		"yParity": hexutil.EncodeUint64(uint64(auth.V)),
+		"r":       hexutil.EncodeBig(auth.R),
+		"s":       hexutil.EncodeBig(auth.S),
+	}}, fields["authorizationList"])
+	assert.Equal(t, "0x4", fields["type"])
+	assert.Equal(t, "0x1e", fields["maxFeePerGas"])
+
+	filters := ethTx.Filters(nil)
+	assert.Equal(t, []string{strings.ToLower(authority.Hex())}, filters["authorities"])
+	assert.Equal(t, []string{strings.ToLower(testCodeAddress.Hex())}, filters["delegated_addresses"])
+
+	// the raw transaction of the feeds is the binary encoding, the form accepted by eth_sendRawTransaction
+	b, err := setCodeTx.MarshalBinary()
+	require.NoError(t, err)
+	rawTx, err := ethTx.rawTx()
+	require.NoError(t, err)
+	assert.Equal(t, b, rawTx)
+	assert.Equal(t, b, CreateNewTransactionNotification(tx).RawTx())
+}
@@ -15,9 +15,27 @@ import (
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
+// ethTxData is implemented by the go-ethereum transactions, and by the blob and set-code transactions
+type ethTxData interface {
+	Type() uint8
+	Hash() common.Hash
+	Nonce() uint64
+	Data() []byte
+	Gas() uint64
+	GasPrice() *big.Int
+	GasFeeCap() *big.Int
+	GasTipCap() *big.Int
+	Value() *big.Int
+	To() *common.Address
+	AccessList() ethtypes.AccessList
+	ChainId() *big.Int
+	RawSignatureValues() (v, r, s *big.Int)
+	MarshalBinary() ([]byte, error)
+}
+
 // EthTransaction represents the JSON encoding of an Ethereum transaction
 type EthTransaction struct {
-	tx        *ethtypes.Transaction
+	tx        ethTxData
 	GasFeeCap *big.Int
 	GasTipCap *big.Int
 	ChainID   *big.Int
@@ -36,27 +54,14 @@ var paramToName = map[string]string{
 	"gas_price":                "gasPrice",
 	"max_fee_per_gas":          "maxFeePerGas",
 	"max_priority_fee_per_gas": "maxPriorityFeePerGas",
+	"max_fee_per_blob_gas":     "maxFeePerBlobGas",
+	"blob_versioned_hashes":    "blobVersionedHashes",
+	"authorization_list":       "authorizationList",
 }
 
 // AllFields is used with blocks feeds
 var AllFields []string
 
-// EmptyFilteredTransactionMap is a map of key value used to check the filters provided by the websocket client
-var EmptyFilteredTransactionMap = map[string]interface{}{
-	"from":                     "0x0",
-	"gas_price":                float64(0),
-	"gas":                      float64(0),
-	"tx_hash":                  "0x0",
-	"input":                    "0x0",
-	"method_id":                "0x0",
-	"value":                    float64(0),
-	"to":                       "0x0",
-	"type":                     "0",
-	"chain_id":                 float64(0),
-	"max_fee_per_gas":          float64(0),
-	"max_priority_fee_per_gas": float64(0),
-}
-
 // NewEthTransaction converts a canonic Ethereum transaction to EthTransaction
 func NewEthTransaction(h SHA256Hash, rawEthTx *ethtypes.Transaction, sender Sender) (*EthTransaction, error) {
 	var (
@@ -71,22 +76,69 @@ func NewEthTransaction(h SHA256Hash, rawEthTx *ethtypes.Transaction, sender Send
 	} else {
 		ethSender.SetBytes(sender[:])
 	}
+	return newEthTransaction(rawEthTx, ethSender), nil
+}
+
+// NewEthBlobTransaction converts a blob transaction to EthTransaction
+func NewEthBlobTransaction(h SHA256Hash, blobTx *BlobTx, sender Sender) (*EthTransaction, error) {
+	var (
+		ethSender common.Address
+		err       error
+	)
+	if sender == EmptySender {
+		ethSender, err = blobTx.Sender()
+		if err != nil {
+			return nil, fmt.Errorf("could not parse Ethereum transaction sender: %v", err)
+		}
+	} else {
+		ethSender.SetBytes(sender[:])
+	}
+	return newEthTransaction(blobTx, ethSender), nil
+}
+
+// NewEthSetCodeTransaction converts a set-code transaction to EthTransaction
+func NewEthSetCodeTransaction(h SHA256Hash, setCodeTx *SetCodeTx, sender Sender) (*EthTransaction, error) {
+	var (
+		ethSender common.Address
+		err       error
+	)
+	if sender == EmptySender {
+		ethSender, err = setCodeTx.Sender()
+		if err != nil {
+			return nil, fmt.Errorf("could not parse Ethereum transaction sender: %v", err)
+		}
+	} else {
+		ethSender.SetBytes(sender[:])
+	}
+	return newEthTransaction(setCodeTx, ethSender), nil
+}
+
+func newEthTransaction(rawEthTx ethTxData, sender common.Address) *EthTransaction {
 	ethTx := &EthTransaction{
 		tx:      rawEthTx,
-		From:    &ethSender,
+		From:    &sender,
 		Nonce:   rawEthTx.Nonce(),
 		ChainID: rawEthTx.ChainId(),
 		lock:    &sync.Mutex{},
 	}
-	switch rawEthTx.Type() {
-	case ethtypes.DynamicFeeTxType:
+	if hasFeeCaps(rawEthTx.Type()) {
 		ethTx.GasFeeCap = rawEthTx.GasFeeCap()
 		ethTx.GasTipCap = rawEthTx.GasTipCap()
-	default:
+	} else {
 		ethTx.GasFeeCap = rawEthTx.GasPrice()
 		ethTx.GasTipCap = rawEthTx.GasPrice()
 	}
-	return ethTx, nil
+	return ethTx
+}
+
+// hasFeeCaps returns true if the transactions of the type have a max fee and a max priority fee instead of a gas price
+func hasFeeCaps(txType uint8) bool {
+	return txType == ethtypes.DynamicFeeTxType || txType == BlobTxType || txType == SetCodeTxType
+}
+
+// HasFeeCaps returns true if the transaction has a max fee and a max priority fee instead of a gas price
+func (et *EthTransaction) HasFeeCaps() bool {
+	return hasFeeCaps(et.tx.Type())
 }
 
 // Type provides the transaction type
@@ -107,11 +159,68 @@ func (et *EthTransaction) Hash() SHA256Hash {
 	return hash
 }
 
+// Input returns the transaction calldata
+func (et *EthTransaction) Input() []byte {
+	return et.tx.Data()
+}
+
+// Gas returns the gas limit of the transaction
+func (et *EthTransaction) Gas() uint64 {
+	return et.tx.Gas()
+}
+
+// GasPrice returns the gas price of the transaction
+func (et *EthTransaction) GasPrice() *big.Int {
+	return et.tx.GasPrice()
+}
+
+// Value returns the amount of wei transferred by the transaction
+func (et *EthTransaction) Value() *big.Int {
+	return et.tx.Value()
+}
+
+// To returns the recipient of the transaction, nil for contract creation
+func (et *EthTransaction) To() *common.Address {
+	return et.tx.To()
+}
+
 // AccessList returns access list
 func (et *EthTransaction) AccessList() ethtypes.AccessList {
 	return et.tx.AccessList()
 }
 
+// BlobFeeCap returns the max fee per blob gas of a blob transaction, nil for the other types
+func (et *EthTransaction) BlobFeeCap() *big.Int {
+	if blobTx, ok := et.tx.(*BlobTx); ok {
+		return blobTx.BlobFeeCap()
+	}
+	return nil
+}
+
+// BlobHashes returns the versioned hashes of the blobs of a blob transaction, nil for the other types
+func (et *EthTransaction) BlobHashes() []common.Hash {
+	if blobTx, ok := et.tx.(*BlobTx); ok {
+		return blobTx.BlobHashes()
+	}
+	return nil
+}
+
+// Authorities returns the accounts which signed the authorizations of a set-code transaction, nil for the other types
+func (et *EthTransaction) Authorities() []common.Address {
+	if setCodeTx, ok := et.tx.(*SetCodeTx); ok {
+		return setCodeTx.Authorities()
+	}
+	return nil
+}
+
+// DelegatedAddresses returns the code addresses delegated to by a set-code transaction, nil for the other types
+func (et *EthTransaction) DelegatedAddresses() []common.Address {
+	if setCodeTx, ok := et.tx.(*SetCodeTx); ok {
+		return setCodeTx.DelegatedAddresses()
+	}
+	return nil
+}
+
 func (et *EthTransaction) createFields() {
 	et.lock.Lock()
 	defer et.lock.Unlock()
@@ -140,7 +249,7 @@ func (et *EthTransaction) createFields() {
 		fields["chainId"] = strings.ToLower(hexutil.EncodeUint64(tx.ChainId().Uint64()))
 	}
 
-	if tx.Type() == ethtypes.DynamicFeeTxType {
+	if hasFeeCaps(tx.Type()) {
 		transactionFilters["max_fee_per_gas"] = int(tx.GasFeeCap().Int64())
 		fields["maxFeePerGas"] = hexutil.EncodeBig(tx.GasFeeCap())
 		transactionFilters["max_priority_fee_per_gas"] = int(tx.GasTipCap().Int64())
@@ -152,6 +261,34 @@ func (et *EthTransaction) createFields() {
 		fields["gasPrice"] = hexutil.EncodeBig(tx.GasPrice())
 	}
 
+	if blobTx, ok := tx.(*BlobTx); ok {
+		transactionFilters["max_fee_per_blob_gas"] = BigIntAsFloat64(blobTx.BlobFeeCap())
+		fields["maxFeePerBlobGas"] = hexutil.EncodeBig(blobTx.BlobFeeCap())
+		blobHashes := make([]string, 0, len(blobTx.BlobHashes()))
+		for _, blobHash := range blobTx.BlobHashes() {
+			blobHashes = append(blobHashes, strings.ToLower(blobHash.Hex()))
+		}
+		transactionFilters["blob_versioned_hashes"] = blobHashes
+		fields["blobVersionedHashes"] = blobHashes
+	}
+
+	if setCodeTx, ok := tx.(*SetCodeTx); ok {
+		authList := make([]map[string]string, 0, len(setCodeTx.AuthList()))
+		for _, auth := range setCodeTx.AuthList() {
+			authList = append(authList, map[string]string{
+				"chainId": BigIntAsString(auth.ChainID),
+				"address": AddressAsString(&auth.Address),
+				"nonce":   hexutil.EncodeUint64(auth.Nonce),
+				"yParity": hexutil.EncodeUint64(uint64(auth.V)),
+				"r":       BigIntAsString(auth.R),
+				"s":       BigIntAsString(auth.S),
+			})
+		}
+		fields["authorizationList"] = authList
+		transactionFilters["authorities"] = addressesAsStrings(setCodeTx.Authorities())
+		transactionFilters["delegated_addresses"] = addressesAsStrings(setCodeTx.DelegatedAddresses())
+	}
+
 	transactionFilters["type"] = strconv.Itoa(int(tx.Type()))
 	fields["type"] = hexutil.EncodeUint64(uint64(tx.Type()))
 
@@ -187,6 +324,21 @@ func (et *EthTransaction) createFields() {
 
 // EthTransactionFromBytes parses and constructs an Ethereum transaction from bytes
 func ethTransactionFromBytes(h SHA256Hash, tc TxContent, sender Sender) (*EthTransaction, error) {
+	if IsBlobTxContent(tc) {
+		blobTx, err := BlobTxFromContent(tc)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode Ethereum transaction: %v", err)
+		}
+		return NewEthBlobTransaction(h, blobTx, sender)
+	}
+	if IsSetCodeTxContent(tc) {
+		setCodeTx, err := SetCodeTxFromContent(tc)
+		if err != nil {
+			return nil, fmt.Errorf("could not decode Ethereum transaction: %v", err)
+		}
+		return NewEthSetCodeTransaction(h, setCodeTx, sender)
+	}
+
 	var rawEthTx ethtypes.Transaction
 
 	err := rlp.DecodeBytes(tc, &rawEthTx)
@@ -239,7 +391,12 @@ func (et *EthTransaction) Fields(fields []string) map[string]interface{} {
 	return transactionContent
 }
 
+// rawTx returns the binary encoding of the transaction, the blob transactions are in their network form if they
+// have their sidecar
 func (et *EthTransaction) rawTx() ([]byte, error) {
+	if blobTx, ok := et.tx.(*BlobTx); ok && blobTx.Sidecar() != nil {
+		return blobTx.MarshalNetwork()
+	}
 	return et.tx.MarshalBinary()
 }
 
@@ -251,6 +408,14 @@ func AddressAsString(addr *common.Address) string {
 	return strings.ToLower(addr.Hex())
 }
 
+func addressesAsStrings(addresses []common.Address) []string {
+	strs := make([]string, 0, len(addresses))
+	for i := range addresses {
+		strs = append(strs, AddressAsString(&addresses[i]))
+	}
+	return strs
+}
+
 // BigIntAsFloat64 converts BigInt to float64
 func BigIntAsFloat64(bigint *big.Int) float64 {
 	floatValue, _ := new(big.Float).SetInt(bigint).Float64()
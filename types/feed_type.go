@@ -11,7 +11,9 @@ const (
 	NewBlocksFeed         FeedType = "newBlocks"
 	OnBlockFeed           FeedType = "ethOnBlock"
 	TxReceiptsFeed        FeedType = "txReceipts"
+	LogsFeed              FeedType = "logs"
 	TransactionStatusFeed FeedType = "transactionStatus"
+	MempoolEventsFeed     FeedType = "mempoolEvents"
 )
 
 // FeedConnectionType types of feeds
@@ -21,19 +23,34 @@ type FeedConnectionType string
 const (
 	WebSocketFeed FeedConnectionType = "ws"
 	GRPCFeed      FeedConnectionType = "grpc"
+	SSEFeed       FeedConnectionType = "sse"
+	RecorderFeed  FeedConnectionType = "recorder"
 )
 
 // Beacon blocks
 const (
 	NewBeaconBlocksFeed FeedType = "newBeaconBlocks"
 	BDNBeaconBlocksFeed FeedType = "bdnBeaconBlocks"
+	BlobSidecarsFeed    FeedType = "blobSidecars"
 )
 
+// ResumableFeeds are the feeds keeping recent notifications, so a client can resubscribe from the last sequence it received
+var ResumableFeeds = []FeedType{NewTxsFeed, NewBlocksFeed, TxReceiptsFeed}
+
 // RPCStreamToFeedType maps gRPC stream to feed type
 var RPCStreamToFeedType = map[string]FeedType{
-	"/gateway.Gateway/NewTxs":    NewTxsFeed,
-	"/gateway.Gateway/NewBlocks": NewBlocksFeed,
-	"/gateway.Gateway/BdnBlocks": BDNBlocksFeed,
+	"/gateway.Gateway/NewTxs":            NewTxsFeed,
+	"/gateway.Gateway/PendingTxs":        PendingTxsFeed,
+	"/gateway.Gateway/NewBlocks":         NewBlocksFeed,
+	"/gateway.Gateway/BdnBlocks":         BDNBlocksFeed,
+	"/gateway.Gateway/EthOnBlock":        OnBlockFeed,
+	"/gateway.Gateway/TxReceipts":        TxReceiptsFeed,
+	"/gateway.Gateway/Logs":              LogsFeed,
+	"/gateway.Gateway/TransactionStatus": TransactionStatusFeed,
+	"/gateway.Gateway/MempoolEvents":     MempoolEventsFeed,
+	"/gateway.Gateway/NewBeaconBlocks":   NewBeaconBlocksFeed,
+	"/gateway.Gateway/BdnBeaconBlocks":   BDNBeaconBlocksFeed,
+	"/gateway.Gateway/BlobSidecars":      BlobSidecarsFeed,
 }
 
 // Exists - checks if a field exists in feedType list
@@ -0,0 +1,152 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// LogNotification - represents a logs feed entry, a log emitted by a transaction of a block
+type LogNotification struct {
+	Log      ethLog
+	sequence uint64
+}
+
+type ethLog struct {
+	Address          string   `json:"address,omitempty"`
+	Topics           []string `json:"topics,omitempty"`
+	Data             string   `json:"data,omitempty"`
+	BlockNumber      string   `json:"block_number,omitempty"`
+	TransactionHash  string   `json:"transaction_hash,omitempty"`
+	TransactionIndex string   `json:"transaction_index,omitempty"`
+	BlockHash        string   `json:"block_hash,omitempty"`
+	LogIndex         string   `json:"log_index,omitempty"`
+	Removed          *bool    `json:"removed,omitempty"`
+}
+
+// NewLogNotification returns a new LogNotification from a log of a transaction receipt fetched from the node
+func NewLogNotification(receiptLog map[string]interface{}) (*LogNotification, error) {
+	logNotification := LogNotification{}
+	for _, field := range []struct {
+		name  string
+		value *string
+	}{
+		{"address", &logNotification.Log.Address},
+		{"data", &logNotification.Log.Data},
+		{"blockNumber", &logNotification.Log.BlockNumber},
+		{"transactionHash", &logNotification.Log.TransactionHash},
+		{"transactionIndex", &logNotification.Log.TransactionIndex},
+		{"blockHash", &logNotification.Log.BlockHash},
+		{"logIndex", &logNotification.Log.LogIndex},
+	} {
+		value, ok := receiptLog[field.name].(string)
+		if !ok {
+			return nil, fmt.Errorf("log field %v is missing", field.name)
+		}
+		*field.value = value
+	}
+	if _, err := hexutil.DecodeUint64(logNotification.Log.LogIndex); err != nil {
+		return nil, fmt.Errorf("invalid log index %v: %v", logNotification.Log.LogIndex, err)
+	}
+
+	topics, _ := receiptLog["topics"].([]interface{})
+	logNotification.Log.Topics = make([]string, 0, len(topics))
+	for _, topic := range topics {
+		topicStr, ok := topic.(string)
+		if !ok {
+			return nil, fmt.Errorf("log topic %v is not a string", topic)
+		}
+		logNotification.Log.Topics = append(logNotification.Log.Topics, topicStr)
+	}
+
+	removed, _ := receiptLog["removed"].(bool)
+	logNotification.Log.Removed = &removed
+
+	return &logNotification, nil
+}
+
+// Removed returns a copy of the log marked as removed from the chain by a reorg
+func (l *LogNotification) Removed() *LogNotification {
+	removed := true
+	logNotification := *l
+	logNotification.Log.Removed = &removed
+	return &logNotification
+}
+
+// IsRemoved returns true if the log was removed from the chain by a reorg
+func (l *LogNotification) IsRemoved() bool {
+	return l.Log.Removed != nil && *l.Log.Removed
+}
+
+// EthFormat returns the log in the format of the eth_subscribe logs notifications
+func (l *LogNotification) EthFormat() map[string]interface{} {
+	return map[string]interface{}{
+		"address":          l.Log.Address,
+		"topics":           l.Log.Topics,
+		"data":             l.Log.Data,
+		"blockNumber":      l.Log.BlockNumber,
+		"transactionHash":  l.Log.TransactionHash,
+		"transactionIndex": l.Log.TransactionIndex,
+		"blockHash":        l.Log.BlockHash,
+		"logIndex":         l.Log.LogIndex,
+		"removed":          l.IsRemoved(),
+	}
+}
+
+// WithFields -
+func (l *LogNotification) WithFields(fields []string) Notification {
+	logNotification := LogNotification{sequence: l.sequence}
+	for _, param := range fields {
+		switch param {
+		case "address":
+			logNotification.Log.Address = l.Log.Address
+		case "topics":
+			logNotification.Log.Topics = l.Log.Topics
+		case "data":
+			logNotification.Log.Data = l.Log.Data
+		case "block_number":
+			logNotification.Log.BlockNumber = l.Log.BlockNumber
+		case "transaction_hash":
+			logNotification.Log.TransactionHash = l.Log.TransactionHash
+		case "transaction_index":
+			logNotification.Log.TransactionIndex = l.Log.TransactionIndex
+		case "block_hash":
+			logNotification.Log.BlockHash = l.Log.BlockHash
+		case "log_index":
+			logNotification.Log.LogIndex = l.Log.LogIndex
+		case "removed":
+			logNotification.Log.Removed = l.Log.Removed
+		}
+	}
+	return &logNotification
+}
+
+// Filters -
+func (l *LogNotification) Filters(filters []string) map[string]interface{} {
+	return nil
+}
+
+// LocalRegion -
+func (l *LogNotification) LocalRegion() bool {
+	return false
+}
+
+// GetHash -
+func (l *LogNotification) GetHash() string {
+	return l.Log.BlockHash
+}
+
+// NotificationType - feed name
+func (l *LogNotification) NotificationType() FeedType {
+	return LogsFeed
+}
+
+// SetSequence - set the position of the block the log belongs to in the feed
+func (l *LogNotification) SetSequence(sequence uint64) {
+	l.sequence = sequence
+}
+
+// Sequence - position of the block the log belongs to in the feed
+func (l *LogNotification) Sequence() uint64 {
+	return l.sequence
+}
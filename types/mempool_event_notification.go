@@ -0,0 +1,63 @@
+package types
+
+// MempoolEvent is a change in the mempool of a pending transaction
+type MempoolEvent string
+
+// MempoolEvent enumeration
+const (
+	// MempoolEventReplaced - the transaction was replaced by a transaction with the same sender and nonce
+	MempoolEventReplaced MempoolEvent = "replaced"
+	// MempoolEventConfirmed - the transaction was included in a block
+	MempoolEventConfirmed MempoolEvent = "confirmed"
+	// MempoolEventDropped - the transaction was evicted from the TxStore without being included in a block
+	MempoolEventDropped MempoolEvent = "dropped"
+)
+
+// MempoolEventNotification - represents a mempoolEvents feed entry
+type MempoolEventNotification struct {
+	Event           MempoolEvent `json:"event,omitempty"`
+	TransactionHash string       `json:"transaction_hash,omitempty"`
+	ReplacedBy      string       `json:"replaced_by,omitempty"`
+	BlockHeight     uint64       `json:"block_height,omitempty"`
+	BlockHash       string       `json:"block_hash,omitempty"`
+}
+
+// WithFields -
+func (n *MempoolEventNotification) WithFields(fields []string) Notification {
+	mempoolEventNotification := MempoolEventNotification{}
+	for _, param := range fields {
+		switch param {
+		case "event":
+			mempoolEventNotification.Event = n.Event
+		case "transaction_hash":
+			mempoolEventNotification.TransactionHash = n.TransactionHash
+		case "replaced_by":
+			mempoolEventNotification.ReplacedBy = n.ReplacedBy
+		case "block_height":
+			mempoolEventNotification.BlockHeight = n.BlockHeight
+		case "block_hash":
+			mempoolEventNotification.BlockHash = n.BlockHash
+		}
+	}
+	return &mempoolEventNotification
+}
+
+// NotificationType - returns the feed name notification
+func (n *MempoolEventNotification) NotificationType() FeedType {
+	return MempoolEventsFeed
+}
+
+// Filters -
+func (n *MempoolEventNotification) Filters(filters []string) map[string]interface{} {
+	return nil
+}
+
+// LocalRegion -
+func (n *MempoolEventNotification) LocalRegion() bool {
+	return false
+}
+
+// GetHash -
+func (n *MempoolEventNotification) GetHash() string {
+	return n.TransactionHash
+}
@@ -24,6 +24,7 @@ type NewTransactionNotification struct {
 	*BxTransaction
 	BlockchainTransaction
 	validationStatus TxValidationStatus
+	sequence         uint64
 	// lock is used to prevent parallel extract of sender address
 	// while not locking the other unrelated go routines.
 	lock *sync.Mutex
@@ -35,6 +36,7 @@ func CreateNewTransactionNotification(bxTx *BxTransaction) *NewTransactionNotifi
 		bxTx,
 		nil,
 		TxPendingValidation,
+		0,
 		&sync.Mutex{},
 	}
 }
@@ -80,6 +82,16 @@ func (newTransactionNotification *NewTransactionNotification) Fields(fields []st
 	return ethTx.Fields(fields)
 }
 
+// Input - creates BlockchainTransaction if needs and returns the calldata of the transaction
+func (newTransactionNotification *NewTransactionNotification) Input() []byte {
+	err := newTransactionNotification.MakeBlockchainTransaction()
+	if err != nil {
+		return nil
+	}
+	ethTx := newTransactionNotification.BlockchainTransaction.(*EthTransaction)
+	return ethTx.Input()
+}
+
 // WithFields - creates BlockchainTransaction if needs and returns the value of requested fields of the transaction
 func (newTransactionNotification *NewTransactionNotification) WithFields(fields []string) Notification {
 	return nil
@@ -99,6 +111,32 @@ func (newTransactionNotification *NewTransactionNotification) GetHash() string {
 // the tx bytes returned can be used directly to submit to RPC endpoint
 // rlp.DecodeBytes is used for the wire protocol, while `MarshalBinary`/`UnmarshalBinary` is used for RPC interface
 func (newTransactionNotification *NewTransactionNotification) RawTx() []byte {
+	if IsBlobTxContent(newTransactionNotification.BxTransaction.content) {
+		// the blob transactions are sent with their sidecar, the form accepted by eth_sendRawTransaction
+		blobTx, err := BlobTxFromContent(newTransactionNotification.BxTransaction.content)
+		if err != nil {
+			log.Infof("invalid blob tx content with hash %v. error %v", newTransactionNotification.BxTransaction.Hash(), err)
+			return nil
+		}
+		marshalledTxBytes, err := blobTx.MarshalNetwork()
+		if err != nil {
+			log.Infof("invalid raw blob tx %v error %v", newTransactionNotification.BxTransaction.Hash(), err)
+		}
+		return marshalledTxBytes
+	}
+	if IsSetCodeTxContent(newTransactionNotification.BxTransaction.content) {
+		setCodeTx, err := SetCodeTxFromContent(newTransactionNotification.BxTransaction.content)
+		if err != nil {
+			log.Infof("invalid set-code tx content with hash %v. error %v", newTransactionNotification.BxTransaction.Hash(), err)
+			return nil
+		}
+		marshalledTxBytes, err := setCodeTx.MarshalBinary()
+		if err != nil {
+			log.Infof("invalid raw set-code tx %v error %v", newTransactionNotification.BxTransaction.Hash(), err)
+		}
+		return marshalledTxBytes
+	}
+
 	var rawTx ethtypes.Transaction
 	err := rlp.DecodeBytes(newTransactionNotification.BxTransaction.content, &rawTx)
 	if err != nil {
@@ -115,3 +153,13 @@ func (newTransactionNotification *NewTransactionNotification) RawTx() []byte {
 func (newTransactionNotification *NewTransactionNotification) NotificationType() FeedType {
 	return NewTxsFeed
 }
+
+// SetSequence - set the position of the notification in the feed
+func (newTransactionNotification *NewTransactionNotification) SetSequence(sequence uint64) {
+	newTransactionNotification.sequence = sequence
+}
+
+// Sequence - position of the notification in the feed
+func (newTransactionNotification *NewTransactionNotification) Sequence() uint64 {
+	return newTransactionNotification.sequence
+}
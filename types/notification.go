@@ -18,3 +18,19 @@ type BlockNotification interface {
 	IsNil() bool
 	Clone() BlockNotification
 }
+
+// SequencedNotification represents a notification of a resumable feed, which carries its position in the feed
+type SequencedNotification interface {
+	Notification
+
+	SetSequence(sequence uint64)
+	Sequence() uint64
+}
+
+// NotificationSequence returns the position of the notification in its feed, or 0 if the feed is not resumable
+func NotificationSequence(notification Notification) uint64 {
+	if sequenced, ok := notification.(SequencedNotification); ok {
+		return sequenced.Sequence()
+	}
+	return 0
+}
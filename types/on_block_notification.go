@@ -1,12 +1,21 @@
 package types
 
+import "encoding/json"
+
 // OnBlockNotification - represents the result of an RPC call on published block
 type OnBlockNotification struct {
-	Name        string `json:"name,omitempty"`
-	Response    string `json:"response,omitempty"`
-	BlockHeight string `json:"block_height,omitempty"`
-	Tag         string `json:"tag,omitempty"`
-	hash        string
+	Name     string `json:"name,omitempty"`
+	Response string `json:"response,omitempty"`
+	// Result is the structured result of the calls not returning a string, e.g. the logs of eth_getLogs. Response is
+	// its JSON encoding
+	Result      interface{} `json:"result,omitempty"`
+	BlockHeight string      `json:"block_height,omitempty"`
+	Tag         string      `json:"tag,omitempty"`
+	// LatencyMs is the time from the start of the calls of the block to the response of the node
+	LatencyMs float64 `json:"latency_ms,omitempty"`
+	// Provider is the endpoint of the node which answered the call
+	Provider string `json:"provider,omitempty"`
+	hash     string
 }
 
 // NewOnBlockNotification returns a new OnBlockNotification
@@ -20,6 +29,22 @@ func NewOnBlockNotification(name string, response string, blockHeight string, ta
 	}
 }
 
+// SetResponse sets the response of the call, the structured responses are also encoded as JSON
+func (n *OnBlockNotification) SetResponse(response interface{}) error {
+	if str, ok := response.(string); ok {
+		n.Response = str
+		n.Result = nil
+		return nil
+	}
+	encoded, err := json.Marshal(response)
+	if err != nil {
+		return err
+	}
+	n.Response = string(encoded)
+	n.Result = response
+	return nil
+}
+
 // WithFields -
 func (n *OnBlockNotification) WithFields(fields []string) Notification {
 	onBlockNotification := OnBlockNotification{}
@@ -29,10 +54,15 @@ func (n *OnBlockNotification) WithFields(fields []string) Notification {
 			onBlockNotification.Name = n.Name
 		case "response":
 			onBlockNotification.Response = n.Response
+			onBlockNotification.Result = n.Result
 		case "block_height":
 			onBlockNotification.BlockHeight = n.BlockHeight
 		case "tag":
 			onBlockNotification.Tag = n.Tag
+		case "latency_ms":
+			onBlockNotification.LatencyMs = n.LatencyMs
+		case "provider":
+			onBlockNotification.Provider = n.Provider
 		}
 	}
 	return &onBlockNotification
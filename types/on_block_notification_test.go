@@ -0,0 +1,24 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOnBlockNotification_SetResponse(t *testing.T) {
+	n := NewOnBlockNotification("balance", "", "0xa", "0xa", "0x01")
+	require.NoError(t, n.SetResponse("0x1"))
+	assert.Equal(t, "0x1", n.Response)
+	assert.Nil(t, n.Result)
+
+	logs := []interface{}{map[string]interface{}{"address": "0x01", "data": "0x"}}
+	require.NoError(t, n.SetResponse(logs))
+	assert.Equal(t, `[{"address":"0x01","data":"0x"}]`, n.Response)
+
+	encoded, err := json.Marshal(n.WithFields([]string{"name", "response"}))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"balance","response":"[{\"address\":\"0x01\",\"data\":\"0x\"}]","result":[{"address":"0x01","data":"0x"}]}`, string(encoded))
+}
@@ -14,6 +14,7 @@ func CreatePendingTransactionNotification(bxTx *BxTransaction) Notification {
 			bxTx,
 			nil,
 			TxPendingValidation,
+			0,
 			&sync.Mutex{},
 		},
 	}
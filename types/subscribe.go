@@ -25,4 +25,8 @@ type ReqOptions struct {
 	Filters  string
 	Includes string
 	Project  string
+	// ResumeFrom is the last sequence received by the client on a previous subscription, 0 when not resuming
+	ResumeFrom uint64
+	// Backpressure is the policy applied when the client does not read the notifications fast enough
+	Backpressure BackpressurePolicy
 }
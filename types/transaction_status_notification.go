@@ -5,6 +5,9 @@ type TransactionStatusNotification struct {
 	SubscriptionIDs []string `json:"subscription_ids,omitempty"`
 	TransactionHash string   `json:"transaction_hash,omitempty"`
 	Status          Status   `json:"status,omitempty"`
+	BlockHeight     uint64   `json:"block_height,omitempty"`
+	BlockHash       string   `json:"block_hash,omitempty"`
+	ReplacedBy      string   `json:"replaced_by,omitempty"`
 }
 
 // Status types of transaction state
@@ -19,12 +22,18 @@ const TxPool Status = "tx_pool"
 // Mined is transaction status for confirmed status
 const Mined Status = "mined"
 
+// BDNBlock is transaction status for a transaction included in a block propagated by the BDN
+const BDNBlock Status = "bdn_block"
+
 // Canceled is transaction status for canceled status
 const Canceled Status = "canceled"
 
 // Replaced is transaction status for replaced status
 const Replaced Status = "replaced"
 
+// Dropped is transaction status for a transaction that left the mempool without being mined
+const Dropped Status = "dropped"
+
 // UpdateSource is the source for updating transaction status
 type UpdateSource string
 
@@ -45,6 +54,12 @@ func (tn *TransactionStatusNotification) WithFields(fields []string) Notificatio
 			txStatusNotification.Status = tn.Status
 		case "subscription_id":
 			txStatusNotification.SubscriptionIDs = tn.SubscriptionIDs
+		case "block_height":
+			txStatusNotification.BlockHeight = tn.BlockHeight
+		case "block_hash":
+			txStatusNotification.BlockHash = tn.BlockHash
+		case "replaced_by":
+			txStatusNotification.ReplacedBy = tn.ReplacedBy
 		}
 	}
 	return &txStatusNotification
@@ -69,3 +84,13 @@ func (tn *TransactionStatusNotification) LocalRegion() bool {
 func (tn *TransactionStatusNotification) GetHash() string {
 	return tn.TransactionHash
 }
+
+// IsSubscribed returns true if the notification is addressed to the provided subscription
+func (tn *TransactionStatusNotification) IsSubscribed(subscriptionID string) bool {
+	for _, id := range tn.SubscriptionIDs {
+		if id == subscriptionID {
+			return true
+		}
+	}
+	return false
+}
@@ -9,7 +9,8 @@ const nullAddressStr = "0x"
 // TxReceiptNotification - represents a transaction receipt feed entry
 // to avoid deserializing/reserializing the message from Ethereum RPC, no conversion work is done
 type TxReceiptNotification struct {
-	Receipt txReceipt
+	Receipt  txReceipt
+	sequence uint64
 }
 
 type txReceipt struct {
@@ -123,7 +124,7 @@ func (r *TxReceiptNotification) MarshalJSON() ([]byte, error) {
 
 // WithFields -
 func (r *TxReceiptNotification) WithFields(fields []string) Notification {
-	txReceiptNotification := TxReceiptNotification{}
+	txReceiptNotification := TxReceiptNotification{sequence: r.sequence}
 	for _, param := range fields {
 		switch param {
 		case "block_hash":
@@ -183,3 +184,13 @@ func (r *TxReceiptNotification) GetHash() string {
 func (r *TxReceiptNotification) NotificationType() FeedType {
 	return TxReceiptsFeed
 }
+
+// SetSequence - set the position of the receipt in the feed
+func (r *TxReceiptNotification) SetSequence(sequence uint64) {
+	r.sequence = sequence
+}
+
+// Sequence - position of the receipt in the feed
+func (r *TxReceiptNotification) Sequence() uint64 {
+	return r.sequence
+}
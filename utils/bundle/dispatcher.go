@@ -115,6 +115,88 @@ func (d *Dispatcher) Dispatch(bundle *bxmessage.MEVBundle) error {
 	return nil
 }
 
+// HasBuilders returns true if at least one MEV builder is configured
+func (d *Dispatcher) HasBuilders() bool {
+	return len(d.builders) > 0
+}
+
+// DispatchPrivateTx sends the raw transaction to all the configured MEV builders using eth_sendPrivateTransaction,
+// so it is included in a block without being exposed to the public mempool
+func (d *Dispatcher) DispatchPrivateTx(txHash string, rawTx string, maxBlockNumber uint64) error {
+	if len(d.builders) == 0 {
+		return fmt.Errorf("no MEV builders are configured, mev-builders-file-path is empty")
+	}
+
+	params := []jsonrpc.RPCSendPrivateTransaction{
+		{
+			Tx: rawTx,
+		},
+	}
+	if maxBlockNumber != 0 {
+		params[0].MaxBlockNumber = hexutil.EncodeUint64(maxBlockNumber)
+	}
+	paramsBytes, err := json.Marshal(params)
+	if err != nil {
+		return fmt.Errorf("failed to create private tx http request for tx: %v, err: %v", txHash, err)
+	}
+	privateTx := jsonrpc2.Request{
+		ID:     jsonrpc2.ID{Num: 1},
+		Params: (*json.RawMessage)(&paramsBytes),
+		Method: string(jsonrpc.RPCEthSendPrivateTransaction),
+	}
+	json, err := json.Marshal(privateTx)
+	if err != nil {
+		return fmt.Errorf("failed to create private tx http request for tx: %v, err: %v", txHash, err)
+	}
+
+	for _, builder := range d.builders {
+		for _, endpoint := range builder.Endpoints {
+			req, err := d.privateTxRequest(endpoint, builder, json)
+			if err != nil {
+				log.Errorf("failed to create private tx http request for mev builder %v, tx: %v, err: %v", endpoint, txHash, err)
+				continue
+			}
+
+			go func(req *http.Request) {
+				resp, err := d.client.Do(req)
+				if err != nil {
+					log.Errorf("failed to forward private tx %v to %v, err: %v", txHash, req.URL, err)
+					return
+				}
+				defer resp.Body.Close()
+
+				respBody, err := io.ReadAll(resp.Body)
+				if err != nil {
+					log.Errorf("failed to read private tx %v response from %v, err: %v", txHash, req.URL, err)
+					return
+				}
+
+				log.Tracef("sent private tx %v to %v got response: %v, status code: %v", txHash, req.URL, string(respBody), resp.StatusCode)
+			}(req)
+		}
+	}
+
+	return nil
+}
+
+func (d *Dispatcher) privateTxRequest(endpoint string, builder *Builder, json []byte) (*http.Request, error) {
+	req, err := http.NewRequest(http.MethodPost, endpoint, bytes.NewReader(json))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	if builder.SignatureRequired {
+		flashbotsSignature, err := generateRandomFlashbotsSignature(json)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create random flashbots signature: %v", err)
+		}
+		req.Header.Set(flashbotAuthHeader, flashbotsSignature)
+	}
+
+	return req, nil
+}
+
 func (d *Dispatcher) bundleJSON(bundle *bxmessage.MEVBundle) ([]byte, error) {
 	params := []jsonrpc.RPCSendBundle{
 		{
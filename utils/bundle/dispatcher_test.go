@@ -268,3 +268,40 @@ func TestDispatcher(t *testing.T) {
 		})
 	}
 }
+
+func TestDispatcher_DispatchPrivateTx(t *testing.T) {
+	mu := &sync.Mutex{}
+	wg := &sync.WaitGroup{}
+	buildersMap := makeBuildersMap("", []string{"builder1", "builder2"})
+	wg.Add(len(buildersMap))
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer wg.Done()
+
+		path := strings.TrimPrefix(r.URL.Path, "/")
+		mu.Lock()
+		_, ok := buildersMap[path]
+		assert.True(t, ok)
+		delete(buildersMap, path)
+		mu.Unlock()
+
+		var req jsonrpc2.Request
+		err := json.NewDecoder(r.Body).Decode(&req)
+		assert.NoError(t, err)
+		assert.Equal(t, string(jsonrpc.RPCEthSendPrivateTransaction), req.Method)
+
+		var payload []jsonrpc.RPCSendPrivateTransaction
+		err = json.Unmarshal(*req.Params, &payload)
+		assert.NoError(t, err)
+		assert.Equal(t, jsonrpc.RPCSendPrivateTransaction{Tx: testTx1, MaxBlockNumber: "0x7b"}, payload[0])
+	}))
+	defer server.Close()
+
+	assert.Error(t, NewDispatcher(nil, false, false).DispatchPrivateTx(testTx1Hash, testTx1, 123))
+
+	d := NewDispatcher(makeBuildersMap(fmt.Sprintf("%s/", server.URL), []string{"builder1", "builder2"}), false, false)
+	assert.True(t, d.HasBuilders())
+	assert.NoError(t, d.DispatchPrivateTx(testTx1Hash, testTx1, 123))
+
+	wg.Wait()
+	assert.Empty(t, buildersMap)
+}
@@ -0,0 +1,647 @@
+// Package filter compiles the filters of the feeds, for example `{value} > 1e18 and to in [0xaa, 0xbb]`, into a
+// function evaluated directly against the feed items. Names are case-insensitive, and so are strings, which are
+// lowercased.
+package filter
+
+import (
+	"math/big"
+	"sort"
+	"strings"
+)
+
+// Kind is the type of the value of a variable or an expression
+type Kind int
+
+// Kind enumeration
+const (
+	Bool Kind = iota
+	Number
+	String
+	NumberList
+	StringList
+	// untyped is the kind of literals until they are compared with a typed expression
+	untyped
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Bool:
+		return "bool"
+	case Number:
+		return "number"
+	case String:
+		return "string"
+	case NumberList:
+		return "number list"
+	case StringList:
+		return "string list"
+	default:
+		return "literal"
+	}
+}
+
+// Var is a variable a filter can refer to. Each getter returns false when the item has no value for the variable,
+// in which case any comparison with it does not match
+type Var[T any] struct {
+	kind    Kind
+	boolean func(T) (bool, bool)
+	number  func(T) (*big.Int, bool)
+	str     func(T) (string, bool)
+	numbers func(T) ([]*big.Int, bool)
+	strs    func(T) ([]string, bool)
+}
+
+// BoolVar creates a bool variable
+func BoolVar[T any](get func(T) (bool, bool)) Var[T] {
+	return Var[T]{kind: Bool, boolean: get}
+}
+
+// NumberVar creates a number variable
+func NumberVar[T any](get func(T) (*big.Int, bool)) Var[T] {
+	return Var[T]{kind: Number, number: get}
+}
+
+// StringVar creates a string variable, its values should be lowercase
+func StringVar[T any](get func(T) (string, bool)) Var[T] {
+	return Var[T]{kind: String, str: get}
+}
+
+// NumberListVar creates a number list variable
+func NumberListVar[T any](get func(T) ([]*big.Int, bool)) Var[T] {
+	return Var[T]{kind: NumberList, numbers: get}
+}
+
+// StringListVar creates a string list variable, its values should be lowercase
+func StringListVar[T any](get func(T) ([]string, bool)) Var[T] {
+	return Var[T]{kind: StringList, strs: get}
+}
+
+// Kind returns the kind of the variable
+func (v Var[T]) Kind() Kind {
+	return v.kind
+}
+
+// Env resolves the variables a filter refers to
+type Env[T any] func(name string) (Var[T], bool)
+
+// Filter is a compiled filter
+type Filter[T any] struct {
+	src   string
+	vars  []string
+	match func(T) bool
+}
+
+// Compile parses and type checks the filter
+func Compile[T any](src string, env Env[T]) (*Filter[T], error) {
+	tree, err := parse(src, func(name string) bool {
+		_, ok := env(name)
+		return ok
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	c := &compiler[T]{env: env, vars: make(map[string]struct{})}
+	root, err := c.compile(tree)
+	if err != nil {
+		return nil, err
+	}
+	if root.kind != Bool {
+		return nil, errorf(tree.position(), "filter must be a condition, got a %v", root.kind)
+	}
+
+	vars := make([]string, 0, len(c.vars))
+	for name := range c.vars {
+		vars = append(vars, name)
+	}
+	sort.Strings(vars)
+	return &Filter[T]{src: src, vars: vars, match: root.boolean}, nil
+}
+
+// Match evaluates the filter
+func (f *Filter[T]) Match(item T) bool {
+	return f.match(item)
+}
+
+// Vars returns the names of the variables the filter refers to
+func (f *Filter[T]) Vars() []string {
+	return f.vars
+}
+
+func (f *Filter[T]) String() string {
+	return f.src
+}
+
+// value is a compiled expression. Only the function of its kind is set, and literals are kept until their kind is
+// known
+type value[T any] struct {
+	kind    Kind
+	pos     int
+	boolean func(T) bool
+	number  func(T) (*big.Int, bool)
+	str     func(T) (string, bool)
+	numbers func(T) ([]*big.Int, bool)
+	strs    func(T) ([]string, bool)
+
+	literal *literalNode
+	list    *listNode
+}
+
+type compiler[T any] struct {
+	env  Env[T]
+	vars map[string]struct{}
+}
+
+func (c *compiler[T]) compile(n node) (*value[T], error) {
+	switch n := n.(type) {
+	case *literalNode:
+		if n.kind == literalBool {
+			b := n.text == "true"
+			return &value[T]{kind: Bool, pos: n.pos, boolean: func(T) bool { return b }}, nil
+		}
+		return &value[T]{kind: untyped, pos: n.pos, literal: n}, nil
+	case *listNode:
+		return &value[T]{kind: untyped, pos: n.pos, list: n}, nil
+	case *varNode:
+		return c.variable(n)
+	case *unaryNode:
+		return c.unary(n)
+	case *binaryNode:
+		switch n.op {
+		case "and", "or":
+			return c.logical(n)
+		case "+", "-", "*", "/", "%":
+			return c.arithmetic(n)
+		default:
+			return c.comparison(n)
+		}
+	default:
+		return nil, errorf(n.position(), "unsupported expression")
+	}
+}
+
+func (c *compiler[T]) variable(n *varNode) (*value[T], error) {
+	v, ok := c.env(n.name)
+	if !ok {
+		return nil, errorf(n.pos, "unknown filter %q", n.name)
+	}
+	c.vars[n.name] = struct{}{}
+
+	compiled := &value[T]{kind: v.kind, pos: n.pos, number: v.number, str: v.str, numbers: v.numbers, strs: v.strs}
+	if v.kind == Bool {
+		get := v.boolean
+		compiled.boolean = func(item T) bool {
+			b, ok := get(item)
+			return ok && b
+		}
+	}
+	return compiled, nil
+}
+
+func (c *compiler[T]) unary(n *unaryNode) (*value[T], error) {
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "not":
+		if x.kind != Bool {
+			return nil, errorf(n.pos, "not expects a condition, got a %v", x.kind)
+		}
+		match := x.boolean
+		return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool { return !match(item) }}, nil
+	default:
+		if err = c.resolve(x, Number); err != nil {
+			return nil, err
+		}
+		get := x.number
+		return &value[T]{kind: Number, pos: n.pos, number: func(item T) (*big.Int, bool) {
+			v, ok := get(item)
+			if !ok {
+				return nil, false
+			}
+			return new(big.Int).Neg(v), true
+		}}, nil
+	}
+}
+
+func (c *compiler[T]) logical(n *binaryNode) (*value[T], error) {
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+	y, err := c.compile(n.y)
+	if err != nil {
+		return nil, err
+	}
+	if x.kind != Bool || y.kind != Bool {
+		return nil, errorf(n.pos, "%v expects conditions, got a %v and a %v", n.op, x.kind, y.kind)
+	}
+	left, right := x.boolean, y.boolean
+	if n.op == "and" {
+		return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool { return left(item) && right(item) }}, nil
+	}
+	return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool { return left(item) || right(item) }}, nil
+}
+
+func (c *compiler[T]) arithmetic(n *binaryNode) (*value[T], error) {
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+	y, err := c.compile(n.y)
+	if err != nil {
+		return nil, err
+	}
+	if err = c.resolve(x, Number); err != nil {
+		return nil, err
+	}
+	if err = c.resolve(y, Number); err != nil {
+		return nil, err
+	}
+
+	var op func(z, a, b *big.Int) *big.Int
+	switch n.op {
+	case "+":
+		op = (*big.Int).Add
+	case "-":
+		op = (*big.Int).Sub
+	case "*":
+		op = (*big.Int).Mul
+	case "/":
+		op = (*big.Int).Quo
+	case "%":
+		op = (*big.Int).Rem
+	}
+	divides := n.op == "/" || n.op == "%"
+	left, right := x.number, y.number
+	return &value[T]{kind: Number, pos: n.pos, number: func(item T) (*big.Int, bool) {
+		a, ok := left(item)
+		if !ok {
+			return nil, false
+		}
+		b, ok := right(item)
+		if !ok || divides && b.Sign() == 0 {
+			return nil, false
+		}
+		return op(new(big.Int), a, b), true
+	}}, nil
+}
+
+func (c *compiler[T]) comparison(n *binaryNode) (*value[T], error) {
+	x, err := c.compile(n.x)
+	if err != nil {
+		return nil, err
+	}
+	y, err := c.compile(n.y)
+	if err != nil {
+		return nil, err
+	}
+
+	switch n.op {
+	case "in", "not in":
+		match, err := c.membership(n, x, y)
+		if err != nil {
+			return nil, err
+		}
+		if n.op == "not in" {
+			return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool {
+				if !x.present(item) {
+					return false
+				}
+				return !match(item)
+			}}, nil
+		}
+		return &value[T]{kind: Bool, pos: n.pos, boolean: match}, nil
+	case "contains":
+		if x.kind == NumberList || x.kind == StringList {
+			match, err := c.membership(n, y, x)
+			if err != nil {
+				return nil, err
+			}
+			return &value[T]{kind: Bool, pos: n.pos, boolean: match}, nil
+		}
+		return c.stringComparison(n, x, y)
+	case "startswith", "endswith":
+		return c.stringComparison(n, x, y)
+	}
+
+	if err = c.unify(n, x, y); err != nil {
+		return nil, err
+	}
+	switch x.kind {
+	case Number:
+		var compare func(int) bool
+		switch n.op {
+		case "==":
+			compare = func(r int) bool { return r == 0 }
+		case "!=":
+			compare = func(r int) bool { return r != 0 }
+		case "<":
+			compare = func(r int) bool { return r < 0 }
+		case "<=":
+			compare = func(r int) bool { return r <= 0 }
+		case ">":
+			compare = func(r int) bool { return r > 0 }
+		case ">=":
+			compare = func(r int) bool { return r >= 0 }
+		}
+		left, right := x.number, y.number
+		return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool {
+			a, ok := left(item)
+			if !ok {
+				return false
+			}
+			b, ok := right(item)
+			return ok && compare(a.Cmp(b))
+		}}, nil
+	case String:
+		if n.op != "==" && n.op != "!=" {
+			return nil, errorf(n.pos, "%v expects numbers, got a %v", n.op, x.kind)
+		}
+		equal := n.op == "=="
+		left, right := x.str, y.str
+		return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool {
+			a, ok := left(item)
+			if !ok {
+				return false
+			}
+			b, ok := right(item)
+			return ok && (a == b) == equal
+		}}, nil
+	case Bool:
+		if n.op != "==" && n.op != "!=" {
+			return nil, errorf(n.pos, "%v expects numbers, got a %v", n.op, x.kind)
+		}
+		equal := n.op == "=="
+		left, right := x.boolean, y.boolean
+		return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool { return (left(item) == right(item)) == equal }}, nil
+	default:
+		return nil, errorf(n.pos, "%v does not support a %v", n.op, x.kind)
+	}
+}
+
+func (c *compiler[T]) stringComparison(n *binaryNode, x, y *value[T]) (*value[T], error) {
+	if err := c.resolve(x, String); err != nil {
+		return nil, err
+	}
+	if err := c.resolve(y, String); err != nil {
+		return nil, err
+	}
+
+	var compare func(s, substr string) bool
+	switch n.op {
+	case "contains":
+		compare = strings.Contains
+	case "startswith":
+		compare = strings.HasPrefix
+	case "endswith":
+		compare = strings.HasSuffix
+	}
+	left, right := x.str, y.str
+	return &value[T]{kind: Bool, pos: n.pos, boolean: func(item T) bool {
+		a, ok := left(item)
+		if !ok {
+			return false
+		}
+		b, ok := right(item)
+		return ok && compare(a, b)
+	}}, nil
+}
+
+// membership compiles `elem in list`
+func (c *compiler[T]) membership(n *binaryNode, elem, list *value[T]) (func(T) bool, error) {
+	if list.kind == untyped && list.list == nil {
+		return nil, errorf(list.pos, "%v expects a list", n.op)
+	}
+	if list.kind == untyped {
+		switch elem.kind {
+		case Number:
+			if err := c.resolve(list, NumberList); err != nil {
+				return nil, err
+			}
+		case String:
+			if err := c.resolve(list, StringList); err != nil {
+				return nil, err
+			}
+		case untyped:
+			return nil, errorf(n.pos, "%v expects a filter name", n.op)
+		default:
+			return nil, errorf(elem.pos, "%v does not support a %v", n.op, elem.kind)
+		}
+	}
+
+	switch list.kind {
+	case NumberList:
+		if err := c.resolve(elem, Number); err != nil {
+			return nil, err
+		}
+		get, getList := elem.number, list.numbers
+		return func(item T) bool {
+			v, ok := get(item)
+			if !ok {
+				return false
+			}
+			values, ok := getList(item)
+			if !ok {
+				return false
+			}
+			for _, value := range values {
+				if value.Cmp(v) == 0 {
+					return true
+				}
+			}
+			return false
+		}, nil
+	case StringList:
+		if err := c.resolve(elem, String); err != nil {
+			return nil, err
+		}
+		get := elem.str
+		if list.list != nil {
+			// a list of literals is a set
+			set := make(map[string]struct{}, len(list.list.elems))
+			for _, literal := range list.list.elems {
+				s, _ := stringLiteral(literal)
+				set[s] = struct{}{}
+			}
+			return func(item T) bool {
+				v, ok := get(item)
+				if !ok {
+					return false
+				}
+				_, ok = set[v]
+				return ok
+			}, nil
+		}
+		getList := list.strs
+		return func(item T) bool {
+			v, ok := get(item)
+			if !ok {
+				return false
+			}
+			values, ok := getList(item)
+			if !ok {
+				return false
+			}
+			for _, value := range values {
+				if value == v {
+					return true
+				}
+			}
+			return false
+		}, nil
+	default:
+		return nil, errorf(list.pos, "%v expects a list, got a %v", n.op, list.kind)
+	}
+}
+
+// unify gives both sides of a comparison the same kind, the kind of the typed one if any
+func (c *compiler[T]) unify(n *binaryNode, x, y *value[T]) error {
+	switch {
+	case x.kind == untyped && y.kind == untyped:
+		if x.list != nil || y.list != nil {
+			return errorf(n.pos, "%v does not support lists", n.op)
+		}
+		kind := Number
+		if _, err := numberLiteral(x.literal); err != nil {
+			kind = String
+		} else if _, err := numberLiteral(y.literal); err != nil {
+			kind = String
+		}
+		if err := c.resolve(x, kind); err != nil {
+			return err
+		}
+		return c.resolve(y, kind)
+	case x.kind == untyped:
+		return c.resolve(x, y.kind)
+	case y.kind == untyped:
+		return c.resolve(y, x.kind)
+	case x.kind != y.kind:
+		return errorf(n.pos, "cannot compare a %v with a %v", x.kind, y.kind)
+	default:
+		return nil
+	}
+}
+
+// resolve turns a literal into a constant of the kind, and checks the kind of the other values
+func (c *compiler[T]) resolve(v *value[T], kind Kind) error {
+	if v.kind != untyped {
+		if v.kind != kind {
+			return errorf(v.pos, "expected a %v, got a %v", kind, v.kind)
+		}
+		return nil
+	}
+
+	switch {
+	case v.literal != nil && kind == Number:
+		n, err := numberLiteral(v.literal)
+		if err != nil {
+			return err
+		}
+		v.number = func(T) (*big.Int, bool) { return n, true }
+	case v.literal != nil && kind == String:
+		s, err := stringLiteral(v.literal)
+		if err != nil {
+			return err
+		}
+		v.str = func(T) (string, bool) { return s, true }
+	case v.list != nil && kind == NumberList:
+		numbers := make([]*big.Int, 0, len(v.list.elems))
+		for _, literal := range v.list.elems {
+			n, err := numberLiteral(literal)
+			if err != nil {
+				return err
+			}
+			numbers = append(numbers, n)
+		}
+		v.numbers = func(T) ([]*big.Int, bool) { return numbers, true }
+	case v.list != nil && kind == StringList:
+		strs := make([]string, 0, len(v.list.elems))
+		for _, literal := range v.list.elems {
+			s, err := stringLiteral(literal)
+			if err != nil {
+				return err
+			}
+			strs = append(strs, s)
+		}
+		v.strs = func(T) ([]string, bool) { return strs, true }
+	case v.list != nil:
+		return errorf(v.pos, "expected a %v, got a list", kind)
+	default:
+		return errorf(v.pos, "expected a %v, got %q", kind, v.literal.text)
+	}
+	v.kind = kind
+	return nil
+}
+
+// maxNumberBits is the size of the largest number literal, the size of the EVM words. Larger literals are rejected, so
+// a filter cannot allocate an arbitrarily large number using an exponent
+const maxNumberBits = 256
+
+func numberLiteral(literal *literalNode) (*big.Int, error) {
+	text := literal.text
+	switch literal.kind {
+	case literalHex:
+		n, ok := new(big.Int).SetString(text[2:], 16)
+		if !ok {
+			return nil, errorf(literal.pos, "invalid number %q", text)
+		}
+		if n.BitLen() > maxNumberBits {
+			return nil, errorf(literal.pos, "number %v is larger than %v bits", text, maxNumberBits)
+		}
+		return n, nil
+	case literalString:
+		if isHexLiteral(text) {
+			return numberLiteral(&literalNode{pos: literal.pos, kind: literalHex, text: text})
+		}
+		if !isNumberLiteral(text) {
+			return nil, errorf(literal.pos, "expected a number, got %q", text)
+		}
+	case literalNumber:
+	default:
+		return nil, errorf(literal.pos, "expected a number, got %q", text)
+	}
+
+	f, ok := new(big.Float).SetPrec(512).SetString(text)
+	if !ok {
+		return nil, errorf(literal.pos, "invalid number %q", text)
+	}
+	// the exponent is the number of bits of the integer part
+	if f.MantExp(nil) > maxNumberBits {
+		return nil, errorf(literal.pos, "number %v is larger than %v bits", text, maxNumberBits)
+	}
+	if !f.IsInt() {
+		return nil, errorf(literal.pos, "number %v is not an integer", text)
+	}
+	n, _ := f.Int(nil)
+	return n, nil
+}
+
+func stringLiteral(literal *literalNode) (string, error) {
+	switch literal.kind {
+	case literalString, literalHex:
+		return literal.text, nil
+	case literalWord:
+		return "0x" + literal.text, nil
+	case literalNumber:
+		if isHexDigits(literal.text) {
+			return "0x" + literal.text, nil
+		}
+	}
+	return "", errorf(literal.pos, "expected a string, got %q", literal.text)
+}
+
+// present reports whether the value is set for the item
+func (v *value[T]) present(item T) bool {
+	var ok bool
+	switch v.kind {
+	case Number:
+		_, ok = v.number(item)
+	case String:
+		_, ok = v.str(item)
+	default:
+		ok = true
+	}
+	return ok
+}
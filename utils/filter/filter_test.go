@@ -0,0 +1,151 @@
+package filter
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testItem struct {
+	value    *big.Int
+	gasPrice *big.Int
+	to       string
+	methodID string
+	path     []string
+	amounts  []*big.Int
+	private  bool
+}
+
+var testVars = map[string]Var[*testItem]{
+	"value": NumberVar(func(item *testItem) (*big.Int, bool) { return item.value, item.value != nil }),
+	"gas_price": NumberVar(func(item *testItem) (*big.Int, bool) {
+		return item.gasPrice, item.gasPrice != nil
+	}),
+	"to":        StringVar(func(item *testItem) (string, bool) { return item.to, item.to != "" }),
+	"method_id": StringVar(func(item *testItem) (string, bool) { return item.methodID, true }),
+	"swap.path": StringListVar(func(item *testItem) ([]string, bool) { return item.path, item.path != nil }),
+	"swap.amounts": NumberListVar(func(item *testItem) ([]*big.Int, bool) {
+		return item.amounts, item.amounts != nil
+	}),
+	"private": BoolVar(func(item *testItem) (bool, bool) { return item.private, true }),
+}
+
+func testEnv(name string) (Var[*testItem], bool) {
+	v, ok := testVars[name]
+	return v, ok
+}
+
+func TestCompile(t *testing.T) {
+	eth, _ := new(big.Int).SetString("1000000000000000000", 10)
+	item := &testItem{
+		value:    new(big.Int).Mul(big.NewInt(2), eth),
+		to:       "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+		methodID: "0x095ea7b3",
+		path:     []string{"0xaa", "0xbb"},
+		amounts:  []*big.Int{big.NewInt(5), eth},
+	}
+
+	tests := map[string]bool{
+		"value > 1e18":                                             true,
+		"{value} > 1e18":                                           true,
+		"value >= 0x1bc16d674ec80000":                              true,
+		"value = 2000000000000000000":                              true,
+		"value == 2e18 and value != 1e18":                          true,
+		"value - 1e18 == 1e18":                                     true,
+		"value / 2 * 3 > 2e18 + 5 % 3":                             true,
+		"-value < 0":                                               true,
+		"value in [1, 2e18]":                                       true,
+		"value not in [1, 2e18]":                                   false,
+		"to = 0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2":          true,
+		"{to} == '0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2'":     true,
+		"to in [0xaa, 0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2]": true,
+		"to startswith 0xc02a and to endswith '6cc2'":              true,
+		"to contains 'aaa39'":                                      true,
+		"method_id = 095ea7b3":                                     true,
+		"method_id in [a9059cbb, 095ea7b3]":                        true,
+		"swap.path contains 0xBB":                                  true,
+		"{swap.path} contains '0xcc'":                              false,
+		"0xaa in swap.path":                                        true,
+		"swap.amounts contains 1e18":                               true,
+		"not private and !(value < 1)":                             true,
+		"private == false":                                         true,
+		"gas_price > 0":                                            false,
+		"gas_price != 0":                                           false,
+		"not gas_price > 0":                                        true,
+		"gas_price > 0 or value > 0":                               true,
+		"value > 0 and (gas_price > 0 || to != 0x0)":               true,
+		"VALUE > 1 AND TO != 0x0":                                  true,
+	}
+	for src, expected := range tests {
+		f, err := Compile(src, testEnv)
+		require.NoError(t, err, src)
+		assert.Equal(t, expected, f.Match(item), src)
+	}
+
+	f, err := Compile("value > 1 and (to = 0xaa or value < 2) and swap.path contains 0xaa", testEnv)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"swap.path", "to", "value"}, f.Vars())
+
+	// the largest numbers are the 256 bits EVM words
+	for _, src := range []string{
+		"value < 115792089237316195423570985008687907853269984665640564039457584007913129639935",
+		"value < 0xffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+		"value < 1e77",
+	} {
+		f, err = Compile(src, testEnv)
+		require.NoError(t, err, src)
+		assert.True(t, f.Match(item), src)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := map[string]int{
+		"":                        1,
+		"value":                   1,
+		"value >":                 8,
+		"value > = 1":             9,
+		"value ! = 1":             7,
+		"(value > 1":              11,
+		"value > 1)":              10,
+		"nonce > 1":               1,
+		"{nonce} > 1":             2,
+		"value > 1.5":             9,
+		"value > 'abc'":           9,
+		"to > 0xaa":               4,
+		"to == 1 and value = 0xg": 21,
+		"value in 5":              10,
+		"to = 'unterminated":      6,
+		"value = 1 and to":        11,
+		"swap.path == 0xaa":       14,
+		"value & 1":               7,
+		"value > 1e600000000":     9,
+		"value > 1e78":            9,
+		"value < 0x10000000000000000000000000000000000000000000000000000000000000000": 9,
+	}
+	for src, pos := range tests {
+		_, err := Compile(src, testEnv)
+		require.Error(t, err, src)
+		var syntaxErr *SyntaxError
+		require.True(t, errors.As(err, &syntaxErr), src)
+		assert.Equal(t, pos, syntaxErr.Pos, "%v: %v", src, err)
+	}
+}
+
+func BenchmarkFilter(b *testing.B) {
+	f, err := Compile("value > 1e18 and to in [0xaa, 0xbb, 0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2] and method_id != a9059cbb", testEnv)
+	require.NoError(b, err)
+	item := &testItem{
+		value:    big.NewInt(0).Exp(big.NewInt(10), big.NewInt(19), nil),
+		to:       "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2",
+		methodID: "0x095ea7b3",
+	}
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		f.Match(item)
+	}
+}
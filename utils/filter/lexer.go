@@ -0,0 +1,220 @@
+package filter
+
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenType int
+
+const (
+	tokenEOF tokenType = iota
+	tokenIdent
+	tokenNumber
+	tokenHex
+	tokenString
+	tokenOperator
+	tokenLParen
+	tokenRParen
+	tokenLBracket
+	tokenRBracket
+	tokenLBrace
+	tokenRBrace
+	tokenComma
+)
+
+type token struct {
+	typ  tokenType
+	text string
+	// pos is the 1-based position of the token in the filter
+	pos int
+}
+
+func (t token) String() string {
+	if t.typ == tokenEOF {
+		return "end of filter"
+	}
+	return fmt.Sprintf("%q", t.text)
+}
+
+// SyntaxError is returned when a filter fails to compile
+type SyntaxError struct {
+	Pos int
+	Msg string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("%v at position %v", e.Msg, e.Pos)
+}
+
+func errorf(pos int, format string, args ...interface{}) *SyntaxError {
+	return &SyntaxError{Pos: pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+// operators, the two characters ones first
+var operators = []string{"==", "!=", "<=", ">=", "&&", "||", "=", "<", ">", "!", "+", "-", "*", "/", "%"}
+
+func tokenize(src string) ([]token, error) {
+	var tokens []token
+	for i := 0; i < len(src); {
+		c := src[i]
+		pos := i + 1
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			i++
+		case c == '(':
+			tokens = append(tokens, token{tokenLParen, "(", pos})
+			i++
+		case c == ')':
+			tokens = append(tokens, token{tokenRParen, ")", pos})
+			i++
+		case c == '[':
+			tokens = append(tokens, token{tokenLBracket, "[", pos})
+			i++
+		case c == ']':
+			tokens = append(tokens, token{tokenRBracket, "]", pos})
+			i++
+		case c == '{':
+			tokens = append(tokens, token{tokenLBrace, "{", pos})
+			i++
+		case c == '}':
+			tokens = append(tokens, token{tokenRBrace, "}", pos})
+			i++
+		case c == ',':
+			tokens = append(tokens, token{tokenComma, ",", pos})
+			i++
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(src[i+1:], c)
+			if end < 0 {
+				return nil, errorf(pos, "unterminated string")
+			}
+			tokens = append(tokens, token{tokenString, src[i+1 : i+1+end], pos})
+			i += end + 2
+		case isDigit(c):
+			end := scanNumber(src, i)
+			text := src[i:end]
+			switch {
+			case isHexLiteral(text):
+				tokens = append(tokens, token{tokenHex, text, pos})
+			case isNumberLiteral(text):
+				tokens = append(tokens, token{tokenNumber, text, pos})
+			case isHexDigits(text):
+				// a hex value without the 0x prefix, for example a method id
+				tokens = append(tokens, token{tokenIdent, text, pos})
+			default:
+				return nil, errorf(pos, "invalid number %q", text)
+			}
+			i = end
+		case isIdentStart(c):
+			end := i + 1
+			for end < len(src) && isIdentChar(src[end]) {
+				end++
+			}
+			tokens = append(tokens, token{tokenIdent, src[i:end], pos})
+			i = end
+		default:
+			operator := ""
+			for _, op := range operators {
+				if strings.HasPrefix(src[i:], op) {
+					operator = op
+					break
+				}
+			}
+			if operator == "" {
+				r, _ := utf8.DecodeRuneInString(src[i:])
+				return nil, errorf(pos, "unexpected character %q", r)
+			}
+			tokens = append(tokens, token{tokenOperator, operator, pos})
+			i += len(operator)
+		}
+	}
+	return append(tokens, token{tokenEOF, "", len(src) + 1}), nil
+}
+
+// scanNumber returns the end of the number starting at i. Letters are part of it, so hex values without the 0x
+// prefix are scanned as a single token, and so is the sign of an exponent
+func scanNumber(src string, i int) int {
+	end := i
+	for end < len(src) {
+		c := src[end]
+		if isDigit(c) || isLetter(c) || c == '.' {
+			end++
+			continue
+		}
+		if (c == '+' || c == '-') && (src[end-1] == 'e' || src[end-1] == 'E') && isNumberLiteral(src[i:end]+"0") {
+			end++
+			continue
+		}
+		break
+	}
+	return end
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isLetter(c byte) bool {
+	return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+}
+
+func isIdentStart(c byte) bool {
+	return isLetter(c) || c == '_'
+}
+
+func isIdentChar(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '.'
+}
+
+func isHexDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if !isDigit(c) && !(c >= 'a' && c <= 'f') && !(c >= 'A' && c <= 'F') {
+			return false
+		}
+	}
+	return true
+}
+
+func isHexLiteral(s string) bool {
+	return len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') && isHexDigits(s[2:])
+}
+
+// isNumberLiteral checks for decimal numbers, with an optional fraction and exponent
+func isNumberLiteral(s string) bool {
+	i := 0
+	digits := 0
+	for i < len(s) && isDigit(s[i]) {
+		i++
+		digits++
+	}
+	if i < len(s) && s[i] == '.' {
+		i++
+		for i < len(s) && isDigit(s[i]) {
+			i++
+			digits++
+		}
+	}
+	if digits == 0 {
+		return false
+	}
+	if i < len(s) && (s[i] == 'e' || s[i] == 'E') {
+		i++
+		if i < len(s) && (s[i] == '+' || s[i] == '-') {
+			i++
+		}
+		exponentDigits := 0
+		for i < len(s) && isDigit(s[i]) {
+			i++
+			exponentDigits++
+		}
+		if exponentDigits == 0 {
+			return false
+		}
+	}
+	return i == len(s)
+}
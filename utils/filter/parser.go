@@ -0,0 +1,334 @@
+package filter
+
+import (
+	"strings"
+)
+
+// node is an expression of the filter syntax tree
+type node interface {
+	position() int
+}
+
+type literalKind int
+
+const (
+	// a decimal number, 1000 or 1e18
+	literalNumber literalKind = iota
+	// a 0x prefixed hex value, which is either a number or a string depending on what it is compared with
+	literalHex
+	// a hex value without the 0x prefix, for example a9059cbb
+	literalWord
+	literalString
+	literalBool
+)
+
+type literalNode struct {
+	pos  int
+	kind literalKind
+	text string
+}
+
+type listNode struct {
+	pos   int
+	elems []*literalNode
+}
+
+type varNode struct {
+	pos  int
+	name string
+}
+
+type unaryNode struct {
+	pos int
+	op  string
+	x   node
+}
+
+type binaryNode struct {
+	pos  int
+	op   string
+	x, y node
+}
+
+func (n *literalNode) position() int { return n.pos }
+func (n *listNode) position() int    { return n.pos }
+func (n *varNode) position() int     { return n.pos }
+func (n *unaryNode) position() int   { return n.pos }
+func (n *binaryNode) position() int  { return n.pos }
+
+// parser builds the syntax tree of a filter:
+//
+//	or      = and { ("or" | "||") and }
+//	and     = not { ("and" | "&&") not }
+//	not     = ("not" | "!") not | compare
+//	compare = sum [ ("=" | "==" | "!=" | "<" | "<=" | ">" | ">=" | ["not"] "in" | "contains" | "startswith" | "endswith") sum ]
+//	sum     = product { ("+" | "-") product }
+//	product = unary { ("*" | "/" | "%") unary }
+//	unary   = "-" unary | primary
+//	primary = "(" or ")" | "[" literal { "," literal } "]" | "{" name "}" | name | literal
+type parser struct {
+	tokens  []token
+	current int
+	isVar   func(name string) bool
+}
+
+func parse(src string, isVar func(name string) bool) (node, error) {
+	tokens, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, isVar: isVar}
+	if p.peek().typ == tokenEOF {
+		return nil, errorf(1, "filter is empty")
+	}
+	n, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.typ != tokenEOF {
+		return nil, errorf(t.pos, "unexpected %v", t)
+	}
+	return n, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.current]
+	if t.typ != tokenEOF {
+		p.current++
+	}
+	return t
+}
+
+// keyword returns the lowercase keyword or operator of the next token, if any
+func (p *parser) keyword() string {
+	t := p.peek()
+	switch t.typ {
+	case tokenOperator:
+		return t.text
+	case tokenIdent:
+		return strings.ToLower(t.text)
+	default:
+		return ""
+	}
+}
+
+func (p *parser) expect(typ tokenType, text string) (token, error) {
+	t := p.next()
+	if t.typ != typ {
+		return t, errorf(t.pos, "expected %q, got %v", text, t)
+	}
+	return t, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	x, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for k := p.keyword(); k == "or" || k == "||"; k = p.keyword() {
+		t := p.next()
+		y, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: "or", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	x, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for k := p.keyword(); k == "and" || k == "&&"; k = p.keyword() {
+		t := p.next()
+		y, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: "and", x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseNot() (node, error) {
+	if k := p.keyword(); k == "not" || k == "!" {
+		t := p.next()
+		x, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{pos: t.pos, op: "not", x: x}, nil
+	}
+	return p.parseCompare()
+}
+
+func (p *parser) parseCompare() (node, error) {
+	x, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+
+	op := p.keyword()
+	switch op {
+	case "=", "==":
+		op = "=="
+	case "!=", "<", "<=", ">", ">=", "in", "contains", "startswith", "endswith":
+	case "not":
+		// only "not in" may follow an operand
+		if p.tokens[p.current+1].typ != tokenIdent || strings.ToLower(p.tokens[p.current+1].text) != "in" {
+			return x, nil
+		}
+		p.next()
+		op = "not in"
+	default:
+		return x, nil
+	}
+	t := p.next()
+	y, err := p.parseSum()
+	if err != nil {
+		return nil, err
+	}
+	return &binaryNode{pos: t.pos, op: op, x: x, y: y}, nil
+}
+
+func (p *parser) parseSum() (node, error) {
+	x, err := p.parseProduct()
+	if err != nil {
+		return nil, err
+	}
+	for k := p.keyword(); k == "+" || k == "-"; k = p.keyword() {
+		t := p.next()
+		y, err := p.parseProduct()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: t.text, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseProduct() (node, error) {
+	x, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+	for k := p.keyword(); k == "*" || k == "/" || k == "%"; k = p.keyword() {
+		t := p.next()
+		y, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		x = &binaryNode{pos: t.pos, op: t.text, x: x, y: y}
+	}
+	return x, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.keyword() == "-" {
+		t := p.next()
+		x, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+		return &unaryNode{pos: t.pos, op: "-", x: x}, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.peek()
+	switch t.typ {
+	case tokenLParen:
+		p.next()
+		x, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = p.expect(tokenRParen, ")"); err != nil {
+			return nil, err
+		}
+		return x, nil
+	case tokenLBracket:
+		return p.parseList()
+	case tokenLBrace:
+		p.next()
+		name, err := p.expect(tokenIdent, "filter name")
+		if err != nil {
+			return nil, err
+		}
+		if _, err = p.expect(tokenRBrace, "}"); err != nil {
+			return nil, err
+		}
+		return p.variable(name)
+	case tokenIdent:
+		switch strings.ToLower(t.text) {
+		case "and", "or", "not", "in", "contains", "startswith", "endswith":
+			return nil, errorf(t.pos, "unexpected %v", t)
+		}
+		if p.isVar(strings.ToLower(t.text)) {
+			return p.variable(p.next())
+		}
+		return p.parseLiteral()
+	case tokenNumber, tokenHex, tokenString:
+		return p.parseLiteral()
+	default:
+		return nil, errorf(t.pos, "unexpected %v", t)
+	}
+}
+
+func (p *parser) variable(t token) (node, error) {
+	name := strings.ToLower(t.text)
+	if !p.isVar(name) {
+		return nil, errorf(t.pos, "unknown filter %q", t.text)
+	}
+	return &varNode{pos: t.pos, name: name}, nil
+}
+
+func (p *parser) parseList() (node, error) {
+	list := &listNode{pos: p.next().pos}
+	for {
+		elem, err := p.parseLiteral()
+		if err != nil {
+			return nil, err
+		}
+		list.elems = append(list.elems, elem)
+
+		t := p.next()
+		if t.typ == tokenRBracket {
+			return list, nil
+		}
+		if t.typ != tokenComma {
+			return nil, errorf(t.pos, "expected \",\" or \"]\", got %v", t)
+		}
+	}
+}
+
+func (p *parser) parseLiteral() (*literalNode, error) {
+	t := p.next()
+	switch t.typ {
+	case tokenNumber:
+		return &literalNode{pos: t.pos, kind: literalNumber, text: t.text}, nil
+	case tokenHex:
+		return &literalNode{pos: t.pos, kind: literalHex, text: strings.ToLower(t.text)}, nil
+	case tokenString:
+		return &literalNode{pos: t.pos, kind: literalString, text: strings.ToLower(t.text)}, nil
+	case tokenIdent:
+		text := strings.ToLower(t.text)
+		switch {
+		case text == "true" || text == "false":
+			return &literalNode{pos: t.pos, kind: literalBool, text: text}, nil
+		case isHexDigits(text):
+			return &literalNode{pos: t.pos, kind: literalWord, text: text}, nil
+		default:
+			return nil, errorf(t.pos, "unknown filter %q", t.text)
+		}
+	default:
+		return nil, errorf(t.pos, "expected a value, got %v", t)
+	}
+}
@@ -99,6 +99,11 @@ var (
 		Aliases: []string{"wsp", "rpc-port"},
 		Value:   28333,
 	}
+	WSCompressionFlag = &cli.BoolFlag{
+		Name:  "ws-compression",
+		Usage: "negotiates the permessage-deflate compression with the websocket clients supporting it",
+		Value: false,
+	}
 	HTTPPortFlag = &cli.IntFlag{
 		Name:  "http-port",
 		Usage: "port for HTTP server to run on",
@@ -290,6 +295,35 @@ var (
 		Usage: "for gateways only, sets max number of backup tx trace log files retained (0 enables unlimited backups)",
 		Value: 3,
 	}
+	RecordFeedsFlag = &cli.StringSliceFlag{
+		Name:  "record-feeds",
+		Usage: "for gateways only, records the notifications of the feeds (newTxs, newBlocks, bdnBlocks, txReceipts, newBeaconBlocks, bdnBeaconBlocks) to local files, which can be replayed by bxcli replay",
+	}
+	RecordDirFlag = &cli.StringFlag{
+		Name:  "record-dir",
+		Usage: "for gateways only, sets the directory of the feed recording files",
+		Value: "recordings",
+	}
+	RecordFormatFlag = &cli.StringFlag{
+		Name:  "record-format",
+		Usage: "for gateways only, sets the format of the feed recordings, json (JSON lines) or protobuf (length-prefixed messages)",
+		Value: "json",
+	}
+	RecordMaxFileSizeFlag = &cli.IntFlag{
+		Name:  "record-max-file-size",
+		Usage: "for gateways only, sets max size of individual feed recording file (megabytes)",
+		Value: 100,
+	}
+	RecordMaxBackupFilesFlag = &cli.IntFlag{
+		Name:  "record-max-backup-files",
+		Usage: "for gateways only, sets max number of rotated feed recording files retained (0 enables unlimited backups)",
+		Value: 10,
+	}
+	RecordRotationIntervalFlag = &cli.DurationFlag{
+		Name:  "record-rotation-interval",
+		Usage: "for gateways only, rotates the feed recording file at this interval in addition to its max size (0 rotates by size only)",
+		Value: 0,
+	}
 	TxCheckerPoolCapacity = &cli.IntFlag{
 		Name:  "tx-checker-pool-capacity",
 		Usage: "for relays only, sets max number of workers that check transaction if it is valid (0 disable checks)",
@@ -417,6 +451,28 @@ var (
 		Usage: "forwards blockchain RPC methods to the node and returns node response",
 		Value: false,
 	}
+	EthOnBlockMulticallFlag = &cli.BoolFlag{
+		Name:  "eth-on-block-multicall",
+		Usage: "aggregates the eth_call calls of ethOnBlock with a target and no sender, gas or value in one call of the Multicall3 contract",
+		Value: false,
+	}
+	RPCProxyFlag = &cli.BoolFlag{
+		Name:  "rpc-proxy",
+		Usage: "forwards the read only blockchain RPC methods of --rpc-proxy-methods to the synced nodes, caching the results until the next block",
+		Value: false,
+	}
+	RPCProxyMethodsFlag = &cli.StringSliceFlag{
+		Name:  "rpc-proxy-methods",
+		Usage: "sets the blockchain RPC methods forwarded by --rpc-proxy, only eth_, net_ and web3_ read only methods are allowed",
+		Value: cli.NewStringSlice(
+			"eth_blockNumber", "eth_chainId", "eth_gasPrice", "eth_maxPriorityFeePerGas", "eth_feeHistory",
+			"eth_getBalance", "eth_getCode", "eth_getStorageAt", "eth_getTransactionCount", "eth_getProof",
+			"eth_call", "eth_estimateGas", "eth_getLogs", "eth_syncing",
+			"eth_getBlockByNumber", "eth_getBlockByHash", "eth_getBlockTransactionCountByNumber", "eth_getBlockTransactionCountByHash",
+			"eth_getTransactionByHash", "eth_getTransactionByBlockNumberAndIndex", "eth_getTransactionByBlockHashAndIndex", "eth_getTransactionReceipt",
+			"net_version", "net_listening", "net_peerCount", "web3_clientVersion", "web3_sha3",
+		),
+	}
 	DialRatio = &cli.IntFlag{
 		Name:   "dial-ratio",
 		Usage:  "fraction of total peers that are outbound (i.e. 3 will mean 1/3 of total peers should be outbound)",
@@ -462,6 +518,10 @@ var (
 		Value:  false,
 		Hidden: true,
 	}
+	ABIDirFlag = &cli.StringFlag{
+		Name:  "abi-dir",
+		Usage: "directory of contract ABI json files, used to decode and filter transactions calldata",
+	}
 	BSCBundleMinAvgGasFee = &cli.IntFlag{
 		Name:  "bsc-bundle-min-avg-gas-fee",
 		Usage: "provide the minimum gwei gas fee needed for a BSC bundle",
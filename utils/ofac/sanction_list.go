@@ -3,6 +3,8 @@ package ofac
 import (
 	"strings"
 
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
@@ -171,3 +173,29 @@ func ShouldBlockTransaction(transaction *ethtypes.Transaction) ([]string, bool)
 	}
 	return blockedAddresses, true
 }
+
+// ShouldBlockSetCodeTransaction checks the sanction list to see if 'from', 'to', the authorities or the delegated code
+// addresses of the set-code transaction are on block list and returns any blocked addresses for stats
+func ShouldBlockSetCodeTransaction(sender common.Address, transaction *types.SetCodeTx) ([]string, bool) {
+	addresses := append([]common.Address{sender, *transaction.To()}, transaction.Authorities()...)
+	addresses = append(addresses, transaction.DelegatedAddresses()...)
+
+	blockedAddresses := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, address := range addresses {
+		hexAddress := strings.ToLower(address.Hex())
+		if seen[hexAddress] {
+			continue
+		}
+		seen[hexAddress] = true
+
+		if _, found := sanctionList[hexAddress]; found {
+			blockedAddresses = append(blockedAddresses, hexAddress)
+		}
+	}
+
+	if len(blockedAddresses) == 0 {
+		return nil, false
+	}
+	return blockedAddresses, true
+}
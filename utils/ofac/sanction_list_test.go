@@ -3,8 +3,10 @@ package ofac
 import (
 	"testing"
 
+	"github.com/bloXroute-Labs/gateway/v2/test/bxmock"
 	"github.com/bloXroute-Labs/gateway/v2/test/fixtures"
 	types "github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -43,3 +45,20 @@ func TestSanctionList_ShouldBlockTransaction_False(t *testing.T) {
 	assert.Empty(t, addresses)
 	assert.False(t, shouldBlock)
 }
+
+func TestSanctionList_ShouldBlockSetCodeTransaction(t *testing.T) {
+	blockedAddress := "0x8576acc5c05d6ce88f4e49bf65bdf0c62f91353c"
+
+	// the delegated code address is on the sanction list
+	tx := bxmock.NewSignedSetCodeTx(1, nil, nil, common.HexToAddress(blockedAddress))
+	sender, err := tx.Sender()
+	assert.Nil(t, err)
+	addresses, shouldBlock := ShouldBlockSetCodeTransaction(sender, tx)
+	assert.Equal(t, []string{blockedAddress}, addresses)
+	assert.True(t, shouldBlock)
+
+	tx = bxmock.NewSignedSetCodeTx(1, nil, nil, common.HexToAddress("0x00000000000000000000000000000000000000cc"))
+	addresses, shouldBlock = ShouldBlockSetCodeTransaction(sender, tx)
+	assert.Empty(t, addresses)
+	assert.False(t, shouldBlock)
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 
 	log "github.com/bloXroute-Labs/gateway/v2/logger"
+	"github.com/bloXroute-Labs/gateway/v2/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/rlp"
 )
@@ -22,3 +24,21 @@ func ParseRawTransaction(txBytes []byte) (*ethtypes.Transaction, error) {
 	}
 	return &ethTx, nil
 }
+
+// RawTransactionHash parses a raw Ethereum transaction and returns its hash, including the set-code transactions
+// which go-ethereum cannot decode
+func RawTransactionHash(txBytes []byte) (common.Hash, error) {
+	if types.IsSetCodeTx(txBytes) {
+		setCodeTx, err := types.DecodeSetCodeTx(txBytes)
+		if err != nil {
+			return common.Hash{}, fmt.Errorf("could not decode Ethereum transaction: %v", err)
+		}
+		return setCodeTx.Hash(), nil
+	}
+
+	tx, err := ParseRawTransaction(txBytes)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return tx.Hash(), nil
+}